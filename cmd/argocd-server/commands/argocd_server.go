@@ -70,6 +70,11 @@ func NewCommand() *cobra.Command {
 		dexServerPlaintext       bool
 		dexServerStrictTLS       bool
 		staticAssetsDir          string
+		auditLogFile             string
+		auditLogStdout           bool
+		auditWebhookURL          string
+		auditWebhookHeaders      []string
+		auditTrustedProxies      []string
 	)
 	var command = &cobra.Command{
 		Use:               cliName,
@@ -182,6 +187,11 @@ func NewCommand() *cobra.Command {
 				ContentSecurityPolicy: contentSecurityPolicy,
 				RedisClient:           redisClient,
 				StaticAssetsDir:       staticAssetsDir,
+				AuditLogFile:          auditLogFile,
+				AuditLogStdout:        auditLogStdout,
+				AuditWebhookURL:       auditWebhookURL,
+				AuditWebhookHeaders:   auditWebhookHeaders,
+				AuditTrustedProxies:   auditTrustedProxies,
 			}
 
 			stats.RegisterStackDumper()
@@ -232,6 +242,11 @@ func NewCommand() *cobra.Command {
 	command.Flags().BoolVar(&repoServerStrictTLS, "repo-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_REPO_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to repo server")
 	command.Flags().BoolVar(&dexServerPlaintext, "dex-server-plaintext", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_PLAINTEXT", false), "Use a plaintext client (non-TLS) to connect to dex server")
 	command.Flags().BoolVar(&dexServerStrictTLS, "dex-server-strict-tls", env.ParseBoolFromEnv("ARGOCD_SERVER_DEX_SERVER_STRICT_TLS", false), "Perform strict validation of TLS certificates when connecting to dex server")
+	command.Flags().StringVar(&auditLogFile, "audit-log-file", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_FILE", ""), "Append the audit records of mutating API calls as JSON lines to the file at the given path. Enables the audit log API.")
+	command.Flags().BoolVar(&auditLogStdout, "audit-log-stdout", env.ParseBoolFromEnv("ARGOCD_SERVER_AUDIT_LOG_STDOUT", false), "Write the audit records of mutating API calls to stdout")
+	command.Flags().StringVar(&auditWebhookURL, "audit-log-webhook-url", env.StringFromEnv("ARGOCD_SERVER_AUDIT_LOG_WEBHOOK_URL", ""), "Post the audit records of mutating API calls to the webhook with the given URL")
+	command.Flags().StringSliceVar(&auditTrustedProxies, "audit-log-trusted-proxies", env.StringsFromEnv("ARGOCD_SERVER_AUDIT_LOG_TRUSTED_PROXIES", []string{}, ","), "IP addresses or networks in CIDR notation of the proxies in front of the API server, whose X-Forwarded-For header determines the client IP of audit records and sessions")
+	command.Flags().StringArrayVar(&auditWebhookHeaders, "audit-log-webhook-header", env.StringsFromEnv("ARGOCD_SERVER_AUDIT_LOG_WEBHOOK_HEADERS", []string{}, ","), "Header of the requests to the audit log webhook in the form <name>: <value>, e.g. to authenticate (can be repeated multiple times)")
 	tlsConfigCustomizerSrc = tls.AddTLSFlagsToCmd(command)
 	cacheSrc = servercache.AddCacheFlagsToCmd(command, func(client *redis.Client) {
 		redisClient = client
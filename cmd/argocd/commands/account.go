@@ -44,6 +44,13 @@ func NewAccountCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewAccountGenerateTokenCommand(clientOpts))
 	command.AddCommand(NewAccountGetCommand(clientOpts))
 	command.AddCommand(NewAccountDeleteTokenCommand(clientOpts))
+	command.AddCommand(NewAccountCreateCommand(clientOpts))
+	command.AddCommand(NewAccountDeleteCommand(clientOpts))
+	command.AddCommand(NewAccountEnableCommand(clientOpts))
+	command.AddCommand(NewAccountDisableCommand(clientOpts))
+	command.AddCommand(NewAccountResetPasswordCommand(clientOpts))
+	command.AddCommand(NewAccountTOTPCommand(clientOpts))
+	command.AddCommand(NewAccountSessionsCommand(clientOpts))
 	return command
 }
 
@@ -117,7 +124,7 @@ has appropriate RBAC permissions to change other accounts.
 				errors.CheckError(err)
 				claims, err := configCtx.User.Claims()
 				errors.CheckError(err)
-				tokenString := passwordLogin(ctx, acdClient, localconfig.GetUsername(claims.Subject), newPassword)
+				tokenString := passwordLogin(ctx, acdClient, localconfig.GetUsername(claims.Subject), newPassword, "")
 				localCfg.UpsertUser(localconfig.User{
 					Name:      localCfg.CurrentContext,
 					AuthToken: tokenString,
@@ -182,7 +189,8 @@ func NewAccountGetUserInfoCommand(clientOpts *argocdclient.ClientOptions) *cobra
 }
 
 func NewAccountCanICommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
-	return &cobra.Command{
+	var explain bool
+	var command = &cobra.Command{
 		Use:   "can-i ACTION RESOURCE SUBRESOURCE",
 		Short: "Can I",
 		Example: fmt.Sprintf(`
@@ -195,6 +203,9 @@ argocd account can-i update projects 'default'
 # Can I create a cluster?
 argocd account can-i create clusters '*'
 
+# Why can or can't I delete apps in the default project?
+argocd account can-i delete applications 'default/*' --explain
+
 Actions: %v
 Resources: %v
 `, rbacpolicy.Actions, rbacpolicy.Resources),
@@ -209,15 +220,39 @@ Resources: %v
 			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
 			defer io.Close(conn)
 
-			response, err := client.CanI(ctx, &accountpkg.CanIRequest{
+			request := &accountpkg.CanIRequest{
 				Action:      args[0],
 				Resource:    args[1],
 				Subresource: args[2],
-			})
+			}
+			if explain {
+				response, err := client.ExplainCanI(ctx, request)
+				errors.CheckError(err)
+				printCanIExplanation(response)
+				return
+			}
+			response, err := client.CanI(ctx, request)
 			errors.CheckError(err)
 			fmt.Println(response.Value)
 		},
 	}
+	command.Flags().BoolVar(&explain, "explain", false, "Explain which subject, roles and policy decided the permission check")
+	return command
+}
+
+func printCanIExplanation(explanation *accountpkg.CanIExplanation) {
+	allowed := "no"
+	if explanation.Allowed {
+		allowed = "yes"
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "Allowed:\t%s\n", allowed)
+	fmt.Fprintf(w, "Subject:\t%s\n", explanation.Subject)
+	fmt.Fprintf(w, "Groups:\t%s\n", strings.Join(explanation.Groups, ", "))
+	fmt.Fprintf(w, "Roles:\t%s\n", strings.Join(explanation.Roles, ", "))
+	fmt.Fprintf(w, "Policy:\t%s\n", explanation.Policy)
+	fmt.Fprintf(w, "Reason:\t%s\n", explanation.Reason)
+	_ = w.Flush()
 }
 
 func printAccountNames(accounts []*accountpkg.Account) {
@@ -327,12 +362,17 @@ func printAccountDetails(acc *accountpkg.Account) {
 	fmt.Printf(printOpFmtStr, "Name:", acc.Name)
 	fmt.Printf(printOpFmtStr, "Enabled:", strconv.FormatBool(acc.Enabled))
 	fmt.Printf(printOpFmtStr, "Capabilities:", strings.Join(acc.Capabilities, ", "))
+	if acc.PasswordMtime != "" {
+		fmt.Printf(printOpFmtStr, "Password Changed:", acc.PasswordMtime)
+	}
+	fmt.Printf(printOpFmtStr, "Password Change Required:", strconv.FormatBool(acc.PasswordChangeRequired))
+	fmt.Printf(printOpFmtStr, "TOTP Enabled:", strconv.FormatBool(acc.TotpEnabled))
 	fmt.Println("\nTokens:")
 	if len(acc.Tokens) == 0 {
 		fmt.Println("NONE")
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\n")
+		fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\tSCOPES\n")
 		for _, t := range acc.Tokens {
 			expiresAtFormatted := "never"
 			if t.ExpiresAt > 0 {
@@ -343,7 +383,11 @@ func printAccountDetails(acc *accountpkg.Account) {
 				}
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted)
+			scopes := "*"
+			if len(t.Scopes) > 0 {
+				scopes = strings.Join(t.Scopes, "; ")
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", t.Id, time.Unix(t.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted, scopes)
 		}
 		_ = w.Flush()
 	}
@@ -354,6 +398,7 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 		account   string
 		expiresIn string
 		id        string
+		scopes    []string
 	)
 	cmd := &cobra.Command{
 		Use:   "generate-token",
@@ -362,7 +407,10 @@ func NewAccountGenerateTokenCommand(clientOpts *argocdclient.ClientOptions) *cob
 argocd account generate-token
 
 # Generate token for the account with the specified name
-argocd account generate-token --account <account-name>`,
+argocd account generate-token --account <account-name>
+
+# Generate a short-lived token which can only sync the applications of project team-a
+argocd account generate-token --account <account-name> --expires-in 1h --scope "applications, sync, team-a/*"`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -378,6 +426,7 @@ argocd account generate-token --account <account-name>`,
 				Name:      account,
 				ExpiresIn: int64(expiresIn.Seconds()),
 				Id:        id,
+				Scopes:    scopes,
 			})
 			errors.CheckError(err)
 			fmt.Println(response.Token)
@@ -386,6 +435,7 @@ argocd account generate-token --account <account-name>`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	cmd.Flags().StringVarP(&expiresIn, "expires-in", "e", "0s", "Duration before the token will expire. (Default: No expiration)")
 	cmd.Flags().StringVar(&id, "id", "", "Optional token id. Fall back to uuid if not value specified.")
+	cmd.Flags().StringArrayVar(&scopes, "scope", []string{}, "Restrict the token to requests within the scope \"<resource>, <action>, <object>\". Can be repeated. (Default: Unrestricted)")
 	return cmd
 }
 
@@ -423,3 +473,370 @@ argocd account delete-token --account <account-name> ID`,
 	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
 	return cmd
 }
+
+func NewAccountCreateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		password     string
+		capabilities []string
+		disabled     bool
+	)
+	cmd := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create a local account",
+		Long: `
+Create a local account. Accounts with the login capability have to change
+their password on the first login. A temporary password is generated and
+printed if no password is specified.
+`,
+		Example: `# Create an account which can log in, using a generated temporary password
+argocd account create alice
+
+# Create an account which can only generate API keys
+argocd account create ci --capabilities apiKey
+
+# Create a disabled account with the given temporary password
+argocd account create bob --password <password> --disabled`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+			response, err := client.CreateAccount(ctx, &accountpkg.CreateAccountRequest{
+				Name:         args[0],
+				Password:     password,
+				Capabilities: capabilities,
+				Disabled:     disabled,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Account '%s' created\n", response.Account.Name)
+			if response.Password != "" {
+				fmt.Printf("Temporary password: %s\n", response.Password)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&password, "password", "", "Temporary password of the account. Generated if not specified.")
+	cmd.Flags().StringSliceVar(&capabilities, "capabilities", []string{"login"}, "Capabilities of the account. One or more of: login|apiKey")
+	cmd.Flags().BoolVar(&disabled, "disabled", false, "Create the account disabled")
+	return cmd
+}
+
+func NewAccountDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete NAME",
+		Short: "Delete a local account",
+		Example: `# Delete the account alice
+argocd account delete alice`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+			_, err := client.DeleteAccount(ctx, &accountpkg.DeleteAccountRequest{Name: args[0]})
+			errors.CheckError(err)
+			fmt.Printf("Account '%s' deleted\n", args[0])
+		},
+	}
+	return cmd
+}
+
+func NewAccountEnableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newAccountSetEnabledCommand(clientOpts, "enable", "enabled", "Enable a local account", `# Enable the account alice
+argocd account enable alice`, true)
+}
+
+func NewAccountDisableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newAccountSetEnabledCommand(clientOpts, "disable", "disabled", "Disable a local account", `# Disable the account alice
+argocd account disable alice`, false)
+}
+
+func newAccountSetEnabledCommand(clientOpts *argocdclient.ClientOptions, verb string, result string, short string, example string, enabled bool) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     verb + " NAME",
+		Short:   short,
+		Example: example,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+			_, err := client.SetAccountEnabled(ctx, &accountpkg.SetAccountEnabledRequest{Name: args[0], Enabled: enabled})
+			errors.CheckError(err)
+			fmt.Printf("Account '%s' %s\n", args[0], result)
+		},
+	}
+	return cmd
+}
+
+func NewAccountResetPasswordCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		newPassword string
+	)
+	cmd := &cobra.Command{
+		Use:   "reset-password NAME",
+		Short: "Reset the password of a local account",
+		Long: `
+Reset the password of a local account to a temporary password, which has to
+be changed on the next login. Existing sessions of the account are
+invalidated. A temporary password is generated and printed if no password
+is specified.
+`,
+		Example: `# Reset the password of the account alice to a generated temporary password
+argocd account reset-password alice
+
+# Reset the password of the account alice to the given temporary password
+argocd account reset-password alice --new-password <password>`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			conn, client := headless.NewClientOrDie(clientOpts, c).NewAccountClientOrDie()
+			defer io.Close(conn)
+			response, err := client.ResetPassword(ctx, &accountpkg.ResetPasswordRequest{Name: args[0], NewPassword: newPassword})
+			errors.CheckError(err)
+			fmt.Printf("Password of account '%s' reset\n", args[0])
+			if response.Password != "" {
+				fmt.Printf("Temporary password: %s\n", response.Password)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&newPassword, "new-password", "", "Temporary password of the account. Generated if not specified.")
+	return cmd
+}
+
+func NewAccountTOTPCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "totp",
+		Short: "Manage time-based one-time passwords of local accounts",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewAccountTOTPEnrollCommand(clientOpts))
+	command.AddCommand(NewAccountTOTPDisableCommand(clientOpts))
+	return command
+}
+
+func NewAccountTOTPEnrollCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "enroll",
+		Short: "Enroll a TOTP authenticator",
+		Long: `
+Enroll a TOTP authenticator app for a local account. The enrollment is
+confirmed with a one-time password of the authenticator, after which a
+one-time password is required on login. The printed recovery codes can each
+be used once instead of a one-time password.
+`,
+		Example: `# Enroll a TOTP authenticator for the currently logged in account
+argocd account totp enroll
+
+# Enroll a TOTP authenticator for the account with the specified name
+argocd account totp enroll --account <account-name>`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+			enrollment, err := client.EnrollTOTP(ctx, &accountpkg.TOTPRequest{Name: account})
+			errors.CheckError(err)
+			printTOTPEnrollment(enrollment.Secret, enrollment.Url)
+			code := cli.PromptMessage("One-time password", "")
+			recoveryCodes, err := client.ConfirmTOTP(ctx, &accountpkg.TOTPRequest{Name: account, Code: code})
+			errors.CheckError(err)
+			printTOTPRecoveryCodes(recoveryCodes.Codes)
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	return cmd
+}
+
+func NewAccountTOTPDisableCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+		code    string
+	)
+	cmd := &cobra.Command{
+		Use:   "disable",
+		Short: "Disable TOTP",
+		Long: `
+Disable TOTP for a local account. Disabling TOTP for the currently logged in
+account requires a one-time password or recovery code, disabling it for other
+accounts requires the permission to update accounts.
+`,
+		Example: `# Disable TOTP for the currently logged in account
+argocd account totp disable --code <one-time-password>
+
+# Disable TOTP for the account with the specified name, e.g. if the authenticator was lost
+argocd account totp disable --account <account-name>`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+			userInfo := getCurrentAccount(ctx, clientset)
+			if account == "" {
+				account = userInfo.Username
+			}
+			if account == userInfo.Username && userInfo.Iss == sessionutil.SessionManagerClaimsIssuer {
+				code = cli.PromptMessage("One-time password or recovery code", code)
+			}
+			_, err := client.DisableTOTP(ctx, &accountpkg.TOTPRequest{Name: account, Code: code})
+			errors.CheckError(err)
+			fmt.Printf("TOTP disabled for account '%s'\n", account)
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	cmd.Flags().StringVar(&code, "code", "", "One-time password or recovery code, required to disable TOTP for the current account")
+	return cmd
+}
+
+func NewAccountSessionsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "sessions",
+		Short: "Manage the active sessions of accounts",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewAccountSessionsListCommand(clientOpts))
+	command.AddCommand(NewAccountSessionsRevokeCommand(clientOpts))
+	return command
+}
+
+func NewAccountSessionsListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output  string
+		account string
+	)
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the active sessions of an account",
+		Example: `# List the sessions of the currently logged in account
+argocd account sessions list
+
+# List the sessions of the account with the specified name
+argocd account sessions list --account <account-name>`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			response, err := client.ListSessions(ctx, &accountpkg.ListSessionsRequest{Name: account})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(response.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSessionsTable(response.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	return cmd
+}
+
+func printSessionsTable(items []*accountpkg.Session) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ID\tISSUED AT\tEXPIRING AT\tLAST USED\tCLIENT IP\tUSER AGENT\n")
+	for _, s := range items {
+		id := s.Id
+		if s.Current {
+			id = fmt.Sprintf("%s (current)", id)
+		}
+		expiresAtFormatted := "never"
+		if s.ExpiresAt > 0 {
+			expiresAtFormatted = time.Unix(s.ExpiresAt, 0).Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", id, time.Unix(s.IssuedAt, 0).Format(time.RFC3339), expiresAtFormatted,
+			time.Unix(s.LastUsed, 0).Format(time.RFC3339), s.ClientIP, s.UserAgent)
+	}
+	_ = w.Flush()
+}
+
+func NewAccountSessionsRevokeCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		account string
+		all     bool
+	)
+	cmd := &cobra.Command{
+		Use:   "revoke [ID...]",
+		Short: "Revoke active sessions of an account",
+		Example: `# Revoke a session of the currently logged in account
+argocd account sessions revoke ID
+
+# Revoke all sessions of the account with the specified name
+argocd account sessions revoke --account <account-name> --all`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if (len(args) == 0) == !all {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+
+			clientset := headless.NewClientOrDie(clientOpts, c)
+			if account == "" {
+				account = getCurrentAccount(ctx, clientset).Username
+			}
+			conn, client := clientset.NewAccountClientOrDie()
+			defer io.Close(conn)
+
+			ids := args
+			if all {
+				response, err := client.ListSessions(ctx, &accountpkg.ListSessionsRequest{Name: account})
+				errors.CheckError(err)
+				// revoke the current session last, so that the others are revoked if it is one of them
+				var current []string
+				for _, s := range response.Items {
+					if s.Current {
+						current = append(current, s.Id)
+					} else {
+						ids = append(ids, s.Id)
+					}
+				}
+				ids = append(ids, current...)
+			}
+			for _, id := range ids {
+				_, err := client.RevokeSession(ctx, &accountpkg.RevokeSessionRequest{Name: account, Id: id})
+				errors.CheckError(err)
+				fmt.Printf("Session '%s' of account '%s' revoked\n", id, account)
+			}
+		},
+	}
+	cmd.Flags().StringVarP(&account, "account", "a", "", "Account name. Defaults to the current account.")
+	cmd.Flags().BoolVar(&all, "all", false, "Revoke all sessions of the account")
+	return cmd
+}
@@ -3,19 +3,25 @@ package admin
 import (
 	"fmt"
 	"os"
+	"text/tabwriter"
 
+	humanize "github.com/dustin/go-humanize"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	apiv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/clientcmd"
 
 	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/common"
+	argocdclient "github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io"
+	kubeutil "github.com/argoproj/argo-cd/v2/util/kube"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
@@ -33,6 +39,7 @@ func NewRepoCommand() *cobra.Command {
 		},
 	}
 	command.AddCommand(NewGenRepoSpecCommand())
+	command.AddCommand(NewRepoCheckoutsCommand())
 
 	return command
 }
@@ -167,3 +174,116 @@ func NewGenRepoSpecCommand() *cobra.Command {
 	cmdutil.AddRepoFlags(command, &repoOpts)
 	return command
 }
+
+// NewRepoCheckoutsCommand returns a new instance of the `argocd admin repo checkouts` command
+func NewRepoCheckoutsCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "checkouts",
+		Short: "Manage repositories checked out by the repo server",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+		},
+	}
+	command.AddCommand(NewRepoCheckoutsListCommand())
+	command.AddCommand(NewRepoCheckoutsEvictCommand())
+	return command
+}
+
+// NewRepoCheckoutsListCommand returns a new instance of the `argocd admin repo checkouts list` command
+func NewRepoCheckoutsListCommand() *cobra.Command {
+	var (
+		clientConfig      clientcmd.ClientConfig
+		repoServerAddress string
+	)
+	var command = &cobra.Command{
+		Use:   "list",
+		Short: "List repositories checked out on the repo server disk",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			conn, repoClient := newRepoServerClient(clientConfig, repoServerAddress)
+			defer io.Close(conn)
+
+			checkouts, err := repoClient.ListCheckouts(ctx, &argocdclient.ListCheckoutsRequest{})
+			errors.CheckError(err)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+			_, _ = fmt.Fprintf(w, "REPO\tSIZE\tLAST USED\tIN USE\n")
+			for _, checkout := range checkouts.Items {
+				lastUsed := ""
+				if checkout.LastUsed != nil {
+					lastUsed = humanize.Time(checkout.LastUsed.Time)
+				}
+				_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", checkout.Repo, humanize.Bytes(uint64(checkout.SizeBytes)), lastUsed, checkout.InUse)
+			}
+			_ = w.Flush()
+
+			maxSize := "unlimited"
+			if checkouts.MaxSizeBytes > 0 {
+				maxSize = humanize.Bytes(uint64(checkouts.MaxSizeBytes))
+			}
+			printLine("\nTotal size: %s (max: %s)", humanize.Bytes(uint64(checkouts.TotalSizeBytes)), maxSize)
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&repoServerAddress, "repo-server", "", "Repo server address.")
+	return command
+}
+
+// NewRepoCheckoutsEvictCommand returns a new instance of the `argocd admin repo checkouts evict` command
+func NewRepoCheckoutsEvictCommand() *cobra.Command {
+	var (
+		clientConfig      clientcmd.ClientConfig
+		repoServerAddress string
+		all               bool
+	)
+	var command = &cobra.Command{
+		Use:   "evict [REPOURL]",
+		Short: "Remove idle repository checkouts from the repo server disk",
+		Example: `  # Remove the checkout of a repository
+  argocd admin repo checkouts evict https://github.com/argoproj/argocd-example-apps.git
+
+  # Remove all idle checkouts
+  argocd admin repo checkouts evict --all`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) > 1 || (len(args) == 0) != all {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			var repo string
+			if len(args) == 1 {
+				repo = args[0]
+			}
+
+			conn, repoClient := newRepoServerClient(clientConfig, repoServerAddress)
+			defer io.Close(conn)
+
+			res, err := repoClient.EvictCheckouts(ctx, &argocdclient.EvictCheckoutsRequest{Repo: repo})
+			errors.CheckError(err)
+			for _, evicted := range res.Repos {
+				printLine("Evicted checkout of %s", evicted)
+			}
+		},
+	}
+	clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&repoServerAddress, "repo-server", "", "Repo server address.")
+	command.Flags().BoolVar(&all, "all", false, "Evict all idle checkouts")
+	return command
+}
+
+func newRepoServerClient(clientConfig clientcmd.ClientConfig, repoServerAddress string) (io.Closer, argocdclient.RepoServerServiceClient) {
+	if repoServerAddress == "" {
+		namespace, _, err := clientConfig.Namespace()
+		errors.CheckError(err)
+		log.Info("Repo server is not provided, trying to port-forward to argocd-repo-server pod.")
+		overrides := clientcmd.ConfigOverrides{}
+		repoServerPort, err := kubeutil.PortForward(common.DefaultPortRepoServer, namespace, &overrides, "app.kubernetes.io/name=argocd-repo-server")
+		errors.CheckError(err)
+		repoServerAddress = fmt.Sprintf("localhost:%d", repoServerPort)
+	}
+	conn, repoClient, err := argocdclient.NewRepoServerClientset(repoServerAddress, 60, argocdclient.TLSConfiguration{DisableTLS: false, StrictValidation: false}).NewRepoServerClient()
+	errors.CheckError(err)
+	return conn, repoClient
+}
@@ -3,6 +3,7 @@ package admin
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"reflect"
@@ -11,6 +12,7 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	gitopsdiff "github.com/argoproj/gitops-engine/pkg/diff"
 	healthutil "github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
@@ -22,8 +24,11 @@ import (
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/clientcmd"
 
+	cmdutil "github.com/argoproj/argo-cd/v2/cmd/util"
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
 	"github.com/argoproj/argo-cd/v2/util/argo/normalizers"
 	"github.com/argoproj/argo-cd/v2/util/cli"
 	"github.com/argoproj/argo-cd/v2/util/errors"
@@ -388,12 +393,23 @@ func executeResourceOverrideCommand(ctx context.Context, cmdCtx commandContext,
 }
 
 func NewResourceIgnoreDifferencesCommand(cmdCtx commandContext) *cobra.Command {
+	var (
+		livePath              string
+		ignoreDifferencesPath string
+	)
 	var command = &cobra.Command{
 		Use:   "ignore-differences RESOURCE_YAML_PATH",
 		Short: "Renders fields excluded from diffing",
-		Long:  "Renders ignored fields using the 'ignoreDifferences' setting specified in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
+		Long: `Renders ignored fields using the 'ignoreDifferences' setting specified in the 'resource.customizations' field of 'argocd-cm' ConfigMap.
+
+Application level ignore difference rules can be simulated with --ignore-differences. If the live state of the resource
+is given with --live, the diff between the live state and the resource is calculated without and with the rules, and
+the differences ignored by each rule are listed.`,
 		Example: `
-argocd admin settings resource-overrides ignore-differences ./deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
+argocd admin settings resource-overrides ignore-differences ./deploy.yaml --argocd-cm-path ./argocd-cm.yaml
+
+# Simulate the ignore difference rules of an application against the live state of a resource
+argocd admin settings resource-overrides ignore-differences ./deploy.yaml --live ./live-deploy.yaml --ignore-differences ./ignore-differences.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -402,6 +418,11 @@ argocd admin settings resource-overrides ignore-differences ./deploy.yaml --argo
 				os.Exit(1)
 			}
 
+			if livePath != "" || ignoreDifferencesPath != "" {
+				simulateIgnoreDifferences(ctx, cmdCtx, args[0], livePath, ignoreDifferencesPath)
+				return
+			}
+
 			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, override v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
 				gvk := res.GroupVersionKind()
 				if len(override.IgnoreDifferences.JSONPointers) == 0 && len(override.IgnoreDifferences.JQPathExpressions) == 0 {
@@ -434,14 +455,112 @@ argocd admin settings resource-overrides ignore-differences ./deploy.yaml --argo
 			})
 		},
 	}
+	command.Flags().StringVar(&livePath, "live", "", "Path to the live state of the resource")
+	command.Flags().StringVar(&ignoreDifferencesPath, "ignore-differences", "", "Path to a list of application level ignore difference rules to simulate")
 	return command
 }
 
+// simulateIgnoreDifferences prints the fields of the resource ignored by the resource customizations and the given
+// application level ignore difference rules. If the live state is given, it prints the diffs between the live state
+// and the resource without and with the rules instead.
+func simulateIgnoreDifferences(ctx context.Context, cmdCtx commandContext, resPath string, livePath string, ignoreDifferencesPath string) {
+	res := readResource(resPath)
+	rules := []v1alpha1.ResourceIgnoreDifferences{}
+	if ignoreDifferencesPath != "" {
+		data, err := os.ReadFile(ignoreDifferencesPath)
+		errors.CheckError(err)
+		errors.CheckError(yaml.Unmarshal(data, &rules))
+	}
+
+	settingsManager, err := cmdCtx.createSettingsManager(ctx)
+	errors.CheckError(err)
+	overrides, err := settingsManager.GetResourceOverrides()
+	errors.CheckError(err)
+	compareOptions, err := settingsManager.GetResourceCompareOptions()
+	errors.CheckError(err)
+	appLabelKey, err := settingsManager.GetAppInstanceLabelKey()
+	errors.CheckError(err)
+
+	if livePath == "" {
+		diffConfig, err := argodiff.NewDiffConfigBuilder().
+			WithDiffSettings(rules, overrides, compareOptions.IgnoreAggregatedRoles).
+			WithTracking(appLabelKey, string(argo.GetTrackingMethod(settingsManager))).
+			WithNoCache().
+			Build()
+		errors.CheckError(err)
+		var result *argodiff.NormalizationResult
+		logs := collectLogs(func() {
+			result, err = argodiff.Normalize([]*unstructured.Unstructured{nil}, []*unstructured.Unstructured{res}, diffConfig)
+			errors.CheckError(err)
+		})
+		if logs != "" {
+			_, _ = fmt.Println(logs)
+		}
+		if reflect.DeepEqual(res, result.Targets[0]) {
+			_, _ = fmt.Printf("No fields are ignored\n")
+			return
+		}
+		_, _ = fmt.Printf("Following fields are ignored:\n\n")
+		_ = cli.PrintDiff(res.GetName(), res, result.Targets[0])
+		return
+	}
+
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings([]v1alpha1.ResourceIgnoreDifferences{}, overrides, compareOptions.IgnoreAggregatedRoles).
+		WithTracking(appLabelKey, string(argo.GetTrackingMethod(settingsManager))).
+		WithNoCache().
+		Build()
+	errors.CheckError(err)
+	var simulation *argodiff.IgnoreDifferencesSimulation
+	logs := collectLogs(func() {
+		simulation, err = argodiff.SimulateIgnoreDifferences(readResource(livePath), res, rules, diffConfig)
+		errors.CheckError(err)
+	})
+	if logs != "" {
+		_, _ = fmt.Println(logs)
+	}
+
+	for _, rule := range simulation.Rules {
+		if len(rule.IgnoredPaths) == 0 {
+			_, _ = fmt.Printf("Rule %d does not ignore any differences\n", rule.Index)
+			continue
+		}
+		_, _ = fmt.Printf("Rule %d ignores differences of:\n", rule.Index)
+		for _, path := range rule.IgnoredPaths {
+			_, _ = fmt.Printf("  %s\n", path)
+		}
+	}
+	if len(simulation.Rules) == 0 {
+		_, _ = fmt.Printf("No rules apply to '%s/%s'\n", res.GroupVersionKind().Group, res.GetKind())
+	}
+	printSimulatedDiff := func(title string, result gitopsdiff.DiffResult) {
+		if !result.Modified {
+			_, _ = fmt.Printf("\nNo differences %s\n", title)
+			return
+		}
+		live, target := &unstructured.Unstructured{}, &unstructured.Unstructured{}
+		errors.CheckError(json.Unmarshal(result.NormalizedLive, live))
+		errors.CheckError(json.Unmarshal(result.PredictedLive, target))
+		_, _ = fmt.Printf("\nDifferences %s:\n\n", title)
+		_ = cli.PrintDiff(res.GetName(), live, target)
+	}
+	printSimulatedDiff("without ignore rules", simulation.Before)
+	printSimulatedDiff("with ignore rules", simulation.After)
+}
+
+func readResource(path string) *unstructured.Unstructured {
+	data, err := os.ReadFile(path)
+	errors.CheckError(err)
+	res := unstructured.Unstructured{}
+	errors.CheckError(yaml.Unmarshal(data, &res))
+	return &res
+}
+
 func NewResourceHealthCommand(cmdCtx commandContext) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "health RESOURCE_YAML_PATH",
 		Short: "Assess resource health",
-		Long:  "Assess resource health using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
+		Long:  "Assess resource health using the lua script or the health conditions configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
 		Example: `
 argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
@@ -454,7 +573,7 @@ argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path .
 
 			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, override v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
 				gvk := res.GroupVersionKind()
-				if override.HealthLua == "" {
+				if override.HealthLua == "" && override.HealthConditions == nil {
 					_, _ = fmt.Printf("Health script is not configured for '%s/%s'\n", gvk.Group, gvk.Kind)
 					return
 				}
@@ -515,13 +634,16 @@ argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-c
 }
 
 func NewResourceActionRunCommand(cmdCtx commandContext) *cobra.Command {
+	var params []string
 	var command = &cobra.Command{
 		Use:     "run-action RESOURCE_YAML_PATH ACTION",
 		Aliases: []string{"action"},
 		Short:   "Executes resource action",
 		Long:    "Executes resource action using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
 		Example: `
-argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml`,
+argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml
+
+argocd admin settings resource-overrides action run /tmp/deploy.yaml scale --param replicas=3 --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -530,6 +652,8 @@ argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --a
 				os.Exit(1)
 			}
 			action := args[1]
+			givenParams, err := cmdutil.ParseActionParams(params)
+			errors.CheckError(err)
 
 			executeResourceOverrideCommand(ctx, cmdCtx, args, func(res unstructured.Unstructured, override v1alpha1.ResourceOverride, overrides map[string]v1alpha1.ResourceOverride) {
 				gvk := res.GroupVersionKind()
@@ -542,18 +666,34 @@ argocd admin settings resource-overrides action run /tmp/deploy.yaml restart --a
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua)
+				declaredParams, err := luaVM.GetResourceActionParams(&res, action.Name)
+				errors.CheckError(err)
+				actionParams, err := lua.ParseResourceActionParams(declaredParams, givenParams)
 				errors.CheckError(err)
 
-				if reflect.DeepEqual(&res, modifiedRes) {
-					_, _ = fmt.Printf("No fields had been changed by action: \n%s\n", action.Name)
-					return
-				}
+				impactedResources, err := luaVM.ExecuteResourceAction(&res, action.ActionLua, actionParams)
+				errors.CheckError(err)
+
+				for _, impactedResource := range impactedResources {
+					result := impactedResource.UnstructuredObj
+					switch impactedResource.K8SOperation {
+					case lua.PatchOperation:
+						if reflect.DeepEqual(&res, result) {
+							_, _ = fmt.Printf("No fields had been changed by action: \n%s\n", action.Name)
+							continue
+						}
 
-				_, _ = fmt.Printf("Following fields have been changed:\n\n")
-				_ = cli.PrintDiff(res.GetName(), &res, modifiedRes)
+						_, _ = fmt.Printf("Following fields have been changed:\n\n")
+						_ = cli.PrintDiff(res.GetName(), &res, result)
+					case lua.CreateOperation:
+						yamlBytes, err := yaml.Marshal(result.Object)
+						errors.CheckError(err)
+						_, _ = fmt.Printf("Following resource would be created:\n\n%s\n", string(yamlBytes))
+					}
+				}
 			})
 		},
 	}
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Parameter of the action in the form name=value (can be repeated multiple times)")
 	return command
 }
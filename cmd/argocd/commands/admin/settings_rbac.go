@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
@@ -24,6 +26,7 @@ import (
 // Provide a mapping of short-hand resource names to their RBAC counterparts
 var resourceMap map[string]string = map[string]string{
 	"account":     rbacpolicy.ResourceAccounts,
+	"audit":       rbacpolicy.ResourceAuditLogs,
 	"app":         rbacpolicy.ResourceApplications,
 	"apps":        rbacpolicy.ResourceApplications,
 	"application": rbacpolicy.ResourceApplications,
@@ -68,6 +71,14 @@ var validRBACActions map[string]bool = map[string]bool{
 	rbacpolicy.ActionUpdate:   true,
 }
 
+// List of actions which may be narrowed down to resources managed by applications, such as 'delete/Pod/*' or
+// 'action/apps/Deployment/restart'
+var fineGrainedRBACActions map[string]bool = map[string]bool{
+	rbacpolicy.ActionAction: true,
+	rbacpolicy.ActionDelete: true,
+	rbacpolicy.ActionUpdate: true,
+}
+
 // NewRBACCommand is the command for 'rbac'
 func NewRBACCommand() *cobra.Command {
 	var command = &cobra.Command{
@@ -120,6 +131,11 @@ argocd admin settings rbac can some:role create application 'default/app' --name
 # You can override a possibly configured default role
 argocd admin settings rbac can someuser create application 'default/app' --default-role role:readonly
 
+# Check whether role some:role may delete a specific pod of an application, or
+# run the restart action on its deployments
+argocd admin settings rbac can some:role delete/Pod/my-namespace/my-pod application 'default/app' --policy-file policy.csv
+argocd admin settings rbac can some:role action/apps/Deployment/restart application 'default/app' --policy-file policy.csv
+
 `,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
@@ -208,25 +224,26 @@ func NewRBACValidateCommand() *cobra.Command {
 		Short: "Validate RBAC policy",
 		Long: `
 Validates an RBAC policy for being syntactically correct. The policy must be
-a local file, and in either CSV or K8s ConfigMap format.
+a local file, and in either CSV or K8s ConfigMap format. For a ConfigMap, the
+policy.csv key and every policy.<name>.csv fragment are validated separately.
 `,
 		Run: func(c *cobra.Command, args []string) {
-			ctx := c.Context()
-
 			if policyFile == "" {
 				c.HelpFunc()(c, args)
 				log.Fatalf("Please specify policy to validate using --policy-file")
 			}
-			userPolicy, _, _ := getPolicy(ctx, policyFile, nil, "")
-			if userPolicy != "" {
-				if err := rbac.ValidatePolicy(userPolicy); err == nil {
-					fmt.Printf("Policy is valid.\n")
-					os.Exit(0)
-				} else {
+			fragments, err := getPolicyFragmentsFromFile(policyFile)
+			if err != nil {
+				log.Fatalf("could not read policy file: %v", err)
+			}
+			if _, errs := rbac.AggregatePolicyFragments(fragments); len(errs) > 0 {
+				for _, err := range errs {
 					fmt.Printf("Policy is invalid: %v\n", err)
-					os.Exit(1)
 				}
+				os.Exit(1)
 			}
+			fmt.Printf("Policy is valid.\n")
+			os.Exit(0)
 		},
 	}
 
@@ -249,7 +266,11 @@ func getPolicy(ctx context.Context, policyFile string, kubeClient kubernetes.Int
 		if err != nil {
 			log.Fatalf("could not get configmap: %v", err)
 		}
-		userPolicy, defaultRole, matchMode = getPolicyFromConfigMap(cm)
+		policyCMs, err := getSelectedPolicyConfigMaps(ctx, kubeClient, namespace, cm)
+		if err != nil {
+			log.Fatalf("could not get policy configmaps: %v", err)
+		}
+		userPolicy, defaultRole, matchMode = getPolicyFromConfigMap(cm, policyCMs...)
 	}
 
 	return userPolicy, defaultRole, matchMode
@@ -263,18 +284,14 @@ func getPolicyFromFile(policyFile string) (string, string, string, error) {
 		matchMode   string
 	)
 
-	upol, err := ioutil.ReadFile(policyFile)
+	upol, upolCM, err := readPolicyFile(policyFile)
 	if err != nil {
 		log.Fatalf("error opening policy file: %v", err)
 		return "", "", "", err
 	}
 
-	// Try to unmarshal the input file as ConfigMap first. If it succeeds, we
-	// assume config map input. Otherwise, we treat it as
-	var upolCM *corev1.ConfigMap
-	err = yaml.Unmarshal(upol, &upolCM)
-	if err != nil {
-		userPolicy = string(upol)
+	if upolCM == nil {
+		userPolicy = upol
 	} else {
 		userPolicy, defaultRole, matchMode = getPolicyFromConfigMap(upolCM)
 	}
@@ -282,16 +299,51 @@ func getPolicyFromFile(policyFile string) (string, string, string, error) {
 	return userPolicy, defaultRole, matchMode, nil
 }
 
-// Retrieve policy information from a ConfigMap
-func getPolicyFromConfigMap(cm *corev1.ConfigMap) (string, string, string) {
+// readPolicyFile reads a policy file, which is returned as ConfigMap if it is in ConfigMap format
+func readPolicyFile(policyFile string) (string, *corev1.ConfigMap, error) {
+	upol, err := ioutil.ReadFile(policyFile)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Try to unmarshal the input file as ConfigMap first. If it succeeds, we
+	// assume config map input. Otherwise, we treat it as
+	var upolCM *corev1.ConfigMap
+	if err := yaml.Unmarshal(upol, &upolCM); err != nil {
+		return string(upol), nil, nil
+	}
+	return "", upolCM, nil
+}
+
+// getPolicyFragmentsFromFile returns the policy fragments of a policy file
+func getPolicyFragmentsFromFile(policyFile string) ([]rbac.PolicyFragment, error) {
+	upol, upolCM, err := readPolicyFile(policyFile)
+	if err != nil {
+		return nil, err
+	}
+	if upolCM == nil {
+		return []rbac.PolicyFragment{{Source: policyFile, Policy: upol}}, nil
+	}
+	return rbac.GetPolicyFragments(upolCM), nil
+}
+
+// Retrieve policy information from a ConfigMap. The policy fragments of the ConfigMap and of the given
+// additional policy ConfigMaps are aggregated. Like the API server, it refuses policies with invalid fragments.
+func getPolicyFromConfigMap(cm *corev1.ConfigMap, policyCMs ...corev1.ConfigMap) (string, string, string) {
 	var (
-		userPolicy  string
 		defaultRole string
 		ok          bool
 	)
-	userPolicy, ok = cm.Data[rbac.ConfigMapPolicyCSVKey]
-	if !ok {
-		userPolicy = ""
+	fragments := rbac.GetPolicyFragments(cm)
+	for i := range policyCMs {
+		fragments = append(fragments, rbac.GetPolicyFragments(&policyCMs[i])...)
+	}
+	userPolicy, errs := rbac.AggregatePolicyFragments(fragments)
+	if len(errs) > 0 {
+		for _, err := range errs {
+			log.Error(err)
+		}
+		log.Fatalf("RBAC policy is invalid, Argo CD keeps its previous policy")
 	}
 	if defaultRole == "" {
 		defaultRole, ok = cm.Data[rbac.ConfigMapPolicyDefaultKey]
@@ -312,6 +364,28 @@ func getPolicyConfigMap(ctx context.Context, client kubernetes.Interface, namesp
 	return cm, nil
 }
 
+// getSelectedPolicyConfigMaps fetches the additional policy ConfigMaps selected by the RBAC config map
+func getSelectedPolicyConfigMaps(ctx context.Context, client kubernetes.Interface, namespace string, cm *corev1.ConfigMap) ([]corev1.ConfigMap, error) {
+	selector := cm.Data[rbac.ConfigMapPolicyConfigMapSelectorKey]
+	if selector == "" {
+		return nil, nil
+	}
+	cms, err := client.CoreV1().ConfigMaps(namespace).List(ctx, v1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	var policyCMs []corev1.ConfigMap
+	for _, policyCM := range cms.Items {
+		if policyCM.Name != cm.Name {
+			policyCMs = append(policyCMs, policyCM)
+		}
+	}
+	sort.Slice(policyCMs, func(i, j int) bool {
+		return policyCMs[i].Name < policyCMs[j].Name
+	})
+	return policyCMs, nil
+}
+
 // checkPolicy checks whether given subject is allowed to execute specified
 // action against specified resource
 func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPolicy, defaultRole, matchMode string, strict bool) bool {
@@ -348,6 +422,9 @@ func checkPolicy(subject, action, resource, subResource, builtinPolicy, userPoli
 		if !isValidRBACAction(action) {
 			log.Fatalf("error in RBAC request: '%s' is not a valid action name", action)
 		}
+		if isFineGrainedRBACAction(action) && realResource != rbacpolicy.ResourceApplications {
+			log.Fatalf("error in RBAC request: fine-grained action '%s' is only valid for resource '%s'", action, rbacpolicy.ResourceApplications)
+		}
 	}
 
 	// Application resources have a special notation - for simplicity's sake,
@@ -374,10 +451,19 @@ func resolveRBACResourceName(name string) string {
 
 // isValidRBACAction checks whether a given action is a valid RBAC action
 func isValidRBACAction(action string) bool {
+	if isFineGrainedRBACAction(action) {
+		return true
+	}
 	_, ok := validRBACActions[action]
 	return ok
 }
 
+// isFineGrainedRBACAction checks whether a given action is narrowed down to resources managed by applications
+func isFineGrainedRBACAction(action string) bool {
+	parts := strings.SplitN(action, "/", 2)
+	return len(parts) == 2 && parts[1] != "" && fineGrainedRBACActions[parts[0]]
+}
+
 // isValidRBACResource checks whether a given resource is a valid RBAC resource
 func isValidRBACResource(resource string) bool {
 	_, ok := validRBACResources[resource]
@@ -25,6 +25,35 @@ func Test_isValidRBACAction(t *testing.T) {
 		ok := isValidRBACAction("invalid")
 		assert.False(t, ok)
 	})
+	t.Run("fine-grained", func(t *testing.T) {
+		assert.True(t, isValidRBACAction("delete/Pod/*"))
+		assert.True(t, isValidRBACAction("action/apps/Deployment/restart"))
+		assert.False(t, isValidRBACAction("get/Pod/*"))
+		assert.False(t, isValidRBACAction("delete/"))
+	})
+}
+
+func Test_FineGrainedPolicy(t *testing.T) {
+	policy := `
+p, role:dev, applications, delete/Pod/*, default/*, allow
+p, role:dev, applications, action/apps/Deployment/restart, default/*, allow
+p, role:ops, applications, delete, default/*, allow
+p, role:ops, applications, delete/Secret/*, default/*, deny
+`
+	t.Run("Fine-grained delete", func(t *testing.T) {
+		assert.True(t, checkPolicy("role:dev", "delete/Pod/my-ns/my-pod", "applications", "default/app", "", policy, "", "", true))
+		assert.False(t, checkPolicy("role:dev", "delete/apps/Deployment/my-ns/my-deploy", "applications", "default/app", "", policy, "", "", true))
+		assert.False(t, checkPolicy("role:dev", "delete", "applications", "default/app", "", policy, "", "", true))
+	})
+	t.Run("Fine-grained action", func(t *testing.T) {
+		assert.True(t, checkPolicy("role:dev", "action/apps/Deployment/restart", "applications", "default/app", "", policy, "", "", true))
+		assert.False(t, checkPolicy("role:dev", "action/apps/Deployment/pause", "applications", "default/app", "", policy, "", "", true))
+	})
+	t.Run("Inherited from application action", func(t *testing.T) {
+		assert.True(t, checkPolicy("role:ops", "delete/apps/Deployment/my-ns/my-deploy", "applications", "default/app", "", policy, "", "", true))
+		assert.False(t, checkPolicy("role:ops", "delete/Secret/my-ns/my-secret", "applications", "default/app", "", policy, "", "", true))
+		assert.False(t, checkPolicy("role:ops", "update/apps/Deployment/my-ns/my-deploy", "applications", "default/app", "", policy, "", "", true))
+	})
 }
 
 func Test_isValidRBACResource(t *testing.T) {
@@ -158,3 +187,57 @@ p, role:, certificates, get, .*, allow`
 		require.False(t, ok)
 	})
 }
+
+func Test_PolicyFromK8sWithFragments(t *testing.T) {
+	ctx := context.Background()
+
+	kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-rbac-cm",
+			Namespace: "argocd",
+		},
+		Data: map[string]string{
+			"policy.csv":               "p, role:user, applications, get, */*, allow",
+			"policy.team-a.csv":        "p, role:team-a, applications, sync, team-a/*, allow",
+			"policy.configMapSelector": "argocd.argoproj.io/rbac-policy=true",
+		},
+	}, &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "team-b-rbac",
+			Namespace: "argocd",
+			Labels:    map[string]string{"argocd.argoproj.io/rbac-policy": "true"},
+		},
+		Data: map[string]string{
+			"policy.csv": "p, role:team-b, applications, sync, team-b/*, allow",
+		},
+	}, &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "unselected",
+			Namespace: "argocd",
+		},
+		Data: map[string]string{
+			"policy.csv": "p, role:team-c, applications, sync, team-c/*, allow",
+		},
+	})
+	uPol, _, _ := getPolicy(ctx, "", kubeclientset, "argocd")
+
+	assert.True(t, checkPolicy("role:user", "get", "applications", "team-a/app", "", uPol, "", "", true))
+	assert.True(t, checkPolicy("role:team-a", "sync", "applications", "team-a/app", "", uPol, "", "", true))
+	assert.True(t, checkPolicy("role:team-b", "sync", "applications", "team-b/app", "", uPol, "", "", true))
+	assert.False(t, checkPolicy("role:team-c", "sync", "applications", "team-c/app", "", uPol, "", "", true))
+}
+
+func Test_PolicyFragmentsFromFile(t *testing.T) {
+	fragments, err := getPolicyFragmentsFromFile("testdata/rbac/policy.csv")
+	require.NoError(t, err)
+	require.Len(t, fragments, 1)
+	assert.Equal(t, "testdata/rbac/policy.csv", fragments[0].Source)
+
+	fragments, err = getPolicyFragmentsFromFile("testdata/rbac/argocd-rbac-cm.yaml")
+	require.NoError(t, err)
+	require.Len(t, fragments, 1)
+	assert.Equal(t, "argocd-rbac-cm/policy.csv", fragments[0].Source)
+
+	_, err = getPolicyFragmentsFromFile("testdata/rbac/missing.csv")
+	assert.Error(t, err)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/argoproj/argo-cd/v2/common"
@@ -298,6 +299,49 @@ func TestResourceOverrideIgnoreDifferences(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, out, "< spec:")
 	})
+
+	rules, rulesCloser, err := tempFile(`- group: apps
+  kind: Deployment
+  jsonPointers:
+  - /spec/replicas
+- kind: Service
+  jsonPointers:
+  - /spec`)
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer utils.Close(rulesCloser)
+
+	t.Run("SimulatedRules", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"ignore-differences", f, "--ignore-differences", rules})
+			err := cmd.Execute()
+			assert.NoError(t, err)
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, out, "Following fields are ignored")
+		assert.Contains(t, out, "<   replicas: 0")
+	})
+
+	t.Run("SimulatedRulesWithLive", func(t *testing.T) {
+		live, liveCloser, err := tempFile(strings.Replace(testDeploymentYAML, "replicas: 0", "replicas: 3", 1))
+		if !assert.NoError(t, err) {
+			return
+		}
+		defer utils.Close(liveCloser)
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"ignore-differences", f, "--live", live, "--ignore-differences", rules})
+			err := cmd.Execute()
+			assert.NoError(t, err)
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, out, "Rule 0 ignores differences of:\n  /spec/replicas")
+		assert.NotContains(t, out, "Rule 1")
+		assert.Contains(t, out, "Differences without ignore rules")
+		assert.Contains(t, out, "No differences with ignore rules")
+	})
 }
 
 func TestResourceOverrideHealth(t *testing.T) {
@@ -333,6 +377,22 @@ func TestResourceOverrideHealth(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Contains(t, out, "Progressing")
 	})
+
+	t.Run("HealthConditionsConfigured", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations": `apps/Deployment:
+  health.conditions: |
+    conditionType: Available
+`}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"health", f})
+			err := cmd.Execute()
+			assert.NoError(t, err)
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, out, "STATUS: Progressing")
+		assert.Contains(t, out, "MESSAGE: Waiting for Available condition")
+	})
 }
 
 func TestResourceOverrideAction(t *testing.T) {
@@ -388,4 +448,34 @@ restart  false
 resume   false
 `)
 	})
+
+	t.Run("ActionWithParamsAndCreate", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(map[string]string{
+			"resource.customizations": `apps/Deployment:
+  actions: |
+    discovery.lua: |
+      actions = {}
+      actions["backup"] = {["params"] = {{["name"] = "suffix"}, {["name"] = "replicas", ["type"] = "number", ["default"] = "1"}}}
+      return actions
+    definitions:
+    - name: backup
+      action.lua: |
+        copy = {}
+        copy.apiVersion = obj.apiVersion
+        copy.kind = obj.kind
+        copy.metadata = {name = obj.metadata.name .. "-" .. actionParams["suffix"], namespace = obj.metadata.namespace}
+        copy.spec = obj.spec
+        copy.spec.replicas = actionParams["replicas"]
+        return {{operation = "create", resource = copy}}
+`}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"run-action", f, "backup", "--param", "suffix=copy", "--param", "replicas=2"})
+			err := cmd.Execute()
+			assert.NoError(t, err)
+		})
+		assert.NoError(t, err)
+		assert.Contains(t, out, "Following resource would be created")
+		assert.Contains(t, out, "name: nginx-deployment-copy")
+		assert.Contains(t, out, "replicas: 2")
+	})
 }
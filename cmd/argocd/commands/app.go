@@ -46,6 +46,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
 	"github.com/argoproj/argo-cd/v2/util/cli"
+	"github.com/argoproj/argo-cd/v2/util/cmp"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	"github.com/argoproj/argo-cd/v2/util/grpc"
@@ -95,6 +96,7 @@ func NewApplicationCommand(clientOpts *argocdclient.ClientOptions) *cobra.Comman
 	command.AddCommand(NewApplicationPatchResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationDeleteResourceCommand(clientOpts))
 	command.AddCommand(NewApplicationResourceActionsCommand(clientOpts))
+	command.AddCommand(NewApplicationOrphansCommand(clientOpts))
 	command.AddCommand(NewApplicationListResourcesCommand(clientOpts))
 	command.AddCommand(NewApplicationLogsCommand(clientOpts))
 	return command
@@ -496,6 +498,16 @@ func printAppSummaryTable(app *argoappv1.Application, appURL string, windows *ar
 		syncStatusStr += fmt.Sprintf(" (%s)", app.Status.Sync.Revision[0:7])
 	}
 	fmt.Printf(printOpFmtStr, "Sync Status:", syncStatusStr)
+	if app.Status.Sync.TagSignatureInfo != "" {
+		fmt.Printf(printOpFmtStr, "Tag Signature:", app.Status.Sync.TagSignatureInfo)
+	}
+	if app.Status.Sync.ChartVersion != "" {
+		chartVersionStr := app.Status.Sync.ChartVersion
+		if app.Status.Sync.ChartVersionConstraint != "" {
+			chartVersionStr += fmt.Sprintf(" (constraint %s)", app.Status.Sync.ChartVersionConstraint)
+		}
+		fmt.Printf(printOpFmtStr, "Chart Version:", chartVersionStr)
+	}
 	healthStr := string(app.Status.Health.Status)
 	if app.Status.Health.Message != "" {
 		healthStr = fmt.Sprintf("%s (%s)", app.Status.Health.Status, app.Status.Health.Message)
@@ -507,6 +519,9 @@ func printAppSourceDetails(appSrc *argoappv1.ApplicationSource) {
 	if appSrc.Helm != nil && len(appSrc.Helm.ValueFiles) > 0 {
 		fmt.Printf(printOpFmtStr, "Helm Values:", strings.Join(appSrc.Helm.ValueFiles, ","))
 	}
+	if appSrc.Helm != nil && appSrc.Helm.PinnedChartVersion != "" {
+		fmt.Printf(printOpFmtStr, "Pinned Chart Version:", appSrc.Helm.PinnedChartVersion)
+	}
 	if appSrc.Kustomize != nil && appSrc.Kustomize.NamePrefix != "" {
 		fmt.Printf(printOpFmtStr, "Name Prefix:", appSrc.Kustomize.NamePrefix)
 	}
@@ -634,6 +649,7 @@ type unsetOpts struct {
 	ignoreMissingValueFiles bool
 	pluginEnvs              []string
 	passCredentials         bool
+	pinnedChartVersion      bool
 }
 
 // NewApplicationUnsetCommand returns a new instance of an `argocd app unset` command
@@ -693,6 +709,7 @@ func NewApplicationUnsetCommand(clientOpts *argocdclient.ClientOptions) *cobra.C
 	command.Flags().StringArrayVar(&opts.kustomizeImages, "kustomize-image", []string{}, "Kustomize images name (e.g. --kustomize-image node --kustomize-image mysql)")
 	command.Flags().StringArrayVar(&opts.pluginEnvs, "plugin-env", []string{}, "Unset plugin env variables (e.g --plugin-env name)")
 	command.Flags().BoolVar(&opts.passCredentials, "pass-credentials", false, "Unset passCredentials")
+	command.Flags().BoolVar(&opts.pinnedChartVersion, "pinned-chart-version", false, "Unset the pinned chart version, deploying the newest version satisfying the revision constraint")
 	return command
 }
 
@@ -732,7 +749,7 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 		}
 	}
 	if source.Helm != nil {
-		if len(opts.parameters) == 0 && len(opts.valuesFiles) == 0 && !opts.valuesLiteral && !opts.ignoreMissingValueFiles && !opts.passCredentials {
+		if len(opts.parameters) == 0 && len(opts.valuesFiles) == 0 && !opts.valuesLiteral && !opts.ignoreMissingValueFiles && !opts.passCredentials && !opts.pinnedChartVersion {
 			return false, true
 		}
 		for _, paramStr := range opts.parameters {
@@ -767,6 +784,10 @@ func unset(source *argoappv1.ApplicationSource, opts unsetOpts) (updated bool, n
 			source.Helm.PassCredentials = false
 			updated = true
 		}
+		if opts.pinnedChartVersion && source.Helm.PinnedChartVersion != "" {
+			source.Helm.PinnedChartVersion = ""
+			updated = true
+		}
 	}
 	if source.Plugin != nil {
 		if len(opts.pluginEnvs) == 0 {
@@ -867,12 +888,13 @@ type objKeyLiveTarget struct {
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
 func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		refresh       bool
-		hardRefresh   bool
-		exitCode      bool
-		local         string
-		revision      string
-		localRepoRoot string
+		refresh            bool
+		hardRefresh        bool
+		exitCode           bool
+		local              string
+		revision           string
+		localRepoRoot      string
+		serverSideGenerate bool
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	var command = &cobra.Command{
@@ -892,6 +914,23 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			appName := args[0]
 			app, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{Name: &appName, Refresh: getRefreshType(refresh, hardRefresh)})
 			errors.CheckError(err)
+			if serverSideGenerate {
+				q := applicationpkg.ApplicationServerSideDiffQuery{Name: &appName}
+				if local != "" {
+					res, err := getServerSideLocalManifests(ctx, appIf, appName, local)
+					errors.CheckError(err)
+					q.Manifests = res.Manifests
+				} else if revision != "" {
+					q.Revision = &revision
+				}
+				res, err := appIf.ServerSideDiff(ctx, &q)
+				errors.CheckError(err)
+				foundDiffs := printServerSideDiff(res.Items)
+				if foundDiffs && exitCode {
+					os.Exit(1)
+				}
+				return
+			}
 			resources, err := appIf.ManagedResources(ctx, &applicationpkg.ResourcesQuery{ApplicationName: &appName})
 			errors.CheckError(err)
 			conn, settingsIf := clientset.NewSettingsClientOrDie()
@@ -930,9 +969,64 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().StringVar(&local, "local", "", "Compare live app to a local manifests")
 	command.Flags().StringVar(&revision, "revision", "", "Compare live app to a particular revision")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
+	command.Flags().BoolVar(&serverSideGenerate, "server-side-generate", false, "Generate the target state and compute the diff on the server. Used together with --local streams the files of the local repository root to the server to generate the manifests of the application path")
 	return command
 }
 
+// getServerSideLocalManifests streams the files of a local directory to the server, which generates the manifests of
+// the application from them
+func getServerSideLocalManifests(ctx context.Context, appIf applicationpkg.ApplicationServiceClient, appName, local string) (*repoapiclient.ManifestResponse, error) {
+	stream, err := appIf.GetManifestsWithFiles(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error opening manifests stream: %w", err)
+	}
+	sendMetadata := func(checksum string, _ int64) error {
+		return stream.Send(&applicationpkg.ApplicationManifestQueryWithFilesWrapper{
+			Part: &applicationpkg.ApplicationManifestQueryWithFilesWrapper_Query{
+				Query: &applicationpkg.ApplicationManifestQueryWithFiles{Name: &appName, Checksum: &checksum},
+			},
+		})
+	}
+	sendChunk := func(chunk []byte) error {
+		return stream.Send(&applicationpkg.ApplicationManifestQueryWithFilesWrapper{
+			Part: &applicationpkg.ApplicationManifestQueryWithFilesWrapper_Chunk{
+				Chunk: &applicationpkg.FileChunk{Chunk: chunk},
+			},
+		})
+	}
+	err = cmp.SendCompressedFiles(ctx, local, []string{".git"}, sendMetadata, sendChunk)
+	if err != nil {
+		return nil, fmt.Errorf("error sending local files: %w", err)
+	}
+	return stream.CloseAndRecv()
+}
+
+// printServerSideDiff prints the diffs calculated by the server, returns true if a difference is found
+func printServerSideDiff(items []*argoappv1.ResourceDiff) bool {
+	var foundDiffs bool
+	for _, item := range items {
+		if !item.Modified {
+			continue
+		}
+		var live, target *unstructured.Unstructured
+		err := json.Unmarshal([]byte(item.NormalizedLiveState), &live)
+		errors.CheckError(err)
+		if live != nil {
+			err = json.Unmarshal([]byte(item.PredictedLiveState), &target)
+		} else {
+			err = json.Unmarshal([]byte(item.TargetState), &target)
+		}
+		errors.CheckError(err)
+		if live != nil && item.TargetState == "null" {
+			target = nil
+		}
+		fmt.Printf("\n===== %s/%s %s/%s ======\n", item.Group, item.Kind, item.Namespace, item.Name)
+		foundDiffs = true
+		_ = cli.PrintDiff(item.Name, live, target)
+	}
+	return foundDiffs
+}
+
 // DifferenceOption struct to store diff options
 type DifferenceOption struct {
 	local         string
@@ -2055,10 +2149,11 @@ func printOperationResult(opState *argoappv1.OperationState) {
 // NewApplicationManifestsCommand returns a new instance of an `argocd app manifests` command
 func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		source        string
-		revision      string
-		local         string
-		localRepoRoot string
+		source             string
+		revision           string
+		local              string
+		localRepoRoot      string
+		serverSideGenerate bool
 	)
 	var command = &cobra.Command{
 		Use:   "manifests APPNAME",
@@ -2080,7 +2175,15 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 			var unstructureds []*unstructured.Unstructured
 			switch source {
 			case "git":
-				if local != "" {
+				if local != "" && serverSideGenerate {
+					res, err := getServerSideLocalManifests(ctx, appIf, appName, local)
+					errors.CheckError(err)
+					for _, mfst := range res.Manifests {
+						obj, err := argoappv1.UnmarshalToUnstructured(mfst)
+						errors.CheckError(err)
+						unstructureds = append(unstructureds, obj)
+					}
+				} else if local != "" {
 					app, err := appIf.Get(context.Background(), &applicationpkg.ApplicationQuery{Name: &appName})
 					errors.CheckError(err)
 
@@ -2132,6 +2235,7 @@ func NewApplicationManifestsCommand(clientOpts *argocdclient.ClientOptions) *cob
 	command.Flags().StringVar(&revision, "revision", "", "Show manifests at a specific revision")
 	command.Flags().StringVar(&local, "local", "", "If set, show locally-generated manifests. Value is the absolute path to app manifests within the manifest repo. Example: '/home/username/apps/env/app-1'.")
 	command.Flags().StringVar(&localRepoRoot, "local-repo-root", ".", "Path to the local repository root. Used together with --local allows setting the repository root. Example: '/home/username/apps'.")
+	command.Flags().BoolVar(&serverSideGenerate, "server-side-generate", false, "Used together with --local, streams the files of the local repository root to the server which generates the manifests with the source configuration of the application")
 	return command
 }
 
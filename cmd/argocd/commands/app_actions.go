@@ -17,6 +17,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/io"
 )
@@ -27,6 +28,7 @@ type DisplayedAction struct {
 	Name     string
 	Action   string
 	Disabled bool
+	Params   []v1alpha1.ResourceActionParam `json:",omitempty"`
 }
 
 // NewApplicationResourceActionsCommand returns a new instance of an `argocd app actions` command
@@ -89,6 +91,7 @@ func NewApplicationResourceActionsListCommand(clientOpts *argocdclient.ClientOpt
 					Name:     obj.GetName(),
 					Action:   action.Name,
 					Disabled: action.Disabled,
+					Params:   action.Params,
 				}
 				availableActions = append(availableActions, displayAction)
 			}
@@ -128,9 +131,15 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	var kind string
 	var group string
 	var all bool
+	var params []string
 	var command = &cobra.Command{
 		Use:   "run APPNAME ACTION",
 		Short: "Runs an available action on resource(s)",
+		Example: `  # Restart all deployments of an application
+  argocd app actions run my-app restart --kind Deployment --all
+
+  # Run an action which accepts parameters
+  argocd app actions run my-app scale --kind Deployment --resource-name my-deployment --param replicas=3`,
 	}
 
 	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
@@ -139,6 +148,7 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 	command.Flags().StringVar(&group, "group", "", "Group")
 	errors.CheckError(command.MarkFlagRequired("kind"))
 	command.Flags().BoolVar(&all, "all", false, "Indicates whether to run the action on multiple matching resources")
+	command.Flags().StringArrayVar(&params, "param", []string{}, "Parameter of the action in the form name=value (can be repeated multiple times)")
 
 	command.Run = func(c *cobra.Command, args []string) {
 		ctx := c.Context()
@@ -149,6 +159,12 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 		}
 		appName := args[0]
 		actionName := args[1]
+		actionParams, err := util.ParseActionParams(params)
+		errors.CheckError(err)
+		var actionParamPtrs []*v1alpha1.ResourceActionParam
+		for i := range actionParams {
+			actionParamPtrs = append(actionParamPtrs, &actionParams[i])
+		}
 
 		conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
 		defer io.Close(conn)
@@ -175,6 +191,7 @@ func NewApplicationResourceActionsRunCommand(clientOpts *argocdclient.ClientOpti
 				Kind:         pointer.String(gvk.Kind),
 				Version:      pointer.String(gvk.GroupVersion().Version),
 				Action:       pointer.String(actionName),
+				Params:       actionParamPtrs,
 			})
 			errors.CheckError(err)
 		}
@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+
+	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/errors"
+	argoio "github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// NewApplicationOrphansCommand returns a new instance of an `argocd app orphans` command
+func NewApplicationOrphansCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "orphans",
+		Short: "Manage orphaned resources of an application",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationOrphansListCommand(clientOpts))
+	command.AddCommand(NewApplicationOrphansAdoptCommand(clientOpts))
+	command.AddCommand(NewApplicationOrphansDeleteCommand(clientOpts))
+	return command
+}
+
+// NewApplicationOrphansListCommand returns a new instance of an `argocd app orphans list` command
+func NewApplicationOrphansListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	var command = &cobra.Command{
+		Use:   "list APPNAME",
+		Short: "List orphaned resources in the application destination namespaces",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			res, err := appIf.ListOrphanedResources(ctx, &applicationpkg.ApplicationOrphanedResourcesQuery{Name: &appName})
+			errors.CheckError(err)
+			switch output {
+			case "yaml":
+				yamlBytes, err := yaml.Marshal(res.Items)
+				errors.CheckError(err)
+				fmt.Println(string(yamlBytes))
+			case "json":
+				jsonBytes, err := json.MarshalIndent(res.Items, "", "  ")
+				errors.CheckError(err)
+				fmt.Println(string(jsonBytes))
+			case "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\n")
+				for _, node := range res.Items {
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node.Group, node.Kind, node.Namespace, node.Name)
+				}
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "out", "o", "", "Output format. One of: yaml, json")
+	return command
+}
+
+// NewApplicationOrphansAdoptCommand returns a new instance of an `argocd app orphans adopt` command
+func NewApplicationOrphansAdoptCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newApplicationOrphansActionCommand(clientOpts, "adopt", "adopted", "Adopt orphaned resources by setting the application tracking metadata", `  # Preview which deployments would be adopted
+  argocd app orphans adopt my-app --kind Deployment --all --dry-run
+
+  # Adopt a single config map
+  argocd app orphans adopt my-app --kind ConfigMap --resource-name my-config`,
+		func(appIf applicationpkg.ApplicationServiceClient) orphansActionFunc {
+			return appIf.AdoptOrphanedResources
+		})
+}
+
+// NewApplicationOrphansDeleteCommand returns a new instance of an `argocd app orphans delete` command
+func NewApplicationOrphansDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newApplicationOrphansActionCommand(clientOpts, "delete", "deleted", "Delete orphaned resources", `  # Preview which orphaned resources would be deleted
+  argocd app orphans delete my-app --all --dry-run
+
+  # Delete a single deployment
+  argocd app orphans delete my-app --group apps --kind Deployment --resource-name my-deployment`,
+		func(appIf applicationpkg.ApplicationServiceClient) orphansActionFunc {
+			return appIf.DeleteOrphanedResources
+		})
+}
+
+type orphansActionFunc func(ctx context.Context, in *applicationpkg.ApplicationOrphanedResourcesActionRequest, opts ...grpc.CallOption) (*applicationpkg.ApplicationOrphanedResourcesActionResponse, error)
+
+func newApplicationOrphansActionCommand(clientOpts *argocdclient.ClientOptions, verb string, result string, short string, example string, getAction func(appIf applicationpkg.ApplicationServiceClient) orphansActionFunc) *cobra.Command {
+	var (
+		namespace    string
+		resourceName string
+		kind         string
+		group        string
+		all          bool
+		dryRun       bool
+	)
+	var command = &cobra.Command{
+		Use:     verb + " APPNAME",
+		Short:   short,
+		Example: example,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appName := args[0]
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer argoio.Close(conn)
+			orphans, err := appIf.ListOrphanedResources(ctx, &applicationpkg.ApplicationOrphanedResourcesQuery{Name: &appName})
+			errors.CheckError(err)
+			selected, err := filterOrphanedResources(orphans.Items, group, kind, namespace, resourceName, all)
+			errors.CheckError(err)
+			res, err := getAction(appIf)(ctx, &applicationpkg.ApplicationOrphanedResourcesActionRequest{
+				Name:      &appName,
+				Resources: selected,
+				DryRun:    &dryRun,
+			})
+			errors.CheckError(err)
+			printOrphansActionResult(result, res)
+		},
+	}
+	command.Flags().StringVar(&resourceName, "resource-name", "", "Name of resource")
+	command.Flags().StringVar(&kind, "kind", "", "Kind")
+	command.Flags().StringVar(&group, "group", "", "Group")
+	command.Flags().StringVar(&namespace, "namespace", "", "Namespace")
+	command.Flags().BoolVar(&all, "all", false, "Indicates whether to "+verb+" multiple matching orphaned resources")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the orphaned resources which would be affected without modifying them")
+	return command
+}
+
+// filterOrphanedResources returns the orphaned resources matching the given filters. Multiple matches are only
+// allowed if all is set.
+func filterOrphanedResources(orphans []*v1alpha1.ResourceNode, group, kind, namespace, resourceName string, all bool) ([]*v1alpha1.SyncOperationResource, error) {
+	var selected []*v1alpha1.SyncOperationResource
+	for _, node := range orphans {
+		if (group != "" && node.Group != group) || (kind != "" && node.Kind != kind) || (namespace != "" && node.Namespace != namespace) || (resourceName != "" && node.Name != resourceName) {
+			continue
+		}
+		selected = append(selected, &v1alpha1.SyncOperationResource{Group: node.Group, Kind: node.Kind, Namespace: node.Namespace, Name: node.Name})
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no matching orphaned resource found")
+	}
+	if len(selected) > 1 && !all {
+		return nil, fmt.Errorf("multiple orphaned resources match the filter, use --all to select all of them")
+	}
+	return selected, nil
+}
+
+func printOrphansActionResult(result string, res *applicationpkg.ApplicationOrphanedResourcesActionResponse) {
+	suffix := ""
+	if res.GetDryRun() {
+		suffix = " (dry run)"
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintf(w, "GROUP\tKIND\tNAMESPACE\tNAME\tRESULT\n")
+	for _, ref := range res.Items {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", ref.Group, ref.Kind, ref.Namespace, ref.Name, result+suffix)
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,46 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+func TestFilterOrphanedResources(t *testing.T) {
+	orphans := []*v1alpha1.ResourceNode{
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "deploy1"}},
+		{ResourceRef: v1alpha1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "deploy2"}},
+		{ResourceRef: v1alpha1.ResourceRef{Kind: "ConfigMap", Namespace: "ns", Name: "config"}},
+	}
+
+	selected, err := filterOrphanedResources(orphans, "", "ConfigMap", "", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, []*v1alpha1.SyncOperationResource{{Kind: "ConfigMap", Namespace: "ns", Name: "config"}}, selected)
+
+	_, err = filterOrphanedResources(orphans, "apps", "Deployment", "", "", false)
+	assert.ErrorContains(t, err, "use --all")
+
+	selected, err = filterOrphanedResources(orphans, "apps", "Deployment", "", "", true)
+	require.NoError(t, err)
+	assert.Len(t, selected, 2)
+
+	_, err = filterOrphanedResources(orphans, "", "Service", "", "", true)
+	assert.ErrorContains(t, err, "no matching orphaned resource")
+}
+
+func TestPrintOrphansActionResult(t *testing.T) {
+	output, err := captureOutput(func() error {
+		printOrphansActionResult("adopted", &applicationpkg.ApplicationOrphanedResourcesActionResponse{
+			Items:  []*v1alpha1.ResourceRef{{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "deploy1"}},
+			DryRun: pointer.Bool(true),
+		})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "GROUP  KIND        NAMESPACE  NAME     RESULT\napps   Deployment  ns         deploy1  adopted (dry run)\n", output)
+}
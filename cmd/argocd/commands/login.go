@@ -18,6 +18,7 @@ import (
 	"github.com/skratchdot/open-golang/open"
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
+	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v2/cmd/argocd/commands/headless"
 	argocdclient "github.com/argoproj/argo-cd/v2/pkg/apiclient"
@@ -31,16 +32,19 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/localconfig"
 	oidcutil "github.com/argoproj/argo-cd/v2/util/oidc"
 	"github.com/argoproj/argo-cd/v2/util/rand"
+	sessionutil "github.com/argoproj/argo-cd/v2/util/session"
 )
 
 // NewLoginCommand returns a new instance of `argocd login` command
 func NewLoginCommand(globalClientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		ctxName  string
-		username string
-		password string
-		sso      bool
-		ssoPort  int
+		ctxName       string
+		username      string
+		password      string
+		otp           string
+		exchangeToken string
+		sso           bool
+		ssoPort       int
 	)
 	var command = &cobra.Command{
 		Use:   "login SERVER",
@@ -52,6 +56,9 @@ argocd login cd.argoproj.io
 # Login to Argo CD using SSO
 argocd login cd.argoproj.io --sso
 
+# Login to Argo CD by exchanging the OIDC token of a CI job, e.g. of GitHub Actions
+argocd login cd.argoproj.io --exchange-token "$ID_TOKEN"
+
 # Configure direct access using Kubernetes API server
 argocd login cd.argoproj.io --core`,
 		Run: func(c *cobra.Command, args []string) {
@@ -119,8 +126,10 @@ argocd login cd.argoproj.io --core`,
 				acdClient := headless.NewClientOrDie(&clientOpts, c)
 				setConn, setIf := acdClient.NewSettingsClientOrDie()
 				defer io.Close(setConn)
-				if !sso {
-					tokenString = passwordLogin(ctx, acdClient, username, password)
+				if exchangeToken != "" {
+					tokenString = exchangeTokenLogin(ctx, acdClient, exchangeToken)
+				} else if !sso {
+					tokenString = passwordLogin(ctx, acdClient, username, password, otp)
 				} else {
 					httpClient, err := acdClient.HTTPClient()
 					errors.CheckError(err)
@@ -174,6 +183,8 @@ argocd login cd.argoproj.io --core`,
 	command.Flags().StringVar(&ctxName, "name", "", "name to use for the context")
 	command.Flags().StringVar(&username, "username", "", "the username of an account to authenticate")
 	command.Flags().StringVar(&password, "password", "", "the password of an account to authenticate")
+	command.Flags().StringVar(&otp, "otp", "", "the one-time password or a recovery code of an account with TOTP enabled")
+	command.Flags().StringVar(&exchangeToken, "exchange-token", "", "an OIDC token of an issuer configured for token exchange, e.g. the ID token of a CI job, to exchange for a session")
 	command.Flags().BoolVar(&sso, "sso", false, "perform SSO login")
 	command.Flags().IntVar(&ssoPort, "sso-port", DefaultSSOLocalPort, "port to run local OAuth2 login application")
 	return command
@@ -330,15 +341,68 @@ func oauth2Login(ctx context.Context, port int, oidcSettings *settingspkg.OIDCCo
 	return tokenString, refreshToken
 }
 
-func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username, password string) string {
+// exchangeTokenLogin exchanges the token of an external issuer for an Argo CD session
+func exchangeTokenLogin(ctx context.Context, acdClient argocdclient.Client, token string) string {
+	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
+	defer io.Close(sessConn)
+	createdSession, err := sessionIf.ExchangeToken(ctx, &sessionpkg.SessionExchangeTokenRequest{Token: token})
+	errors.CheckError(err)
+	return createdSession.Token
+}
+
+func passwordLogin(ctx context.Context, acdClient argocdclient.Client, username, password, otp string) string {
 	username, password = cli.PromptCredentials(username, password)
 	sessConn, sessionIf := acdClient.NewSessionClientOrDie()
 	defer io.Close(sessConn)
 	sessionRequest := sessionpkg.SessionCreateRequest{
 		Username: username,
 		Password: password,
+		Otp:      otp,
+	}
+	for {
+		createdSession, err := sessionIf.Create(ctx, &sessionRequest)
+		switch {
+		case isStatusError(err, sessionutil.PasswordChangeRequiredErr) && sessionRequest.NewPassword == "":
+			// the password was reset by an administrator or has expired and must be changed before logging in
+			fmt.Println(status.Convert(err).Message())
+			sessionRequest.NewPassword, err = cli.ReadAndConfirmPassword(username)
+			errors.CheckError(err)
+		case isStatusError(err, sessionutil.OTPRequiredErr) && sessionRequest.Otp == "":
+			sessionRequest.Otp = cli.PromptMessage("One-time password", "")
+		case isStatusError(err, sessionutil.TOTPEnrollmentRequiredErr) && sessionRequest.Otp == "":
+			fmt.Println(status.Convert(err).Message())
+			enrollment, err := sessionIf.EnrollTOTP(ctx, &sessionpkg.TOTPEnrollRequest{Username: username, Password: password})
+			errors.CheckError(err)
+			printTOTPEnrollment(enrollment.Secret, enrollment.Url)
+			sessionRequest.Otp = cli.PromptMessage("One-time password", "")
+		default:
+			errors.CheckError(err)
+			if len(createdSession.RecoveryCodes) > 0 {
+				printTOTPRecoveryCodes(createdSession.RecoveryCodes)
+			}
+			return createdSession.Token
+		}
+	}
+}
+
+// isStatusError returns whether the error has the same gRPC status code and message as the target error
+func isStatusError(err error, target error) bool {
+	if err == nil {
+		return false
+	}
+	actual, expected := status.Convert(err), status.Convert(target)
+	return actual.Code() == expected.Code() && actual.Message() == expected.Message()
+}
+
+func printTOTPEnrollment(secret string, url string) {
+	fmt.Println("Add the following secret to your authenticator app, or open the URL to add it:")
+	fmt.Printf("Secret: %s\n", secret)
+	fmt.Printf("URL:    %s\n", url)
+}
+
+func printTOTPRecoveryCodes(recoveryCodes []string) {
+	fmt.Println("TOTP enabled. Store the following recovery codes in a safe place, each of them can be used once instead of a one-time password:")
+	for _, code := range recoveryCodes {
+		fmt.Println(code)
 	}
-	createdSession, err := sessionIf.Create(ctx, &sessionRequest)
-	errors.CheckError(err)
-	return createdSession.Token
 }
@@ -148,9 +148,21 @@ func NewProjectSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command
 
 // NewProjectAddSignatureKeyCommand returns a new instance of an `argocd proj add-signature-key` command
 func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		keyType string
+		issuer  string
+	)
 	var command = &cobra.Command{
 		Use:   "add-signature-key PROJECT KEY-ID",
-		Short: "Add GnuPG signature key to project",
+		Short: "Add GnuPG, SSH or gitsign signature key to project",
+		Example: `  # Add a GnuPG key ID
+  argocd proj add-signature-key PROJECT 4AEE18F83AFDEB23
+
+  # Add an SSH public key, which is stored as its SHA256 fingerprint
+  argocd proj add-signature-key PROJECT "$(cat ~/.ssh/id_ed25519.pub)"
+
+  # Add the identity of a gitsign signer and the OIDC issuer of its certificates
+  argocd proj add-signature-key PROJECT jane@example.com --type gitsign --issuer https://accounts.google.com`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -159,10 +171,10 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 				os.Exit(1)
 			}
 			projName := args[0]
-			signatureKey := args[1]
 
-			if !gpg.IsShortKeyID(signatureKey) && !gpg.IsLongKeyID(signatureKey) {
-				log.Fatalf("%s is not a valid GnuPG key ID", signatureKey)
+			signatureKey, err := gpg.NewSignatureKey(keyType, args[1], issuer)
+			if err != nil {
+				log.Fatal(err)
 			}
 
 			conn, projIf := headless.NewClientOrDie(clientOpts, c).NewProjectClientOrDie()
@@ -172,15 +184,17 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 			errors.CheckError(err)
 
 			for _, key := range proj.Spec.SignatureKeys {
-				if key.KeyID == signatureKey {
+				if key.KeyID == signatureKey.KeyID && key.Type == signatureKey.Type && key.Issuer == signatureKey.Issuer {
 					log.Fatal("Specified signature key is already defined in project")
 				}
 			}
-			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, v1alpha1.SignatureKey{KeyID: signatureKey})
+			proj.Spec.SignatureKeys = append(proj.Spec.SignatureKeys, signatureKey)
 			_, err = projIf.Update(ctx, &projectpkg.ProjectUpdateRequest{Project: proj})
 			errors.CheckError(err)
 		},
 	}
+	command.Flags().StringVar(&keyType, "type", "", "Type of the key, one of gpg, ssh or gitsign. Detected from the key ID for GnuPG and SSH keys if omitted")
+	command.Flags().StringVar(&issuer, "issuer", "", "OIDC issuer of the certificates of a gitsign signer, e.g. https://accounts.google.com (required for gitsign keys)")
 	return command
 }
 
@@ -188,7 +202,7 @@ func NewProjectAddSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *c
 func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var command = &cobra.Command{
 		Use:   "remove-signature-key PROJECT KEY-ID",
-		Short: "Remove GnuPG signature key from project",
+		Short: "Remove GnuPG, SSH or gitsign signature key from project",
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -205,6 +219,11 @@ func NewProjectRemoveSignatureKeyCommand(clientOpts *argocdclient.ClientOptions)
 			proj, err := projIf.Get(ctx, &projectpkg.ProjectQuery{Name: projName})
 			errors.CheckError(err)
 
+			// SSH public keys are stored as their fingerprint
+			if key, err := gpg.NewSignatureKey("", signatureKey, ""); err == nil {
+				signatureKey = key.KeyID
+			}
+
 			index := -1
 			for i, key := range proj.Spec.SignatureKeys {
 				if key.KeyID == signatureKey {
@@ -793,11 +812,20 @@ func printProject(p *v1alpha1.AppProject, scopedRepositories []*v1alpha1.Reposit
 	if len(p.Spec.SignatureKeys) > 0 {
 		kids := make([]string, 0)
 		for _, key := range p.Spec.SignatureKeys {
-			kids = append(kids, key.KeyID)
+			if key.Issuer != "" {
+				kids = append(kids, fmt.Sprintf("%s (%s, %s)", key.KeyID, key.Type, key.Issuer))
+			} else if key.Type != "" && key.Type != gpg.SignatureKeyTypeGPG {
+				kids = append(kids, fmt.Sprintf("%s (%s)", key.KeyID, key.Type))
+			} else {
+				kids = append(kids, key.KeyID)
+			}
 		}
 		signatureKeysStr = strings.Join(kids, ", ")
 	}
 	fmt.Printf(printProjFmtStr, "Signature keys:", signatureKeysStr)
+	if p.Spec.SignaturePolicy != "" {
+		fmt.Printf(printProjFmtStr, "Signature policy:", p.Spec.SignaturePolicy)
+	}
 
 	fmt.Printf(printProjFmtStr, "Orphaned Resources:", formatOrphanedResources(p))
 
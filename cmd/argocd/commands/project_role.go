@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"text/tabwriter"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/errors"
 	"github.com/argoproj/argo-cd/v2/util/io"
 	"github.com/argoproj/argo-cd/v2/util/jwt"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
 )
 
 const (
@@ -221,11 +223,17 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 		expiresIn       string
 		outputTokenOnly bool
 		tokenID         string
+		scopes          []string
 	)
 	var command = &cobra.Command{
 		Use:     "create-token PROJECT ROLE-NAME",
 		Short:   "Create a project token",
 		Aliases: []string{"token-create"},
+		Example: `  # Create a token of the role ci of project team-a
+  argocd proj role create-token team-a ci
+
+  # Create a token which can only sync the applications of project team-a
+  argocd proj role create-token team-a ci --scope "applications, sync, team-a/*"`,
 		Run: func(c *cobra.Command, args []string) {
 			ctx := c.Context()
 
@@ -247,6 +255,7 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 				Role:      roleName,
 				ExpiresIn: int64(duration.Seconds()),
 				Id:        tokenID,
+				Scopes:    scopes,
 			})
 			errors.CheckError(err)
 
@@ -268,6 +277,9 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 				fmt.Printf("  ID: %s\n  Issued At: %s\n  Expires At: %s\n",
 					id, tokenTimeToString(issuedAt), tokenTimeToString(expiresAt),
 				)
+				if tokenScopes := jwt.GetScopeValues(claims, []string{rbac.TokenScopesClaim}); len(tokenScopes) > 0 {
+					fmt.Printf("  Scopes: %s\n", strings.Join(tokenScopes, "; "))
+				}
 				fmt.Println("  Token: " + tokenResponse.Token)
 			} else {
 				fmt.Println(tokenResponse.Token)
@@ -279,6 +291,7 @@ func NewProjectRoleCreateTokenCommand(clientOpts *argocdclient.ClientOptions) *c
 	)
 	command.Flags().StringVarP(&tokenID, "id", "i", "", "Token unique identifier. (Default: Random UUID)")
 	command.Flags().BoolVarP(&outputTokenOnly, "token-only", "t", false, "Output token only - for use in scripts.")
+	command.Flags().StringArrayVar(&scopes, "scope", []string{}, "Restrict the token to requests within the scope \"<resource>, <action>, <object>\". Can be repeated. (Default: Unrestricted)")
 
 	return command
 }
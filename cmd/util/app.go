@@ -47,6 +47,7 @@ type AppOptions struct {
 	helmVersion                     string
 	helmPassCredentials             bool
 	helmSkipCrds                    bool
+	helmPinnedChartVersion          string
 	project                         string
 	syncPolicy                      string
 	syncOptions                     []string
@@ -99,6 +100,7 @@ func AddAppFlags(command *cobra.Command, opts *AppOptions) {
 	command.Flags().StringArrayVar(&opts.helmSetStrings, "helm-set-string", []string{}, "Helm set STRING values on the command line (can be repeated to set several values: --helm-set-string key1=val1 --helm-set-string key2=val2)")
 	command.Flags().StringArrayVar(&opts.helmSetFiles, "helm-set-file", []string{}, "Helm set values from respective files specified via the command line (can be repeated to set several values: --helm-set-file key1=path1 --helm-set-file key2=path2)")
 	command.Flags().BoolVar(&opts.helmSkipCrds, "helm-skip-crds", false, "Skip helm crd installation step")
+	command.Flags().StringVar(&opts.helmPinnedChartVersion, "helm-pinned-chart-version", "", "Pin the chart to an exact version, reporting newer versions satisfying the revision constraint instead of deploying them")
 	command.Flags().StringVar(&opts.project, "project", "", "Application project name")
 	command.Flags().StringVar(&opts.syncPolicy, "sync-policy", "", "Set the sync policy (one of: none, automated (aliases of automated: auto, automatic))")
 	command.Flags().StringArrayVar(&opts.syncOptions, "sync-option", []string{}, "Add or remove a sync option, e.g add `Prune=false`. Remove using `!` prefix, e.g. `!Prune=false`")
@@ -179,6 +181,8 @@ func SetAppSpecOptions(flags *pflag.FlagSet, spec *argoappv1.ApplicationSpec, ap
 			setHelmOpt(&spec.Source, helmOpts{helmSetFiles: appOpts.helmSetFiles})
 		case "helm-skip-crds":
 			setHelmOpt(&spec.Source, helmOpts{skipCrds: appOpts.helmSkipCrds})
+		case "helm-pinned-chart-version":
+			setHelmOpt(&spec.Source, helmOpts{pinnedChartVersion: appOpts.helmPinnedChartVersion})
 		case "directory-recurse":
 			if spec.Source.Directory != nil {
 				spec.Source.Directory.Recurse = appOpts.directoryRecurse
@@ -387,6 +391,7 @@ type helmOpts struct {
 	helmSetFiles            []string
 	passCredentials         bool
 	skipCrds                bool
+	pinnedChartVersion      string
 }
 
 func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
@@ -414,6 +419,9 @@ func setHelmOpt(src *argoappv1.ApplicationSource, opts helmOpts) {
 	if opts.skipCrds {
 		src.Helm.SkipCrds = opts.skipCrds
 	}
+	if opts.pinnedChartVersion != "" {
+		src.Helm.PinnedChartVersion = opts.pinnedChartVersion
+	}
 	for _, text := range opts.helmSets {
 		p, err := argoappv1.NewHelmParameter(text, false)
 		if err != nil {
@@ -501,6 +509,20 @@ func SetParameterOverrides(app *argoappv1.Application, parameters []string) {
 	}
 }
 
+// ParseActionParams parses the parameters of a resource action, which are expected to be in the form:
+// name=value
+func ParseActionParams(params []string) ([]argoappv1.ResourceActionParam, error) {
+	var actionParams []argoappv1.ResourceActionParam
+	for _, p := range params {
+		parts := strings.SplitN(p, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("expected parameter of the form: name=value. Received: %s", p)
+		}
+		actionParams = append(actionParams, argoappv1.ResourceActionParam{Name: parts[0], Value: parts[1]})
+	}
+	return actionParams, nil
+}
+
 func readApps(yml []byte, apps *[]*argoappv1.Application) error {
 	yamls, _ := kube.SplitYAMLToString(yml)
 
@@ -313,6 +313,15 @@ func TestConstructBasedOnName(t *testing.T) {
 	assert.Equal(t, "test", apps[0].Name)
 }
 
+func TestParseActionParams(t *testing.T) {
+	params, err := ParseActionParams([]string{"replicas=3", "reason=a=b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []v1alpha1.ResourceActionParam{{Name: "replicas", Value: "3"}, {Name: "reason", Value: "a=b"}}, params)
+
+	_, err = ParseActionParams([]string{"replicas"})
+	assert.Error(t, err)
+}
+
 func TestFilterResources(t *testing.T) {
 
 	t.Run("Filter by ns", func(t *testing.T) {
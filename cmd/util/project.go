@@ -24,6 +24,8 @@ type ProjectOpts struct {
 	destinations  []string
 	Sources       []string
 	SignatureKeys []string
+	// SignaturePolicy is the project's signature policy, see v1alpha1.SignaturePolicy
+	SignaturePolicy string
 
 	orphanedResourcesEnabled   bool
 	orphanedResourcesWarn      bool
@@ -38,7 +40,8 @@ func AddProjFlags(command *cobra.Command, opts *ProjectOpts) {
 	command.Flags().StringArrayVarP(&opts.destinations, "dest", "d", []string{},
 		"Permitted destination server and namespace (e.g. https://192.168.99.100:8443,default)")
 	command.Flags().StringArrayVarP(&opts.Sources, "src", "s", []string{}, "Permitted source repository URL")
-	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "GnuPG public key IDs for commit signature verification")
+	command.Flags().StringSliceVar(&opts.SignatureKeys, "signature-keys", []string{}, "Keys for commit signature verification: GnuPG key IDs, SSH public key fingerprints, or TYPE:KEY-ID with TYPE one of gpg or ssh. Use add-signature-key for gitsign keys")
+	command.Flags().StringVar(&opts.SignaturePolicy, "signature-policy", "", "Git objects which must be signed with one of the signature keys: commit, tag or both")
 	command.Flags().BoolVar(&opts.orphanedResourcesEnabled, "orphaned-resources", false, "Enables orphaned resources monitoring")
 	command.Flags().BoolVar(&opts.orphanedResourcesWarn, "orphaned-resources-warn", false, "Specifies if applications should have a warning condition when orphaned resources detected")
 	command.Flags().StringArrayVar(&opts.allowedClusterResources, "allow-cluster-resource", []string{}, "List of allowed cluster level resources")
@@ -96,10 +99,11 @@ func (opts *ProjectOpts) GetDestinations() []v1alpha1.ApplicationDestination {
 func (opts *ProjectOpts) GetSignatureKeys() []v1alpha1.SignatureKey {
 	signatureKeys := make([]v1alpha1.SignatureKey, 0)
 	for _, keyStr := range opts.SignatureKeys {
-		if !gpg.IsShortKeyID(keyStr) && !gpg.IsLongKeyID(keyStr) {
-			log.Fatalf("'%s' is not a valid GnuPG key ID", keyStr)
+		key, err := gpg.ParseSignatureKey(keyStr)
+		if err != nil {
+			log.Fatalf("'%s' is not a valid signature key: %v", keyStr, err)
 		}
-		signatureKeys = append(signatureKeys, v1alpha1.SignatureKey{KeyID: gpg.KeyID(keyStr)})
+		signatureKeys = append(signatureKeys, key)
 	}
 	return signatureKeys
 }
@@ -148,6 +152,8 @@ func SetProjSpecOptions(flags *pflag.FlagSet, spec *v1alpha1.AppProjectSpec, pro
 			spec.SourceRepos = projOpts.Sources
 		case "signature-keys":
 			spec.SignatureKeys = projOpts.GetSignatureKeys()
+		case "signature-policy":
+			spec.SignaturePolicy = v1alpha1.SignaturePolicy(projOpts.SignaturePolicy)
 		case "allow-cluster-resource":
 			spec.ClusterResourceWhitelist = projOpts.GetAllowedClusterResources()
 		case "deny-cluster-resource":
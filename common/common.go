@@ -61,6 +61,8 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// Default name for the allowed signers file used for SSH signature verification
+	DefaultSSHAllowedSignersName = "allowed_signers"
 	// Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// Default path to cmp server plugin socket file
@@ -176,6 +178,8 @@ const (
 	EnvGitSubmoduleEnabled = "ARGOCD_GIT_MODULES_ENABLED"
 	// EnvGnuPGHome is the path to ArgoCD's GnuPG keyring for signature verification
 	EnvGnuPGHome = "ARGOCD_GNUPGHOME"
+	// EnvSSHAllowedSigners is the path to the allowed signers file used by Git for SSH signature verification
+	EnvSSHAllowedSigners = "ARGOCD_SSH_ALLOWED_SIGNERS"
 	// EnvWatchAPIBufferSize is the buffer size used to transfer K8S watch events to watch API consumer
 	EnvWatchAPIBufferSize = "ARGOCD_WATCH_API_BUFFER_SIZE"
 	// EnvPauseGenerationAfterFailedAttempts will pause manifest generation after the specified number of failed generation attempts
@@ -250,6 +254,16 @@ func GetGnuPGHomePath() string {
 	}
 }
 
+// GetSSHAllowedSignersPath retrieves the path of the allowed signers file for SSH signature verification, which is either
+// taken from the ARGOCD_SSH_ALLOWED_SIGNERS environment or placed in the GnuPG home directory
+func GetSSHAllowedSignersPath() string {
+	if allowedSigners := os.Getenv(EnvSSHAllowedSigners); allowedSigners == "" {
+		return filepath.Join(GetGnuPGHomePath(), DefaultSSHAllowedSignersName)
+	} else {
+		return allowedSigners
+	}
+}
+
 // GetPluginSockFilePath retrieves the path of plugin sock file, which is either taken from PluginSockFilePath environment or a default value
 func GetPluginSockFilePath() string {
 	if pluginSockFilePath := os.Getenv(EnvPluginSockFilePath); pluginSockFilePath == "" {
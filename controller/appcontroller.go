@@ -383,6 +383,25 @@ func isKnownOrphanedResourceExclusion(key kube.ResourceKey, proj *appv1.AppProje
 	return false
 }
 
+// getAppDestinationNamespaces returns the app destination namespace along with every namespace the app manages resources in
+func getAppDestinationNamespaces(a *appv1.Application, managedResources []*appv1.ResourceDiff) []string {
+	namespaces := make(map[string]bool)
+	if a.Spec.Destination.Namespace != "" {
+		namespaces[a.Spec.Destination.Namespace] = true
+	}
+	for _, res := range managedResources {
+		if res.Namespace != "" {
+			namespaces[res.Namespace] = true
+		}
+	}
+	result := make([]string, 0, len(namespaces))
+	for namespace := range namespaces {
+		result = append(result, namespace)
+	}
+	sort.Strings(result)
+	return result
+}
+
 func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managedResources []*appv1.ResourceDiff) (*appv1.ApplicationTree, error) {
 	nodes := make([]appv1.ResourceNode, 0)
 
@@ -393,9 +412,14 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 	orphanedNodesMap := make(map[kube.ResourceKey]appv1.ResourceNode)
 	warnOrphaned := true
 	if proj.Spec.OrphanedResources != nil {
-		orphanedNodesMap, err = ctrl.stateCache.GetNamespaceTopLevelResources(a.Spec.Destination.Server, a.Spec.Destination.Namespace)
-		if err != nil {
-			return nil, err
+		for _, namespace := range getAppDestinationNamespaces(a, managedResources) {
+			namespaceResources, err := ctrl.stateCache.GetNamespaceTopLevelResources(a.Spec.Destination.Server, namespace)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range namespaceResources {
+				orphanedNodesMap[k] = v
+			}
 		}
 		warnOrphaned = proj.Spec.OrphanedResources.IsWarn()
 	}
@@ -458,11 +482,19 @@ func (ctrl *ApplicationController) getResourceTree(a *appv1.Application, managed
 			}
 		}
 	}
+	// only orphaned resources in the destination namespace are warned about, the ones in other namespaces the app
+	// manages resources in are just listed
+	destinationOrphans := 0
+	for _, node := range orphanedNodes {
+		if node.Namespace == a.Spec.Destination.Namespace {
+			destinationOrphans++
+		}
+	}
 	var conditions []appv1.ApplicationCondition
-	if len(orphanedNodes) > 0 && warnOrphaned {
+	if destinationOrphans > 0 && warnOrphaned {
 		conditions = []appv1.ApplicationCondition{{
 			Type:    appv1.ApplicationConditionOrphanedResourceWarning,
-			Message: fmt.Sprintf("Application has %d orphaned resources", len(orphanedNodes)),
+			Message: fmt.Sprintf("Application has %d orphaned resources", destinationOrphans),
 		}}
 	}
 	a.Status.SetConditions(conditions, map[appv1.ApplicationConditionType]bool{appv1.ApplicationConditionOrphanedResourceWarning: true})
@@ -568,6 +600,10 @@ func (ctrl *ApplicationController) getAppHosts(a *appv1.Application, appNodes []
 
 func (ctrl *ApplicationController) hideSecretData(app *appv1.Application, comparisonResult *comparisonResult) ([]*appv1.ResourceDiff, error) {
 	items := make([]*appv1.ResourceDiff, len(comparisonResult.managedResources))
+	diffStrategy := argodiff.DiffStrategyLegacy
+	if comparisonResult.diffConfig != nil {
+		diffStrategy = comparisonResult.diffConfig.Strategy()
+	}
 	for i := range comparisonResult.managedResources {
 		res := comparisonResult.managedResources[i]
 		item := appv1.ResourceDiff{
@@ -577,6 +613,7 @@ func (ctrl *ApplicationController) hideSecretData(app *appv1.Application, compar
 			Kind:            res.Kind,
 			Hook:            res.Hook,
 			ResourceVersion: res.ResourceVersion,
+			DiffStrategy:    string(diffStrategy),
 		}
 
 		target := res.Target
@@ -831,6 +831,71 @@ func TestGetResourceTree_HasOrphanedResources(t *testing.T) {
 	assert.Equal(t, tree.OrphanedNodes, []argoappv1.ResourceNode{orphanedDeploy1, orphanedDeploy2})
 }
 
+func TestGetResourceTree_OrphanedResourceWarningInDestinationNamespace(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination.Namespace = "default"
+	proj := defaultProj.DeepCopy()
+	warn := true
+	proj.Spec.OrphanedResources = &argoappv1.OrphanedResourcesMonitorSettings{Warn: &warn}
+
+	orphanedDeploy := argoappv1.ResourceNode{
+		ResourceRef: argoappv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "deploy1"},
+	}
+	otherNamespaceDeploy := argoappv1.ResourceNode{
+		ResourceRef: argoappv1.ResourceRef{Group: "apps", Kind: "Deployment", Namespace: "other", Name: "deploy2"},
+	}
+	managedResources := []*argoappv1.ResourceDiff{{
+		Namespace:   "other",
+		Name:        "nginx-deployment",
+		Kind:        "Deployment",
+		Group:       "apps",
+		LiveState:   "null",
+		TargetState: test.DeploymentManifest,
+	}}
+
+	t.Run("OtherNamespace", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, proj},
+			namespacedResources: map[kube.ResourceKey]namespacedResource{
+				kube.NewResourceKey("apps", "Deployment", "other", "deploy2"): {ResourceNode: otherNamespaceDeploy},
+			},
+		})
+		app := app.DeepCopy()
+		tree, err := ctrl.getResourceTree(app, managedResources)
+		assert.NoError(t, err)
+		assert.Equal(t, []argoappv1.ResourceNode{otherNamespaceDeploy}, tree.OrphanedNodes)
+		assert.Empty(t, app.Status.GetConditions(map[argoappv1.ApplicationConditionType]bool{argoappv1.ApplicationConditionOrphanedResourceWarning: true}))
+	})
+	t.Run("DestinationNamespace", func(t *testing.T) {
+		ctrl := newFakeController(&fakeData{
+			apps: []runtime.Object{app, proj},
+			namespacedResources: map[kube.ResourceKey]namespacedResource{
+				kube.NewResourceKey("apps", "Deployment", "default", "deploy1"): {ResourceNode: orphanedDeploy},
+				kube.NewResourceKey("apps", "Deployment", "other", "deploy2"):   {ResourceNode: otherNamespaceDeploy},
+			},
+		})
+		app := app.DeepCopy()
+		tree, err := ctrl.getResourceTree(app, managedResources)
+		assert.NoError(t, err)
+		assert.Equal(t, []argoappv1.ResourceNode{orphanedDeploy, otherNamespaceDeploy}, tree.OrphanedNodes)
+		conditions := app.Status.GetConditions(map[argoappv1.ApplicationConditionType]bool{argoappv1.ApplicationConditionOrphanedResourceWarning: true})
+		if assert.Len(t, conditions, 1) {
+			assert.Equal(t, "Application has 1 orphaned resources", conditions[0].Message)
+		}
+	})
+}
+
+func TestGetAppDestinationNamespaces(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Destination.Namespace = "default"
+	namespaces := getAppDestinationNamespaces(app, []*argoappv1.ResourceDiff{
+		{Kind: "Deployment", Namespace: "other", Name: "deploy"},
+		{Kind: "Service", Namespace: "default", Name: "svc"},
+		{Kind: "ClusterRole", Name: "role"},
+	})
+	assert.Equal(t, []string{"default", "other"}, namespaces)
+}
+
 func TestSetOperationStateOnDeletedApp(t *testing.T) {
 	ctrl := newFakeController(&fakeData{apps: []runtime.Object{}})
 	fakeAppCs := ctrl.applicationClientset.(*appclientset.Clientset)
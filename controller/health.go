@@ -5,17 +5,21 @@ import (
 	hookutil "github.com/argoproj/gitops-engine/pkg/sync/hook"
 	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	kubeutil "github.com/argoproj/gitops-engine/pkg/utils/kube"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	statecache "github.com/argoproj/argo-cd/v2/controller/cache"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/lua"
 )
 
-// setApplicationHealth updates the health statuses of all resources performed in the comparison
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application) (*appv1.HealthStatus, error) {
+// setApplicationHealth updates the health statuses of all resources performed in the comparison. Health scripts which
+// opted into it can access the children of resources returned by getChildren, if any.
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, getChildren lua.GetChildrenFunc) (*appv1.HealthStatus, error) {
 	var savedErr error
 	appHealth := appv1.HealthStatus{Status: health.HealthStatusHealthy}
+	healthOverrides := lua.ResourceHealthOverridesWithChildren{Overrides: resourceOverrides, GetChildren: getChildren}
 	for i, res := range resources {
 		if res.Target != nil && hookutil.Skip(res.Target) {
 			continue
@@ -27,7 +31,6 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 
 		var healthStatus *health.HealthStatus
 		var err error
-		healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 		gvk := schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind}
 		if res.Live == nil {
 			healthStatus = &health.HealthStatus{Status: health.HealthStatusMissing}
@@ -46,7 +49,7 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 			statuses[i].Health = &resHealth
 
 			// Is health status is missing but resource has not built-in/custom health check then it should not affect parent app health
-			if _, hasOverride := healthOverrides[lua.GetConfigMapKey(gvk)]; healthStatus.Status == health.HealthStatusMissing && !hasOverride && health.GetHealthCheckFunc(gvk) == nil {
+			if healthStatus.Status == health.HealthStatusMissing && !hasHealthOverride(resourceOverrides, gvk) && health.GetHealthCheckFunc(gvk) == nil {
 				continue
 			}
 
@@ -62,3 +65,41 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 	}
 	return &appHealth, savedErr
 }
+
+// hasHealthOverride returns whether the resource override configured for exactly the group and kind of the resource,
+// or a glob pattern override with a health script matches the resource
+func hasHealthOverride(resourceOverrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) bool {
+	if _, ok := resourceOverrides[lua.GetConfigMapKey(gvk)]; ok {
+		return true
+	}
+	for _, key := range lua.GetMatchingOverrideKeys(resourceOverrides, gvk) {
+		if override := resourceOverrides[key]; override.HealthLua != "" || override.HealthConditions != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// getChildrenFromCache returns a function which lists the children of resources in the given cluster from the live
+// state cache
+func getChildrenFromCache(liveStateCache statecache.LiveStateCache, server string) lua.GetChildrenFunc {
+	return func(obj *unstructured.Unstructured, limit int) ([]appv1.ResourceNode, error) {
+		key := kubeutil.GetResourceKey(obj)
+		children := make([]appv1.ResourceNode, 0)
+		err := liveStateCache.IterateHierarchy(server, key, func(child appv1.ResourceNode, _ string) bool {
+			if len(children) >= limit {
+				return false
+			}
+			// the hierarchy starts with the resource itself
+			if kubeutil.NewResourceKey(child.Group, child.Kind, child.Namespace, child.Name) == key {
+				return true
+			}
+			children = append(children, child)
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+		return children, nil
+	}
+}
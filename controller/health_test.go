@@ -9,10 +9,12 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	mockstatecache "github.com/argoproj/argo-cd/v2/controller/cache/mocks"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/lua"
@@ -51,13 +53,13 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app)
+	healthStatus, err = setApplicationHealth(resources, resourceStatuses, nil, app, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 }
@@ -69,7 +71,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 		Group: "", Version: "v1", Kind: "Pod", Target: &pod}, {}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app)
+	healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
 }
@@ -82,7 +84,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 	resourceStatuses := initStatuses(resources)
 
 	t.Run("NoOverride", func(t *testing.T) {
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 		assert.Equal(t, resourceStatuses[0].Health.Status, health.HealthStatusMissing)
@@ -93,7 +95,7 @@ func TestSetApplicationHealth_MissingResourceNoBuiltHealthCheck(t *testing.T) {
 			lua.GetConfigMapKey(schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}): appv1.ResourceOverride{
 				HealthLua: "some health check",
 			},
-		}, app)
+		}, app, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusMissing, healthStatus.Status)
 	})
@@ -143,7 +145,7 @@ return hs`,
 			Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Live: degradedApp}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
 	})
@@ -154,8 +156,54 @@ return hs`,
 			Group: application.Group, Version: "v1alpha1", Kind: application.ApplicationKind, Live: degradedApp}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil)
 		assert.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus.Status)
 	})
 }
+
+func TestSetApplicationHealthWithChildren(t *testing.T) {
+	runningPod := resourceFromFile("./testdata/pod-running-restart-always.yaml")
+	resources := []managedResource{{Group: "", Version: "v1", Kind: "Pod", Live: &runningPod}}
+	overrides := map[string]appv1.ResourceOverride{
+		lua.GetConfigMapKey(schema.GroupVersionKind{Version: "v1", Kind: "Pod"}): {
+			HealthLua: `
+hs = {status = "Healthy"}
+if children == nil then
+  hs.status = "Unknown"
+  return hs
+end
+for i, child in ipairs(children) do
+  if child.health ~= nil and child.health.status == "Degraded" then
+    hs.status = "Degraded"
+    hs.message = child.kind .. " " .. child.name .. " is degraded"
+  end
+end
+return hs`,
+			UseChildren: true,
+		},
+	}
+	liveStateCache := &mockstatecache.LiveStateCache{}
+	liveStateCache.On("IterateHierarchy", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		action := args.Get(2).(func(appv1.ResourceNode, string) bool)
+		// the resource itself, followed by its children
+		action(appv1.ResourceNode{ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: runningPod.GetNamespace(), Name: runningPod.GetName()}}, "")
+		action(appv1.ResourceNode{ResourceRef: appv1.ResourceRef{Group: "example.com", Kind: "Sidecar", Name: "healthy"}, Health: &appv1.HealthStatus{Status: health.HealthStatusHealthy}}, "")
+		action(appv1.ResourceNode{ResourceRef: appv1.ResourceRef{Group: "example.com", Kind: "Sidecar", Name: "broken"}, Health: &appv1.HealthStatus{Status: health.HealthStatusDegraded}}, "")
+	}).Return(nil)
+
+	t.Run("WithChildren", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, getChildrenFromCache(liveStateCache, "https://localhost:6443"))
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+		assert.Equal(t, "Sidecar broken is degraded", resourceStatuses[0].Health.Message)
+	})
+
+	t.Run("ChildrenUnavailable", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		healthStatus, err := setApplicationHealth(resources, resourceStatuses, overrides, app, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, health.HealthStatusUnknown, healthStatus.Status)
+	})
+}
@@ -170,6 +170,12 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		return nil, nil, err
 	}
 	ts.AddCheckpoint("version_ms")
+	var signatureKeys []*appv1.SignatureKey
+	if verifySignature {
+		for i := range proj.Spec.SignatureKeys {
+			signatureKeys = append(signatureKeys, &proj.Spec.SignatureKeys[i])
+		}
+	}
 	manifestInfo, err := repoClient.GenerateManifest(context.Background(), &apiclient.ManifestRequest{
 		Repo:               repo,
 		Repos:              permittedHelmRepos,
@@ -185,6 +191,8 @@ func (m *appStateManager) getRepoObjs(app *v1alpha1.Application, source v1alpha1
 		KubeVersion:        serverVersion,
 		ApiVersions:        argo.APIResourcesToStrings(apiResources, true),
 		VerifySignature:    verifySignature,
+		SignaturePolicy:    string(proj.Spec.SignaturePolicy),
+		SignatureKeys:      signatureKeys,
 		HelmRepoCreds:      permittedHelmCredentials,
 		TrackingMethod:     string(argo.GetTrackingMethod(m.settingsMgr)),
 		EnabledSourceTypes: enabledSourceTypes,
@@ -280,40 +288,58 @@ func (m *appStateManager) getComparisonSettings() (string, map[string]v1alpha1.R
 	return appLabelKey, resourceOverrides, resFilter, nil
 }
 
-// verifyGnuPGSignature verifies the result of a GnuPG operation for a given git
-// revision.
+// verifyGnuPGSignature verifies the result of a GnuPG, SSH or gitsign signature
+// verification for a given git revision, according to the project's signature policy.
 func verifyGnuPGSignature(revision string, project *appv1.AppProject, manifestInfo *apiclient.ManifestResponse) []appv1.ApplicationCondition {
+	conditions := make([]appv1.ApplicationCondition, 0)
+	policy := project.Spec.SignaturePolicy
+	if policy.RequiresCommitSignature() || !policy.RequiresTagSignature() {
+		unsignedMsg := fmt.Sprintf("Target revision %s in Git is not signed, but a signature is required", revision)
+		conditions = append(conditions, verifySignatureResult(revision, project, manifestInfo.VerifyResult, unsignedMsg)...)
+	}
+	if policy.RequiresTagSignature() {
+		unsignedMsg := fmt.Sprintf("Target revision %s is not a signed annotated tag, but the project requires signed tags", revision)
+		conditions = append(conditions, verifySignatureResult(revision, project, manifestInfo.VerifyTagResult, unsignedMsg)...)
+	}
+	return conditions
+}
+
+// verifySignatureResult verifies a single result of git verify-commit or git verify-tag against the signature keys
+// allowed in the project
+func verifySignatureResult(revision string, project *appv1.AppProject, rawResult string, unsignedMsg string) []appv1.ApplicationCondition {
 	now := metav1.Now()
 	conditions := make([]appv1.ApplicationCondition, 0)
 	// We need to have some data in the verification result to parse, otherwise there was no signature
-	if manifestInfo.VerifyResult != "" {
-		verifyResult := gpg.ParseGitCommitVerification(manifestInfo.VerifyResult)
+	if rawResult != "" {
+		verifyResult := gpg.ParseSignatureVerification(rawResult)
 		switch verifyResult.Result {
 		case gpg.VerifyResultGood:
 			// This is the only case we allow to sync to, but we need to make sure signing key is allowed
 			validKey := false
 			for _, k := range project.Spec.SignatureKeys {
-				if gpg.KeyID(k.KeyID) == gpg.KeyID(verifyResult.KeyID) && gpg.KeyID(k.KeyID) != "" {
+				if gpg.SignatureKeyMatches(k, verifyResult) {
 					validKey = true
 					break
 				}
 			}
 			if !validKey {
-				msg := fmt.Sprintf("Found good signature made with %s key %s, but this key is not allowed in AppProject",
-					verifyResult.Cipher, verifyResult.KeyID)
+				msg := fmt.Sprintf("Found good %s signature made with %s key %s, but this key is not allowed in AppProject",
+					verifyResult.Type, verifyResult.Cipher, verifyResult.KeyID)
 				conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 			}
 		case gpg.VerifyResultInvalid:
 			msg := fmt.Sprintf("Found signature made with %s key %s, but verification result was invalid: '%s'",
 				verifyResult.Cipher, verifyResult.KeyID, verifyResult.Message)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+		case gpg.VerifyResultBad:
+			msg := fmt.Sprintf("Found bad %s signature on revision '%s': '%s'", verifyResult.Type, revision, verifyResult.Message)
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 		default:
 			msg := fmt.Sprintf("Could not verify commit signature on revision '%s', check logs for more information.", revision)
 			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
 		}
 	} else {
-		msg := fmt.Sprintf("Target revision %s in Git is not signed, but a signature is required", revision)
-		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: unsignedMsg, LastTransitionTime: &now})
 	}
 
 	return conditions
@@ -468,6 +494,21 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	}
 	diffConfigBuilder.WithGVKParser(gvkParser)
 
+	diffStrategy, err := argodiff.GetAppDiffStrategy(app, compareOptions.DiffStrategy)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
+		diffStrategy = argodiff.DiffStrategyLegacy
+	}
+	if diffStrategy == argodiff.DiffStrategyServerSide {
+		if dryRunner, err := m.getServerSideDryRunner(app); err != nil {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionDiffStrategyWarning, Message: fmt.Sprintf("Falling back to legacy diff strategy: %v", err), LastTransitionTime: &now})
+			diffStrategy = argodiff.DiffStrategyLegacy
+		} else {
+			diffConfigBuilder.WithServerSideDryRunner(dryRunner)
+		}
+	}
+	diffConfigBuilder.WithStrategy(diffStrategy)
+
 	// it is necessary to ignore the error at this point to avoid creating duplicated
 	// application conditions as argo.StateDiffs will validate this diffConfig again.
 	diffConfig, _ := diffConfigBuilder.Build()
@@ -577,10 +618,24 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 	}
 	if manifestInfo != nil {
 		syncStatus.Revision = manifestInfo.Revision
+		if verifySignature && project.Spec.SignaturePolicy.RequiresTagSignature() {
+			syncStatus.TagSignatureInfo = gpg.SignatureInfo(manifestInfo.VerifyTagResult)
+		}
+		if source.IsHelm() {
+			syncStatus.ChartVersion = manifestInfo.Revision
+			syncStatus.ChartVersionConstraint = manifestInfo.ChartVersionConstraint
+		}
+		if manifestInfo.ChartUpdateVersion != "" {
+			conditions = append(conditions, v1alpha1.ApplicationCondition{
+				Type:               v1alpha1.ApplicationConditionChartUpdateAvailable,
+				Message:            fmt.Sprintf("Chart %s version %s satisfies the version constraint '%s', but the application is pinned to version %s", source.Chart, manifestInfo.ChartUpdateVersion, manifestInfo.ChartVersionConstraint, manifestInfo.Revision),
+				LastTransitionTime: &now,
+			})
+		}
 	}
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app)
+	healthStatus, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, getChildrenFromCache(m.liveStateCache, app.Spec.Destination.Server))
 	if err != nil {
 		conditions = append(conditions, appv1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: err.Error(), LastTransitionTime: &now})
 	}
@@ -609,6 +664,7 @@ func (m *appStateManager) CompareAppState(app *v1alpha1.Application, project *ap
 		appv1.ApplicationConditionSharedResourceWarning:   true,
 		appv1.ApplicationConditionRepeatedResourceWarning: true,
 		appv1.ApplicationConditionExcludedResourceWarning: true,
+		appv1.ApplicationConditionChartUpdateAvailable:    true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
@@ -96,6 +96,45 @@ func TestCompareAppStateExtra(t *testing.T) {
 	assert.Equal(t, 0, len(app.Status.Conditions))
 }
 
+func TestCompareAppStateChartUpdateAvailable(t *testing.T) {
+	app := newFakeApp()
+	app.Spec.Source = argoappv1.ApplicationSource{
+		RepoURL:        "https://charts.example.com",
+		Chart:          "guestbook",
+		TargetRevision: "1.*",
+		Helm:           &argoappv1.ApplicationSourceHelm{PinnedChartVersion: "1.0.0"},
+	}
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests:              []string{},
+			Namespace:              test.FakeDestNamespace,
+			Server:                 test.FakeClusterURL,
+			Revision:               "1.0.0",
+			ChartVersionConstraint: "1.*",
+			ChartUpdateVersion:     "1.2.0",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	assert.NotNil(t, compRes)
+	assert.Equal(t, "1.0.0", compRes.syncStatus.ChartVersion)
+	assert.Equal(t, "1.*", compRes.syncStatus.ChartVersionConstraint)
+	assert.Len(t, app.Status.Conditions, 1)
+	assert.Equal(t, argoappv1.ApplicationConditionChartUpdateAvailable, app.Status.Conditions[0].Type)
+	assert.Contains(t, app.Status.Conditions[0].Message, "version 1.2.0")
+	assert.False(t, app.Status.Conditions[0].IsError())
+
+	// the condition is removed once the newest version is pinned
+	data.manifestResponse.Revision = "1.2.0"
+	data.manifestResponse.ChartUpdateVersion = ""
+	app.Spec.Source.Helm.PinnedChartVersion = "1.2.0"
+	ctrl = newFakeController(&data)
+	compRes = ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	assert.Equal(t, "1.2.0", compRes.syncStatus.ChartVersion)
+	assert.Len(t, app.Status.Conditions, 0)
+}
+
 // TestCompareAppStateHook checks that hooks are detected during manifest generation, and not
 // considered as part of resources when assessing Synced status
 func TestCompareAppStateHook(t *testing.T) {
@@ -755,6 +794,182 @@ func TestSignedResponseSignatureRequired(t *testing.T) {
 
 }
 
+func TestSignedResponseTagSignatureRequired(t *testing.T) {
+	oldval := os.Getenv("ARGOCD_GPG_ENABLED")
+	os.Setenv("ARGOCD_GPG_ENABLED", "true")
+	defer os.Setenv("ARGOCD_GPG_ENABLED", oldval)
+
+	newTagSignedProj := func(policy argoappv1.SignaturePolicy) *argoappv1.AppProject {
+		proj := signedProj.DeepCopy()
+		proj.Spec.SignatureKeys = []argoappv1.SignatureKey{{KeyID: "4AEE18F83AFDEB23"}}
+		proj.Spec.SignaturePolicy = policy
+		return proj
+	}
+	compare := func(proj *argoappv1.AppProject, verifyResult string, verifyTagResult string) (*argoappv1.Application, *comparisonResult) {
+		app := newFakeApp()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests:       []string{},
+				Namespace:       test.FakeDestNamespace,
+				Server:          test.FakeClusterURL,
+				Revision:        "abc123",
+				VerifyResult:    verifyResult,
+				VerifyTagResult: verifyTagResult,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		ctrl := newFakeController(&data)
+		compRes := ctrl.appStateManager.CompareAppState(app, proj, "v1.0.0", app.Spec.Source, false, false, nil)
+		assert.NotNil(t, compRes)
+		return app, compRes
+	}
+	goodSignature := mustReadFile("../util/gpg/testdata/good_signature.txt")
+
+	// Tag is signed with an allowed key, the commit does not matter - sync!
+	{
+		app, compRes := compare(newTagSignedProj(argoappv1.SignaturePolicyTag), "", goodSignature)
+		assert.Len(t, app.Status.Conditions, 0)
+		assert.Equal(t, "Good signature from RSA key 4AEE18F83AFDEB23", compRes.syncStatus.TagSignatureInfo)
+	}
+	// Only the commit is signed - do not sync
+	{
+		app, compRes := compare(newTagSignedProj(argoappv1.SignaturePolicyTag), goodSignature, "")
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "is not a signed annotated tag")
+		assert.Equal(t, "Revision is not signed.", compRes.syncStatus.TagSignatureInfo)
+	}
+	// Tag is signed with a key that is not allowed - do not sync
+	{
+		app, _ := compare(newTagSignedProj(argoappv1.SignaturePolicyTag), "", mustReadFile("../util/gpg/testdata/ssh_good_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "key is not allowed")
+	}
+	// Both the tag and the commit must be signed, but the commit is not - do not sync
+	{
+		app, _ := compare(newTagSignedProj(argoappv1.SignaturePolicyBoth), "", goodSignature)
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "is not signed")
+	}
+	// Both the tag and the commit are signed - sync!
+	{
+		app, _ := compare(newTagSignedProj(argoappv1.SignaturePolicyBoth), goodSignature, goodSignature)
+		assert.Len(t, app.Status.Conditions, 0)
+	}
+	// The commit policy does not require a signed tag - sync!
+	{
+		app, compRes := compare(newTagSignedProj(argoappv1.SignaturePolicyCommit), goodSignature, "")
+		assert.Len(t, app.Status.Conditions, 0)
+		assert.Empty(t, compRes.syncStatus.TagSignatureInfo)
+	}
+}
+
+func TestSignedResponseSSHSignatureRequired(t *testing.T) {
+	oldval := os.Getenv("ARGOCD_GPG_ENABLED")
+	os.Setenv("ARGOCD_GPG_ENABLED", "true")
+	defer os.Setenv("ARGOCD_GPG_ENABLED", oldval)
+
+	newSSHSignedProj := func(keyID string) *argoappv1.AppProject {
+		proj := signedProj.DeepCopy()
+		proj.Spec.SignatureKeys = []argoappv1.SignatureKey{{KeyID: keyID, Type: "ssh"}}
+		return proj
+	}
+	compare := func(proj *argoappv1.AppProject, verifyResult string) *argoappv1.Application {
+		app := newFakeApp()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests:    []string{},
+				Namespace:    test.FakeDestNamespace,
+				Server:       test.FakeClusterURL,
+				Revision:     "abc123",
+				VerifyResult: verifyResult,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		ctrl := newFakeController(&data)
+		compRes := ctrl.appStateManager.CompareAppState(app, proj, "abc123", app.Spec.Source, false, false, nil)
+		assert.NotNil(t, compRes)
+		assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+		return app
+	}
+
+	// We have a good SSH signature made with an allowed key - sync!
+	{
+		app := compare(newSSHSignedProj("SHA256:OW4ExkkOgRSi4AMq423pWSP4eWdAAjLp6Kf+HRMU/PE"), mustReadFile("../util/gpg/testdata/ssh_good_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 0)
+	}
+	// The allowed key is configured as public key - sync!
+	{
+		app := compare(newSSHSignedProj(mustReadFile("../util/gpg/testdata/ssh_public_key.pub")), mustReadFile("../util/gpg/testdata/ssh_good_signature_principal.txt"))
+		assert.Len(t, app.Status.Conditions, 0)
+	}
+	// We have a good SSH signature made with a key that is not allowed - do not sync
+	{
+		app := compare(newSSHSignedProj("SHA256:Ua8ytDRK1K5nz6YBg8mqFbrFv1ah6RZ1s3sHhLnvAsc"), mustReadFile("../util/gpg/testdata/ssh_good_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "key is not allowed")
+	}
+	// We have a bad SSH signature - do not sync
+	{
+		app := compare(newSSHSignedProj("SHA256:OW4ExkkOgRSi4AMq423pWSP4eWdAAjLp6Kf+HRMU/PE"), mustReadFile("../util/gpg/testdata/ssh_bad_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "bad ssh signature")
+	}
+	// A GnuPG key with the same ID does not allow an SSH signature - do not sync
+	{
+		proj := newSSHSignedProj("SHA256:OW4ExkkOgRSi4AMq423pWSP4eWdAAjLp6Kf+HRMU/PE")
+		proj.Spec.SignatureKeys[0].Type = ""
+		app := compare(proj, mustReadFile("../util/gpg/testdata/ssh_good_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 1)
+	}
+}
+
+func TestSignedResponseGitsignSignatureRequired(t *testing.T) {
+	oldval := os.Getenv("ARGOCD_GPG_ENABLED")
+	os.Setenv("ARGOCD_GPG_ENABLED", "true")
+	defer os.Setenv("ARGOCD_GPG_ENABLED", oldval)
+
+	newGitsignSignedProj := func(issuer string) *argoappv1.AppProject {
+		proj := signedProj.DeepCopy()
+		proj.Spec.SignatureKeys = []argoappv1.SignatureKey{{KeyID: "jane@example.com", Type: "gitsign", Issuer: issuer}}
+		return proj
+	}
+	compare := func(proj *argoappv1.AppProject, verifyResult string) *argoappv1.Application {
+		app := newFakeApp()
+		data := fakeData{
+			manifestResponse: &apiclient.ManifestResponse{
+				Manifests:    []string{},
+				Namespace:    test.FakeDestNamespace,
+				Server:       test.FakeClusterURL,
+				Revision:     "abc123",
+				VerifyResult: verifyResult,
+			},
+			managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		}
+		ctrl := newFakeController(&data)
+		compRes := ctrl.appStateManager.CompareAppState(app, proj, "abc123", app.Spec.Source, false, false, nil)
+		assert.NotNil(t, compRes)
+		return app
+	}
+
+	// The certificate claims were validated for an allowed identity and issuer - sync!
+	{
+		app := compare(newGitsignSignedProj("https://accounts.google.com"), mustReadFile("../util/gpg/testdata/gitsign_good_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 0)
+	}
+	// The same identity certified by another issuer - do not sync
+	{
+		app := compare(newGitsignSignedProj("https://token.actions.githubusercontent.com"), mustReadFile("../util/gpg/testdata/gitsign_good_signature.txt"))
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "key is not allowed")
+	}
+	// The certificate claims were not validated - do not sync
+	{
+		app := compare(newGitsignSignedProj("https://accounts.google.com"), mustReadFile("../util/gpg/testdata/gitsign_unvalidated_claims.txt"))
+		assert.Len(t, app.Status.Conditions, 1)
+		assert.Contains(t, app.Status.Conditions[0].Message, "Certificate claims could not be validated")
+	}
+}
+
 func TestComparisonResult_GetHealthStatus(t *testing.T) {
 	status := &argoappv1.HealthStatus{Status: health.HealthStatusMissing}
 	res := comparisonResult{
@@ -897,3 +1112,26 @@ func TestIsLiveResourceManaged(t *testing.T) {
 	// Nil resource
 	assert.True(t, manager.isSelfReferencedObj(nil, common.AnnotationKeyAppInstance, argo.TrackingMethodAnnotation))
 }
+
+func TestCompareAppStateServerSideDiffFallback(t *testing.T) {
+	app := newFakeApp()
+	app.Annotations = map[string]string{common.AnnotationCompareOptions: "DiffStrategy=server-side"}
+	app.Spec.Destination.Server = "https://unknown-cluster"
+	data := fakeData{
+		apps: []runtime.Object{app},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+	}
+	ctrl := newFakeController(&data)
+	compRes := ctrl.appStateManager.CompareAppState(app, &defaultProj, "", app.Spec.Source, false, false, nil)
+	assert.Equal(t, argoappv1.SyncStatusCodeSynced, compRes.syncStatus.Status)
+	if assert.Len(t, app.Status.Conditions, 1) {
+		assert.Equal(t, argoappv1.ApplicationConditionDiffStrategyWarning, app.Status.Conditions[0].Type)
+		assert.Contains(t, app.Status.Conditions[0].Message, "Falling back to legacy diff strategy")
+	}
+}
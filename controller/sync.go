@@ -18,6 +18,8 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
 	"k8s.io/kubectl/pkg/util/openapi"
 
 	cdcommon "github.com/argoproj/argo-cd/v2/common"
@@ -55,6 +57,44 @@ func (m *appStateManager) getGVKParser(server string) (*managedfields.GvkParser,
 	return cluster.GetGVKParser(), nil
 }
 
+// getServerSideDryRunner returns a dry runner applying resources to the destination cluster of the application
+func (m *appStateManager) getServerSideDryRunner(app *v1alpha1.Application) (diff.ServerSideDryRunner, error) {
+	clusterCache, err := m.liveStateCache.GetClusterCache(app.Spec.Destination.Server)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster cache: %w", err)
+	}
+	cluster, err := m.db.GetCluster(context.Background(), app.Spec.Destination.Server)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cluster: %w", err)
+	}
+	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, cluster.RESTConfig())
+	dynamicIf, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error creating dynamic client: %w", err)
+	}
+	return diff.NewServerSideDryRunner(dynamicIf, clusterCache.GetAPIResources(), diff.ServerSideDiffManager), nil
+}
+
+// configureImpersonation sets the project destination service account matching the application destination as the
+// user to impersonate in the given configs, if sync impersonation is enabled
+func (m *appStateManager) configureImpersonation(proj *v1alpha1.AppProject, app *v1alpha1.Application, server string, configs ...*rest.Config) error {
+	enabled, err := m.settingsMgr.IsImpersonationEnabled()
+	if err != nil {
+		return fmt.Errorf("error getting impersonation setting: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+	serviceAccount, err := proj.GetImpersonatedServiceAccount(server, app.Spec.Destination.Namespace)
+	if err != nil {
+		return err
+	}
+	for _, config := range configs {
+		config.Impersonate = rest.ImpersonationConfig{UserName: serviceAccount}
+	}
+	return nil
+}
+
 func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha1.OperationState) {
 	// Sync requests might be requested with ambiguous revisions (e.g. master, HEAD, v1.2.3).
 	// This can change meaning when resuming operations (e.g a hook sync). After calculating a
@@ -140,6 +180,11 @@ func (m *appStateManager) SyncAppState(app *v1alpha1.Application, state *v1alpha
 
 	rawConfig := clst.RawRestConfig()
 	restConfig := metrics.AddMetricsTransportWrapper(m.metricsServer, app, clst.RESTConfig())
+	if err := m.configureImpersonation(proj, app, clst.Server, rawConfig, restConfig); err != nil {
+		state.Phase = common.OperationError
+		state.Message = fmt.Sprintf("Failed to configure impersonation: %v", err)
+		return
+	}
 
 	resourceOverrides, err := m.settingsMgr.GetResourceOverrides()
 	if err != nil {
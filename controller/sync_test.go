@@ -13,6 +13,7 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 
 	"github.com/argoproj/argo-cd/v2/controller/testdata"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -348,3 +349,72 @@ func TestNormalizeTargetResources(t *testing.T) {
 		assert.Equal(t, 2, len(containers))
 	})
 }
+
+func TestConfigureImpersonation(t *testing.T) {
+	app := newFakeApp()
+	project := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+		Spec: v1alpha1.AppProjectSpec{
+			DestinationServiceAccounts: []v1alpha1.ApplicationDestinationServiceAccount{{
+				Server:                test.FakeClusterURL,
+				Namespace:             "*",
+				DefaultServiceAccount: "deployer",
+			}},
+		},
+	}
+	newManager := func(configMapData map[string]string) *appStateManager {
+		ctrl := newFakeController(&fakeData{apps: []runtime.Object{app, project}, configMapData: configMapData})
+		return ctrl.appStateManager.(*appStateManager)
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		config := &rest.Config{}
+		err := newManager(nil).configureImpersonation(project, app, test.FakeClusterURL, config)
+		assert.NoError(t, err)
+		assert.Empty(t, config.Impersonate.UserName)
+	})
+	t.Run("Enabled", func(t *testing.T) {
+		rawConfig, config := &rest.Config{}, &rest.Config{}
+		err := newManager(map[string]string{"application.sync.impersonation.enabled": "true"}).configureImpersonation(project, app, test.FakeClusterURL, rawConfig, config)
+		assert.NoError(t, err)
+		expected := "system:serviceaccount:" + test.FakeDestNamespace + ":deployer"
+		assert.Equal(t, expected, rawConfig.Impersonate.UserName)
+		assert.Equal(t, expected, config.Impersonate.UserName)
+	})
+	t.Run("NoMatchingServiceAccount", func(t *testing.T) {
+		config := &rest.Config{}
+		err := newManager(map[string]string{"application.sync.impersonation.enabled": "true"}).configureImpersonation(project, app, "https://other-cluster", config)
+		assert.ErrorContains(t, err, "no destination service account configured")
+	})
+}
+
+func TestSyncAppStateImpersonationWithoutServiceAccount(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	project := &v1alpha1.AppProject{
+		ObjectMeta: v1.ObjectMeta{
+			Namespace: test.FakeArgoCDNamespace,
+			Name:      "default",
+		},
+	}
+	ctrl := newFakeController(&fakeData{
+		apps: []runtime.Object{app, project},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		configMapData:   map[string]string{"application.sync.impersonation.enabled": "true"},
+	})
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{Source: &v1alpha1.ApplicationSource{}},
+	}}
+	ctrl.appStateManager.SyncAppState(app, opState)
+	assert.Equal(t, common.OperationError, opState.Phase)
+	assert.Contains(t, opState.Message, "Failed to configure impersonation")
+}
@@ -2,6 +2,13 @@
 # Wrapper script to perform GPG signature validation on git commit SHAs and
 # annotated tags.
 #
+# Usage: git-verify-wrapper.sh REVISION [commit|tag] [IDENTITY ISSUER]
+#
+# If the type of the object to verify is not given, it is detected from the
+# revision. If an identity and an OIDC issuer are given, the gitsign signature
+# of the object is verified with gitsign, which also validates that the signing
+# certificate was issued for the identity by the issuer.
+#
 # We capture stderr to stdout, so we can have the output in the logs. Also,
 # we ignore error codes that are emitted if signature verification failed.
 #
@@ -11,10 +18,28 @@ if test "$1" = ""; then
 fi
 
 REVISION="$1"
-TYPE=
+TYPE="$2"
+IDENTITY="$3"
+ISSUER="$4"
 
+if test "$IDENTITY" != ""; then
+	IFS=''
+	if test "$TYPE" = "tag"; then
+		OUTPUT=$(gitsign verify-tag --certificate-identity="$IDENTITY" --certificate-oidc-issuer="$ISSUER" "$REVISION" 2>&1)
+	else
+		OUTPUT=$(gitsign verify --certificate-identity="$IDENTITY" --certificate-oidc-issuer="$ISSUER" "$REVISION" 2>&1)
+	fi
+	RET=$?
+elif test "$TYPE" = "tag"; then
+	IFS=''
+	OUTPUT=$(git verify-tag "$REVISION" 2>&1)
+	RET=$?
+elif test "$TYPE" = "commit"; then
+	IFS=''
+	OUTPUT=$(git verify-commit "$REVISION" 2>&1)
+	RET=$?
 # Figure out we have an annotated tag or a commit SHA
-if git describe --exact-match "${REVISION}" >/dev/null 2>&1; then
+elif git describe --exact-match "${REVISION}" >/dev/null 2>&1; then
 	IFS=''
 	TYPE=tag
 	OUTPUT=$(git verify-tag "$REVISION" 2>&1)
@@ -167,8 +167,10 @@ type ProjectTokenCreateRequest struct {
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	Role        string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
 	// expiresIn represents a duration in seconds
-	ExpiresIn            int64    `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
-	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	ExpiresIn int64  `protobuf:"varint,4,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	Id        string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// scopes optionally restrict the token to requests within the listed "<resource>, <action>, <object>" scopes
+	Scopes               []string `protobuf:"bytes,6,rep,name=scopes,proto3" json:"scopes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -242,6 +244,13 @@ func (m *ProjectTokenCreateRequest) GetId() string {
 	return ""
 }
 
+func (m *ProjectTokenCreateRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
 // ProjectTokenResponse wraps the created token or returns an empty string if deleted.
 type ProjectTokenResponse struct {
 	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
@@ -653,67 +662,68 @@ func init() {
 func init() { proto.RegisterFile("server/project/project.proto", fileDescriptor_5f0a51496972c9e2) }
 
 var fileDescriptor_5f0a51496972c9e2 = []byte{
-	// 950 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xc7, 0xe5, 0xa4, 0xcd, 0x6e, 0x27, 0x4b, 0x29, 0xb3, 0xbb, 0xc5, 0x0d, 0xdd, 0x6e, 0x18,
-	0x44, 0x15, 0x15, 0x3a, 0x56, 0x53, 0x90, 0x56, 0x70, 0x62, 0x77, 0xab, 0x80, 0xd4, 0x03, 0xb8,
-	0x20, 0x10, 0x07, 0xd0, 0xc4, 0x7e, 0xca, 0xce, 0xc6, 0xf1, 0x0c, 0x9e, 0x89, 0xb7, 0x21, 0xea,
-	0x05, 0x09, 0x90, 0x38, 0x70, 0x80, 0x13, 0xff, 0x00, 0x27, 0xfe, 0x09, 0x6e, 0x1c, 0x91, 0xf8,
-	0x07, 0x50, 0xc5, 0x1f, 0x82, 0x3c, 0xfe, 0x91, 0x38, 0xa9, 0x11, 0x68, 0xc3, 0x9e, 0x3c, 0x1e,
-	0x3f, 0xbf, 0xef, 0xe7, 0xbd, 0x99, 0x79, 0x6f, 0xd0, 0xae, 0x82, 0x28, 0x86, 0xc8, 0x91, 0x91,
-	0x78, 0x0c, 0x9e, 0xce, 0x9f, 0x54, 0x46, 0x42, 0x0b, 0x7c, 0x2d, 0x7b, 0x6d, 0xed, 0x0e, 0x84,
-	0x18, 0x04, 0xe0, 0x30, 0xc9, 0x1d, 0x16, 0x86, 0x42, 0x33, 0xcd, 0x45, 0xa8, 0x52, 0xb3, 0x16,
-	0x19, 0xde, 0x53, 0x94, 0x0b, 0xf3, 0xd5, 0x13, 0x11, 0x38, 0xf1, 0x91, 0x33, 0x80, 0x10, 0x22,
-	0xa6, 0xc1, 0xcf, 0x6c, 0x4e, 0x07, 0x5c, 0x3f, 0x1a, 0xf7, 0xa9, 0x27, 0x46, 0x0e, 0x8b, 0x06,
-	0x22, 0xf1, 0x6c, 0x06, 0x87, 0x9e, 0xef, 0xc4, 0x5d, 0x47, 0x0e, 0x07, 0xc9, 0xff, 0xca, 0x61,
-	0x52, 0x06, 0xdc, 0x33, 0xfe, 0x9d, 0xf8, 0x88, 0x05, 0xf2, 0x11, 0x5b, 0xf2, 0x46, 0x7e, 0xb0,
-	0xd0, 0xad, 0xf7, 0x53, 0xb6, 0x07, 0x11, 0x30, 0x0d, 0x2e, 0x7c, 0x31, 0x06, 0xa5, 0x71, 0x1f,
-	0xe5, 0xcc, 0xb6, 0xd5, 0xb6, 0x3a, 0xcd, 0xee, 0xbb, 0x74, 0x26, 0x4c, 0x73, 0x61, 0x33, 0xf8,
-	0xdc, 0xf3, 0x69, 0xdc, 0xa5, 0x72, 0x38, 0xa0, 0x89, 0x30, 0x9d, 0x13, 0xa6, 0xb9, 0x30, 0x7d,
-	0x47, 0xca, 0x4c, 0xc7, 0xcd, 0x1d, 0xe3, 0x6d, 0xd4, 0x18, 0x4b, 0x05, 0x91, 0xb6, 0x6b, 0x6d,
-	0xab, 0x73, 0xdd, 0xcd, 0xde, 0xc8, 0x10, 0xed, 0x64, 0xb6, 0x1f, 0x8a, 0x21, 0x84, 0x0f, 0x21,
-	0x80, 0x19, 0x98, 0x5d, 0x06, 0xdb, 0x98, 0xb9, 0xc3, 0x68, 0x2d, 0x12, 0x01, 0x18, 0x67, 0x1b,
-	0xae, 0x19, 0xe3, 0x2d, 0x54, 0xe7, 0x4c, 0xdb, 0xf5, 0xb6, 0xd5, 0xa9, 0xbb, 0xc9, 0x10, 0x6f,
-	0xa2, 0x1a, 0xf7, 0xed, 0x35, 0x63, 0x53, 0xe3, 0x3e, 0xf9, 0xc9, 0x2a, 0xab, 0x95, 0xd3, 0x50,
-	0xad, 0xd6, 0x46, 0x4d, 0x1f, 0x94, 0x17, 0x71, 0x99, 0x04, 0x9a, 0x89, 0xce, 0x4f, 0x15, 0x3c,
-	0xf5, 0x39, 0x9e, 0x5d, 0xb4, 0x01, 0xe7, 0x92, 0x47, 0xa0, 0xde, 0x0b, 0x0d, 0x44, 0xdd, 0x9d,
-	0x4d, 0x64, 0x6c, 0xeb, 0x05, 0xdb, 0xeb, 0xc5, 0xe2, 0x18, 0x34, 0x17, 0x94, 0x14, 0xa1, 0x02,
-	0x7c, 0x0b, 0xad, 0xeb, 0x64, 0x22, 0x63, 0x4a, 0x5f, 0x08, 0x41, 0x37, 0x32, 0xeb, 0x0f, 0xc6,
-	0x10, 0x4d, 0x12, 0xfd, 0x90, 0x8d, 0x20, 0x33, 0x32, 0x63, 0xf2, 0x65, 0xe1, 0xf1, 0x23, 0xe9,
-	0x3f, 0xdb, 0xe5, 0x26, 0xcf, 0xa3, 0xe7, 0x4e, 0x46, 0x52, 0x4f, 0xf2, 0x30, 0xc8, 0x3e, 0xda,
-	0x3a, 0x9b, 0x84, 0xde, 0xc7, 0x3c, 0xf4, 0xc5, 0x13, 0x55, 0x0d, 0x3d, 0x41, 0x37, 0xe7, 0xec,
-	0x8a, 0x2c, 0xf4, 0xd1, 0xb5, 0x27, 0xe9, 0x94, 0x6d, 0xb5, 0xeb, 0x4f, 0xcf, 0x3c, 0xd3, 0x70,
-	0x73, 0xc7, 0xe4, 0x1c, 0x6d, 0xf7, 0x02, 0xd1, 0x67, 0x41, 0x16, 0xcd, 0x4c, 0xfd, 0x33, 0xb4,
-	0xce, 0x35, 0x8c, 0x56, 0xa4, 0x3d, 0x97, 0xaf, 0xd4, 0x2d, 0xf9, 0xb5, 0x8e, 0xec, 0x87, 0xa0,
-	0x19, 0x0f, 0xc0, 0x5f, 0x12, 0x97, 0x68, 0x73, 0x50, 0xc2, 0x5a, 0x39, 0xc5, 0x82, 0xff, 0xf9,
-	0x0d, 0x52, 0xfb, 0xbf, 0xea, 0x41, 0x80, 0x6e, 0x44, 0x20, 0x85, 0xe2, 0x5a, 0x44, 0x1c, 0x94,
-	0x5d, 0x5f, 0x45, 0x4c, 0x6e, 0xee, 0x71, 0xe2, 0x96, 0xbc, 0x63, 0x86, 0xae, 0x7b, 0xc1, 0x58,
-	0x69, 0x88, 0x94, 0xbd, 0x66, 0x94, 0x4e, 0x9e, 0x4e, 0xe9, 0x41, 0xea, 0xcd, 0x2d, 0xdc, 0x76,
-	0x7f, 0x69, 0xa2, 0xcd, 0x2c, 0xca, 0x33, 0x88, 0x62, 0xee, 0x01, 0xfe, 0xce, 0x42, 0xcd, 0xb4,
-	0xc4, 0x98, 0x23, 0x8d, 0x09, 0xcd, 0x3b, 0x45, 0x65, 0x11, 0x6a, 0xdd, 0xb9, 0xd2, 0xa6, 0x38,
-	0x46, 0xf7, 0xbe, 0xfa, 0xe3, 0xaf, 0x1f, 0x6b, 0x5d, 0x72, 0x68, 0xfa, 0x46, 0x7c, 0x94, 0xf7,
-	0x1e, 0xe5, 0x4c, 0xb3, 0xd1, 0x85, 0x93, 0x14, 0x1f, 0xe5, 0x4c, 0x93, 0xc7, 0x85, 0x63, 0xca,
-	0xc5, 0x5b, 0xd6, 0x01, 0xfe, 0xc6, 0x42, 0xcd, 0xb4, 0xba, 0xfe, 0x13, 0x4c, 0xa9, 0xfe, 0xb6,
-	0xb6, 0x0b, 0x9b, 0xf2, 0x61, 0x7e, 0xdb, 0x50, 0xbc, 0x79, 0x70, 0xfc, 0x9f, 0x28, 0x9c, 0x29,
-	0x67, 0xfa, 0x02, 0x7f, 0x6f, 0xa1, 0x46, 0x1a, 0x33, 0x5e, 0x0a, 0xb6, 0x9c, 0x8b, 0x95, 0x6d,
-	0x3b, 0xf2, 0x92, 0x01, 0xbe, 0x4d, 0xb6, 0x16, 0x81, 0x93, 0xcc, 0x7c, 0x6d, 0xa1, 0xb5, 0x53,
-	0xae, 0x34, 0xbe, 0xbd, 0x88, 0x63, 0xca, 0x54, 0xeb, 0x74, 0x55, 0x18, 0x89, 0x08, 0xb1, 0x0d,
-	0x0a, 0xc6, 0x4b, 0x28, 0xf8, 0x1c, 0xe1, 0x1e, 0xe8, 0x85, 0x3a, 0x50, 0x05, 0xf5, 0x72, 0x31,
-	0x5d, 0x55, 0x38, 0x48, 0xc7, 0x28, 0x11, 0xdc, 0x5e, 0x5e, 0xa5, 0xa4, 0xd4, 0x5e, 0x38, 0x7e,
-	0xf6, 0x27, 0xfe, 0xd6, 0x42, 0xf5, 0x1e, 0x54, 0x6a, 0xad, 0x6e, 0x1d, 0xee, 0x1a, 0xa4, 0x1d,
-	0xfc, 0x62, 0x05, 0x12, 0x9e, 0xa2, 0x17, 0x7a, 0xa0, 0xcb, 0x65, 0xb8, 0x0a, 0xeb, 0x6e, 0x31,
-	0x7d, 0x75, 0xd9, 0x26, 0xd4, 0xa8, 0x75, 0xf0, 0x7e, 0x55, 0x02, 0xd2, 0xba, 0x57, 0x2c, 0xc0,
-	0xcf, 0x16, 0x6a, 0xa4, 0xad, 0x72, 0x79, 0x67, 0x96, 0x5a, 0xe8, 0x0a, 0x33, 0x72, 0x6c, 0x18,
-	0x0f, 0x5b, 0x9d, 0xca, 0xa3, 0x44, 0x47, 0xa0, 0x99, 0xcf, 0x34, 0xa3, 0x06, 0x3a, 0xd9, 0xb1,
-	0x9f, 0xa0, 0x46, 0x7a, 0x50, 0xab, 0x52, 0x53, 0x75, 0x70, 0xb3, 0xfc, 0x1f, 0x54, 0xe6, 0xff,
-	0x31, 0x42, 0xc9, 0x2e, 0x3d, 0x89, 0x21, 0xac, 0x4e, 0xfc, 0x1d, 0x9a, 0xde, 0x5d, 0x93, 0x08,
-	0x69, 0x72, 0x77, 0xa5, 0xf1, 0x11, 0x35, 0xbf, 0x98, 0x1d, 0xbe, 0x6f, 0x44, 0xda, 0x78, 0xaf,
-	0x2a, 0xed, 0x90, 0x7a, 0x9f, 0xa2, 0x9b, 0x3d, 0xd0, 0x73, 0xdd, 0xfe, 0x4c, 0x27, 0xa9, 0xdf,
-	0x29, 0x44, 0x17, 0x2f, 0x0c, 0xad, 0xdd, 0xab, 0x3e, 0x15, 0xc1, 0xbd, 0x66, 0x74, 0x5f, 0xc5,
-	0xaf, 0x54, 0xe9, 0xaa, 0x49, 0xe8, 0x65, 0xcd, 0xfe, 0xfe, 0xfd, 0xdf, 0x2e, 0xf7, 0xac, 0xdf,
-	0x2f, 0xf7, 0xac, 0x3f, 0x2f, 0xf7, 0xac, 0x4f, 0xdf, 0xf8, 0x77, 0x17, 0x6d, 0x2f, 0xe0, 0x10,
-	0x16, 0xf7, 0xfd, 0x7e, 0xc3, 0xdc, 0xab, 0x8f, 0xff, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x0c, 0x1d,
-	0x2d, 0x85, 0x10, 0x0c, 0x00, 0x00,
+	// 965 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0xb5, 0xde, 0xc4, 0x6d, 0xc6, 0x25, 0x84, 0x69, 0x1b, 0x36, 0x26, 0x4d, 0xcd, 0x20,
+	0x22, 0x2b, 0x90, 0x59, 0xc5, 0x01, 0xa9, 0x82, 0x13, 0x6d, 0x23, 0x83, 0x94, 0x03, 0x6c, 0x40,
+	0x20, 0x0e, 0xa0, 0xf5, 0xee, 0x93, 0x3b, 0xf5, 0x7a, 0x67, 0xd8, 0x19, 0xbb, 0x31, 0x56, 0x2e,
+	0x48, 0x80, 0xc4, 0x81, 0x03, 0xfc, 0x0f, 0x9c, 0xb8, 0xf0, 0x27, 0x70, 0xe3, 0x88, 0xc4, 0x3f,
+	0x80, 0x22, 0xfe, 0x10, 0x34, 0xb3, 0x3f, 0xec, 0xb5, 0xb3, 0x08, 0x54, 0xd3, 0x93, 0x67, 0x67,
+	0xdf, 0xbe, 0xef, 0xe7, 0xbd, 0x99, 0x79, 0x6f, 0x8c, 0x76, 0x25, 0x24, 0x63, 0x48, 0x5c, 0x91,
+	0xf0, 0xc7, 0x10, 0xa8, 0xfc, 0x97, 0x8a, 0x84, 0x2b, 0x8e, 0xaf, 0x65, 0x8f, 0xcd, 0xdd, 0x3e,
+	0xe7, 0xfd, 0x08, 0x5c, 0x5f, 0x30, 0xd7, 0x8f, 0x63, 0xae, 0x7c, 0xc5, 0x78, 0x2c, 0x53, 0xb3,
+	0x26, 0x19, 0xdc, 0x93, 0x94, 0x71, 0xf3, 0x36, 0xe0, 0x09, 0xb8, 0xe3, 0x23, 0xb7, 0x0f, 0x31,
+	0x24, 0xbe, 0x82, 0x30, 0xb3, 0x39, 0xed, 0x33, 0xf5, 0x68, 0xd4, 0xa3, 0x01, 0x1f, 0xba, 0x7e,
+	0xd2, 0xe7, 0xda, 0xb3, 0x19, 0x1c, 0x06, 0xa1, 0x3b, 0xee, 0xb8, 0x62, 0xd0, 0xd7, 0xdf, 0x4b,
+	0xd7, 0x17, 0x22, 0x62, 0x81, 0xf1, 0xef, 0x8e, 0x8f, 0xfc, 0x48, 0x3c, 0xf2, 0x97, 0xbc, 0x91,
+	0x1f, 0x2c, 0x74, 0xeb, 0xfd, 0x94, 0xed, 0x41, 0x02, 0xbe, 0x02, 0x0f, 0xbe, 0x18, 0x81, 0x54,
+	0xb8, 0x87, 0x72, 0x66, 0xc7, 0x6a, 0x59, 0xed, 0x46, 0xe7, 0x5d, 0x3a, 0x13, 0xa6, 0xb9, 0xb0,
+	0x19, 0x7c, 0x1e, 0x84, 0x74, 0xdc, 0xa1, 0x62, 0xd0, 0xa7, 0x5a, 0x98, 0xce, 0x09, 0xd3, 0x5c,
+	0x98, 0xbe, 0x23, 0x44, 0xa6, 0xe3, 0xe5, 0x8e, 0xf1, 0x36, 0xaa, 0x8f, 0x84, 0x84, 0x44, 0x39,
+	0xb5, 0x96, 0xd5, 0xbe, 0xee, 0x65, 0x4f, 0x64, 0x80, 0x76, 0x32, 0xdb, 0x0f, 0xf9, 0x00, 0xe2,
+	0x87, 0x10, 0xc1, 0x0c, 0xcc, 0x29, 0x83, 0x6d, 0xcc, 0xdc, 0x61, 0xb4, 0x96, 0xf0, 0x08, 0x8c,
+	0xb3, 0x0d, 0xcf, 0x8c, 0xf1, 0x16, 0xb2, 0x99, 0xaf, 0x1c, 0xbb, 0x65, 0xb5, 0x6d, 0x4f, 0x0f,
+	0xf1, 0x26, 0xaa, 0xb1, 0xd0, 0x59, 0x33, 0x36, 0x35, 0x16, 0x92, 0x5f, 0xac, 0xb2, 0x5a, 0x39,
+	0x0d, 0xd5, 0x6a, 0x2d, 0xd4, 0x08, 0x41, 0x06, 0x09, 0x13, 0x3a, 0xd0, 0x4c, 0x74, 0x7e, 0xaa,
+	0xe0, 0xb1, 0xe7, 0x78, 0x76, 0xd1, 0x06, 0x9c, 0x0b, 0x96, 0x80, 0x7c, 0x2f, 0x36, 0x10, 0xb6,
+	0x37, 0x9b, 0xc8, 0xd8, 0xd6, 0x73, 0x36, 0x9d, 0x20, 0x19, 0x70, 0x01, 0xd2, 0xa9, 0xb7, 0xec,
+	0xf6, 0x86, 0x97, 0x3d, 0x91, 0xd7, 0x8b, 0x45, 0x33, 0xc8, 0x1e, 0x48, 0xc1, 0x63, 0x09, 0xf8,
+	0x16, 0x5a, 0x57, 0x7a, 0x22, 0x63, 0x4d, 0x1f, 0x08, 0x41, 0x37, 0x32, 0xeb, 0x0f, 0x46, 0x90,
+	0x4c, 0x34, 0x57, 0xec, 0x0f, 0x21, 0x33, 0x32, 0x63, 0xf2, 0x65, 0xe1, 0xf1, 0x23, 0x11, 0x3e,
+	0xdb, 0x6d, 0x40, 0x9e, 0x47, 0xcf, 0x9d, 0x0c, 0x85, 0x9a, 0xe4, 0x61, 0x90, 0x7d, 0xb4, 0x75,
+	0x36, 0x89, 0x83, 0x8f, 0x59, 0x1c, 0xf2, 0x27, 0xb2, 0x1a, 0x7a, 0x82, 0x6e, 0xce, 0xd9, 0x15,
+	0x59, 0xe8, 0xa1, 0x6b, 0x4f, 0xd2, 0x29, 0xc7, 0x6a, 0xd9, 0x4f, 0xcf, 0x3c, 0xd3, 0xf0, 0x72,
+	0xc7, 0xe4, 0x1c, 0x6d, 0x77, 0x23, 0xde, 0xf3, 0xa3, 0x2c, 0x9a, 0x99, 0xfa, 0x67, 0x68, 0x9d,
+	0x29, 0x18, 0xae, 0x48, 0x7b, 0x2e, 0x5f, 0xa9, 0x5b, 0xf2, 0xab, 0x8d, 0x9c, 0x87, 0xa0, 0x7c,
+	0x16, 0x41, 0xb8, 0x24, 0x2e, 0xd0, 0x66, 0xbf, 0x84, 0xb5, 0x72, 0x8a, 0x05, 0xff, 0xf3, 0x1b,
+	0xa4, 0xf6, 0x7f, 0xd5, 0x89, 0x08, 0xdd, 0x48, 0x40, 0x70, 0xc9, 0x14, 0x4f, 0x18, 0x48, 0xc7,
+	0x5e, 0x45, 0x4c, 0x5e, 0xee, 0x71, 0xe2, 0x95, 0xbc, 0x63, 0x1f, 0x5d, 0x0f, 0xa2, 0x91, 0x54,
+	0x90, 0x48, 0x67, 0xcd, 0x28, 0x9d, 0x3c, 0x9d, 0xd2, 0x83, 0xd4, 0x9b, 0x57, 0xb8, 0xed, 0xfc,
+	0xdc, 0x40, 0x9b, 0x59, 0x94, 0x67, 0x90, 0x8c, 0x59, 0x00, 0xf8, 0x3b, 0x0b, 0x35, 0xd2, 0xd2,
+	0x63, 0x8e, 0x34, 0x26, 0x34, 0xef, 0x20, 0x95, 0xc5, 0xa9, 0x79, 0xe7, 0x4a, 0x9b, 0xe2, 0x18,
+	0xdd, 0xfb, 0xea, 0x8f, 0xbf, 0x7e, 0xac, 0x75, 0xc8, 0xa1, 0xe9, 0x27, 0xe3, 0xa3, 0xbc, 0x27,
+	0x49, 0x77, 0x9a, 0x8d, 0x2e, 0x5c, 0x5d, 0x94, 0xa4, 0x3b, 0xd5, 0x3f, 0x17, 0xae, 0x29, 0x17,
+	0x6f, 0x59, 0x07, 0xf8, 0x1b, 0x0b, 0x35, 0xd2, 0xaa, 0xfb, 0x4f, 0x30, 0xa5, 0xba, 0xdc, 0xdc,
+	0x2e, 0x6c, 0xca, 0x87, 0xf9, 0x6d, 0x43, 0xf1, 0xe6, 0xc1, 0xf1, 0x7f, 0xa2, 0x70, 0xa7, 0xcc,
+	0x57, 0x17, 0xf8, 0x7b, 0x0b, 0xd5, 0xd3, 0x98, 0xf1, 0x52, 0xb0, 0xe5, 0x5c, 0xac, 0x6c, 0xdb,
+	0x91, 0x97, 0x0c, 0xf0, 0x6d, 0xb2, 0xb5, 0x08, 0xac, 0x33, 0xf3, 0xb5, 0x85, 0xd6, 0x4e, 0x99,
+	0x54, 0xf8, 0xf6, 0x22, 0x8e, 0x29, 0x53, 0xcd, 0xd3, 0x55, 0x61, 0x68, 0x11, 0xe2, 0x18, 0x14,
+	0x8c, 0x97, 0x50, 0xf0, 0x39, 0xc2, 0x5d, 0x50, 0x0b, 0x75, 0xa0, 0x0a, 0xea, 0xe5, 0x62, 0xba,
+	0xaa, 0x70, 0x90, 0xb6, 0x51, 0x22, 0xb8, 0xb5, 0xbc, 0x4a, 0xba, 0xd4, 0x5e, 0xb8, 0x61, 0xf6,
+	0x25, 0xfe, 0xd6, 0x42, 0x76, 0x17, 0x2a, 0xb5, 0x56, 0xb7, 0x0e, 0x77, 0x0d, 0xd2, 0x0e, 0x7e,
+	0xb1, 0x02, 0x09, 0x4f, 0xd1, 0x0b, 0x5d, 0x50, 0xe5, 0x32, 0x5c, 0x85, 0x75, 0xb7, 0x98, 0xbe,
+	0xba, 0x6c, 0x13, 0x6a, 0xd4, 0xda, 0x78, 0xbf, 0x2a, 0x01, 0x69, 0xdd, 0x2b, 0x16, 0xe0, 0x27,
+	0x0b, 0xd5, 0xd3, 0x56, 0xb9, 0xbc, 0x33, 0x4b, 0x2d, 0x74, 0x85, 0x19, 0x39, 0x36, 0x8c, 0x87,
+	0xcd, 0x76, 0xe5, 0x51, 0xa2, 0x43, 0x50, 0x7e, 0xe8, 0x2b, 0x9f, 0x1a, 0x68, 0xbd, 0x63, 0x3f,
+	0x41, 0xf5, 0xf4, 0xa0, 0x56, 0xa5, 0xa6, 0xea, 0xe0, 0x66, 0xf9, 0x3f, 0xa8, 0xcc, 0xff, 0x63,
+	0x84, 0xf4, 0x2e, 0x3d, 0x19, 0x43, 0x5c, 0x9d, 0xf8, 0x3b, 0x34, 0xbd, 0xd3, 0xea, 0x08, 0xa9,
+	0xbe, 0xd3, 0xd2, 0xf1, 0x11, 0x35, 0x9f, 0x98, 0x1d, 0xbe, 0x6f, 0x44, 0x5a, 0x78, 0xaf, 0x2a,
+	0xed, 0x90, 0x7a, 0x9f, 0xa2, 0x9b, 0x5d, 0x50, 0x73, 0xdd, 0xfe, 0x4c, 0xe9, 0xd4, 0xef, 0x14,
+	0xa2, 0x8b, 0x17, 0x86, 0xe6, 0xee, 0x55, 0xaf, 0x8a, 0xe0, 0x5e, 0x33, 0xba, 0xaf, 0xe2, 0x57,
+	0xaa, 0x74, 0xe5, 0x24, 0x0e, 0xb2, 0x66, 0x7f, 0xff, 0xfe, 0x6f, 0x97, 0x7b, 0xd6, 0xef, 0x97,
+	0x7b, 0xd6, 0x9f, 0x97, 0x7b, 0xd6, 0xa7, 0x6f, 0xfc, 0xbb, 0x0b, 0x78, 0x10, 0x31, 0x88, 0x8b,
+	0xff, 0x01, 0xbd, 0xba, 0xb9, 0x6f, 0x1f, 0xff, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x04, 0x15,
+	0x15, 0x28, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1304,6 +1314,15 @@ func (m *ProjectTokenCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Scopes) > 0 {
+		for iNdEx := len(m.Scopes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Scopes[iNdEx])
+			copy(dAtA[i:], m.Scopes[iNdEx])
+			i = encodeVarintProject(dAtA, i, uint64(len(m.Scopes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Id) > 0 {
 		i -= len(m.Id)
 		copy(dAtA[i:], m.Id)
@@ -1753,6 +1772,12 @@ func (m *ProjectTokenCreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovProject(uint64(l))
 	}
+	if len(m.Scopes) > 0 {
+		for _, s := range m.Scopes {
+			l = len(s)
+			n += 1 + l + sovProject(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2360,6 +2385,38 @@ func (m *ProjectTokenCreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scopes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProject
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProject
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProject
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scopes = append(m.Scopes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProject(dAtA[iNdEx:])
@@ -33,9 +33,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // SessionCreateRequest is for logging in.
 type SessionCreateRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Token    string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// newPassword is the new password of a local account whose password must be changed on login
+	NewPassword string `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	// otp is the one-time password or a recovery code of a local account with TOTP enabled
+	Otp                  string   `protobuf:"bytes,5,opt,name=otp,proto3" json:"otp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -95,6 +99,181 @@ func (m *SessionCreateRequest) GetToken() string {
 	return ""
 }
 
+func (m *SessionCreateRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+func (m *SessionCreateRequest) GetOtp() string {
+	if m != nil {
+		return m.Otp
+	}
+	return ""
+}
+
+// TOTPEnrollRequest is for enrolling a TOTP authenticator on login, if multi-factor authentication is required.
+type TOTPEnrollRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TOTPEnrollRequest) Reset()         { *m = TOTPEnrollRequest{} }
+func (m *TOTPEnrollRequest) String() string { return proto.CompactTextString(m) }
+func (*TOTPEnrollRequest) ProtoMessage()    {}
+func (*TOTPEnrollRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{1}
+}
+func (m *TOTPEnrollRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TOTPEnrollRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TOTPEnrollRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TOTPEnrollRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TOTPEnrollRequest.Merge(m, src)
+}
+func (m *TOTPEnrollRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TOTPEnrollRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TOTPEnrollRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TOTPEnrollRequest proto.InternalMessageInfo
+
+func (m *TOTPEnrollRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *TOTPEnrollRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+// TOTPEnrollResponse returns the TOTP secret, which must be confirmed by logging in with a one-time password.
+type TOTPEnrollResponse struct {
+	Secret               string   `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TOTPEnrollResponse) Reset()         { *m = TOTPEnrollResponse{} }
+func (m *TOTPEnrollResponse) String() string { return proto.CompactTextString(m) }
+func (*TOTPEnrollResponse) ProtoMessage()    {}
+func (*TOTPEnrollResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{2}
+}
+func (m *TOTPEnrollResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TOTPEnrollResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TOTPEnrollResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TOTPEnrollResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TOTPEnrollResponse.Merge(m, src)
+}
+func (m *TOTPEnrollResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TOTPEnrollResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TOTPEnrollResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TOTPEnrollResponse proto.InternalMessageInfo
+
+func (m *TOTPEnrollResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *TOTPEnrollResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+// SessionExchangeTokenRequest is for exchanging a token of an external issuer configured for token exchange, e.g. the
+// workload identity token of a CI job, for a short-lived session.
+type SessionExchangeTokenRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionExchangeTokenRequest) Reset()         { *m = SessionExchangeTokenRequest{} }
+func (m *SessionExchangeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*SessionExchangeTokenRequest) ProtoMessage()    {}
+func (*SessionExchangeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87870a51a62685ed, []int{3}
+}
+func (m *SessionExchangeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SessionExchangeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SessionExchangeTokenRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SessionExchangeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionExchangeTokenRequest.Merge(m, src)
+}
+func (m *SessionExchangeTokenRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SessionExchangeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionExchangeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionExchangeTokenRequest proto.InternalMessageInfo
+
+func (m *SessionExchangeTokenRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // SessionDeleteRequest is for logging out.
 type SessionDeleteRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -106,7 +285,7 @@ func (m *SessionDeleteRequest) Reset()         { *m = SessionDeleteRequest{} }
 func (m *SessionDeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionDeleteRequest) ProtoMessage()    {}
 func (*SessionDeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87870a51a62685ed, []int{1}
+	return fileDescriptor_87870a51a62685ed, []int{4}
 }
 func (m *SessionDeleteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -137,7 +316,9 @@ var xxx_messageInfo_SessionDeleteRequest proto.InternalMessageInfo
 
 // SessionResponse wraps the created token or returns an empty string if deleted.
 type SessionResponse struct {
-	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// recoveryCodes are returned once the TOTP enrollment was confirmed on login
+	RecoveryCodes        []string `protobuf:"bytes,2,rep,name=recoveryCodes,proto3" json:"recoveryCodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -147,7 +328,7 @@ func (m *SessionResponse) Reset()         { *m = SessionResponse{} }
 func (m *SessionResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionResponse) ProtoMessage()    {}
 func (*SessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87870a51a62685ed, []int{2}
+	return fileDescriptor_87870a51a62685ed, []int{5}
 }
 func (m *SessionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -183,6 +364,13 @@ func (m *SessionResponse) GetToken() string {
 	return ""
 }
 
+func (m *SessionResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
 // Get the current user's userInfo info
 type GetUserInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -194,7 +382,7 @@ func (m *GetUserInfoRequest) Reset()         { *m = GetUserInfoRequest{} }
 func (m *GetUserInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUserInfoRequest) ProtoMessage()    {}
 func (*GetUserInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87870a51a62685ed, []int{3}
+	return fileDescriptor_87870a51a62685ed, []int{6}
 }
 func (m *GetUserInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,7 +426,7 @@ func (m *GetUserInfoResponse) Reset()         { *m = GetUserInfoResponse{} }
 func (m *GetUserInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserInfoResponse) ProtoMessage()    {}
 func (*GetUserInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87870a51a62685ed, []int{4}
+	return fileDescriptor_87870a51a62685ed, []int{7}
 }
 func (m *GetUserInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -297,6 +485,9 @@ func (m *GetUserInfoResponse) GetGroups() []string {
 
 func init() {
 	proto.RegisterType((*SessionCreateRequest)(nil), "session.SessionCreateRequest")
+	proto.RegisterType((*TOTPEnrollRequest)(nil), "session.TOTPEnrollRequest")
+	proto.RegisterType((*TOTPEnrollResponse)(nil), "session.TOTPEnrollResponse")
+	proto.RegisterType((*SessionExchangeTokenRequest)(nil), "session.SessionExchangeTokenRequest")
 	proto.RegisterType((*SessionDeleteRequest)(nil), "session.SessionDeleteRequest")
 	proto.RegisterType((*SessionResponse)(nil), "session.SessionResponse")
 	proto.RegisterType((*GetUserInfoRequest)(nil), "session.GetUserInfoRequest")
@@ -306,33 +497,43 @@ func init() {
 func init() { proto.RegisterFile("server/session/session.proto", fileDescriptor_87870a51a62685ed) }
 
 var fileDescriptor_87870a51a62685ed = []byte{
-	// 404 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8e, 0xd3, 0x30,
-	0x18, 0x85, 0x95, 0x14, 0x42, 0xc7, 0x48, 0x0c, 0x98, 0x68, 0x88, 0x42, 0xa9, 0xaa, 0x6c, 0x18,
-	0x8d, 0x44, 0x23, 0x06, 0x56, 0x2c, 0x0b, 0x12, 0xea, 0x36, 0x15, 0x9b, 0x4a, 0x2c, 0xd2, 0xe4,
-	0xc7, 0xb8, 0x4d, 0xfd, 0x07, 0xdb, 0x49, 0xf7, 0x5c, 0x81, 0xc3, 0x70, 0x05, 0x96, 0x48, 0x5c,
-	0x00, 0x55, 0x1c, 0x04, 0x25, 0x4e, 0x42, 0x9b, 0x56, 0xac, 0xe2, 0xe7, 0xdf, 0xf9, 0xde, 0xb3,
-	0x9e, 0xc9, 0x48, 0x81, 0x2c, 0x41, 0x86, 0x0a, 0x94, 0xe2, 0x28, 0xda, 0xef, 0x34, 0x97, 0xa8,
-	0x91, 0xde, 0x6b, 0xa4, 0x3f, 0x62, 0x88, 0x2c, 0x83, 0x30, 0xce, 0x79, 0x18, 0x0b, 0x81, 0x3a,
-	0xd6, 0x1c, 0x85, 0x32, 0xc7, 0x82, 0x94, 0xb8, 0x0b, 0x73, 0xf0, 0xad, 0x84, 0x58, 0x43, 0x04,
-	0x5f, 0x0a, 0x50, 0x9a, 0xfa, 0x64, 0x58, 0x28, 0x90, 0x22, 0xde, 0x82, 0x67, 0x4d, 0xac, 0xeb,
-	0x8b, 0xa8, 0xd3, 0xd5, 0x2c, 0x8f, 0x95, 0xda, 0xa1, 0x4c, 0x3d, 0xdb, 0xcc, 0x5a, 0x4d, 0x5d,
-	0x72, 0x57, 0xe3, 0x06, 0x84, 0x37, 0xa8, 0x07, 0x46, 0x04, 0x57, 0x9d, 0xcb, 0x3b, 0xc8, 0xa0,
-	0x73, 0x09, 0x9e, 0x93, 0xcb, 0x66, 0x3f, 0x02, 0x95, 0xa3, 0x50, 0xf0, 0x0f, 0x60, 0x1d, 0x02,
-	0x5c, 0x42, 0xdf, 0x83, 0xfe, 0xa0, 0x40, 0xce, 0xc5, 0x27, 0x6c, 0x7f, 0xdf, 0x91, 0xc7, 0x47,
-	0xbb, 0x0d, 0xc2, 0x27, 0xc3, 0x0c, 0x19, 0x83, 0x74, 0x6e, 0x28, 0xc3, 0xa8, 0xd3, 0x47, 0xf7,
-	0xb2, 0x7b, 0xf7, 0x7a, 0x48, 0x06, 0x5c, 0xa9, 0x26, 0x79, 0xb5, 0xa4, 0x57, 0xc4, 0x61, 0x12,
-	0x8b, 0x5c, 0x79, 0x77, 0x26, 0x83, 0xeb, 0x8b, 0xa8, 0x51, 0xb7, 0xdf, 0x6d, 0xf2, 0xa0, 0x09,
-	0xbe, 0x00, 0x59, 0xf2, 0x04, 0xe8, 0x9a, 0xdc, 0x3f, 0xc8, 0x42, 0x9f, 0x4e, 0xdb, 0x3a, 0x4e,
-	0x73, 0xfb, 0xa3, 0xf3, 0x43, 0x13, 0x3f, 0x98, 0x7c, 0xfd, 0xf5, 0xe7, 0x9b, 0xed, 0x53, 0xaf,
-	0xae, 0xac, 0x7c, 0xd9, 0x15, 0x5c, 0x05, 0xe5, 0x15, 0xfc, 0x23, 0x71, 0x4c, 0x5b, 0xf4, 0x59,
-	0x47, 0x3a, 0xd7, 0xa2, 0xef, 0xf5, 0xc7, 0x9d, 0x89, 0x5f, 0x9b, 0xb8, 0xc1, 0x65, 0xcf, 0xe4,
-	0x8d, 0x75, 0x43, 0x97, 0xc4, 0x31, 0x35, 0x9d, 0xe2, 0x8f, 0xea, 0xfb, 0x0f, 0xfe, 0x49, 0x8d,
-	0x7f, 0x74, 0xd3, 0xc7, 0xcf, 0x66, 0x3f, 0xf6, 0x63, 0xeb, 0xe7, 0x7e, 0x6c, 0xfd, 0xde, 0x8f,
-	0xad, 0xe5, 0x6b, 0xc6, 0xf5, 0xe7, 0x62, 0x35, 0x4d, 0x70, 0x1b, 0xc6, 0x92, 0x61, 0x2e, 0x71,
-	0x5d, 0x2f, 0x5e, 0x24, 0x69, 0x58, 0xde, 0x86, 0xf9, 0x86, 0x55, 0x80, 0x24, 0xe3, 0x20, 0x74,
-	0xcb, 0x58, 0x39, 0xf5, 0xd3, 0x7d, 0xf5, 0x37, 0x00, 0x00, 0xff, 0xff, 0xda, 0x7e, 0xce, 0xb3,
-	0x01, 0x03, 0x00, 0x00,
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x18, 0x94, 0x93, 0x34, 0x7f, 0xfa, 0x55, 0xfd, 0xdb, 0x2e, 0x51, 0x31, 0x4e, 0x1a, 0xa2, 0xa5,
+	0x87, 0xaa, 0x12, 0xb1, 0x68, 0x39, 0x71, 0xe0, 0xd0, 0x52, 0xa1, 0x0a, 0x21, 0xaa, 0x34, 0x5c,
+	0x2a, 0x71, 0x70, 0x9d, 0xaf, 0xae, 0x5b, 0x77, 0xd7, 0xec, 0x6e, 0x12, 0xb8, 0xf2, 0x0a, 0x08,
+	0x89, 0x47, 0xe2, 0x88, 0xc4, 0x0b, 0xa0, 0x88, 0x07, 0x41, 0xbb, 0x5e, 0x5b, 0x89, 0x13, 0xf5,
+	0xc2, 0x29, 0xfb, 0xed, 0x6c, 0x66, 0xe6, 0x9b, 0x8c, 0x02, 0x6d, 0x89, 0x62, 0x8c, 0xc2, 0x97,
+	0x28, 0x65, 0xcc, 0x59, 0xfe, 0xd9, 0x4b, 0x05, 0x57, 0x9c, 0xfc, 0x67, 0x47, 0xaf, 0x1d, 0x71,
+	0x1e, 0x25, 0xe8, 0x07, 0x69, 0xec, 0x07, 0x8c, 0x71, 0x15, 0xa8, 0x98, 0x33, 0x99, 0x3d, 0xa3,
+	0xdf, 0x1d, 0x68, 0x9e, 0x67, 0x2f, 0x8f, 0x05, 0x06, 0x0a, 0xfb, 0xf8, 0x71, 0x84, 0x52, 0x11,
+	0x0f, 0x1a, 0x23, 0x89, 0x82, 0x05, 0x77, 0xe8, 0x3a, 0x5d, 0x67, 0x6f, 0xb5, 0x5f, 0xcc, 0x1a,
+	0x4b, 0x03, 0x29, 0x27, 0x5c, 0x0c, 0xdd, 0x4a, 0x86, 0xe5, 0x33, 0x69, 0xc2, 0x8a, 0xe2, 0xb7,
+	0xc8, 0xdc, 0xaa, 0x01, 0xb2, 0x81, 0x74, 0x61, 0x8d, 0xe1, 0xe4, 0x2c, 0xff, 0x52, 0xcd, 0x60,
+	0xb3, 0x57, 0x64, 0x13, 0xaa, 0x5c, 0xa5, 0xee, 0x8a, 0x41, 0xf4, 0x91, 0xbe, 0x81, 0xad, 0xc1,
+	0xbb, 0xc1, 0xd9, 0x09, 0x13, 0x3c, 0x49, 0xfe, 0xd1, 0x16, 0x7d, 0x09, 0x64, 0x96, 0x4c, 0xa6,
+	0x9c, 0x49, 0x24, 0xdb, 0x50, 0x97, 0x18, 0x0a, 0x54, 0x96, 0xcb, 0x4e, 0xda, 0xcc, 0x48, 0x24,
+	0x96, 0x44, 0x1f, 0xe9, 0x21, 0xb4, 0x6c, 0x4c, 0x27, 0x9f, 0xc2, 0xeb, 0x80, 0x45, 0x38, 0xd0,
+	0x8b, 0xe5, 0xb6, 0x8a, 0xad, 0x9d, 0x99, 0xad, 0xe9, 0x76, 0x91, 0xed, 0x2b, 0x4c, 0xb0, 0xc8,
+	0x96, 0xbe, 0x85, 0x0d, 0x7b, 0x5f, 0x38, 0x59, 0x4a, 0x40, 0x76, 0x61, 0x5d, 0x60, 0xc8, 0xc7,
+	0x28, 0x3e, 0x1f, 0xf3, 0x21, 0x4a, 0xb7, 0xd2, 0xad, 0xee, 0xad, 0xf6, 0xe7, 0x2f, 0x69, 0x13,
+	0xc8, 0x6b, 0x54, 0xef, 0x25, 0x8a, 0x53, 0x76, 0xc5, 0x73, 0x91, 0x09, 0x3c, 0x98, 0xbb, 0xb5,
+	0x42, 0x1e, 0x34, 0x12, 0x1e, 0x45, 0x38, 0x3c, 0xcd, 0xb4, 0x1a, 0xfd, 0x62, 0x9e, 0x0b, 0xb7,
+	0x52, 0x0a, 0x77, 0x13, 0xaa, 0xb1, 0x94, 0xf6, 0x57, 0xd5, 0x47, 0x1d, 0x5e, 0x24, 0xf8, 0x28,
+	0x95, 0x6e, 0xcd, 0xb8, 0xb2, 0xd3, 0xc1, 0xb7, 0x1a, 0xfc, 0x6f, 0xd7, 0x3b, 0x47, 0x31, 0x8e,
+	0x43, 0x24, 0x37, 0xb0, 0x36, 0xe3, 0x85, 0xb4, 0x7a, 0x79, 0x57, 0x17, 0x7d, 0x7b, 0xed, 0xe5,
+	0x60, 0x66, 0x9f, 0x76, 0xbf, 0xfc, 0xfa, 0xf3, 0xb5, 0xe2, 0x11, 0xd7, 0xf4, 0x79, 0xfc, 0xac,
+	0x68, 0xbf, 0x36, 0x1a, 0x6b, 0xf2, 0x0f, 0x50, 0xcf, 0x9a, 0x4c, 0x76, 0x0a, 0xa6, 0x65, 0x0d,
+	0xf7, 0xdc, 0x32, 0x5c, 0x88, 0x78, 0x46, 0xa4, 0x49, 0x37, 0x4a, 0x22, 0x2f, 0x9c, 0x7d, 0x72,
+	0x05, 0x90, 0x95, 0x48, 0xd7, 0x89, 0x78, 0x05, 0xc7, 0x42, 0x55, 0xbd, 0xd6, 0x52, 0xcc, 0x4a,
+	0x3c, 0x36, 0x12, 0x8f, 0x68, 0xb3, 0xbc, 0x87, 0xe2, 0x2a, 0xd5, 0x3a, 0x0a, 0xd6, 0xe7, 0x9a,
+	0x46, 0x76, 0xcb, 0x76, 0x97, 0x15, 0xf1, 0x9e, 0xa5, 0x9e, 0x18, 0xc5, 0x1d, 0xba, 0x90, 0x1c,
+	0x5a, 0x1e, 0xad, 0x7a, 0x01, 0xf5, 0xac, 0xaa, 0x8b, 0xe1, 0xcd, 0x55, 0xf8, 0x1e, 0x9d, 0x87,
+	0x46, 0x67, 0x6b, 0xbf, 0x1c, 0xde, 0xd1, 0xd1, 0x8f, 0x69, 0xc7, 0xf9, 0x39, 0xed, 0x38, 0xbf,
+	0xa7, 0x1d, 0xe7, 0xe2, 0x79, 0x14, 0xab, 0xeb, 0xd1, 0x65, 0x2f, 0xe4, 0x77, 0x7e, 0x20, 0x22,
+	0x9e, 0x0a, 0x7e, 0x63, 0x0e, 0x4f, 0xc3, 0xa1, 0x3f, 0x3e, 0xf0, 0xd3, 0xdb, 0x48, 0x13, 0x84,
+	0x49, 0x8c, 0x4c, 0xe5, 0x1c, 0x97, 0x75, 0xf3, 0xaf, 0x75, 0xf8, 0x37, 0x00, 0x00, 0xff, 0xff,
+	0xd5, 0x5d, 0xb2, 0x44, 0xfc, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -351,6 +552,10 @@ type SessionServiceClient interface {
 	GetUserInfo(ctx context.Context, in *GetUserInfoRequest, opts ...grpc.CallOption) (*GetUserInfoResponse, error)
 	// Create a new JWT for authentication and set a cookie if using HTTP
 	Create(ctx context.Context, in *SessionCreateRequest, opts ...grpc.CallOption) (*SessionResponse, error)
+	// EnrollTOTP generates a TOTP secret for a local account which is required to enroll before logging in
+	EnrollTOTP(ctx context.Context, in *TOTPEnrollRequest, opts ...grpc.CallOption) (*TOTPEnrollResponse, error)
+	// ExchangeToken exchanges a token of an external issuer for a short-lived JWT mapped to an RBAC subject
+	ExchangeToken(ctx context.Context, in *SessionExchangeTokenRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error)
 }
@@ -381,6 +586,24 @@ func (c *sessionServiceClient) Create(ctx context.Context, in *SessionCreateRequ
 	return out, nil
 }
 
+func (c *sessionServiceClient) EnrollTOTP(ctx context.Context, in *TOTPEnrollRequest, opts ...grpc.CallOption) (*TOTPEnrollResponse, error) {
+	out := new(TOTPEnrollResponse)
+	err := c.cc.Invoke(ctx, "/session.SessionService/EnrollTOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sessionServiceClient) ExchangeToken(ctx context.Context, in *SessionExchangeTokenRequest, opts ...grpc.CallOption) (*SessionResponse, error) {
+	out := new(SessionResponse)
+	err := c.cc.Invoke(ctx, "/session.SessionService/ExchangeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sessionServiceClient) Delete(ctx context.Context, in *SessionDeleteRequest, opts ...grpc.CallOption) (*SessionResponse, error) {
 	out := new(SessionResponse)
 	err := c.cc.Invoke(ctx, "/session.SessionService/Delete", in, out, opts...)
@@ -396,6 +619,10 @@ type SessionServiceServer interface {
 	GetUserInfo(context.Context, *GetUserInfoRequest) (*GetUserInfoResponse, error)
 	// Create a new JWT for authentication and set a cookie if using HTTP
 	Create(context.Context, *SessionCreateRequest) (*SessionResponse, error)
+	// EnrollTOTP generates a TOTP secret for a local account which is required to enroll before logging in
+	EnrollTOTP(context.Context, *TOTPEnrollRequest) (*TOTPEnrollResponse, error)
+	// ExchangeToken exchanges a token of an external issuer for a short-lived JWT mapped to an RBAC subject
+	ExchangeToken(context.Context, *SessionExchangeTokenRequest) (*SessionResponse, error)
 	// Delete an existing JWT cookie if using HTTP
 	Delete(context.Context, *SessionDeleteRequest) (*SessionResponse, error)
 }
@@ -410,6 +637,12 @@ func (*UnimplementedSessionServiceServer) GetUserInfo(ctx context.Context, req *
 func (*UnimplementedSessionServiceServer) Create(ctx context.Context, req *SessionCreateRequest) (*SessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
 }
+func (*UnimplementedSessionServiceServer) EnrollTOTP(ctx context.Context, req *TOTPEnrollRequest) (*TOTPEnrollResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTOTP not implemented")
+}
+func (*UnimplementedSessionServiceServer) ExchangeToken(ctx context.Context, req *SessionExchangeTokenRequest) (*SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeToken not implemented")
+}
 func (*UnimplementedSessionServiceServer) Delete(ctx context.Context, req *SessionDeleteRequest) (*SessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -454,6 +687,42 @@ func _SessionService_Create_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SessionService_EnrollTOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TOTPEnrollRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).EnrollTOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/EnrollTOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).EnrollTOTP(ctx, req.(*TOTPEnrollRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SessionService_ExchangeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionExchangeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SessionServiceServer).ExchangeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/session.SessionService/ExchangeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SessionServiceServer).ExchangeToken(ctx, req.(*SessionExchangeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SessionService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SessionDeleteRequest)
 	if err := dec(in); err != nil {
@@ -484,6 +753,14 @@ var _SessionService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Create",
 			Handler:    _SessionService_Create_Handler,
 		},
+		{
+			MethodName: "EnrollTOTP",
+			Handler:    _SessionService_EnrollTOTP_Handler,
+		},
+		{
+			MethodName: "ExchangeToken",
+			Handler:    _SessionService_ExchangeToken_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _SessionService_Delete_Handler,
@@ -517,6 +794,20 @@ func (m *SessionCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Otp) > 0 {
+		i -= len(m.Otp)
+		copy(dAtA[i:], m.Otp)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Otp)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewPassword) > 0 {
+		i -= len(m.NewPassword)
+		copy(dAtA[i:], m.NewPassword)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.NewPassword)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Token) > 0 {
 		i -= len(m.Token)
 		copy(dAtA[i:], m.Token)
@@ -541,7 +832,7 @@ func (m *SessionCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SessionDeleteRequest) Marshal() (dAtA []byte, err error) {
+func (m *TOTPEnrollRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -551,12 +842,53 @@ func (m *SessionDeleteRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *TOTPEnrollRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TOTPEnrollRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Password) > 0 {
+		i -= len(m.Password)
+		copy(dAtA[i:], m.Password)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Password)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TOTPEnrollResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TOTPEnrollResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TOTPEnrollResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -565,10 +897,24 @@ func (m *SessionDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Secret) > 0 {
+		i -= len(m.Secret)
+		copy(dAtA[i:], m.Secret)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Secret)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SessionResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionExchangeTokenRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -578,12 +924,12 @@ func (m *SessionResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SessionResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionExchangeTokenRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionExchangeTokenRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -602,7 +948,7 @@ func (m *SessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUserInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *SessionDeleteRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -612,12 +958,12 @@ func (m *GetUserInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUserInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionDeleteRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionDeleteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -629,7 +975,7 @@ func (m *GetUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *GetUserInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *SessionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -639,12 +985,12 @@ func (m *GetUserInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GetUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *SessionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -653,21 +999,91 @@ func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Groups[iNdEx])
-			copy(dAtA[i:], m.Groups[iNdEx])
-			i = encodeVarintSession(dAtA, i, uint64(len(m.Groups[iNdEx])))
+	if len(m.RecoveryCodes) > 0 {
+		for iNdEx := len(m.RecoveryCodes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RecoveryCodes[iNdEx])
+			copy(dAtA[i:], m.RecoveryCodes[iNdEx])
+			i = encodeVarintSession(dAtA, i, uint64(len(m.RecoveryCodes[iNdEx])))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Iss) > 0 {
-		i -= len(m.Iss)
-		copy(dAtA[i:], m.Iss)
-		i = encodeVarintSession(dAtA, i, uint64(len(m.Iss)))
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Token)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintSession(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Iss) > 0 {
+		i -= len(m.Iss)
+		copy(dAtA[i:], m.Iss)
+		i = encodeVarintSession(dAtA, i, uint64(len(m.Iss)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Username) > 0 {
 		i -= len(m.Username)
@@ -718,6 +1134,70 @@ func (m *SessionCreateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSession(uint64(l))
 	}
+	l = len(m.NewPassword)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Otp)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TOTPEnrollRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Password)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TOTPEnrollResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Secret)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SessionExchangeTokenRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovSession(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -746,6 +1226,12 @@ func (m *SessionResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSession(uint64(l))
 	}
+	if len(m.RecoveryCodes) > 0 {
+		for _, s := range m.RecoveryCodes {
+			l = len(s)
+			n += 1 + l + sovSession(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -924,57 +1410,70 @@ func (m *SessionCreateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSession(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPassword", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthSession
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SessionDeleteRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSession
+			m.NewPassword = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Otp", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SessionDeleteRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Otp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSession(dAtA[iNdEx:])
@@ -997,7 +1496,7 @@ func (m *SessionDeleteRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SessionResponse) Unmarshal(dAtA []byte) error {
+func (m *TOTPEnrollRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1020,15 +1519,15 @@ func (m *SessionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SessionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TOTPEnrollRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TOTPEnrollRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1056,7 +1555,403 @@ func (m *SessionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Password", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Password = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TOTPEnrollResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TOTPEnrollResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TOTPEnrollResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Secret = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionExchangeTokenRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionExchangeTokenRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionExchangeTokenRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionDeleteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionDeleteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionDeleteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSession(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSession
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SessionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSession
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SessionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryCodes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSession
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSession
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSession
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecoveryCodes = append(m.RecoveryCodes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
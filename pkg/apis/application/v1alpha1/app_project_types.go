@@ -172,6 +172,21 @@ func (p *AppProject) ValidateProject() error {
 		}
 		destKeys[key] = true
 	}
+	for _, dsa := range p.Spec.DestinationServiceAccounts {
+		if dsa.Server == "" || strings.HasPrefix(dsa.Server, "!") || strings.HasPrefix(dsa.Namespace, "!") {
+			return status.Errorf(codes.InvalidArgument, "destination service account server '%s' and namespace '%s' must be non-empty patterns without negation", dsa.Server, dsa.Namespace)
+		}
+		saName := dsa.DefaultServiceAccount
+		if parts := strings.SplitN(saName, ":", 2); len(parts) == 2 {
+			if parts[0] == "" {
+				return status.Errorf(codes.InvalidArgument, "destination service account '%s' has an empty namespace", dsa.DefaultServiceAccount)
+			}
+			saName = parts[1]
+		}
+		if saName == "" || strings.ContainsAny(dsa.DefaultServiceAccount, " \t*") {
+			return status.Errorf(codes.InvalidArgument, "destination service account '%s' is invalid", dsa.DefaultServiceAccount)
+		}
+	}
 	srcRepos := make(map[string]bool)
 	for _, src := range p.Spec.SourceRepos {
 		if _, ok := srcRepos[src]; ok {
@@ -211,6 +226,12 @@ func (p *AppProject) ValidateProject() error {
 		roleNames[role.Name] = true
 	}
 
+	switch p.Spec.SignaturePolicy {
+	case "", SignaturePolicyCommit, SignaturePolicyTag, SignaturePolicyBoth:
+	default:
+		return status.Errorf(codes.InvalidArgument, "signature policy '%s' is invalid, must be one of %s, %s or %s", p.Spec.SignaturePolicy, SignaturePolicyCommit, SignaturePolicyTag, SignaturePolicyBoth)
+	}
+
 	if p.Spec.SyncWindows.HasWindows() {
 		existingWindows := make(map[string]bool)
 		for _, window := range p.Spec.SyncWindows {
@@ -395,6 +416,25 @@ func (proj AppProject) IsDestinationPermitted(dst ApplicationDestination) bool {
 	return anyDestinationMatched && noDenyDestinationsMatched
 }
 
+// GetImpersonatedServiceAccount returns the user name of the service account to impersonate when syncing to the given
+// destination server and namespace. The first matching destination service account of the project is used.
+func (proj AppProject) GetImpersonatedServiceAccount(server string, namespace string) (string, error) {
+	for _, item := range proj.Spec.DestinationServiceAccounts {
+		if !globMatch(item.Server, server, false) || !globMatch(item.Namespace, namespace, false) {
+			continue
+		}
+		saNamespace, saName := namespace, item.DefaultServiceAccount
+		if parts := strings.SplitN(item.DefaultServiceAccount, ":", 2); len(parts) == 2 {
+			saNamespace, saName = parts[0], parts[1]
+		}
+		if saNamespace == "" {
+			return "", fmt.Errorf("namespace of service account '%s' must be specified for destinations without a namespace", saName)
+		}
+		return fmt.Sprintf("system:serviceaccount:%s:%s", saNamespace, saName), nil
+	}
+	return "", fmt.Errorf("no destination service account configured in project '%s' for server '%s' and namespace '%s'", proj.Name, server, namespace)
+}
+
 func isDenyDestination(pattern string) bool {
 	return strings.HasPrefix(pattern, "!")
 }
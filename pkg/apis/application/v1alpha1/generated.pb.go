@@ -260,10 +260,38 @@ func (m *ApplicationDestination) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ApplicationDestination proto.InternalMessageInfo
 
+func (m *ApplicationDestinationServiceAccount) Reset()      { *m = ApplicationDestinationServiceAccount{} }
+func (*ApplicationDestinationServiceAccount) ProtoMessage() {}
+func (*ApplicationDestinationServiceAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{8}
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.Merge(m, src)
+}
+func (m *ApplicationDestinationServiceAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationDestinationServiceAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationDestinationServiceAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationDestinationServiceAccount proto.InternalMessageInfo
+
 func (m *ApplicationList) Reset()      { *m = ApplicationList{} }
 func (*ApplicationList) ProtoMessage() {}
 func (*ApplicationList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{8}
+	return fileDescriptor_030104ce3b95bcac, []int{9}
 }
 func (m *ApplicationList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +319,7 @@ var xxx_messageInfo_ApplicationList proto.InternalMessageInfo
 func (m *ApplicationSource) Reset()      { *m = ApplicationSource{} }
 func (*ApplicationSource) ProtoMessage() {}
 func (*ApplicationSource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{9}
+	return fileDescriptor_030104ce3b95bcac, []int{10}
 }
 func (m *ApplicationSource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +347,7 @@ var xxx_messageInfo_ApplicationSource proto.InternalMessageInfo
 func (m *ApplicationSourceDirectory) Reset()      { *m = ApplicationSourceDirectory{} }
 func (*ApplicationSourceDirectory) ProtoMessage() {}
 func (*ApplicationSourceDirectory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{10}
+	return fileDescriptor_030104ce3b95bcac, []int{11}
 }
 func (m *ApplicationSourceDirectory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -347,7 +375,7 @@ var xxx_messageInfo_ApplicationSourceDirectory proto.InternalMessageInfo
 func (m *ApplicationSourceHelm) Reset()      { *m = ApplicationSourceHelm{} }
 func (*ApplicationSourceHelm) ProtoMessage() {}
 func (*ApplicationSourceHelm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{11}
+	return fileDescriptor_030104ce3b95bcac, []int{12}
 }
 func (m *ApplicationSourceHelm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +403,7 @@ var xxx_messageInfo_ApplicationSourceHelm proto.InternalMessageInfo
 func (m *ApplicationSourceJsonnet) Reset()      { *m = ApplicationSourceJsonnet{} }
 func (*ApplicationSourceJsonnet) ProtoMessage() {}
 func (*ApplicationSourceJsonnet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{12}
+	return fileDescriptor_030104ce3b95bcac, []int{13}
 }
 func (m *ApplicationSourceJsonnet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +431,7 @@ var xxx_messageInfo_ApplicationSourceJsonnet proto.InternalMessageInfo
 func (m *ApplicationSourceKustomize) Reset()      { *m = ApplicationSourceKustomize{} }
 func (*ApplicationSourceKustomize) ProtoMessage() {}
 func (*ApplicationSourceKustomize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{13}
+	return fileDescriptor_030104ce3b95bcac, []int{14}
 }
 func (m *ApplicationSourceKustomize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +459,7 @@ var xxx_messageInfo_ApplicationSourceKustomize proto.InternalMessageInfo
 func (m *ApplicationSourcePlugin) Reset()      { *m = ApplicationSourcePlugin{} }
 func (*ApplicationSourcePlugin) ProtoMessage() {}
 func (*ApplicationSourcePlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{14}
+	return fileDescriptor_030104ce3b95bcac, []int{15}
 }
 func (m *ApplicationSourcePlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +487,7 @@ var xxx_messageInfo_ApplicationSourcePlugin proto.InternalMessageInfo
 func (m *ApplicationSpec) Reset()      { *m = ApplicationSpec{} }
 func (*ApplicationSpec) ProtoMessage() {}
 func (*ApplicationSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{15}
+	return fileDescriptor_030104ce3b95bcac, []int{16}
 }
 func (m *ApplicationSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +515,7 @@ var xxx_messageInfo_ApplicationSpec proto.InternalMessageInfo
 func (m *ApplicationStatus) Reset()      { *m = ApplicationStatus{} }
 func (*ApplicationStatus) ProtoMessage() {}
 func (*ApplicationStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{16}
+	return fileDescriptor_030104ce3b95bcac, []int{17}
 }
 func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +543,7 @@ var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
 func (m *ApplicationSummary) Reset()      { *m = ApplicationSummary{} }
 func (*ApplicationSummary) ProtoMessage() {}
 func (*ApplicationSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{17}
+	return fileDescriptor_030104ce3b95bcac, []int{18}
 }
 func (m *ApplicationSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +571,7 @@ var xxx_messageInfo_ApplicationSummary proto.InternalMessageInfo
 func (m *ApplicationTree) Reset()      { *m = ApplicationTree{} }
 func (*ApplicationTree) ProtoMessage() {}
 func (*ApplicationTree) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{18}
+	return fileDescriptor_030104ce3b95bcac, []int{19}
 }
 func (m *ApplicationTree) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +599,7 @@ var xxx_messageInfo_ApplicationTree proto.InternalMessageInfo
 func (m *ApplicationWatchEvent) Reset()      { *m = ApplicationWatchEvent{} }
 func (*ApplicationWatchEvent) ProtoMessage() {}
 func (*ApplicationWatchEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{19}
+	return fileDescriptor_030104ce3b95bcac, []int{20}
 }
 func (m *ApplicationWatchEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +627,7 @@ var xxx_messageInfo_ApplicationWatchEvent proto.InternalMessageInfo
 func (m *Backoff) Reset()      { *m = Backoff{} }
 func (*Backoff) ProtoMessage() {}
 func (*Backoff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{20}
+	return fileDescriptor_030104ce3b95bcac, []int{21}
 }
 func (m *Backoff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +655,7 @@ var xxx_messageInfo_Backoff proto.InternalMessageInfo
 func (m *Cluster) Reset()      { *m = Cluster{} }
 func (*Cluster) ProtoMessage() {}
 func (*Cluster) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{21}
+	return fileDescriptor_030104ce3b95bcac, []int{22}
 }
 func (m *Cluster) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -655,7 +683,7 @@ var xxx_messageInfo_Cluster proto.InternalMessageInfo
 func (m *ClusterCacheInfo) Reset()      { *m = ClusterCacheInfo{} }
 func (*ClusterCacheInfo) ProtoMessage() {}
 func (*ClusterCacheInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{22}
+	return fileDescriptor_030104ce3b95bcac, []int{23}
 }
 func (m *ClusterCacheInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -683,7 +711,7 @@ var xxx_messageInfo_ClusterCacheInfo proto.InternalMessageInfo
 func (m *ClusterConfig) Reset()      { *m = ClusterConfig{} }
 func (*ClusterConfig) ProtoMessage() {}
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{23}
+	return fileDescriptor_030104ce3b95bcac, []int{24}
 }
 func (m *ClusterConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -711,7 +739,7 @@ var xxx_messageInfo_ClusterConfig proto.InternalMessageInfo
 func (m *ClusterInfo) Reset()      { *m = ClusterInfo{} }
 func (*ClusterInfo) ProtoMessage() {}
 func (*ClusterInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{24}
+	return fileDescriptor_030104ce3b95bcac, []int{25}
 }
 func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +767,7 @@ var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
 func (m *ClusterList) Reset()      { *m = ClusterList{} }
 func (*ClusterList) ProtoMessage() {}
 func (*ClusterList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{25}
+	return fileDescriptor_030104ce3b95bcac, []int{26}
 }
 func (m *ClusterList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,7 +795,7 @@ var xxx_messageInfo_ClusterList proto.InternalMessageInfo
 func (m *Command) Reset()      { *m = Command{} }
 func (*Command) ProtoMessage() {}
 func (*Command) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{26}
+	return fileDescriptor_030104ce3b95bcac, []int{27}
 }
 func (m *Command) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -795,7 +823,7 @@ var xxx_messageInfo_Command proto.InternalMessageInfo
 func (m *ComparedTo) Reset()      { *m = ComparedTo{} }
 func (*ComparedTo) ProtoMessage() {}
 func (*ComparedTo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{27}
+	return fileDescriptor_030104ce3b95bcac, []int{28}
 }
 func (m *ComparedTo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -823,7 +851,7 @@ var xxx_messageInfo_ComparedTo proto.InternalMessageInfo
 func (m *ComponentParameter) Reset()      { *m = ComponentParameter{} }
 func (*ComponentParameter) ProtoMessage() {}
 func (*ComponentParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{28}
+	return fileDescriptor_030104ce3b95bcac, []int{29}
 }
 func (m *ComponentParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -851,7 +879,7 @@ var xxx_messageInfo_ComponentParameter proto.InternalMessageInfo
 func (m *ConfigManagementPlugin) Reset()      { *m = ConfigManagementPlugin{} }
 func (*ConfigManagementPlugin) ProtoMessage() {}
 func (*ConfigManagementPlugin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{29}
+	return fileDescriptor_030104ce3b95bcac, []int{30}
 }
 func (m *ConfigManagementPlugin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +907,7 @@ var xxx_messageInfo_ConfigManagementPlugin proto.InternalMessageInfo
 func (m *ConnectionState) Reset()      { *m = ConnectionState{} }
 func (*ConnectionState) ProtoMessage() {}
 func (*ConnectionState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{30}
+	return fileDescriptor_030104ce3b95bcac, []int{31}
 }
 func (m *ConnectionState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -907,7 +935,7 @@ var xxx_messageInfo_ConnectionState proto.InternalMessageInfo
 func (m *EnvEntry) Reset()      { *m = EnvEntry{} }
 func (*EnvEntry) ProtoMessage() {}
 func (*EnvEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{31}
+	return fileDescriptor_030104ce3b95bcac, []int{32}
 }
 func (m *EnvEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -935,7 +963,7 @@ var xxx_messageInfo_EnvEntry proto.InternalMessageInfo
 func (m *ExecProviderConfig) Reset()      { *m = ExecProviderConfig{} }
 func (*ExecProviderConfig) ProtoMessage() {}
 func (*ExecProviderConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{32}
+	return fileDescriptor_030104ce3b95bcac, []int{33}
 }
 func (m *ExecProviderConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +991,7 @@ var xxx_messageInfo_ExecProviderConfig proto.InternalMessageInfo
 func (m *GnuPGPublicKey) Reset()      { *m = GnuPGPublicKey{} }
 func (*GnuPGPublicKey) ProtoMessage() {}
 func (*GnuPGPublicKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{33}
+	return fileDescriptor_030104ce3b95bcac, []int{34}
 }
 func (m *GnuPGPublicKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -991,7 +1019,7 @@ var xxx_messageInfo_GnuPGPublicKey proto.InternalMessageInfo
 func (m *GnuPGPublicKeyList) Reset()      { *m = GnuPGPublicKeyList{} }
 func (*GnuPGPublicKeyList) ProtoMessage() {}
 func (*GnuPGPublicKeyList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{34}
+	return fileDescriptor_030104ce3b95bcac, []int{35}
 }
 func (m *GnuPGPublicKeyList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1019,7 +1047,7 @@ var xxx_messageInfo_GnuPGPublicKeyList proto.InternalMessageInfo
 func (m *HealthStatus) Reset()      { *m = HealthStatus{} }
 func (*HealthStatus) ProtoMessage() {}
 func (*HealthStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{35}
+	return fileDescriptor_030104ce3b95bcac, []int{36}
 }
 func (m *HealthStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1075,7 @@ var xxx_messageInfo_HealthStatus proto.InternalMessageInfo
 func (m *HelmFileParameter) Reset()      { *m = HelmFileParameter{} }
 func (*HelmFileParameter) ProtoMessage() {}
 func (*HelmFileParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{36}
+	return fileDescriptor_030104ce3b95bcac, []int{37}
 }
 func (m *HelmFileParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1103,7 @@ var xxx_messageInfo_HelmFileParameter proto.InternalMessageInfo
 func (m *HelmOptions) Reset()      { *m = HelmOptions{} }
 func (*HelmOptions) ProtoMessage() {}
 func (*HelmOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{37}
+	return fileDescriptor_030104ce3b95bcac, []int{38}
 }
 func (m *HelmOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1103,7 +1131,7 @@ var xxx_messageInfo_HelmOptions proto.InternalMessageInfo
 func (m *HelmParameter) Reset()      { *m = HelmParameter{} }
 func (*HelmParameter) ProtoMessage() {}
 func (*HelmParameter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{38}
+	return fileDescriptor_030104ce3b95bcac, []int{39}
 }
 func (m *HelmParameter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1131,7 +1159,7 @@ var xxx_messageInfo_HelmParameter proto.InternalMessageInfo
 func (m *HostInfo) Reset()      { *m = HostInfo{} }
 func (*HostInfo) ProtoMessage() {}
 func (*HostInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{39}
+	return fileDescriptor_030104ce3b95bcac, []int{40}
 }
 func (m *HostInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1159,7 +1187,7 @@ var xxx_messageInfo_HostInfo proto.InternalMessageInfo
 func (m *HostResourceInfo) Reset()      { *m = HostResourceInfo{} }
 func (*HostResourceInfo) ProtoMessage() {}
 func (*HostResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{40}
+	return fileDescriptor_030104ce3b95bcac, []int{41}
 }
 func (m *HostResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1187,7 +1215,7 @@ var xxx_messageInfo_HostResourceInfo proto.InternalMessageInfo
 func (m *Info) Reset()      { *m = Info{} }
 func (*Info) ProtoMessage() {}
 func (*Info) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{41}
+	return fileDescriptor_030104ce3b95bcac, []int{42}
 }
 func (m *Info) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1243,7 @@ var xxx_messageInfo_Info proto.InternalMessageInfo
 func (m *InfoItem) Reset()      { *m = InfoItem{} }
 func (*InfoItem) ProtoMessage() {}
 func (*InfoItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{42}
+	return fileDescriptor_030104ce3b95bcac, []int{43}
 }
 func (m *InfoItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1243,7 +1271,7 @@ var xxx_messageInfo_InfoItem proto.InternalMessageInfo
 func (m *JWTToken) Reset()      { *m = JWTToken{} }
 func (*JWTToken) ProtoMessage() {}
 func (*JWTToken) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{43}
+	return fileDescriptor_030104ce3b95bcac, []int{44}
 }
 func (m *JWTToken) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1271,7 +1299,7 @@ var xxx_messageInfo_JWTToken proto.InternalMessageInfo
 func (m *JWTTokens) Reset()      { *m = JWTTokens{} }
 func (*JWTTokens) ProtoMessage() {}
 func (*JWTTokens) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{44}
+	return fileDescriptor_030104ce3b95bcac, []int{45}
 }
 func (m *JWTTokens) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1299,7 +1327,7 @@ var xxx_messageInfo_JWTTokens proto.InternalMessageInfo
 func (m *JsonnetVar) Reset()      { *m = JsonnetVar{} }
 func (*JsonnetVar) ProtoMessage() {}
 func (*JsonnetVar) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{45}
+	return fileDescriptor_030104ce3b95bcac, []int{46}
 }
 func (m *JsonnetVar) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1327,7 +1355,7 @@ var xxx_messageInfo_JsonnetVar proto.InternalMessageInfo
 func (m *KnownTypeField) Reset()      { *m = KnownTypeField{} }
 func (*KnownTypeField) ProtoMessage() {}
 func (*KnownTypeField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{46}
+	return fileDescriptor_030104ce3b95bcac, []int{47}
 }
 func (m *KnownTypeField) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1355,7 +1383,7 @@ var xxx_messageInfo_KnownTypeField proto.InternalMessageInfo
 func (m *KustomizeOptions) Reset()      { *m = KustomizeOptions{} }
 func (*KustomizeOptions) ProtoMessage() {}
 func (*KustomizeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{47}
+	return fileDescriptor_030104ce3b95bcac, []int{48}
 }
 func (m *KustomizeOptions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1383,7 +1411,7 @@ var xxx_messageInfo_KustomizeOptions proto.InternalMessageInfo
 func (m *Operation) Reset()      { *m = Operation{} }
 func (*Operation) ProtoMessage() {}
 func (*Operation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{48}
+	return fileDescriptor_030104ce3b95bcac, []int{49}
 }
 func (m *Operation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1411,7 +1439,7 @@ var xxx_messageInfo_Operation proto.InternalMessageInfo
 func (m *OperationInitiator) Reset()      { *m = OperationInitiator{} }
 func (*OperationInitiator) ProtoMessage() {}
 func (*OperationInitiator) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{49}
+	return fileDescriptor_030104ce3b95bcac, []int{50}
 }
 func (m *OperationInitiator) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1439,7 +1467,7 @@ var xxx_messageInfo_OperationInitiator proto.InternalMessageInfo
 func (m *OperationState) Reset()      { *m = OperationState{} }
 func (*OperationState) ProtoMessage() {}
 func (*OperationState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{50}
+	return fileDescriptor_030104ce3b95bcac, []int{51}
 }
 func (m *OperationState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1467,7 +1495,7 @@ var xxx_messageInfo_OperationState proto.InternalMessageInfo
 func (m *OrphanedResourceKey) Reset()      { *m = OrphanedResourceKey{} }
 func (*OrphanedResourceKey) ProtoMessage() {}
 func (*OrphanedResourceKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{51}
+	return fileDescriptor_030104ce3b95bcac, []int{52}
 }
 func (m *OrphanedResourceKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1495,7 +1523,7 @@ var xxx_messageInfo_OrphanedResourceKey proto.InternalMessageInfo
 func (m *OrphanedResourcesMonitorSettings) Reset()      { *m = OrphanedResourcesMonitorSettings{} }
 func (*OrphanedResourcesMonitorSettings) ProtoMessage() {}
 func (*OrphanedResourcesMonitorSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{52}
+	return fileDescriptor_030104ce3b95bcac, []int{53}
 }
 func (m *OrphanedResourcesMonitorSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1520,10 +1548,38 @@ func (m *OrphanedResourcesMonitorSettings) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_OrphanedResourcesMonitorSettings proto.InternalMessageInfo
 
+func (m *OverrideHealthConditions) Reset()      { *m = OverrideHealthConditions{} }
+func (*OverrideHealthConditions) ProtoMessage() {}
+func (*OverrideHealthConditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030104ce3b95bcac, []int{54}
+}
+func (m *OverrideHealthConditions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OverrideHealthConditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OverrideHealthConditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverrideHealthConditions.Merge(m, src)
+}
+func (m *OverrideHealthConditions) XXX_Size() int {
+	return m.Size()
+}
+func (m *OverrideHealthConditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverrideHealthConditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverrideHealthConditions proto.InternalMessageInfo
+
 func (m *OverrideIgnoreDiff) Reset()      { *m = OverrideIgnoreDiff{} }
 func (*OverrideIgnoreDiff) ProtoMessage() {}
 func (*OverrideIgnoreDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{53}
+	return fileDescriptor_030104ce3b95bcac, []int{55}
 }
 func (m *OverrideIgnoreDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1551,7 +1607,7 @@ var xxx_messageInfo_OverrideIgnoreDiff proto.InternalMessageInfo
 func (m *ProjectRole) Reset()      { *m = ProjectRole{} }
 func (*ProjectRole) ProtoMessage() {}
 func (*ProjectRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{54}
+	return fileDescriptor_030104ce3b95bcac, []int{56}
 }
 func (m *ProjectRole) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1579,7 +1635,7 @@ var xxx_messageInfo_ProjectRole proto.InternalMessageInfo
 func (m *RepoCreds) Reset()      { *m = RepoCreds{} }
 func (*RepoCreds) ProtoMessage() {}
 func (*RepoCreds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{55}
+	return fileDescriptor_030104ce3b95bcac, []int{57}
 }
 func (m *RepoCreds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1607,7 +1663,7 @@ var xxx_messageInfo_RepoCreds proto.InternalMessageInfo
 func (m *RepoCredsList) Reset()      { *m = RepoCredsList{} }
 func (*RepoCredsList) ProtoMessage() {}
 func (*RepoCredsList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{56}
+	return fileDescriptor_030104ce3b95bcac, []int{58}
 }
 func (m *RepoCredsList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1691,7 @@ var xxx_messageInfo_RepoCredsList proto.InternalMessageInfo
 func (m *Repository) Reset()      { *m = Repository{} }
 func (*Repository) ProtoMessage() {}
 func (*Repository) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{57}
+	return fileDescriptor_030104ce3b95bcac, []int{59}
 }
 func (m *Repository) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1663,7 +1719,7 @@ var xxx_messageInfo_Repository proto.InternalMessageInfo
 func (m *RepositoryCertificate) Reset()      { *m = RepositoryCertificate{} }
 func (*RepositoryCertificate) ProtoMessage() {}
 func (*RepositoryCertificate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{58}
+	return fileDescriptor_030104ce3b95bcac, []int{60}
 }
 func (m *RepositoryCertificate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1691,7 +1747,7 @@ var xxx_messageInfo_RepositoryCertificate proto.InternalMessageInfo
 func (m *RepositoryCertificateList) Reset()      { *m = RepositoryCertificateList{} }
 func (*RepositoryCertificateList) ProtoMessage() {}
 func (*RepositoryCertificateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{59}
+	return fileDescriptor_030104ce3b95bcac, []int{61}
 }
 func (m *RepositoryCertificateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +1775,7 @@ var xxx_messageInfo_RepositoryCertificateList proto.InternalMessageInfo
 func (m *RepositoryList) Reset()      { *m = RepositoryList{} }
 func (*RepositoryList) ProtoMessage() {}
 func (*RepositoryList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{60}
+	return fileDescriptor_030104ce3b95bcac, []int{62}
 }
 func (m *RepositoryList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1803,7 @@ var xxx_messageInfo_RepositoryList proto.InternalMessageInfo
 func (m *ResourceAction) Reset()      { *m = ResourceAction{} }
 func (*ResourceAction) ProtoMessage() {}
 func (*ResourceAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{61}
+	return fileDescriptor_030104ce3b95bcac, []int{63}
 }
 func (m *ResourceAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1775,7 +1831,7 @@ var xxx_messageInfo_ResourceAction proto.InternalMessageInfo
 func (m *ResourceActionDefinition) Reset()      { *m = ResourceActionDefinition{} }
 func (*ResourceActionDefinition) ProtoMessage() {}
 func (*ResourceActionDefinition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{62}
+	return fileDescriptor_030104ce3b95bcac, []int{64}
 }
 func (m *ResourceActionDefinition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1803,7 +1859,7 @@ var xxx_messageInfo_ResourceActionDefinition proto.InternalMessageInfo
 func (m *ResourceActionParam) Reset()      { *m = ResourceActionParam{} }
 func (*ResourceActionParam) ProtoMessage() {}
 func (*ResourceActionParam) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{63}
+	return fileDescriptor_030104ce3b95bcac, []int{65}
 }
 func (m *ResourceActionParam) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1831,7 +1887,7 @@ var xxx_messageInfo_ResourceActionParam proto.InternalMessageInfo
 func (m *ResourceActions) Reset()      { *m = ResourceActions{} }
 func (*ResourceActions) ProtoMessage() {}
 func (*ResourceActions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{64}
+	return fileDescriptor_030104ce3b95bcac, []int{66}
 }
 func (m *ResourceActions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1859,7 +1915,7 @@ var xxx_messageInfo_ResourceActions proto.InternalMessageInfo
 func (m *ResourceDiff) Reset()      { *m = ResourceDiff{} }
 func (*ResourceDiff) ProtoMessage() {}
 func (*ResourceDiff) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{65}
+	return fileDescriptor_030104ce3b95bcac, []int{67}
 }
 func (m *ResourceDiff) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1887,7 +1943,7 @@ var xxx_messageInfo_ResourceDiff proto.InternalMessageInfo
 func (m *ResourceIgnoreDifferences) Reset()      { *m = ResourceIgnoreDifferences{} }
 func (*ResourceIgnoreDifferences) ProtoMessage() {}
 func (*ResourceIgnoreDifferences) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{66}
+	return fileDescriptor_030104ce3b95bcac, []int{68}
 }
 func (m *ResourceIgnoreDifferences) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1915,7 +1971,7 @@ var xxx_messageInfo_ResourceIgnoreDifferences proto.InternalMessageInfo
 func (m *ResourceNetworkingInfo) Reset()      { *m = ResourceNetworkingInfo{} }
 func (*ResourceNetworkingInfo) ProtoMessage() {}
 func (*ResourceNetworkingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{67}
+	return fileDescriptor_030104ce3b95bcac, []int{69}
 }
 func (m *ResourceNetworkingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1943,7 +1999,7 @@ var xxx_messageInfo_ResourceNetworkingInfo proto.InternalMessageInfo
 func (m *ResourceNode) Reset()      { *m = ResourceNode{} }
 func (*ResourceNode) ProtoMessage() {}
 func (*ResourceNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{68}
+	return fileDescriptor_030104ce3b95bcac, []int{70}
 }
 func (m *ResourceNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1971,7 +2027,7 @@ var xxx_messageInfo_ResourceNode proto.InternalMessageInfo
 func (m *ResourceOverride) Reset()      { *m = ResourceOverride{} }
 func (*ResourceOverride) ProtoMessage() {}
 func (*ResourceOverride) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{69}
+	return fileDescriptor_030104ce3b95bcac, []int{71}
 }
 func (m *ResourceOverride) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2055,7 @@ var xxx_messageInfo_ResourceOverride proto.InternalMessageInfo
 func (m *ResourceRef) Reset()      { *m = ResourceRef{} }
 func (*ResourceRef) ProtoMessage() {}
 func (*ResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{70}
+	return fileDescriptor_030104ce3b95bcac, []int{72}
 }
 func (m *ResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2027,7 +2083,7 @@ var xxx_messageInfo_ResourceRef proto.InternalMessageInfo
 func (m *ResourceResult) Reset()      { *m = ResourceResult{} }
 func (*ResourceResult) ProtoMessage() {}
 func (*ResourceResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{71}
+	return fileDescriptor_030104ce3b95bcac, []int{73}
 }
 func (m *ResourceResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2055,7 +2111,7 @@ var xxx_messageInfo_ResourceResult proto.InternalMessageInfo
 func (m *ResourceStatus) Reset()      { *m = ResourceStatus{} }
 func (*ResourceStatus) ProtoMessage() {}
 func (*ResourceStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{72}
+	return fileDescriptor_030104ce3b95bcac, []int{74}
 }
 func (m *ResourceStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2083,7 +2139,7 @@ var xxx_messageInfo_ResourceStatus proto.InternalMessageInfo
 func (m *RetryStrategy) Reset()      { *m = RetryStrategy{} }
 func (*RetryStrategy) ProtoMessage() {}
 func (*RetryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{73}
+	return fileDescriptor_030104ce3b95bcac, []int{75}
 }
 func (m *RetryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2111,7 +2167,7 @@ var xxx_messageInfo_RetryStrategy proto.InternalMessageInfo
 func (m *RevisionHistory) Reset()      { *m = RevisionHistory{} }
 func (*RevisionHistory) ProtoMessage() {}
 func (*RevisionHistory) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{74}
+	return fileDescriptor_030104ce3b95bcac, []int{76}
 }
 func (m *RevisionHistory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2139,7 +2195,7 @@ var xxx_messageInfo_RevisionHistory proto.InternalMessageInfo
 func (m *RevisionMetadata) Reset()      { *m = RevisionMetadata{} }
 func (*RevisionMetadata) ProtoMessage() {}
 func (*RevisionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{75}
+	return fileDescriptor_030104ce3b95bcac, []int{77}
 }
 func (m *RevisionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2167,7 +2223,7 @@ var xxx_messageInfo_RevisionMetadata proto.InternalMessageInfo
 func (m *SignatureKey) Reset()      { *m = SignatureKey{} }
 func (*SignatureKey) ProtoMessage() {}
 func (*SignatureKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{76}
+	return fileDescriptor_030104ce3b95bcac, []int{78}
 }
 func (m *SignatureKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2195,7 +2251,7 @@ var xxx_messageInfo_SignatureKey proto.InternalMessageInfo
 func (m *SyncOperation) Reset()      { *m = SyncOperation{} }
 func (*SyncOperation) ProtoMessage() {}
 func (*SyncOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{77}
+	return fileDescriptor_030104ce3b95bcac, []int{79}
 }
 func (m *SyncOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2223,7 +2279,7 @@ var xxx_messageInfo_SyncOperation proto.InternalMessageInfo
 func (m *SyncOperationResource) Reset()      { *m = SyncOperationResource{} }
 func (*SyncOperationResource) ProtoMessage() {}
 func (*SyncOperationResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{78}
+	return fileDescriptor_030104ce3b95bcac, []int{80}
 }
 func (m *SyncOperationResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2251,7 +2307,7 @@ var xxx_messageInfo_SyncOperationResource proto.InternalMessageInfo
 func (m *SyncOperationResult) Reset()      { *m = SyncOperationResult{} }
 func (*SyncOperationResult) ProtoMessage() {}
 func (*SyncOperationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{79}
+	return fileDescriptor_030104ce3b95bcac, []int{81}
 }
 func (m *SyncOperationResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2279,7 +2335,7 @@ var xxx_messageInfo_SyncOperationResult proto.InternalMessageInfo
 func (m *SyncPolicy) Reset()      { *m = SyncPolicy{} }
 func (*SyncPolicy) ProtoMessage() {}
 func (*SyncPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{80}
+	return fileDescriptor_030104ce3b95bcac, []int{82}
 }
 func (m *SyncPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2307,7 +2363,7 @@ var xxx_messageInfo_SyncPolicy proto.InternalMessageInfo
 func (m *SyncPolicyAutomated) Reset()      { *m = SyncPolicyAutomated{} }
 func (*SyncPolicyAutomated) ProtoMessage() {}
 func (*SyncPolicyAutomated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{81}
+	return fileDescriptor_030104ce3b95bcac, []int{83}
 }
 func (m *SyncPolicyAutomated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2335,7 +2391,7 @@ var xxx_messageInfo_SyncPolicyAutomated proto.InternalMessageInfo
 func (m *SyncStatus) Reset()      { *m = SyncStatus{} }
 func (*SyncStatus) ProtoMessage() {}
 func (*SyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{82}
+	return fileDescriptor_030104ce3b95bcac, []int{84}
 }
 func (m *SyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2363,7 +2419,7 @@ var xxx_messageInfo_SyncStatus proto.InternalMessageInfo
 func (m *SyncStrategy) Reset()      { *m = SyncStrategy{} }
 func (*SyncStrategy) ProtoMessage() {}
 func (*SyncStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{83}
+	return fileDescriptor_030104ce3b95bcac, []int{85}
 }
 func (m *SyncStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2391,7 +2447,7 @@ var xxx_messageInfo_SyncStrategy proto.InternalMessageInfo
 func (m *SyncStrategyApply) Reset()      { *m = SyncStrategyApply{} }
 func (*SyncStrategyApply) ProtoMessage() {}
 func (*SyncStrategyApply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{84}
+	return fileDescriptor_030104ce3b95bcac, []int{86}
 }
 func (m *SyncStrategyApply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2419,7 +2475,7 @@ var xxx_messageInfo_SyncStrategyApply proto.InternalMessageInfo
 func (m *SyncStrategyHook) Reset()      { *m = SyncStrategyHook{} }
 func (*SyncStrategyHook) ProtoMessage() {}
 func (*SyncStrategyHook) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{85}
+	return fileDescriptor_030104ce3b95bcac, []int{87}
 }
 func (m *SyncStrategyHook) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2447,7 +2503,7 @@ var xxx_messageInfo_SyncStrategyHook proto.InternalMessageInfo
 func (m *SyncWindow) Reset()      { *m = SyncWindow{} }
 func (*SyncWindow) ProtoMessage() {}
 func (*SyncWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{86}
+	return fileDescriptor_030104ce3b95bcac, []int{88}
 }
 func (m *SyncWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2475,7 +2531,7 @@ var xxx_messageInfo_SyncWindow proto.InternalMessageInfo
 func (m *TLSClientConfig) Reset()      { *m = TLSClientConfig{} }
 func (*TLSClientConfig) ProtoMessage() {}
 func (*TLSClientConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030104ce3b95bcac, []int{87}
+	return fileDescriptor_030104ce3b95bcac, []int{89}
 }
 func (m *TLSClientConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2510,6 +2566,7 @@ func init() {
 	proto.RegisterType((*Application)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.Application")
 	proto.RegisterType((*ApplicationCondition)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationCondition")
 	proto.RegisterType((*ApplicationDestination)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestination")
+	proto.RegisterType((*ApplicationDestinationServiceAccount)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationDestinationServiceAccount")
 	proto.RegisterType((*ApplicationList)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationList")
 	proto.RegisterType((*ApplicationSource)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSource")
 	proto.RegisterType((*ApplicationSourceDirectory)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ApplicationSourceDirectory")
@@ -2560,6 +2617,7 @@ func init() {
 	proto.RegisterType((*OperationState)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OperationState")
 	proto.RegisterType((*OrphanedResourceKey)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourceKey")
 	proto.RegisterType((*OrphanedResourcesMonitorSettings)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OrphanedResourcesMonitorSettings")
+	proto.RegisterType((*OverrideHealthConditions)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideHealthConditions")
 	proto.RegisterType((*OverrideIgnoreDiff)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.OverrideIgnoreDiff")
 	proto.RegisterType((*ProjectRole)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.ProjectRole")
 	proto.RegisterType((*RepoCreds)(nil), "github.com.argoproj.argo_cd.v2.pkg.apis.application.v1alpha1.RepoCreds")
@@ -2604,433 +2662,457 @@ func init() {
 }
 
 var fileDescriptor_030104ce3b95bcac = []byte{
-	// 6809 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x8c, 0x24, 0xc9,
-	0x51, 0xf0, 0x55, 0xf7, 0xfc, 0x74, 0xc7, 0xfc, 0xec, 0x4e, 0xee, 0xcf, 0x8d, 0xe7, 0x3b, 0xef,
-	0xac, 0xea, 0x64, 0xfb, 0xbe, 0xcf, 0xf6, 0xcc, 0x77, 0xcb, 0xd9, 0x1c, 0x3e, 0x73, 0x66, 0x7a,
-	0x66, 0x7f, 0x66, 0x77, 0xfe, 0x36, 0x66, 0x76, 0x17, 0x9f, 0x8d, 0xb9, 0x9a, 0xea, 0xec, 0xee,
-	0xda, 0xe9, 0xae, 0xea, 0xab, 0xaa, 0x9e, 0x9d, 0xb6, 0xf1, 0x9f, 0x64, 0xf0, 0x49, 0xfe, 0x95,
-	0xcd, 0x83, 0x2d, 0x21, 0x30, 0x3f, 0x42, 0xe2, 0xc1, 0x02, 0x9e, 0x00, 0x21, 0x1e, 0x30, 0x2f,
-	0x46, 0x3c, 0x60, 0x09, 0x84, 0x0d, 0x16, 0x83, 0xbd, 0x80, 0x0c, 0x48, 0x80, 0x00, 0xbf, 0xb0,
-	0xe2, 0x01, 0xe5, 0x4f, 0x65, 0x66, 0x55, 0x77, 0xef, 0xcc, 0x6c, 0xd7, 0x2e, 0x96, 0xc5, 0xdb,
-	0x74, 0x44, 0x64, 0x44, 0x64, 0x56, 0x66, 0x64, 0x44, 0x64, 0x64, 0x0e, 0xac, 0xd5, 0xbd, 0xb8,
-	0xd1, 0xd9, 0x5d, 0x70, 0x83, 0xd6, 0xa2, 0x13, 0xd6, 0x83, 0x76, 0x18, 0xdc, 0xe5, 0x7f, 0xbc,
-	0xdd, 0xad, 0x2e, 0xee, 0x5f, 0x5a, 0x6c, 0xef, 0xd5, 0x17, 0x9d, 0xb6, 0x17, 0x2d, 0x3a, 0xed,
-	0x76, 0xd3, 0x73, 0x9d, 0xd8, 0x0b, 0xfc, 0xc5, 0xfd, 0xe7, 0x9d, 0x66, 0xbb, 0xe1, 0x3c, 0xbf,
-	0x58, 0xa7, 0x3e, 0x0d, 0x9d, 0x98, 0x56, 0x17, 0xda, 0x61, 0x10, 0x07, 0xe4, 0xdd, 0x9a, 0xdb,
-	0x42, 0xc2, 0x8d, 0xff, 0xf1, 0xd3, 0x6e, 0x75, 0x61, 0xff, 0xd2, 0x42, 0x7b, 0xaf, 0xbe, 0xc0,
-	0xb8, 0x2d, 0x18, 0xdc, 0x16, 0x12, 0x6e, 0x73, 0x6f, 0x37, 0x74, 0xa9, 0x07, 0xf5, 0x60, 0x91,
-	0x33, 0xdd, 0xed, 0xd4, 0xf8, 0x2f, 0xfe, 0x83, 0xff, 0x25, 0x84, 0xcd, 0xd9, 0x7b, 0x2f, 0x46,
-	0x0b, 0x5e, 0xc0, 0xd4, 0x5b, 0x74, 0x83, 0x90, 0x2e, 0xee, 0xf7, 0x28, 0x34, 0xf7, 0x82, 0xa6,
-	0x69, 0x39, 0x6e, 0xc3, 0xf3, 0x69, 0xd8, 0xd5, 0x7d, 0x6a, 0xd1, 0xd8, 0xe9, 0xd7, 0x6a, 0x71,
-	0x50, 0xab, 0xb0, 0xe3, 0xc7, 0x5e, 0x8b, 0xf6, 0x34, 0x78, 0xe7, 0x51, 0x0d, 0x22, 0xb7, 0x41,
-	0x5b, 0x4e, 0xb6, 0x9d, 0xfd, 0x1a, 0x4c, 0x2d, 0xdd, 0xd9, 0x5e, 0xea, 0xc4, 0x8d, 0xe5, 0xc0,
-	0xaf, 0x79, 0x75, 0xf2, 0x0e, 0x98, 0x70, 0x9b, 0x9d, 0x28, 0xa6, 0xe1, 0x86, 0xd3, 0xa2, 0xb3,
-	0xd6, 0x45, 0xeb, 0xb9, 0x72, 0xe5, 0xcc, 0xd7, 0x0f, 0xe7, 0x9f, 0xba, 0x7f, 0x38, 0x3f, 0xb1,
-	0xac, 0x51, 0x68, 0xd2, 0x91, 0xff, 0x0b, 0xe3, 0x61, 0xd0, 0xa4, 0x4b, 0xb8, 0x31, 0x5b, 0xe0,
-	0x4d, 0x4e, 0xc9, 0x26, 0xe3, 0x28, 0xc0, 0x98, 0xe0, 0xed, 0xbf, 0x28, 0x00, 0x2c, 0xb5, 0xdb,
-	0x5b, 0x61, 0x70, 0x97, 0xba, 0x31, 0x79, 0x15, 0x4a, 0x6c, 0x14, 0xaa, 0x4e, 0xec, 0x70, 0x69,
-	0x13, 0x97, 0xfe, 0xff, 0x82, 0xe8, 0xcc, 0x82, 0xd9, 0x19, 0xfd, 0xe5, 0x18, 0xf5, 0xc2, 0xfe,
-	0xf3, 0x0b, 0x9b, 0xbb, 0xac, 0xfd, 0x3a, 0x8d, 0x9d, 0x0a, 0x91, 0xc2, 0x40, 0xc3, 0x50, 0x71,
-	0x25, 0x3e, 0x8c, 0x44, 0x6d, 0xea, 0x72, 0xc5, 0x26, 0x2e, 0xad, 0x2d, 0x0c, 0x33, 0x45, 0x16,
-	0xb4, 0xe6, 0xdb, 0x6d, 0xea, 0x56, 0x26, 0xa5, 0xe4, 0x11, 0xf6, 0x0b, 0xb9, 0x1c, 0xb2, 0x0f,
-	0x63, 0x51, 0xec, 0xc4, 0x9d, 0x68, 0xb6, 0xc8, 0x25, 0x6e, 0xe4, 0x26, 0x91, 0x73, 0xad, 0x4c,
-	0x4b, 0x99, 0x63, 0xe2, 0x37, 0x4a, 0x69, 0xf6, 0x5f, 0x5b, 0x30, 0xad, 0x89, 0xd7, 0xbc, 0x28,
-	0x26, 0xef, 0xef, 0x19, 0xdc, 0x85, 0xe3, 0x0d, 0x2e, 0x6b, 0xcd, 0x87, 0xf6, 0xb4, 0x14, 0x56,
-	0x4a, 0x20, 0xc6, 0xc0, 0xb6, 0x60, 0xd4, 0x8b, 0x69, 0x2b, 0x9a, 0x2d, 0x5c, 0x2c, 0x3e, 0x37,
-	0x71, 0xe9, 0x5a, 0x5e, 0xfd, 0xac, 0x4c, 0x49, 0xa1, 0xa3, 0xab, 0x8c, 0x3d, 0x0a, 0x29, 0xf6,
-	0xf7, 0xc1, 0xec, 0x1f, 0x1b, 0x70, 0xf2, 0x3c, 0x4c, 0x44, 0x41, 0x27, 0x74, 0x29, 0xd2, 0x76,
-	0x10, 0xcd, 0x5a, 0x17, 0x8b, 0x6c, 0xea, 0xb1, 0x99, 0xba, 0xad, 0xc1, 0x68, 0xd2, 0x90, 0xcf,
-	0x5a, 0x30, 0x59, 0xa5, 0x51, 0xec, 0xf9, 0x5c, 0x7e, 0xa2, 0xfc, 0xce, 0xd0, 0xca, 0x27, 0xc0,
-	0x15, 0xcd, 0xbc, 0x72, 0x56, 0x76, 0x64, 0xd2, 0x00, 0x46, 0x98, 0x92, 0xcf, 0x56, 0x5c, 0x95,
-	0x46, 0x6e, 0xe8, 0xb5, 0xd9, 0x6f, 0x3e, 0x67, 0x8c, 0x15, 0xb7, 0xa2, 0x51, 0x68, 0xd2, 0x11,
-	0x1f, 0x46, 0xd9, 0x8a, 0x8a, 0x66, 0x47, 0xb8, 0xfe, 0xab, 0xc3, 0xe9, 0x2f, 0x07, 0x95, 0x2d,
-	0x56, 0x3d, 0xfa, 0xec, 0x57, 0x84, 0x42, 0x0c, 0xf9, 0x8c, 0x05, 0xb3, 0x72, 0xc5, 0x23, 0x15,
-	0x03, 0x7a, 0xa7, 0xe1, 0xc5, 0xb4, 0xe9, 0x45, 0xf1, 0xec, 0x28, 0xd7, 0x61, 0xf1, 0x78, 0x73,
-	0xeb, 0x6a, 0x18, 0x74, 0xda, 0x37, 0x3c, 0xbf, 0x5a, 0xb9, 0x28, 0x25, 0xcd, 0x2e, 0x0f, 0x60,
-	0x8c, 0x03, 0x45, 0x92, 0x2f, 0x5a, 0x30, 0xe7, 0x3b, 0x2d, 0x1a, 0xb5, 0x1d, 0xf6, 0x69, 0x05,
-	0xba, 0xd2, 0x74, 0xdc, 0x3d, 0xae, 0xd1, 0xd8, 0xa3, 0x69, 0x64, 0x4b, 0x8d, 0xe6, 0x36, 0x06,
-	0xb2, 0xc6, 0x87, 0x88, 0x25, 0xbf, 0x6a, 0xc1, 0x4c, 0x10, 0xb6, 0x1b, 0x8e, 0x4f, 0xab, 0x09,
-	0x36, 0x9a, 0x1d, 0xe7, 0x4b, 0xef, 0x03, 0xc3, 0x7d, 0xa2, 0xcd, 0x2c, 0xdb, 0xf5, 0xc0, 0xf7,
-	0xe2, 0x20, 0xdc, 0xa6, 0x71, 0xec, 0xf9, 0xf5, 0xa8, 0x72, 0xee, 0xfe, 0xe1, 0xfc, 0x4c, 0x0f,
-	0x15, 0xf6, 0xea, 0x43, 0x3e, 0x04, 0x13, 0x51, 0xd7, 0x77, 0xef, 0x78, 0x7e, 0x35, 0xb8, 0x17,
-	0xcd, 0x96, 0xf2, 0x58, 0xbe, 0xdb, 0x8a, 0xa1, 0x5c, 0x80, 0x5a, 0x00, 0x9a, 0xd2, 0xfa, 0x7f,
-	0x38, 0x3d, 0x95, 0xca, 0x79, 0x7f, 0x38, 0x3d, 0x99, 0x1e, 0x22, 0x96, 0x7c, 0xd2, 0x82, 0xa9,
-	0xc8, 0xab, 0xfb, 0x4e, 0xdc, 0x09, 0xe9, 0x0d, 0xda, 0x8d, 0x66, 0x81, 0x2b, 0x72, 0x7d, 0xc8,
-	0x51, 0x31, 0x58, 0x56, 0xce, 0x49, 0x1d, 0xa7, 0x4c, 0x68, 0x84, 0x69, 0xb9, 0xfd, 0x16, 0x9a,
-	0x9e, 0xd6, 0x13, 0xf9, 0x2e, 0x34, 0x3d, 0xa9, 0x07, 0x8a, 0xb4, 0xff, 0xb8, 0x00, 0xa7, 0xb3,
-	0x7b, 0x10, 0xf9, 0x75, 0x0b, 0x4e, 0xdd, 0xbd, 0x17, 0xef, 0x04, 0x7b, 0xd4, 0x8f, 0x2a, 0x5d,
-	0x66, 0x29, 0xb8, 0xf5, 0x9d, 0xb8, 0xe4, 0xe6, 0xbb, 0xdb, 0x2d, 0x5c, 0x4f, 0x4b, 0xb9, 0xec,
-	0xc7, 0x61, 0xb7, 0xf2, 0xb4, 0xec, 0xcf, 0xa9, 0xeb, 0x77, 0x76, 0x4c, 0x2c, 0x66, 0x95, 0x9a,
-	0xfb, 0x94, 0x05, 0x67, 0xfb, 0xb1, 0x20, 0xa7, 0xa1, 0xb8, 0x47, 0xbb, 0xc2, 0xc1, 0x41, 0xf6,
-	0x27, 0xf9, 0x29, 0x18, 0xdd, 0x77, 0x9a, 0x1d, 0x2a, 0x1d, 0x85, 0xab, 0xc3, 0x75, 0x44, 0x69,
-	0x86, 0x82, 0xeb, 0xbb, 0x0a, 0x2f, 0x5a, 0xf6, 0x9f, 0x16, 0x61, 0xc2, 0xd8, 0x2a, 0x9e, 0x80,
-	0xf3, 0x13, 0xa4, 0x9c, 0x9f, 0xf5, 0xdc, 0x76, 0xb9, 0x81, 0xde, 0xcf, 0xbd, 0x8c, 0xf7, 0xb3,
-	0x99, 0x9f, 0xc8, 0x87, 0xba, 0x3f, 0x24, 0x86, 0x72, 0xd0, 0x66, 0xce, 0x2d, 0xdb, 0x45, 0x47,
-	0xf2, 0xf8, 0x84, 0x9b, 0x09, 0xbb, 0xca, 0xd4, 0xfd, 0xc3, 0xf9, 0xb2, 0xfa, 0x89, 0x5a, 0x90,
-	0xfd, 0x4d, 0x0b, 0xce, 0x1a, 0x3a, 0x2e, 0x07, 0x7e, 0xd5, 0xe3, 0x9f, 0xf6, 0x22, 0x8c, 0xc4,
-	0xdd, 0x76, 0xe2, 0x41, 0xab, 0x91, 0xda, 0xe9, 0xb6, 0x29, 0x72, 0x0c, 0xf3, 0x99, 0x5b, 0x34,
-	0x8a, 0x9c, 0x3a, 0xcd, 0xfa, 0xcc, 0xeb, 0x02, 0x8c, 0x09, 0x9e, 0x84, 0x40, 0x9a, 0x4e, 0x14,
-	0xef, 0x84, 0x8e, 0x1f, 0x71, 0xf6, 0x3b, 0x5e, 0x8b, 0xca, 0x01, 0xfe, 0x7f, 0xc7, 0x9b, 0x31,
-	0xac, 0x45, 0xe5, 0xfc, 0xfd, 0xc3, 0x79, 0xb2, 0xd6, 0xc3, 0x09, 0xfb, 0x70, 0xb7, 0xbf, 0x68,
-	0xc1, 0xf9, 0xfe, 0x6e, 0x0d, 0x79, 0x33, 0x8c, 0x45, 0x34, 0xdc, 0xa7, 0xa1, 0xec, 0x9d, 0xfe,
-	0x24, 0x1c, 0x8a, 0x12, 0x4b, 0x16, 0xa1, 0xac, 0x4c, 0xae, 0xec, 0xe3, 0x8c, 0x24, 0x2d, 0x6b,
-	0x3b, 0xad, 0x69, 0xd8, 0xa0, 0xb1, 0x1f, 0xd2, 0x09, 0x52, 0x83, 0xc6, 0xe3, 0x0d, 0x8e, 0xb1,
-	0xff, 0xc6, 0x82, 0x53, 0x86, 0x56, 0x4f, 0xc0, 0xcb, 0xf5, 0xd3, 0x5e, 0xee, 0x6a, 0x6e, 0xf3,
-	0x79, 0x80, 0x9b, 0xfb, 0x47, 0xa3, 0x30, 0x63, 0xce, 0x7a, 0x6e, 0x8e, 0x79, 0x80, 0x45, 0xdb,
-	0xc1, 0x2d, 0x5c, 0x93, 0x63, 0xae, 0x03, 0x2c, 0x01, 0xc6, 0x04, 0xcf, 0x06, 0xb1, 0xed, 0xc4,
-	0x0d, 0x39, 0xe0, 0x6a, 0x10, 0xb7, 0x9c, 0xb8, 0x81, 0x1c, 0x43, 0x5e, 0x86, 0xe9, 0xd8, 0x09,
-	0xeb, 0x34, 0x46, 0xba, 0xef, 0x45, 0xc9, 0x7a, 0x29, 0x57, 0xce, 0x4b, 0xda, 0xe9, 0x9d, 0x14,
-	0x16, 0x33, 0xd4, 0xe4, 0x35, 0x18, 0x69, 0xd0, 0x66, 0x4b, 0xfa, 0x35, 0xdb, 0xf9, 0xad, 0x70,
-	0xde, 0xd7, 0x6b, 0xb4, 0xd9, 0xaa, 0x94, 0x98, 0xca, 0xec, 0x2f, 0xe4, 0xa2, 0xc8, 0xcf, 0x5a,
-	0x50, 0xde, 0xeb, 0x44, 0x71, 0xd0, 0xf2, 0x3e, 0x48, 0x67, 0x4b, 0x5c, 0xf0, 0x4f, 0xe6, 0x2c,
-	0xf8, 0x46, 0xc2, 0x5f, 0xac, 0x77, 0xf5, 0x13, 0xb5, 0x64, 0xae, 0x47, 0xd5, 0x0b, 0xa9, 0x1b,
-	0x07, 0x61, 0x77, 0x16, 0x1e, 0x8b, 0x1e, 0x2b, 0x09, 0x7f, 0xa1, 0x87, 0xfa, 0x89, 0x5a, 0x32,
-	0xe9, 0xc2, 0x58, 0xbb, 0xd9, 0xa9, 0x7b, 0xfe, 0xec, 0x04, 0xd7, 0xe1, 0x56, 0xce, 0x3a, 0x6c,
-	0x71, 0xe6, 0x15, 0x60, 0xab, 0x5a, 0xfc, 0x8d, 0x52, 0x20, 0x79, 0x16, 0x46, 0xdd, 0x86, 0x13,
-	0xc6, 0xb3, 0x93, 0x7c, 0xd2, 0xa8, 0x59, 0xbc, 0xcc, 0x80, 0x28, 0x70, 0xf6, 0x2f, 0x17, 0x60,
-	0x6e, 0x70, 0xc7, 0xc4, 0x74, 0x76, 0x3b, 0x61, 0x24, 0x0c, 0x64, 0xc9, 0x9c, 0xce, 0x1c, 0x8c,
-	0x09, 0x9e, 0x7c, 0xdc, 0x82, 0xf1, 0xbb, 0x51, 0xe0, 0xfb, 0x34, 0x96, 0xbb, 0xd8, 0xed, 0x9c,
-	0xfb, 0x7a, 0x5d, 0x70, 0xd7, 0x3a, 0x48, 0x00, 0x26, 0x72, 0x99, 0xba, 0xf4, 0xc0, 0x6d, 0x76,
-	0xaa, 0x89, 0x69, 0x52, 0xa4, 0x97, 0x05, 0x18, 0x13, 0x3c, 0x23, 0xf5, 0x7c, 0x41, 0x3a, 0x92,
-	0x26, 0x5d, 0xf5, 0x25, 0xa9, 0xc4, 0xdb, 0xbf, 0x35, 0x0a, 0xe7, 0xfa, 0xce, 0x7e, 0xb2, 0x00,
-	0xc0, 0x9d, 0x86, 0x2b, 0x1e, 0x8b, 0xf0, 0x44, 0x58, 0x3b, 0xcd, 0xf6, 0xf8, 0xdb, 0x0a, 0x8a,
-	0x06, 0x05, 0xf9, 0x28, 0x40, 0xdb, 0x09, 0x9d, 0x16, 0x8d, 0x69, 0x98, 0x18, 0xaa, 0x1b, 0xc3,
-	0x8d, 0x12, 0xd3, 0x63, 0x2b, 0xe1, 0xa9, 0x9d, 0x0c, 0x05, 0x8a, 0xd0, 0x10, 0xc9, 0x82, 0xd8,
-	0x90, 0x36, 0xa9, 0x13, 0xd1, 0x0d, 0x6d, 0xbf, 0x55, 0x10, 0x8b, 0x1a, 0x85, 0x26, 0x1d, 0xdb,
-	0x48, 0x78, 0x2f, 0x22, 0x39, 0x56, 0x6a, 0x23, 0xe1, 0xfd, 0x8c, 0x50, 0x62, 0xc9, 0xe7, 0x2c,
-	0x98, 0xae, 0x79, 0x4d, 0xaa, 0xa5, 0xcb, 0x90, 0x73, 0x73, 0xf8, 0x4e, 0x5e, 0x31, 0xf9, 0x6a,
-	0x13, 0x98, 0x02, 0x47, 0x98, 0x11, 0xcf, 0x3e, 0xf3, 0x3e, 0x0d, 0xb9, 0xed, 0x1c, 0x4b, 0x7f,
-	0xe6, 0xdb, 0x02, 0x8c, 0x09, 0x9e, 0x2c, 0xc1, 0xa9, 0xb6, 0x13, 0x45, 0xcb, 0x21, 0xad, 0x52,
-	0x3f, 0xf6, 0x9c, 0xa6, 0x08, 0x08, 0x4b, 0xda, 0x8b, 0xdd, 0x4a, 0xa3, 0x31, 0x4b, 0x4f, 0xde,
-	0x0b, 0x4f, 0x7b, 0x75, 0x3f, 0x08, 0xe9, 0xba, 0x17, 0x45, 0x9e, 0x5f, 0xd7, 0xd3, 0x80, 0x9b,
-	0xc2, 0x52, 0x65, 0x5e, 0xb2, 0x7a, 0x7a, 0xb5, 0x3f, 0x19, 0x0e, 0x6a, 0x4f, 0xde, 0x06, 0xa5,
-	0x68, 0xcf, 0x6b, 0x2f, 0x87, 0xd5, 0x68, 0xb6, 0xcc, 0x79, 0xa9, 0xcd, 0x70, 0x5b, 0xc2, 0x51,
-	0x51, 0xd8, 0x5f, 0x2e, 0xc0, 0xec, 0xa0, 0xf5, 0x43, 0x22, 0xb6, 0x4a, 0xe2, 0xdb, 0x4e, 0x18,
-	0xc9, 0x58, 0x60, 0xc8, 0x90, 0x52, 0xf2, 0xbd, 0xed, 0x84, 0xe6, 0x7a, 0xe3, 0x02, 0x30, 0x91,
-	0x44, 0xee, 0xc2, 0x48, 0xdc, 0x74, 0x72, 0xca, 0x41, 0x19, 0x12, 0xb5, 0xc7, 0xb6, 0xb6, 0x14,
-	0x21, 0x97, 0x41, 0x9e, 0x81, 0x91, 0xa6, 0xb7, 0xcb, 0x3c, 0x5b, 0xb6, 0x20, 0xf9, 0x16, 0xb5,
-	0xe6, 0xed, 0x46, 0xc8, 0xa1, 0xf6, 0xbf, 0x8e, 0xf5, 0x31, 0x79, 0x6a, 0x13, 0x21, 0x97, 0x00,
-	0x98, 0x07, 0xb3, 0x15, 0xd2, 0x9a, 0x77, 0x20, 0x37, 0x71, 0xb5, 0xac, 0x36, 0x14, 0x06, 0x0d,
-	0xaa, 0xa4, 0xcd, 0x76, 0xa7, 0xc6, 0xda, 0x14, 0x7a, 0xdb, 0x08, 0x0c, 0x1a, 0x54, 0xe4, 0x05,
-	0x18, 0xf3, 0x5a, 0x4e, 0x9d, 0x26, 0x6a, 0x3e, 0xc3, 0xd6, 0xd3, 0x2a, 0x87, 0x3c, 0x38, 0x9c,
-	0x9f, 0x56, 0x0a, 0x71, 0x10, 0x4a, 0x5a, 0xf2, 0x6b, 0x16, 0x4c, 0xba, 0x41, 0xab, 0x15, 0xf8,
-	0x6b, 0xce, 0x2e, 0x6d, 0x26, 0x69, 0xa5, 0xbb, 0x8f, 0x6b, 0x8b, 0x5d, 0x58, 0x36, 0x84, 0x89,
-	0xa0, 0x4e, 0x25, 0xcb, 0x4c, 0x14, 0xa6, 0xb4, 0x32, 0x97, 0xdd, 0xe8, 0x11, 0xcb, 0xee, 0x77,
-	0x2d, 0x98, 0x11, 0x6d, 0x97, 0x7c, 0x3f, 0x88, 0x65, 0xb6, 0x4f, 0xe4, 0x85, 0x82, 0xc7, 0xdc,
-	0x2d, 0x43, 0xa2, 0xe8, 0xdb, 0x1b, 0xa4, 0x9a, 0x33, 0x3d, 0x78, 0xec, 0x55, 0x92, 0x5c, 0x85,
-	0x99, 0x5a, 0x10, 0xba, 0xd4, 0x1c, 0x08, 0x69, 0x33, 0x14, 0xa3, 0x2b, 0x59, 0x02, 0xec, 0x6d,
-	0x43, 0x6e, 0xc3, 0x79, 0x03, 0x68, 0x8e, 0x83, 0x30, 0x1b, 0x17, 0x24, 0xb7, 0xf3, 0x57, 0xfa,
-	0x52, 0xe1, 0x80, 0xd6, 0x73, 0xef, 0x81, 0x99, 0x9e, 0xef, 0xd7, 0x27, 0xa2, 0x3e, 0x6b, 0x46,
-	0xd4, 0x65, 0x23, 0x10, 0x9e, 0x5b, 0x81, 0xf3, 0xfd, 0x47, 0xea, 0x24, 0x5c, 0xec, 0x5f, 0xb4,
-	0xe0, 0xe9, 0x01, 0x9e, 0x8b, 0x0a, 0x25, 0xac, 0x41, 0xa1, 0x04, 0x71, 0xa0, 0x48, 0xfd, 0x7d,
-	0x69, 0x38, 0xae, 0x0c, 0x37, 0x23, 0x2e, 0xfb, 0xfb, 0xe2, 0x43, 0x8f, 0xdf, 0x3f, 0x9c, 0x2f,
-	0x5e, 0xf6, 0xf7, 0x91, 0xf1, 0xb6, 0x7f, 0x7e, 0x2c, 0x15, 0xad, 0x6c, 0x27, 0x01, 0x32, 0x57,
-	0x54, 0xc6, 0x2a, 0x9b, 0x39, 0xcf, 0x45, 0x23, 0x1a, 0x13, 0x69, 0x6f, 0x29, 0x8e, 0x7c, 0xca,
-	0xe2, 0x99, 0xe6, 0x24, 0x8a, 0x93, 0xce, 0xd4, 0xe3, 0x49, 0x7c, 0x9b, 0xf9, 0xeb, 0x04, 0x88,
-	0xa6, 0x74, 0xb6, 0x92, 0xdb, 0x22, 0xd1, 0x93, 0x75, 0xa9, 0x92, 0x5c, 0x74, 0x82, 0x27, 0x07,
-	0x00, 0x51, 0xd7, 0x77, 0xb7, 0x82, 0xa6, 0xe7, 0x76, 0x65, 0x68, 0x9f, 0x43, 0xb6, 0x52, 0xf0,
-	0x13, 0x7e, 0x95, 0xfe, 0x8d, 0x86, 0x2c, 0xf2, 0x15, 0x0b, 0x66, 0xc4, 0xc6, 0xb9, 0xe2, 0xd5,
-	0x6a, 0x34, 0xa4, 0xbe, 0x4b, 0x13, 0xd7, 0xe3, 0xce, 0x70, 0x1a, 0x24, 0x89, 0xb6, 0xd5, 0x2c,
-	0x7b, 0xbd, 0xc4, 0x7b, 0x50, 0xd8, 0xab, 0x0c, 0xa9, 0xc2, 0x88, 0xe7, 0xd7, 0x02, 0x69, 0xd8,
-	0x2a, 0xc3, 0x29, 0xb5, 0xea, 0xd7, 0x02, 0xbd, 0x56, 0xd8, 0x2f, 0xe4, 0xdc, 0xc9, 0x1a, 0x9c,
-	0x0d, 0x65, 0xf4, 0x77, 0xcd, 0x8b, 0x98, 0x0b, 0xbf, 0xe6, 0xb5, 0xbc, 0x98, 0x1b, 0xa5, 0x62,
-	0x65, 0xf6, 0xfe, 0xe1, 0xfc, 0x59, 0xec, 0x83, 0xc7, 0xbe, 0xad, 0xec, 0xd7, 0xcb, 0xe9, 0x10,
-	0x57, 0x24, 0x70, 0x3e, 0x0c, 0xe5, 0x50, 0xa5, 0xcc, 0x85, 0x03, 0xb1, 0x96, 0xcf, 0x18, 0xcb,
-	0xcc, 0x91, 0xca, 0x3d, 0xe8, 0xe4, 0xb8, 0x96, 0xc8, 0x1c, 0x09, 0xf6, 0xe5, 0xe5, 0xb2, 0xc8,
-	0x61, 0x7e, 0x49, 0xa9, 0x3a, 0x49, 0xd6, 0xf5, 0x5d, 0xe4, 0x32, 0x48, 0x08, 0x63, 0x0d, 0xea,
-	0x34, 0xe3, 0x86, 0xcc, 0xe1, 0x5c, 0x1f, 0xd6, 0x8d, 0x65, 0xbc, 0xb2, 0xf9, 0x31, 0x01, 0x45,
-	0x29, 0x89, 0x1c, 0xc0, 0x78, 0x43, 0x7c, 0x04, 0xb9, 0xb7, 0xaf, 0x0f, 0x3b, 0xb8, 0xa9, 0x2f,
-	0xab, 0xd7, 0xaf, 0x04, 0x60, 0x22, 0x8e, 0xfc, 0x9c, 0x05, 0xe0, 0x26, 0x89, 0xb1, 0x64, 0xf9,
-	0x60, 0x6e, 0x76, 0x47, 0xe5, 0xdc, 0xb4, 0x6b, 0xa4, 0x40, 0x11, 0x1a, 0x92, 0xc9, 0xab, 0x30,
-	0x19, 0x52, 0x37, 0xf0, 0x5d, 0xaf, 0x49, 0xab, 0x4b, 0x31, 0xf7, 0xdc, 0x4f, 0x96, 0x40, 0x3b,
-	0xcd, 0xfc, 0x13, 0x34, 0x78, 0x60, 0x8a, 0x23, 0x79, 0xdd, 0x82, 0x69, 0x95, 0x1c, 0x64, 0x1f,
-	0x84, 0xca, 0x24, 0xc9, 0x5a, 0x4e, 0xa9, 0x48, 0xce, 0xb3, 0x42, 0x58, 0x84, 0x92, 0x86, 0x61,
-	0x46, 0x2e, 0x79, 0x05, 0x20, 0xd8, 0xe5, 0x89, 0x38, 0xd6, 0xd5, 0xd2, 0x89, 0xbb, 0x3a, 0x2d,
-	0x72, 0xca, 0x09, 0x07, 0x34, 0xb8, 0x91, 0x1b, 0x00, 0x62, 0xd9, 0xec, 0x74, 0xdb, 0x94, 0x87,
-	0x0d, 0xe5, 0xca, 0x5b, 0x93, 0xc1, 0xdf, 0x56, 0x98, 0x07, 0x87, 0xf3, 0xbd, 0x01, 0x2e, 0xcf,
-	0x80, 0x1a, 0xcd, 0xc9, 0x87, 0x60, 0x3c, 0xea, 0xb4, 0x5a, 0x8e, 0xca, 0xa7, 0x6c, 0xe5, 0xb7,
-	0x23, 0x0a, 0xbe, 0x7a, 0x6e, 0x4a, 0x00, 0x26, 0x12, 0x6d, 0x1f, 0x48, 0x2f, 0x3d, 0x79, 0x01,
-	0x26, 0xe9, 0x41, 0x4c, 0x43, 0xdf, 0x69, 0xde, 0xc2, 0xb5, 0x24, 0x02, 0xe7, 0x1f, 0xff, 0xb2,
-	0x01, 0xc7, 0x14, 0x15, 0xb1, 0x95, 0xe7, 0x5d, 0xe0, 0xf4, 0xa0, 0x3d, 0xef, 0xc4, 0xcf, 0xb6,
-	0xff, 0xb3, 0x90, 0xf2, 0x08, 0x76, 0x42, 0x4a, 0x49, 0x00, 0xa3, 0x7e, 0x50, 0x55, 0x46, 0xef,
-	0x7a, 0x3e, 0x46, 0x6f, 0x23, 0xa8, 0x1a, 0x67, 0xb9, 0xec, 0x57, 0x84, 0x42, 0x0e, 0x3f, 0xec,
-	0x4a, 0x4e, 0x05, 0x39, 0x42, 0x3a, 0x41, 0x79, 0x4a, 0x56, 0x87, 0x5d, 0x9b, 0xa6, 0x20, 0x4c,
-	0xcb, 0x25, 0x7b, 0x30, 0xda, 0x08, 0xa2, 0x58, 0xc4, 0x2a, 0x43, 0x7b, 0x61, 0xd7, 0x82, 0x28,
-	0xe6, 0x5b, 0x98, 0xea, 0x36, 0x83, 0x44, 0x28, 0x64, 0xd8, 0xdf, 0xb3, 0x52, 0xf9, 0x96, 0x3b,
-	0x4e, 0xec, 0x36, 0x2e, 0xef, 0x53, 0x9f, 0xcd, 0x67, 0x33, 0x59, 0xff, 0xa3, 0x66, 0xb2, 0xfe,
-	0xc1, 0xe1, 0xfc, 0x5b, 0x06, 0x15, 0xd7, 0xdc, 0x63, 0x1c, 0x16, 0x38, 0x0b, 0x23, 0xaf, 0xff,
-	0x31, 0x0b, 0x26, 0x0c, 0xf5, 0xe4, 0x86, 0x92, 0x63, 0xde, 0x58, 0x39, 0x57, 0x06, 0x10, 0x4d,
-	0x91, 0xf6, 0x17, 0x2c, 0x18, 0xaf, 0x38, 0xee, 0x5e, 0x50, 0xab, 0xb1, 0x00, 0xbf, 0xda, 0x91,
-	0xc7, 0x22, 0xa2, 0x7f, 0x2a, 0xc0, 0x5f, 0x91, 0x70, 0x54, 0x14, 0x6c, 0x0e, 0xd7, 0x1c, 0x37,
-	0x0e, 0x42, 0xae, 0x76, 0x51, 0xcc, 0xe1, 0x2b, 0x1c, 0x82, 0x12, 0x43, 0xde, 0x01, 0x13, 0x2d,
-	0xe7, 0x20, 0x69, 0x9c, 0x4d, 0xf6, 0xac, 0x6b, 0x14, 0x9a, 0x74, 0xf6, 0x1f, 0x96, 0x61, 0x5c,
-	0x9e, 0x3f, 0x1e, 0xfb, 0x04, 0x21, 0xf1, 0xe2, 0x0b, 0x03, 0xbd, 0xf8, 0x08, 0xc6, 0x5c, 0x5e,
-	0xba, 0x24, 0xb7, 0xd2, 0x21, 0xd3, 0x5e, 0x52, 0x41, 0x51, 0x0d, 0xa5, 0xd5, 0x12, 0xbf, 0x51,
-	0x8a, 0x22, 0x9f, 0xb7, 0xe0, 0x94, 0x1b, 0xf8, 0x3e, 0x75, 0xb5, 0x9d, 0x1f, 0xc9, 0xe3, 0x84,
-	0x6d, 0x39, 0xcd, 0x54, 0xa7, 0x88, 0x32, 0x08, 0xcc, 0x8a, 0x27, 0x2f, 0xc1, 0x94, 0x18, 0xb3,
-	0xdb, 0xa9, 0xf8, 0x58, 0x9f, 0x39, 0x9b, 0x48, 0x4c, 0xd3, 0x92, 0x05, 0x91, 0x67, 0xe0, 0x87,
-	0x30, 0x22, 0x46, 0x96, 0xf9, 0x46, 0x75, 0x4a, 0x13, 0xa1, 0x41, 0x41, 0x42, 0x20, 0x21, 0xad,
-	0x85, 0x34, 0x6a, 0x20, 0x7d, 0xad, 0x43, 0xa3, 0x98, 0xef, 0x31, 0xe3, 0x8f, 0x76, 0x1e, 0x85,
-	0x3d, 0x9c, 0xb0, 0x0f, 0x77, 0xb2, 0x27, 0x1d, 0xdd, 0x52, 0x1e, 0xcb, 0x49, 0x7e, 0xe6, 0x81,
-	0xfe, 0xee, 0x3c, 0x8c, 0x46, 0x0d, 0x27, 0xac, 0xf2, 0xbd, 0xad, 0x58, 0x29, 0x33, 0x5b, 0xb2,
-	0xcd, 0x00, 0x28, 0xe0, 0x64, 0x05, 0x4e, 0x67, 0x4e, 0xcc, 0x23, 0xbe, 0x7b, 0x95, 0x2a, 0xb3,
-	0x92, 0xdd, 0xe9, 0xcc, 0x59, 0x7b, 0x84, 0x3d, 0x2d, 0xcc, 0x20, 0x68, 0xe2, 0x88, 0x20, 0xa8,
-	0x0b, 0x63, 0x4d, 0x91, 0x08, 0x98, 0xe4, 0xa6, 0xf2, 0x66, 0x2e, 0x03, 0xb0, 0x60, 0x26, 0x60,
-	0xd4, 0x6c, 0x97, 0x09, 0x05, 0x29, 0x90, 0x7c, 0x86, 0x19, 0x34, 0x23, 0x77, 0x30, 0xc5, 0x15,
-	0xb8, 0x9d, 0x8f, 0x02, 0x3d, 0xa9, 0x12, 0x6d, 0xdd, 0x8c, 0x44, 0x84, 0x29, 0x7f, 0xee, 0xc7,
-	0x60, 0xe2, 0x51, 0xf3, 0x0e, 0x2f, 0xc3, 0xe9, 0xa1, 0x32, 0x0e, 0xdf, 0xb7, 0x20, 0xf9, 0xae,
-	0xcb, 0x8e, 0xdb, 0xa0, 0x6c, 0xca, 0x90, 0x97, 0x61, 0x5a, 0x85, 0x11, 0xcb, 0x41, 0xc7, 0x8f,
-	0x39, 0xaf, 0xa2, 0xce, 0x25, 0x63, 0x0a, 0x8b, 0x19, 0x6a, 0xb2, 0x08, 0x65, 0x36, 0x4e, 0xa2,
-	0xa9, 0x30, 0xbb, 0x2a, 0x54, 0x59, 0xda, 0x5a, 0x95, 0xad, 0x34, 0x0d, 0x09, 0x60, 0xa6, 0xe9,
-	0x44, 0x31, 0xd7, 0x80, 0x45, 0x15, 0x8f, 0x78, 0x1a, 0xcc, 0x0b, 0x86, 0xd6, 0xb2, 0x8c, 0xb0,
-	0x97, 0xb7, 0xfd, 0xcd, 0x11, 0x98, 0x4a, 0x59, 0x46, 0xb6, 0xab, 0x74, 0x22, 0xe6, 0xfa, 0xa8,
-	0x14, 0x8b, 0xda, 0x55, 0x6e, 0x49, 0x38, 0x2a, 0x0a, 0x46, 0xdd, 0x76, 0xa2, 0xe8, 0x5e, 0x10,
-	0x56, 0xa5, 0x29, 0x57, 0xd4, 0x5b, 0x12, 0x8e, 0x8a, 0x82, 0xed, 0x2f, 0xbb, 0xd4, 0x09, 0x69,
-	0xc8, 0x0b, 0x28, 0xb2, 0xfb, 0x4b, 0x45, 0xa3, 0xd0, 0xa4, 0xe3, 0x46, 0x39, 0x6e, 0x46, 0xcb,
-	0x4d, 0x8f, 0xfa, 0xb1, 0x50, 0x33, 0x1f, 0xa3, 0xbc, 0xb3, 0xb6, 0x6d, 0x32, 0xd5, 0x46, 0x39,
-	0x83, 0xc0, 0xac, 0x78, 0xf2, 0x09, 0x0b, 0xa6, 0x9c, 0x7b, 0x91, 0xae, 0xaf, 0xe5, 0x56, 0x79,
-	0xe8, 0x4d, 0x2a, 0x55, 0xb2, 0x5b, 0x99, 0x61, 0xe6, 0x3d, 0x05, 0xc2, 0xb4, 0x50, 0xf2, 0x25,
-	0x0b, 0x08, 0x3d, 0xa0, 0xee, 0x56, 0x18, 0xec, 0x7b, 0xd5, 0xe4, 0x1b, 0xca, 0xf0, 0x67, 0x48,
-	0x6f, 0xfb, 0x72, 0x0f, 0x5f, 0x61, 0xd5, 0x7b, 0xe1, 0xd8, 0x47, 0x07, 0xfb, 0xaf, 0x8a, 0x30,
-	0x61, 0x18, 0xe3, 0xbe, 0x3b, 0xab, 0xf5, 0x03, 0xb6, 0xb3, 0x16, 0x4e, 0xb0, 0xb3, 0x7e, 0x14,
-	0xca, 0x6e, 0x62, 0x28, 0xf2, 0xa9, 0x07, 0xce, 0x9a, 0x1f, 0x6d, 0x2b, 0x14, 0x08, 0xb5, 0x4c,
-	0x72, 0x15, 0x66, 0x0c, 0x36, 0xd2, 0xc8, 0x8c, 0x70, 0x23, 0xa3, 0x12, 0x4d, 0x4b, 0x59, 0x02,
-	0xec, 0x6d, 0x43, 0x9e, 0x67, 0x5e, 0xad, 0x27, 0xfb, 0x25, 0xa2, 0x78, 0x59, 0x6b, 0xbb, 0xb4,
-	0xb5, 0x9a, 0x80, 0xd1, 0xa4, 0xb1, 0xbf, 0x69, 0xa9, 0x8f, 0xfb, 0x04, 0x0a, 0x35, 0xee, 0xa6,
-	0x0b, 0x35, 0x2e, 0xe7, 0x32, 0xcc, 0x03, 0x8a, 0x34, 0x36, 0x60, 0x7c, 0x39, 0x68, 0xb5, 0x1c,
-	0xbf, 0x4a, 0xde, 0x04, 0xe3, 0xae, 0xf8, 0x53, 0x86, 0x89, 0x13, 0x6c, 0xff, 0x96, 0x58, 0x4c,
-	0x70, 0xe4, 0x19, 0x18, 0x71, 0xc2, 0x7a, 0x12, 0x1a, 0xf2, 0xb3, 0xa3, 0xa5, 0xb0, 0x1e, 0x21,
-	0x87, 0xda, 0x5f, 0x2c, 0x00, 0x2c, 0x07, 0xad, 0xb6, 0x13, 0xd2, 0xea, 0x4e, 0xf0, 0xbf, 0x39,
-	0x62, 0x11, 0x31, 0x7c, 0xda, 0x02, 0xc2, 0x46, 0x25, 0xf0, 0xa9, 0x1f, 0xab, 0xc3, 0x57, 0xb6,
-	0x5f, 0xba, 0x09, 0x54, 0x6e, 0x3e, 0x7a, 0x0d, 0x24, 0x08, 0xd4, 0x34, 0xc7, 0x88, 0x22, 0x9e,
-	0x4d, 0x76, 0xfc, 0x62, 0xba, 0xa6, 0x81, 0x1f, 0x94, 0x4a, 0x07, 0xc0, 0xfe, 0x5a, 0x01, 0xce,
-	0x0b, 0xb3, 0xb5, 0xee, 0xf8, 0x4e, 0x9d, 0xb6, 0x98, 0x56, 0xc7, 0x3d, 0x6d, 0x70, 0x99, 0xfb,
-	0xea, 0x25, 0x25, 0x0c, 0xc3, 0x4e, 0x4e, 0x31, 0xa9, 0xc4, 0x34, 0x5a, 0xf5, 0xbd, 0x18, 0x39,
-	0x73, 0x12, 0x41, 0x29, 0xb9, 0xe1, 0x21, 0x8d, 0x4d, 0x4e, 0x82, 0xd4, 0xba, 0xbb, 0x2a, 0xd9,
-	0xa3, 0x12, 0xc4, 0x36, 0xf7, 0x66, 0xe0, 0xee, 0x21, 0x6d, 0x07, 0xdc, 0xb0, 0x18, 0x27, 0xc8,
-	0x6b, 0x12, 0x8e, 0x8a, 0xc2, 0xfe, 0x9a, 0x05, 0x59, 0x93, 0xcb, 0xa3, 0x41, 0x51, 0x33, 0x98,
-	0x8d, 0x06, 0xd3, 0x25, 0x7e, 0x27, 0xa8, 0x98, 0x7b, 0x3f, 0x4c, 0x38, 0x71, 0x4c, 0x5b, 0x6d,
-	0x11, 0x9a, 0x14, 0x1f, 0x2d, 0xfd, 0xb5, 0x1e, 0x54, 0xbd, 0x9a, 0xc7, 0x43, 0x12, 0x93, 0x9d,
-	0x7d, 0x13, 0x4a, 0xc9, 0x89, 0xcf, 0x31, 0x3e, 0xfd, 0xb3, 0x29, 0x77, 0x72, 0xc0, 0xe4, 0x7a,
-	0x50, 0x80, 0x3e, 0x7b, 0x26, 0xeb, 0xb2, 0xb6, 0x2e, 0xa9, 0x2e, 0x9f, 0xcc, 0xc2, 0x90, 0x03,
-	0x71, 0xda, 0x25, 0xf2, 0x2c, 0xef, 0xcd, 0x7b, 0xcf, 0xd7, 0x07, 0x60, 0x13, 0x52, 0x3f, 0x75,
-	0x08, 0x46, 0x2e, 0x01, 0xe8, 0x4d, 0x41, 0x16, 0x7a, 0xa8, 0x4c, 0xad, 0xde, 0x3b, 0xd0, 0xa0,
-	0x62, 0x2e, 0xa0, 0xe7, 0x47, 0xb1, 0xd3, 0x6c, 0x5e, 0xf3, 0xfc, 0x58, 0xc6, 0xb2, 0xca, 0x60,
-	0xac, 0x6a, 0x14, 0x9a, 0x74, 0x73, 0xef, 0x34, 0xbe, 0xcb, 0x49, 0xdc, 0xfa, 0x4f, 0x17, 0x60,
-	0xfa, 0xaa, 0xdf, 0xd9, 0xba, 0xba, 0xd5, 0xd9, 0x6d, 0x7a, 0xee, 0x0d, 0xda, 0x65, 0x1f, 0x6d,
-	0x8f, 0x76, 0x57, 0x57, 0xe4, 0xb0, 0xab, 0x8f, 0x76, 0x83, 0x01, 0x51, 0xe0, 0x98, 0x9a, 0x35,
-	0xcf, 0xaf, 0xd3, 0xb0, 0x1d, 0x7a, 0xd2, 0x77, 0x37, 0xd4, 0xbc, 0xa2, 0x51, 0x68, 0xd2, 0x31,
-	0xde, 0xc1, 0x3d, 0x9f, 0x86, 0x59, 0x6b, 0xb3, 0xc9, 0x80, 0x28, 0x70, 0x8c, 0x28, 0x0e, 0x3b,
-	0x51, 0x2c, 0x47, 0x4c, 0x11, 0xed, 0x30, 0x20, 0x0a, 0x1c, 0x9b, 0x1e, 0x51, 0x67, 0x97, 0x67,
-	0x61, 0x33, 0xe7, 0xe1, 0xdb, 0x02, 0x8c, 0x09, 0x9e, 0x91, 0xee, 0xd1, 0xee, 0x0a, 0xdb, 0x7b,
-	0x33, 0x15, 0x2b, 0x37, 0x04, 0x18, 0x13, 0xbc, 0xfd, 0xf7, 0x16, 0x90, 0xf4, 0x70, 0x3c, 0x81,
-	0xed, 0xfb, 0xb5, 0xf4, 0xf6, 0x3d, 0x64, 0xc2, 0x3c, 0xad, 0xfe, 0x80, 0x5d, 0xfc, 0x57, 0x2c,
-	0x98, 0x34, 0xcf, 0x4e, 0x48, 0x3d, 0x63, 0x88, 0x36, 0xd3, 0x86, 0xe8, 0xc1, 0xe1, 0xfc, 0x8f,
-	0xf7, 0xbb, 0xae, 0x58, 0xf7, 0xe2, 0xa0, 0x1d, 0xbd, 0x9d, 0xfa, 0x75, 0xcf, 0xa7, 0x3c, 0x33,
-	0x28, 0xce, 0x5c, 0x52, 0x07, 0x33, 0xcb, 0x41, 0x95, 0x3e, 0x82, 0x25, 0xb3, 0xef, 0xc0, 0x4c,
-	0x4f, 0x99, 0xd2, 0x31, 0x8c, 0xce, 0x91, 0x55, 0xa0, 0x36, 0xc2, 0x04, 0x63, 0xbc, 0xd9, 0x16,
-	0x87, 0x23, 0xcb, 0x30, 0x23, 0xaa, 0xad, 0x98, 0xa4, 0x6d, 0xb7, 0x41, 0x5b, 0xaa, 0xf4, 0x8c,
-	0x07, 0x8a, 0xb7, 0xb3, 0x48, 0xec, 0xa5, 0xb7, 0x3f, 0x63, 0xc1, 0x54, 0xaa, 0x72, 0x2c, 0x27,
-	0xf3, 0xc8, 0x57, 0x5a, 0xc0, 0x8f, 0xf2, 0x42, 0xcf, 0x17, 0xb9, 0xbe, 0x92, 0xb1, 0xd2, 0x34,
-	0x0a, 0x4d, 0x3a, 0xfb, 0x0b, 0x05, 0x28, 0x25, 0x59, 0xe1, 0x63, 0xa8, 0xf2, 0x29, 0x0b, 0xa6,
-	0x54, 0x70, 0xce, 0x5d, 0x76, 0x31, 0x19, 0x37, 0x86, 0xcf, 0x4b, 0xab, 0xf3, 0x5e, 0xe6, 0xb2,
-	0xab, 0xd8, 0x01, 0x4d, 0x61, 0x98, 0x96, 0x4d, 0x6e, 0x03, 0x44, 0xdd, 0x28, 0xa6, 0x2d, 0x23,
-	0x78, 0xb0, 0x8d, 0x15, 0xb7, 0xe0, 0x06, 0x21, 0x65, 0xeb, 0x6b, 0x23, 0xa8, 0xd2, 0x6d, 0x45,
-	0xa9, 0x8d, 0xab, 0x86, 0xa1, 0xc1, 0xc9, 0xfe, 0xcd, 0x02, 0x9c, 0xce, 0xaa, 0x44, 0xde, 0x07,
-	0x93, 0x89, 0x74, 0xe3, 0xe6, 0x67, 0x92, 0x0a, 0x9f, 0x44, 0x03, 0xf7, 0xe0, 0x70, 0x7e, 0xbe,
-	0xf7, 0xea, 0xeb, 0x82, 0x49, 0x82, 0x29, 0x66, 0x22, 0x43, 0x22, 0x53, 0x79, 0x95, 0xee, 0x52,
-	0xbb, 0x2d, 0xd3, 0x1c, 0x46, 0x86, 0xc4, 0xc4, 0x62, 0x86, 0x9a, 0x6c, 0xc1, 0x59, 0x03, 0xb2,
-	0x41, 0xbd, 0x7a, 0x63, 0x37, 0x08, 0xc5, 0x15, 0x83, 0x62, 0xe5, 0x19, 0xc9, 0xe5, 0x2c, 0xf6,
-	0xa1, 0xc1, 0xbe, 0x2d, 0x99, 0xd3, 0xe2, 0x3a, 0x6d, 0xc7, 0xf5, 0xe2, 0xae, 0x8c, 0x86, 0x94,
-	0x6d, 0x5a, 0x96, 0x70, 0x54, 0x14, 0xf6, 0x3a, 0x8c, 0x1c, 0x73, 0x06, 0x1d, 0x6b, 0xaf, 0xbf,
-	0x09, 0x25, 0xc6, 0x8e, 0xd9, 0xa2, 0xbc, 0x58, 0x06, 0x50, 0x4a, 0x6e, 0x9c, 0x10, 0x1b, 0x8a,
-	0x9e, 0x93, 0x24, 0xa1, 0x54, 0xb7, 0x56, 0xa3, 0xa8, 0xc3, 0x3d, 0x19, 0x86, 0x24, 0xcf, 0x42,
-	0x91, 0x1e, 0xb4, 0xb3, 0xd9, 0xa6, 0xcb, 0x07, 0x6d, 0x2f, 0xa4, 0x11, 0x23, 0xa2, 0x07, 0x6d,
-	0x32, 0x07, 0x05, 0xaf, 0x2a, 0x37, 0x29, 0x90, 0x34, 0x85, 0xd5, 0x15, 0x2c, 0x78, 0x55, 0xfb,
-	0x00, 0xca, 0xea, 0x8a, 0x0b, 0xd9, 0x4b, 0x6c, 0xb7, 0x95, 0xc7, 0x31, 0x4e, 0xc2, 0x77, 0x80,
-	0xd5, 0xee, 0x00, 0xe8, 0x3a, 0xbd, 0xbc, 0xec, 0xcb, 0x45, 0x18, 0x71, 0x03, 0x59, 0xde, 0x5b,
-	0xd2, 0x6c, 0xb8, 0xd1, 0xe6, 0x18, 0xfb, 0x0e, 0x4c, 0xdf, 0xf0, 0x83, 0x7b, 0x3e, 0xdb, 0x4c,
-	0xaf, 0x78, 0xb4, 0x59, 0x65, 0x8c, 0x6b, 0xec, 0x8f, 0xac, 0x8b, 0xc0, 0xb1, 0x28, 0x70, 0xea,
-	0x1e, 0x48, 0x61, 0xd0, 0x3d, 0x10, 0xfb, 0x63, 0x16, 0x9c, 0x56, 0x05, 0x64, 0x89, 0x35, 0x7e,
-	0x11, 0x26, 0x77, 0x3b, 0x5e, 0xb3, 0x2a, 0x7f, 0x4b, 0x11, 0xaa, 0x44, 0xae, 0x62, 0xe0, 0x30,
-	0x45, 0xc9, 0xdc, 0xad, 0x5d, 0xcf, 0x77, 0xc2, 0xee, 0x96, 0x36, 0xff, 0xca, 0x22, 0x54, 0x14,
-	0x06, 0x0d, 0x2a, 0xfb, 0xcf, 0x8b, 0xa0, 0xaf, 0xb7, 0x10, 0x4f, 0x56, 0x42, 0x58, 0x79, 0xe4,
-	0xaa, 0xb6, 0xbb, 0xbe, 0xab, 0x2f, 0xd2, 0x94, 0x32, 0x85, 0x10, 0x9f, 0xb4, 0x98, 0xa3, 0xe7,
-	0xc5, 0x9e, 0xc3, 0xd7, 0xa7, 0x8c, 0x8e, 0xb6, 0x72, 0x3a, 0x2c, 0x5f, 0x15, 0x9c, 0x83, 0xd0,
-	0x74, 0x1d, 0x95, 0x30, 0x34, 0x25, 0x93, 0x57, 0xe5, 0xf1, 0x42, 0x31, 0xb7, 0x3a, 0x9a, 0x52,
-	0xe6, 0x4c, 0xa1, 0x0d, 0xa3, 0x21, 0x8d, 0xc3, 0xa4, 0x82, 0xe9, 0xc6, 0xb0, 0x87, 0xad, 0x71,
-	0xd8, 0xdd, 0x8e, 0x59, 0x04, 0x56, 0x37, 0xfc, 0x1b, 0x0e, 0x46, 0x21, 0xc8, 0x8e, 0x80, 0xf4,
-	0x8e, 0xc5, 0x09, 0x53, 0xb7, 0x8b, 0x50, 0x76, 0x3a, 0x71, 0xd0, 0x62, 0xc3, 0xc4, 0x3f, 0x4f,
-	0xc9, 0x48, 0x4e, 0x27, 0x08, 0xd4, 0x34, 0xf6, 0xe7, 0x46, 0x21, 0x53, 0x9a, 0x40, 0x0e, 0xcc,
-	0xab, 0x59, 0x56, 0xbe, 0x57, 0xb3, 0x94, 0x32, 0xfd, 0xae, 0x67, 0x91, 0x3a, 0x8c, 0xb6, 0x1b,
-	0x4e, 0x94, 0x2c, 0xbf, 0x9b, 0xc9, 0x30, 0x6d, 0x31, 0xe0, 0x83, 0xc3, 0xf9, 0x9f, 0x38, 0x9e,
-	0x3b, 0xc7, 0xe6, 0xea, 0xa2, 0xa8, 0xd3, 0xd4, 0xa2, 0x39, 0x0f, 0x14, 0xfc, 0x4d, 0x87, 0xae,
-	0x78, 0x44, 0x68, 0xfa, 0x71, 0x4b, 0xd4, 0xb3, 0x21, 0x8d, 0x3a, 0xcd, 0x58, 0xce, 0x86, 0x9b,
-	0x39, 0xae, 0x32, 0xc1, 0x58, 0x17, 0xb6, 0x89, 0xdf, 0x68, 0x08, 0x25, 0xef, 0x83, 0x72, 0x14,
-	0x3b, 0x61, 0xfc, 0x88, 0x65, 0x30, 0x6a, 0xd0, 0xb7, 0x13, 0x26, 0xa8, 0xf9, 0x91, 0x57, 0x00,
-	0x6a, 0x9e, 0xef, 0x45, 0x8d, 0x47, 0x3c, 0x15, 0xe4, 0x8a, 0x5f, 0x51, 0x1c, 0xd0, 0xe0, 0xc6,
-	0xac, 0x1b, 0x9f, 0xdb, 0x22, 0x8f, 0x59, 0xe2, 0xdb, 0x97, 0xb2, 0x6e, 0xa8, 0x30, 0x68, 0x50,
-	0xd9, 0x1f, 0x81, 0x33, 0xd9, 0x6b, 0xd1, 0x32, 0xc2, 0xab, 0x87, 0x41, 0xa7, 0x9d, 0x35, 0xdf,
-	0xfc, 0xda, 0x2c, 0x0a, 0x1c, 0x33, 0xdf, 0x7b, 0x9e, 0x5f, 0xcd, 0x9a, 0xef, 0x1b, 0x9e, 0x5f,
-	0x45, 0x8e, 0x39, 0xc6, 0x9d, 0xb5, 0xdf, 0xb7, 0xe0, 0xe2, 0x51, 0xb7, 0xb7, 0x59, 0xf4, 0x7e,
-	0xcf, 0x09, 0x7d, 0x79, 0x1d, 0x86, 0xdb, 0x8e, 0x3b, 0x4e, 0xe8, 0x23, 0x87, 0x92, 0x2e, 0x8c,
-	0x89, 0xd2, 0x3f, 0xe9, 0x90, 0xde, 0xcc, 0xf7, 0x2e, 0x39, 0x0b, 0x91, 0x54, 0xd2, 0x45, 0x94,
-	0x1d, 0xa2, 0x14, 0x68, 0x7f, 0xc7, 0x02, 0xb2, 0xb9, 0x4f, 0xc3, 0xd0, 0xab, 0x1a, 0xc5, 0x8a,
-	0xe4, 0x05, 0x98, 0xbc, 0xbb, 0xbd, 0xb9, 0xb1, 0x15, 0x78, 0x3e, 0xbf, 0x8f, 0x61, 0x94, 0xc8,
-	0x5c, 0x37, 0xe0, 0x98, 0xa2, 0x62, 0x41, 0xc6, 0xdd, 0xd7, 0xd8, 0x96, 0x73, 0xf9, 0xa0, 0x1d,
-	0xd2, 0x28, 0x52, 0x2f, 0x30, 0xc8, 0x20, 0xe3, 0xfa, 0xcd, 0x0c, 0x12, 0x7b, 0xe9, 0xc9, 0x26,
-	0x9c, 0x6b, 0xf1, 0x04, 0x5c, 0x95, 0xef, 0xb4, 0x91, 0xc8, 0xc6, 0x85, 0x49, 0xc1, 0xfb, 0x1b,
-	0xee, 0x1f, 0xce, 0x9f, 0x5b, 0xef, 0x47, 0x80, 0xfd, 0xdb, 0xd9, 0x5f, 0x2d, 0xc0, 0x84, 0xf1,
-	0x02, 0xc2, 0x31, 0x7c, 0x8a, 0xcc, 0xa3, 0x0d, 0x85, 0x63, 0x3e, 0xda, 0xf0, 0x1c, 0x94, 0xda,
-	0x41, 0xd3, 0x73, 0x3d, 0x55, 0x9d, 0x3f, 0xc9, 0xcf, 0xc0, 0x24, 0x0c, 0x15, 0x96, 0xdc, 0x83,
-	0xb2, 0xba, 0xca, 0x2c, 0xeb, 0xf5, 0xf2, 0xf2, 0xaa, 0xd4, 0xe2, 0xd5, 0x57, 0x94, 0xb5, 0x2c,
-	0x62, 0xc3, 0x18, 0x9f, 0xf9, 0x49, 0x86, 0x9f, 0x17, 0x80, 0xf0, 0x25, 0x11, 0xa1, 0xc4, 0xd8,
-	0xff, 0x34, 0x0a, 0x65, 0xa4, 0xed, 0x60, 0x39, 0xa4, 0xd5, 0x88, 0xbc, 0x11, 0x8a, 0x9d, 0xb0,
-	0x29, 0x07, 0x4b, 0xa5, 0x7f, 0x6e, 0xe1, 0x1a, 0x32, 0x78, 0x6a, 0xbb, 0x29, 0x9c, 0xe8, 0xa4,
-	0xb0, 0x78, 0xe4, 0x49, 0xe1, 0x4b, 0x30, 0x15, 0x45, 0x8d, 0xad, 0xd0, 0xdb, 0x77, 0x62, 0x36,
-	0x89, 0x65, 0xae, 0x44, 0x1f, 0xcd, 0x6c, 0x5f, 0xd3, 0x48, 0x4c, 0xd3, 0x92, 0xab, 0x30, 0xa3,
-	0xcf, 0xeb, 0x68, 0x18, 0xf3, 0xd4, 0x88, 0xc8, 0xa2, 0xa8, 0x93, 0x11, 0x7d, 0xc2, 0x27, 0x09,
-	0xb0, 0xb7, 0x0d, 0x59, 0x81, 0xd3, 0x29, 0x20, 0x53, 0x44, 0xa4, 0x58, 0x54, 0x2d, 0x40, 0x8a,
-	0x0f, 0xd3, 0xa5, 0xa7, 0x05, 0x59, 0x87, 0x33, 0xe2, 0xfb, 0xf2, 0x2b, 0xf0, 0xaa, 0x47, 0xe3,
-	0x9c, 0xd1, 0xff, 0x91, 0x8c, 0xce, 0x5c, 0xed, 0x25, 0xc1, 0x7e, 0xed, 0xd8, 0x0c, 0x55, 0xe0,
-	0xd5, 0x15, 0x69, 0x29, 0xd5, 0x0c, 0x55, 0x6c, 0x56, 0xab, 0x68, 0xd2, 0x91, 0xf7, 0xc2, 0xd3,
-	0xfa, 0xa7, 0xc8, 0xac, 0x09, 0xf7, 0x61, 0x45, 0x96, 0x42, 0xa8, 0x9b, 0x46, 0x57, 0xfb, 0x92,
-	0x55, 0x71, 0x50, 0x7b, 0xb2, 0x0b, 0x73, 0x0a, 0x75, 0x99, 0x99, 0x83, 0x76, 0xe8, 0x45, 0xb4,
-	0xe2, 0x44, 0xf4, 0x56, 0xd8, 0xe4, 0xc5, 0x13, 0x65, 0xfd, 0x8c, 0xc3, 0x55, 0x2f, 0xbe, 0xd6,
-	0x8f, 0x12, 0xd7, 0xf0, 0x21, 0x5c, 0x98, 0xb7, 0x42, 0x7d, 0x67, 0xb7, 0x49, 0x37, 0x97, 0x57,
-	0x79, 0x49, 0x85, 0xe1, 0xad, 0x5c, 0x4e, 0x10, 0xa8, 0x69, 0x94, 0x7b, 0x3e, 0x39, 0xd0, 0x3d,
-	0xff, 0xb6, 0x05, 0x53, 0x6a, 0xb2, 0x3f, 0x81, 0x3c, 0x58, 0x33, 0x9d, 0x07, 0xbb, 0x3a, 0xac,
-	0x9b, 0x28, 0x35, 0x1f, 0x10, 0x4c, 0x7d, 0xaf, 0x0c, 0xc0, 0x1f, 0xc6, 0xf1, 0x78, 0xa9, 0xee,
-	0x45, 0x18, 0x09, 0x69, 0x3b, 0xc8, 0x5a, 0x3e, 0x9e, 0xc3, 0xe7, 0x98, 0x1f, 0xdc, 0xe5, 0xdc,
-	0xef, 0xe4, 0x78, 0xf4, 0x7f, 0xf6, 0xe4, 0x78, 0x1b, 0xce, 0x79, 0x7e, 0x44, 0xdd, 0x4e, 0x28,
-	0x77, 0xce, 0x6b, 0x41, 0xa4, 0xac, 0x43, 0xa9, 0xf2, 0x46, 0xc9, 0xe8, 0xdc, 0x6a, 0x3f, 0x22,
-	0xec, 0xdf, 0x96, 0x0d, 0x69, 0x82, 0x90, 0x77, 0x82, 0x74, 0x88, 0x2f, 0xe1, 0xa8, 0x28, 0xf4,
-	0x82, 0x58, 0xab, 0x25, 0x97, 0x7e, 0x32, 0x0b, 0x62, 0xed, 0xca, 0x36, 0x6a, 0x9a, 0xfe, 0x56,
-	0xb1, 0x9c, 0x93, 0x55, 0x84, 0x13, 0x5b, 0xc5, 0x64, 0x7d, 0x4e, 0x0c, 0x7c, 0x46, 0x21, 0xd9,
-	0xac, 0x27, 0x07, 0x6e, 0xd6, 0x2f, 0xc3, 0xb4, 0xe7, 0x37, 0x68, 0xe8, 0xc5, 0xb4, 0xca, 0xd7,
-	0xc2, 0xec, 0x14, 0x1f, 0x08, 0x95, 0x7d, 0x5a, 0x4d, 0x61, 0x31, 0x43, 0x9d, 0x36, 0x2a, 0xd3,
-	0xc7, 0x30, 0x2a, 0x03, 0x4c, 0xf9, 0xa9, 0x7c, 0x4c, 0xf9, 0xe9, 0xe1, 0x4d, 0xf9, 0xcc, 0x63,
-	0x35, 0xe5, 0x24, 0x17, 0x53, 0xfe, 0x2c, 0x8c, 0xb6, 0xc3, 0xe0, 0xa0, 0x3b, 0x7b, 0x26, 0xed,
-	0x9e, 0x6f, 0x31, 0x20, 0x0a, 0x9c, 0x59, 0x40, 0x77, 0xf6, 0xe1, 0x05, 0x74, 0xf6, 0xeb, 0x05,
-	0x38, 0xa7, 0x2d, 0x1d, 0x9b, 0x5f, 0x5e, 0x8d, 0xad, 0x75, 0x7e, 0x33, 0x53, 0x14, 0x6d, 0x18,
-	0x89, 0x4f, 0x9d, 0x43, 0x55, 0x18, 0x34, 0xa8, 0x78, 0xfe, 0x90, 0x86, 0xbc, 0xec, 0x37, 0x6b,
-	0x06, 0x97, 0x25, 0x1c, 0x15, 0x05, 0x7f, 0x55, 0x8f, 0x86, 0xb1, 0x3c, 0x93, 0xc9, 0x56, 0x34,
-	0x2d, 0x6b, 0x14, 0x9a, 0x74, 0xcc, 0x5d, 0x74, 0x93, 0x25, 0xc8, 0x4c, 0xe1, 0xa4, 0x70, 0x17,
-	0xd5, 0xaa, 0x53, 0xd8, 0x44, 0x1d, 0x9e, 0x28, 0x1e, 0xed, 0x55, 0x87, 0x67, 0x21, 0x14, 0x85,
-	0xfd, 0x1f, 0x16, 0xbc, 0xa1, 0xef, 0x50, 0x3c, 0x81, 0xed, 0xed, 0x20, 0xbd, 0xbd, 0x6d, 0x0f,
-	0xbf, 0xbd, 0xf5, 0xf4, 0x62, 0xc0, 0x56, 0xf7, 0x97, 0x16, 0x4c, 0x6b, 0xfa, 0x27, 0xd0, 0x55,
-	0x2f, 0xd7, 0xf7, 0xf1, 0xb4, 0xea, 0xa2, 0x1c, 0x35, 0xd5, 0xb7, 0x6f, 0xf3, 0xbe, 0x89, 0x60,
-	0x6e, 0xc9, 0x4d, 0x1e, 0xa0, 0x39, 0x22, 0x88, 0xe9, 0xc2, 0x18, 0xbf, 0xc2, 0x1f, 0xe5, 0x13,
-	0x54, 0xa6, 0xe5, 0xf3, 0x13, 0x20, 0x1d, 0x54, 0xf2, 0x9f, 0x11, 0x4a, 0x81, 0xbc, 0x28, 0xdd,
-	0x8b, 0x98, 0xbd, 0xac, 0xca, 0x94, 0xab, 0x2e, 0x4a, 0x97, 0x70, 0x54, 0x14, 0x76, 0x0b, 0x66,
-	0xd3, 0xcc, 0x57, 0x68, 0x8d, 0xe7, 0xee, 0x8e, 0xd5, 0xcd, 0x45, 0x28, 0x3b, 0xbc, 0xd5, 0x5a,
-	0xc7, 0xc9, 0xbe, 0x42, 0xb3, 0x94, 0x20, 0x50, 0xd3, 0xd8, 0xbf, 0x61, 0xc1, 0x99, 0x3e, 0x9d,
-	0xc9, 0x31, 0xd5, 0x1c, 0x6b, 0x2b, 0x30, 0xe0, 0x65, 0xa0, 0x2a, 0xad, 0x39, 0x49, 0x76, 0xc8,
-	0xb0, 0x6a, 0x2b, 0x02, 0x8c, 0x09, 0xde, 0xfe, 0x67, 0x0b, 0x4e, 0xa5, 0x75, 0x8d, 0xc8, 0x75,
-	0x20, 0xa2, 0x33, 0x2b, 0x5e, 0xe4, 0x06, 0xfb, 0x34, 0xec, 0xb2, 0x9e, 0x0b, 0xad, 0xe7, 0x24,
-	0x27, 0xb2, 0xd4, 0x43, 0x81, 0x7d, 0x5a, 0xf1, 0xda, 0xdf, 0xaa, 0x1a, 0xed, 0x64, 0xa6, 0xdc,
-	0xce, 0x73, 0xa6, 0xe8, 0x8f, 0x69, 0x46, 0xd0, 0x4a, 0x24, 0x9a, 0xf2, 0xed, 0xef, 0x8c, 0x80,
-	0x3a, 0x8b, 0xe2, 0x79, 0x88, 0x9c, 0xb2, 0x38, 0xa9, 0xa7, 0x8a, 0x8a, 0x27, 0x78, 0xaa, 0x68,
-	0xe4, 0x61, 0x39, 0x02, 0xf1, 0x6e, 0x8e, 0xf6, 0x45, 0x0d, 0xa3, 0xbf, 0xa3, 0x51, 0x68, 0xd2,
-	0x31, 0x4d, 0x9a, 0xde, 0x3e, 0x15, 0x8d, 0xc6, 0xd2, 0x9a, 0xac, 0x25, 0x08, 0xd4, 0x34, 0x4c,
-	0x93, 0xaa, 0x57, 0xab, 0xc9, 0x48, 0x51, 0x69, 0xc2, 0x46, 0x07, 0x39, 0x86, 0x51, 0x34, 0x82,
-	0x60, 0x4f, 0xfa, 0x7f, 0x8a, 0xe2, 0x5a, 0x10, 0xec, 0x21, 0xc7, 0x30, 0x8f, 0xc5, 0x0f, 0xc2,
-	0x96, 0xd3, 0xf4, 0x3e, 0x48, 0xab, 0x4a, 0x8a, 0xf4, 0xfb, 0x94, 0xc7, 0xb2, 0xd1, 0x4b, 0x82,
-	0xfd, 0xda, 0xb1, 0x19, 0xd8, 0x0e, 0x69, 0xd5, 0x73, 0x63, 0x93, 0x1b, 0xa4, 0x67, 0xe0, 0x56,
-	0x0f, 0x05, 0xf6, 0x69, 0x45, 0x96, 0xe0, 0x54, 0x72, 0x96, 0x98, 0xd4, 0x90, 0x08, 0x67, 0x50,
-	0xf9, 0xe1, 0x98, 0x46, 0x63, 0x96, 0x9e, 0x59, 0x9b, 0x96, 0xac, 0xe4, 0xe1, 0x6e, 0xa2, 0x61,
-	0x6d, 0x92, 0x0a, 0x1f, 0x54, 0x14, 0xf6, 0xc7, 0x8b, 0x6c, 0x77, 0x1c, 0x70, 0x3b, 0xf7, 0x89,
-	0x65, 0x0d, 0xd3, 0x33, 0x72, 0xe4, 0x18, 0x33, 0xf2, 0x05, 0x98, 0xbc, 0x1b, 0x05, 0xbe, 0xca,
-	0xc8, 0x8d, 0x0e, 0xcc, 0xc8, 0x19, 0x54, 0xfd, 0x33, 0x72, 0x63, 0x79, 0x65, 0xe4, 0xc6, 0x1f,
-	0x31, 0x23, 0xf7, 0x27, 0xa3, 0x70, 0x5e, 0x9d, 0x27, 0xd3, 0xf8, 0x5e, 0x10, 0xee, 0x79, 0x7e,
-	0x9d, 0x9f, 0xc1, 0x7e, 0xc5, 0x82, 0x49, 0xb1, 0x5e, 0xe4, 0xc3, 0x08, 0xe2, 0xcc, 0xb1, 0x96,
-	0xd3, 0xdd, 0xb5, 0x94, 0xb0, 0x85, 0x1d, 0x43, 0x50, 0xe6, 0x95, 0x0a, 0x13, 0x85, 0x29, 0x8d,
-	0xc8, 0x87, 0x01, 0x92, 0x17, 0xb3, 0x6a, 0x39, 0xbd, 0x1b, 0x96, 0xe8, 0x87, 0xb4, 0xa6, 0x7d,
-	0xd3, 0x1d, 0x25, 0x04, 0x0d, 0x81, 0xe4, 0x75, 0x4b, 0xdd, 0x15, 0x11, 0xa7, 0x59, 0xaf, 0x3e,
-	0x96, 0xb1, 0x39, 0xce, 0xd5, 0x11, 0x84, 0x71, 0xcf, 0xaf, 0xb3, 0x79, 0x22, 0x93, 0x98, 0x6f,
-	0xe9, 0x57, 0xbf, 0xb0, 0x16, 0x38, 0xd5, 0x8a, 0xd3, 0x74, 0x7c, 0x97, 0x86, 0xab, 0x82, 0xdc,
-	0x7c, 0x36, 0x89, 0x03, 0x30, 0x61, 0xd4, 0x73, 0x39, 0x73, 0xf4, 0x38, 0x97, 0x33, 0xe7, 0xde,
-	0x03, 0x33, 0x3d, 0x1f, 0xf3, 0x44, 0x57, 0x47, 0x1e, 0xfd, 0xd6, 0x89, 0xfd, 0x07, 0x63, 0x7a,
-	0xd3, 0xda, 0x08, 0xaa, 0xe2, 0x8a, 0x60, 0xa8, 0xbf, 0xa8, 0xf4, 0x3d, 0x73, 0x9c, 0x22, 0xc6,
-	0xd3, 0x4b, 0x0a, 0x88, 0xa6, 0x48, 0x36, 0x47, 0xdb, 0x4e, 0x48, 0xfd, 0xc7, 0x3d, 0x47, 0xb7,
-	0x94, 0x10, 0x34, 0x04, 0x92, 0x46, 0xea, 0xb8, 0xf5, 0xca, 0xf0, 0xc7, 0xad, 0xcc, 0x1d, 0xee,
-	0x7b, 0x95, 0xeb, 0xf3, 0x16, 0x4c, 0xfb, 0xa9, 0x99, 0x2b, 0x8f, 0xdc, 0x76, 0x1e, 0xc7, 0xaa,
-	0x10, 0x57, 0xb3, 0xd3, 0x30, 0xcc, 0xc8, 0xef, 0xb7, 0xa5, 0x8d, 0x9e, 0x70, 0x4b, 0xd3, 0x77,
-	0x8d, 0xc7, 0x06, 0xdd, 0x35, 0x26, 0xbe, 0x7a, 0x65, 0x60, 0x3c, 0xf7, 0x57, 0x06, 0xa0, 0xcf,
-	0x0b, 0x03, 0x77, 0xa0, 0xec, 0x86, 0xd4, 0x89, 0x1f, 0xf1, 0xc2, 0x39, 0x7f, 0xec, 0x6e, 0x39,
-	0x61, 0x80, 0x9a, 0x97, 0xfd, 0x67, 0x45, 0x38, 0x9d, 0x8c, 0x48, 0x72, 0x14, 0xc5, 0xf6, 0x47,
-	0x21, 0x57, 0x3b, 0xb7, 0x6a, 0x7f, 0xbc, 0x96, 0x20, 0x50, 0xd3, 0x30, 0x7f, 0xac, 0x13, 0xd1,
-	0xcd, 0x36, 0xf5, 0xd7, 0xbc, 0xdd, 0x88, 0x8f, 0xb8, 0x51, 0x42, 0x76, 0x4b, 0xa3, 0xd0, 0xa4,
-	0x63, 0xce, 0xb8, 0xf0, 0x8b, 0xa3, 0xec, 0xc9, 0xae, 0xf4, 0xb7, 0x31, 0xc1, 0x93, 0x2f, 0xf7,
-	0x7d, 0x2e, 0x24, 0x9f, 0x9a, 0x86, 0x9e, 0x13, 0xb8, 0x13, 0xbe, 0x13, 0xf2, 0x39, 0x0b, 0x4e,
-	0xed, 0xa5, 0xea, 0x57, 0x12, 0x93, 0x3c, 0x64, 0xa5, 0x65, 0xba, 0x28, 0x46, 0x4f, 0xe1, 0x34,
-	0x3c, 0xc2, 0xac, 0x74, 0xfb, 0xdf, 0x2c, 0x30, 0xcd, 0xd3, 0xf1, 0x3c, 0x2b, 0xe3, 0x01, 0xa8,
-	0xc2, 0x11, 0x0f, 0x40, 0x25, 0x4e, 0x58, 0xf1, 0x78, 0x4e, 0xff, 0xc8, 0x09, 0x9c, 0xfe, 0xd1,
-	0x81, 0x5e, 0xdb, 0x1b, 0xa1, 0xd8, 0xf1, 0xaa, 0xd2, 0x6f, 0xd7, 0x87, 0x61, 0xab, 0x2b, 0xc8,
-	0xe0, 0xf6, 0xef, 0x8d, 0xea, 0x38, 0x5d, 0x1e, 0xc5, 0xff, 0x50, 0x74, 0xbb, 0xa6, 0x0a, 0x67,
-	0x45, 0xcf, 0x37, 0x7a, 0x0a, 0x67, 0xdf, 0x7d, 0xf2, 0x4a, 0x0b, 0x31, 0x40, 0x83, 0xea, 0x66,
-	0xc7, 0x8f, 0x28, 0xb3, 0xb8, 0x0b, 0x25, 0x16, 0xda, 0xf0, 0x84, 0x5b, 0x29, 0xa5, 0x54, 0xe9,
-	0x9a, 0x84, 0x3f, 0x38, 0x9c, 0x7f, 0xd7, 0xc9, 0xd5, 0x4a, 0x5a, 0xa3, 0xe2, 0x4f, 0x22, 0x28,
-	0xb3, 0xbf, 0x79, 0x45, 0x88, 0x0c, 0x9a, 0x6e, 0x29, 0x5b, 0x94, 0x20, 0x72, 0x29, 0x37, 0xd1,
-	0x72, 0x88, 0x0f, 0x65, 0xfe, 0x54, 0x11, 0x17, 0x2a, 0x62, 0xab, 0x2d, 0x55, 0x97, 0x91, 0x20,
-	0x1e, 0x1c, 0xce, 0xbf, 0x74, 0x72, 0xa1, 0xaa, 0x39, 0x6a, 0x11, 0xf6, 0xdf, 0x15, 0xf5, 0xdc,
-	0x95, 0xf5, 0xd2, 0x3f, 0x14, 0x73, 0xf7, 0xc5, 0xcc, 0xdc, 0xbd, 0xd8, 0x33, 0x77, 0xa7, 0xf5,
-	0x73, 0x3e, 0xa9, 0xd9, 0xf8, 0xa4, 0x37, 0xd8, 0xa3, 0xe3, 0x78, 0xee, 0x59, 0xbc, 0xd6, 0xf1,
-	0x42, 0x1a, 0x6d, 0x85, 0x1d, 0xdf, 0xf3, 0xeb, 0xf2, 0x51, 0x47, 0xc3, 0xb3, 0x48, 0xa1, 0x31,
-	0x4b, 0x6f, 0x7f, 0x95, 0x9f, 0x77, 0x1a, 0xc5, 0x65, 0xec, 0x2b, 0x37, 0xf9, 0x6b, 0x4f, 0xa2,
-	0xa2, 0x54, 0x7d, 0x65, 0xf1, 0xc4, 0x93, 0xc0, 0x91, 0x7b, 0x30, 0xbe, 0x2b, 0x5e, 0x9c, 0xc8,
-	0xe7, 0x8a, 0x93, 0x7c, 0xbe, 0x82, 0x5f, 0x26, 0x4d, 0xde, 0xb2, 0x78, 0xa0, 0xff, 0xc4, 0x44,
-	0x9a, 0xfd, 0x4b, 0x45, 0x38, 0x95, 0x79, 0x8b, 0x88, 0x05, 0xfc, 0xc9, 0xc3, 0x53, 0xd9, 0xec,
-	0xbc, 0x7a, 0xd4, 0x58, 0x51, 0x90, 0x0f, 0x00, 0x54, 0x69, 0xbb, 0x19, 0x74, 0xb9, 0xe3, 0x32,
-	0x72, 0x62, 0xc7, 0x45, 0xf9, 0xba, 0x2b, 0x8a, 0x0b, 0x1a, 0x1c, 0x65, 0x19, 0xed, 0xa8, 0x78,
-	0x4f, 0x23, 0x5d, 0x46, 0x6b, 0xdc, 0xf4, 0x1b, 0x7b, 0xb2, 0x37, 0xfd, 0x3c, 0x38, 0x25, 0x54,
-	0x54, 0x25, 0x5c, 0x8f, 0x50, 0xa9, 0x75, 0x86, 0xcd, 0xa8, 0x95, 0x34, 0x1b, 0xcc, 0xf2, 0xb5,
-	0x3f, 0x5b, 0x60, 0xee, 0x9b, 0x18, 0xec, 0xf5, 0x24, 0x39, 0xfe, 0x66, 0x18, 0x73, 0x3a, 0x71,
-	0x23, 0xe8, 0x79, 0x01, 0x64, 0x89, 0x43, 0x51, 0x62, 0xc9, 0x1a, 0x8c, 0x54, 0x9d, 0x38, 0x79,
-	0x94, 0xff, 0x24, 0xca, 0xe9, 0x4c, 0x98, 0x13, 0x53, 0xe4, 0x5c, 0xc8, 0x33, 0x30, 0x12, 0x3b,
-	0xf5, 0xd4, 0x0b, 0x9e, 0x3b, 0x4e, 0x3d, 0x42, 0x0e, 0x35, 0x77, 0x97, 0x91, 0x23, 0x76, 0x97,
-	0x97, 0x8c, 0x7f, 0x17, 0x61, 0x9c, 0xba, 0xf4, 0xfe, 0x8b, 0x07, 0x51, 0xd8, 0x9f, 0xa2, 0xb5,
-	0x7f, 0x04, 0x26, 0xcd, 0x7f, 0x01, 0x71, 0xac, 0xbb, 0x46, 0xf6, 0x3f, 0x8e, 0xc0, 0x54, 0xaa,
-	0xcc, 0x2f, 0x35, 0xcb, 0xad, 0x23, 0x67, 0x39, 0x3f, 0x4f, 0xeb, 0xf8, 0x54, 0x16, 0x71, 0x1a,
-	0xe7, 0x69, 0x1d, 0x9f, 0xa2, 0xc0, 0xb1, 0xaf, 0x52, 0x0d, 0xbb, 0xd8, 0xf1, 0x65, 0x56, 0x5e,
-	0x7d, 0x95, 0x15, 0x0e, 0x45, 0x89, 0x65, 0x01, 0xec, 0x64, 0xc4, 0x8d, 0xa2, 0xb0, 0x11, 0x72,
-	0xd5, 0x5c, 0xcf, 0xe3, 0xd5, 0x34, 0x59, 0xd2, 0xca, 0x03, 0x7a, 0x13, 0x82, 0x29, 0x89, 0xe4,
-	0x13, 0x96, 0xf9, 0x5e, 0xdc, 0x58, 0x1e, 0xa7, 0x49, 0xd9, 0x2a, 0x4a, 0xb1, 0x82, 0x1e, 0xfe,
-	0x6c, 0x5c, 0xa4, 0x16, 0xf0, 0xf8, 0xe3, 0x59, 0xc0, 0xd0, 0x67, 0xf1, 0xbe, 0x15, 0xca, 0x2d,
-	0xc7, 0xf7, 0x6a, 0x34, 0x8a, 0xc5, 0xbf, 0x6f, 0x29, 0x8b, 0xe8, 0x69, 0x3d, 0x01, 0xa2, 0xc6,
-	0xf3, 0x7f, 0x92, 0xc4, 0x3b, 0x26, 0x82, 0x98, 0xb2, 0xf1, 0x4f, 0x92, 0x34, 0x18, 0x4d, 0x1a,
-	0xfb, 0xb7, 0x2d, 0x38, 0xd7, 0x77, 0x30, 0x7e, 0x70, 0xd3, 0x9f, 0xf6, 0xef, 0x14, 0xe0, 0x4c,
-	0x9f, 0x32, 0x58, 0xd2, 0x7d, 0x6c, 0xcf, 0x0a, 0xca, 0x3a, 0xdb, 0xa9, 0x81, 0x73, 0xe3, 0x64,
-	0xdb, 0x90, 0xde, 0x0a, 0x8a, 0x4f, 0x74, 0x2b, 0xb0, 0xbf, 0x5a, 0x00, 0xe3, 0x01, 0x4c, 0xf2,
-	0x11, 0xb3, 0xe2, 0xdb, 0xca, 0xab, 0x3a, 0x59, 0x30, 0x57, 0x15, 0xe3, 0x62, 0xd4, 0xfa, 0x15,
-	0x90, 0x67, 0xe7, 0x6b, 0xe1, 0xe8, 0xf9, 0x4a, 0x9a, 0x49, 0x69, 0x7d, 0x31, 0xff, 0xd2, 0xfa,
-	0x72, 0x4f, 0x59, 0xfd, 0x2f, 0x58, 0x62, 0xa6, 0x65, 0xba, 0xa4, 0x2d, 0xac, 0xf5, 0x10, 0x0b,
-	0xfb, 0x36, 0x28, 0x45, 0xb4, 0x59, 0x63, 0x9e, 0x9d, 0xb4, 0xc4, 0xfa, 0xbd, 0x6d, 0x09, 0x47,
-	0x45, 0xc1, 0xef, 0xce, 0x36, 0x9b, 0xc1, 0xbd, 0xcb, 0xad, 0x76, 0xdc, 0x95, 0x36, 0x59, 0xdf,
-	0x9d, 0x55, 0x18, 0x34, 0xa8, 0xec, 0x7f, 0xb7, 0xc4, 0xe7, 0x94, 0x3e, 0xfa, 0x8b, 0x99, 0x3b,
-	0x8d, 0xc7, 0x77, 0x6f, 0x7f, 0x06, 0xc0, 0x55, 0x6f, 0x12, 0xe4, 0xf3, 0x2e, 0xa6, 0x7e, 0xe3,
-	0xc0, 0x7c, 0xac, 0x31, 0x81, 0xa1, 0x21, 0x2f, 0xb5, 0x78, 0x8a, 0x47, 0x2d, 0x1e, 0xfb, 0x5f,
-	0x2c, 0x48, 0x6d, 0x16, 0xa4, 0x0d, 0xa3, 0x4c, 0x83, 0x6e, 0x3e, 0x2f, 0x28, 0x98, 0xac, 0xd9,
-	0xc2, 0x92, 0xd3, 0x82, 0xff, 0x89, 0x42, 0x10, 0x69, 0x4a, 0xef, 0xbc, 0x90, 0xc7, 0x2b, 0x1f,
-	0xa6, 0x40, 0xe6, 0xdf, 0xcb, 0x7f, 0x88, 0xa1, 0x3c, 0x7d, 0xfb, 0x45, 0x98, 0xe9, 0x51, 0x8a,
-	0xdf, 0x48, 0x0a, 0x92, 0x67, 0x23, 0x8c, 0x19, 0xc8, 0xef, 0x47, 0xa2, 0xc0, 0x31, 0x07, 0xff,
-	0x74, 0x96, 0x3d, 0xf9, 0x92, 0x05, 0x33, 0x51, 0x96, 0xdf, 0xe3, 0x1a, 0x3b, 0x95, 0xb9, 0xea,
-	0x41, 0x61, 0xaf, 0x12, 0xf6, 0x7f, 0x49, 0xf3, 0x24, 0xfe, 0x81, 0x98, 0xda, 0x5c, 0xac, 0x81,
-	0x9b, 0x0b, 0x5b, 0x62, 0x6e, 0x83, 0x56, 0x3b, 0xcd, 0x9e, 0xda, 0x9c, 0x6d, 0x09, 0x47, 0x45,
-	0x91, 0x7a, 0x1f, 0xaf, 0x78, 0xe4, 0xfb, 0x78, 0x2f, 0xc0, 0xa4, 0xf9, 0x34, 0x0a, 0x4f, 0xa1,
-	0xc9, 0xc3, 0x07, 0xf3, 0x15, 0x15, 0x4c, 0x51, 0x65, 0xde, 0x57, 0x1b, 0x3d, 0xf2, 0x7d, 0xb5,
-	0xe7, 0xa0, 0x24, 0xdf, 0x0a, 0x4b, 0xf2, 0xbb, 0xa2, 0xf0, 0x47, 0xc2, 0x50, 0x61, 0x99, 0x81,
-	0x68, 0x39, 0x7e, 0xc7, 0x69, 0xb2, 0x11, 0x92, 0xf5, 0x80, 0x6a, 0x65, 0xad, 0x2b, 0x0c, 0x1a,
-	0x54, 0xac, 0xc7, 0xb1, 0xd7, 0xa2, 0xaf, 0x04, 0x7e, 0x92, 0x19, 0x51, 0x3d, 0xde, 0x91, 0x70,
-	0x54, 0x14, 0xf6, 0x3f, 0x58, 0x90, 0x7d, 0xe8, 0x28, 0x55, 0x83, 0x68, 0x1d, 0x59, 0x83, 0x98,
-	0xae, 0xaf, 0x2a, 0x1c, 0xab, 0xbe, 0xca, 0x2c, 0x7d, 0x2a, 0x3e, 0xb4, 0xf4, 0xe9, 0x4d, 0xfa,
-	0x5e, 0xbb, 0xa8, 0x91, 0x9a, 0xe8, 0x77, 0xa7, 0x9d, 0xd8, 0x30, 0xe6, 0x3a, 0xaa, 0xc4, 0x7b,
-	0x52, 0xb8, 0x55, 0xcb, 0x4b, 0x9c, 0x48, 0x62, 0x2a, 0xbb, 0x5f, 0xff, 0xee, 0x85, 0xa7, 0xbe,
-	0xf1, 0xdd, 0x0b, 0x4f, 0x7d, 0xeb, 0xbb, 0x17, 0x9e, 0xfa, 0xd8, 0xfd, 0x0b, 0xd6, 0xd7, 0xef,
-	0x5f, 0xb0, 0xbe, 0x71, 0xff, 0x82, 0xf5, 0xad, 0xfb, 0x17, 0xac, 0xef, 0xdc, 0xbf, 0x60, 0x7d,
-	0xfe, 0x6f, 0x2f, 0x3c, 0xf5, 0xca, 0xbb, 0x87, 0xf9, 0x8f, 0xb5, 0xff, 0x1d, 0x00, 0x00, 0xff,
-	0xff, 0xd1, 0x9f, 0xce, 0xf7, 0xf0, 0x76, 0x00, 0x00,
+	// 7200 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x6c, 0x24, 0xd9,
+	0x55, 0xf0, 0x56, 0xb7, 0xdb, 0xee, 0x3e, 0xfe, 0x19, 0xfb, 0xce, 0xcf, 0x3a, 0xfe, 0x36, 0xe3,
+	0x51, 0xed, 0x97, 0x64, 0xbf, 0x64, 0x63, 0x7f, 0x3b, 0xda, 0xe4, 0x9b, 0x2f, 0x1b, 0x36, 0xb8,
+	0xed, 0xf9, 0xf1, 0x8c, 0x67, 0xec, 0x3d, 0xf6, 0xcc, 0x90, 0x4d, 0x08, 0x5b, 0xae, 0xbe, 0xee,
+	0xae, 0x71, 0x77, 0x55, 0x6f, 0x55, 0xb5, 0xc7, 0x9d, 0xff, 0x48, 0x81, 0xac, 0x94, 0x5f, 0x65,
+	0x79, 0x48, 0x10, 0x82, 0xf0, 0x2b, 0xf1, 0x10, 0x21, 0x9e, 0x00, 0x21, 0x1e, 0x08, 0x12, 0x0a,
+	0xf0, 0x40, 0x1e, 0x22, 0x12, 0x88, 0x30, 0xc9, 0x40, 0x14, 0x40, 0x02, 0x84, 0xe0, 0x85, 0x51,
+	0x1e, 0xd0, 0xfd, 0xa9, 0x7b, 0x6f, 0x55, 0x77, 0x8f, 0xed, 0xe9, 0x9a, 0x21, 0x8a, 0x78, 0xeb,
+	0x3a, 0xe7, 0xdc, 0x73, 0xee, 0xef, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x6d, 0x58, 0xab, 0x7b, 0x71,
+	0xa3, 0xb3, 0xbd, 0xe0, 0x06, 0xad, 0x45, 0x27, 0xac, 0x07, 0xed, 0x30, 0xb8, 0xc3, 0x7f, 0xbc,
+	0xdd, 0xad, 0x2d, 0xee, 0x9d, 0x5f, 0x6c, 0xef, 0xd6, 0x17, 0x9d, 0xb6, 0x17, 0x2d, 0x3a, 0xed,
+	0x76, 0xd3, 0x73, 0x9d, 0xd8, 0x0b, 0xfc, 0xc5, 0xbd, 0xe7, 0x9c, 0x66, 0xbb, 0xe1, 0x3c, 0xb7,
+	0x58, 0xa7, 0x3e, 0x0d, 0x9d, 0x98, 0xd6, 0x16, 0xda, 0x61, 0x10, 0x07, 0xe4, 0xdd, 0x9a, 0xdb,
+	0x42, 0xc2, 0x8d, 0xff, 0xf8, 0x19, 0xb7, 0xb6, 0xb0, 0x77, 0x7e, 0xa1, 0xbd, 0x5b, 0x5f, 0x60,
+	0xdc, 0x16, 0x0c, 0x6e, 0x0b, 0x09, 0xb7, 0xb9, 0xb7, 0x1b, 0x75, 0xa9, 0x07, 0xf5, 0x60, 0x91,
+	0x33, 0xdd, 0xee, 0xec, 0xf0, 0x2f, 0xfe, 0xc1, 0x7f, 0x09, 0x61, 0x73, 0xf6, 0xee, 0x85, 0x68,
+	0xc1, 0x0b, 0x58, 0xf5, 0x16, 0xdd, 0x20, 0xa4, 0x8b, 0x7b, 0x3d, 0x15, 0x9a, 0x7b, 0x5e, 0xd3,
+	0xb4, 0x1c, 0xb7, 0xe1, 0xf9, 0x34, 0xec, 0xea, 0x36, 0xb5, 0x68, 0xec, 0xf4, 0x2b, 0xb5, 0x38,
+	0xa8, 0x54, 0xd8, 0xf1, 0x63, 0xaf, 0x45, 0x7b, 0x0a, 0xbc, 0xf3, 0xb0, 0x02, 0x91, 0xdb, 0xa0,
+	0x2d, 0x27, 0x5b, 0xce, 0x7e, 0x15, 0x26, 0x97, 0x6e, 0x6f, 0x2e, 0x75, 0xe2, 0xc6, 0x72, 0xe0,
+	0xef, 0x78, 0x75, 0xf2, 0x0e, 0x18, 0x77, 0x9b, 0x9d, 0x28, 0xa6, 0xe1, 0x0d, 0xa7, 0x45, 0x67,
+	0xad, 0x73, 0xd6, 0x33, 0x95, 0xea, 0xc9, 0xaf, 0x1f, 0xcc, 0x3f, 0x71, 0xef, 0x60, 0x7e, 0x7c,
+	0x59, 0xa3, 0xd0, 0xa4, 0x23, 0xff, 0x07, 0xc6, 0xc2, 0xa0, 0x49, 0x97, 0xf0, 0xc6, 0x6c, 0x81,
+	0x17, 0x39, 0x21, 0x8b, 0x8c, 0xa1, 0x00, 0x63, 0x82, 0xb7, 0xff, 0xb2, 0x00, 0xb0, 0xd4, 0x6e,
+	0x6f, 0x84, 0xc1, 0x1d, 0xea, 0xc6, 0xe4, 0x15, 0x28, 0xb3, 0x5e, 0xa8, 0x39, 0xb1, 0xc3, 0xa5,
+	0x8d, 0x9f, 0xff, 0xbf, 0x0b, 0xa2, 0x31, 0x0b, 0x66, 0x63, 0xf4, 0xc8, 0x31, 0xea, 0x85, 0xbd,
+	0xe7, 0x16, 0xd6, 0xb7, 0x59, 0xf9, 0xeb, 0x34, 0x76, 0xaa, 0x44, 0x0a, 0x03, 0x0d, 0x43, 0xc5,
+	0x95, 0xf8, 0x30, 0x12, 0xb5, 0xa9, 0xcb, 0x2b, 0x36, 0x7e, 0x7e, 0x6d, 0x61, 0x98, 0x29, 0xb2,
+	0xa0, 0x6b, 0xbe, 0xd9, 0xa6, 0x6e, 0x75, 0x42, 0x4a, 0x1e, 0x61, 0x5f, 0xc8, 0xe5, 0x90, 0x3d,
+	0x18, 0x8d, 0x62, 0x27, 0xee, 0x44, 0xb3, 0x45, 0x2e, 0xf1, 0x46, 0x6e, 0x12, 0x39, 0xd7, 0xea,
+	0x94, 0x94, 0x39, 0x2a, 0xbe, 0x51, 0x4a, 0xb3, 0xff, 0xc6, 0x82, 0x29, 0x4d, 0xbc, 0xe6, 0x45,
+	0x31, 0x79, 0x7f, 0x4f, 0xe7, 0x2e, 0x1c, 0xad, 0x73, 0x59, 0x69, 0xde, 0xb5, 0xd3, 0x52, 0x58,
+	0x39, 0x81, 0x18, 0x1d, 0xdb, 0x82, 0x92, 0x17, 0xd3, 0x56, 0x34, 0x5b, 0x38, 0x57, 0x7c, 0x66,
+	0xfc, 0xfc, 0x95, 0xbc, 0xda, 0x59, 0x9d, 0x94, 0x42, 0x4b, 0xab, 0x8c, 0x3d, 0x0a, 0x29, 0xf6,
+	0xeb, 0x93, 0x66, 0xfb, 0x58, 0x87, 0x93, 0xe7, 0x60, 0x3c, 0x0a, 0x3a, 0xa1, 0x4b, 0x91, 0xb6,
+	0x83, 0x68, 0xd6, 0x3a, 0x57, 0x64, 0x53, 0x8f, 0xcd, 0xd4, 0x4d, 0x0d, 0x46, 0x93, 0x86, 0x7c,
+	0xce, 0x82, 0x89, 0x1a, 0x8d, 0x62, 0xcf, 0xe7, 0xf2, 0x93, 0xca, 0x6f, 0x0d, 0x5d, 0xf9, 0x04,
+	0xb8, 0xa2, 0x99, 0x57, 0x4f, 0xc9, 0x86, 0x4c, 0x18, 0xc0, 0x08, 0x53, 0xf2, 0xd9, 0x8a, 0xab,
+	0xd1, 0xc8, 0x0d, 0xbd, 0x36, 0xfb, 0xe6, 0x73, 0xc6, 0x58, 0x71, 0x2b, 0x1a, 0x85, 0x26, 0x1d,
+	0xf1, 0xa1, 0xc4, 0x56, 0x54, 0x34, 0x3b, 0xc2, 0xeb, 0xbf, 0x3a, 0x5c, 0xfd, 0x65, 0xa7, 0xb2,
+	0xc5, 0xaa, 0x7b, 0x9f, 0x7d, 0x45, 0x28, 0xc4, 0x90, 0xcf, 0x5a, 0x30, 0x2b, 0x57, 0x3c, 0x52,
+	0xd1, 0xa1, 0xb7, 0x1b, 0x5e, 0x4c, 0x9b, 0x5e, 0x14, 0xcf, 0x96, 0x78, 0x1d, 0x16, 0x8f, 0x36,
+	0xb7, 0x2e, 0x87, 0x41, 0xa7, 0x7d, 0xcd, 0xf3, 0x6b, 0xd5, 0x73, 0x52, 0xd2, 0xec, 0xf2, 0x00,
+	0xc6, 0x38, 0x50, 0x24, 0x79, 0xdd, 0x82, 0x39, 0xdf, 0x69, 0xd1, 0xa8, 0xed, 0xb0, 0xa1, 0x15,
+	0xe8, 0x6a, 0xd3, 0x71, 0x77, 0x79, 0x8d, 0x46, 0x1f, 0xae, 0x46, 0xb6, 0xac, 0xd1, 0xdc, 0x8d,
+	0x81, 0xac, 0xf1, 0x01, 0x62, 0xc9, 0xaf, 0x59, 0x30, 0x13, 0x84, 0xed, 0x86, 0xe3, 0xd3, 0x5a,
+	0x82, 0x8d, 0x66, 0xc7, 0xf8, 0xd2, 0xfb, 0xc0, 0x70, 0x43, 0xb4, 0x9e, 0x65, 0x7b, 0x3d, 0xf0,
+	0xbd, 0x38, 0x08, 0x37, 0x69, 0x1c, 0x7b, 0x7e, 0x3d, 0xaa, 0x9e, 0xbe, 0x77, 0x30, 0x3f, 0xd3,
+	0x43, 0x85, 0xbd, 0xf5, 0x21, 0x1f, 0x82, 0xf1, 0xa8, 0xeb, 0xbb, 0xb7, 0x3d, 0xbf, 0x16, 0xdc,
+	0x8d, 0x66, 0xcb, 0x79, 0x2c, 0xdf, 0x4d, 0xc5, 0x50, 0x2e, 0x40, 0x2d, 0x00, 0x4d, 0x69, 0xfd,
+	0x07, 0x4e, 0x4f, 0xa5, 0x4a, 0xde, 0x03, 0xa7, 0x27, 0xd3, 0x03, 0xc4, 0x92, 0x4f, 0x59, 0x30,
+	0x19, 0x79, 0x75, 0xdf, 0x89, 0x3b, 0x21, 0xbd, 0x46, 0xbb, 0xd1, 0x2c, 0xf0, 0x8a, 0x5c, 0x1d,
+	0xb2, 0x57, 0x0c, 0x96, 0xd5, 0xd3, 0xb2, 0x8e, 0x93, 0x26, 0x34, 0xc2, 0xb4, 0xdc, 0x7e, 0x0b,
+	0x4d, 0x4f, 0xeb, 0xf1, 0x7c, 0x17, 0x9a, 0x9e, 0xd4, 0x03, 0x45, 0x92, 0x2d, 0x38, 0xa1, 0x2a,
+	0xb8, 0x11, 0x34, 0x3d, 0xb7, 0x3b, 0x3b, 0xc1, 0x75, 0xd4, 0x5b, 0x25, 0xd3, 0x13, 0x9b, 0x69,
+	0xf4, 0xfd, 0x5e, 0x10, 0x66, 0x59, 0x90, 0x3f, 0xb1, 0x60, 0xce, 0x50, 0x83, 0x9b, 0x34, 0xdc,
+	0xf3, 0x5c, 0xba, 0xe4, 0xba, 0x41, 0xc7, 0x8f, 0xa3, 0xd9, 0x49, 0xde, 0xce, 0xed, 0x47, 0xa1,
+	0x94, 0xd3, 0xa2, 0xf4, 0xc4, 0x19, 0x48, 0x12, 0xe1, 0x03, 0x6a, 0x6a, 0xff, 0x69, 0x01, 0xa6,
+	0xb3, 0x5b, 0x34, 0xf9, 0x4d, 0x0b, 0x4e, 0xdc, 0xb9, 0x1b, 0x6f, 0x05, 0xbb, 0xd4, 0x8f, 0xaa,
+	0x5d, 0xa6, 0x48, 0xf9, 0xe6, 0x34, 0x7e, 0xde, 0xcd, 0xd7, 0x18, 0x58, 0xb8, 0x9a, 0x96, 0x72,
+	0xd1, 0x8f, 0xc3, 0x6e, 0xf5, 0xc9, 0x64, 0x64, 0xae, 0xde, 0xde, 0x32, 0xb1, 0x98, 0xad, 0xd4,
+	0xdc, 0xa7, 0x2d, 0x38, 0xd5, 0x8f, 0x05, 0x99, 0x86, 0xe2, 0x2e, 0xed, 0x0a, 0xfb, 0x0f, 0xd9,
+	0x4f, 0xf2, 0xd3, 0x50, 0xda, 0x73, 0x9a, 0x1d, 0x2a, 0xed, 0xa8, 0xcb, 0xc3, 0x35, 0x44, 0xd5,
+	0x0c, 0x05, 0xd7, 0x77, 0x15, 0x2e, 0x58, 0xf6, 0x5f, 0x14, 0x61, 0xdc, 0x18, 0xb4, 0xc7, 0x60,
+	0x1b, 0x06, 0x29, 0xdb, 0xf0, 0x7a, 0x6e, 0xf3, 0x6d, 0xa0, 0x71, 0x78, 0x37, 0x63, 0x1c, 0xae,
+	0xe7, 0x27, 0xf2, 0x81, 0xd6, 0x21, 0x89, 0xa1, 0x12, 0xb4, 0x99, 0xed, 0xcf, 0x8c, 0x8c, 0x91,
+	0x3c, 0x86, 0x70, 0x3d, 0x61, 0x57, 0x9d, 0xbc, 0x77, 0x30, 0x5f, 0x51, 0x9f, 0xa8, 0x05, 0xd9,
+	0xdf, 0xb2, 0xe0, 0x94, 0x51, 0xc7, 0xe5, 0xc0, 0xaf, 0x79, 0x7c, 0x68, 0xcf, 0xc1, 0x48, 0xdc,
+	0x6d, 0x27, 0x0e, 0x86, 0xea, 0xa9, 0xad, 0x6e, 0x9b, 0x22, 0xc7, 0x30, 0x97, 0xa2, 0x45, 0xa3,
+	0xc8, 0xa9, 0xd3, 0xac, 0x4b, 0x71, 0x5d, 0x80, 0x31, 0xc1, 0x93, 0x10, 0x48, 0xd3, 0x89, 0xe2,
+	0xad, 0xd0, 0xf1, 0x23, 0xce, 0x7e, 0xcb, 0x6b, 0x51, 0xd9, 0xc1, 0x6f, 0x3d, 0xda, 0x8c, 0x61,
+	0x25, 0xaa, 0x67, 0xee, 0x1d, 0xcc, 0x93, 0xb5, 0x1e, 0x4e, 0xd8, 0x87, 0xbb, 0xfd, 0xba, 0x05,
+	0x67, 0xfa, 0x2b, 0x18, 0xf2, 0x66, 0x18, 0x8d, 0x68, 0xb8, 0x47, 0x43, 0xd9, 0x3a, 0x3d, 0x24,
+	0x1c, 0x8a, 0x12, 0x4b, 0x16, 0xa1, 0xa2, 0x76, 0x24, 0xd9, 0xc6, 0x19, 0x49, 0x5a, 0xd1, 0xdb,
+	0x98, 0xa6, 0x61, 0x9d, 0xc6, 0x3e, 0xa4, 0x8d, 0xa8, 0x3a, 0x8d, 0xbb, 0x63, 0x1c, 0x63, 0x7f,
+	0xd3, 0x82, 0xff, 0x7d, 0x14, 0xb5, 0xf7, 0xe8, 0xea, 0xb8, 0x09, 0xa7, 0x6b, 0x74, 0xc7, 0xe9,
+	0x34, 0xe3, 0xb4, 0x44, 0x59, 0xe9, 0x37, 0xca, 0xc2, 0xa7, 0x57, 0xfa, 0x11, 0x61, 0xff, 0xb2,
+	0xf6, 0xdf, 0x5a, 0x70, 0xc2, 0x68, 0xd6, 0x63, 0xf0, 0x6d, 0xfc, 0xb4, 0x6f, 0xb3, 0x9a, 0xdb,
+	0x32, 0x1d, 0xe0, 0xdc, 0xfc, 0x71, 0x09, 0x66, 0xcc, 0xc5, 0xcc, 0x37, 0x61, 0xee, 0x56, 0xd3,
+	0x76, 0x70, 0x13, 0xd7, 0xe4, 0x30, 0x69, 0xb7, 0x5a, 0x80, 0x31, 0xc1, 0xb3, 0xb9, 0xd1, 0x76,
+	0xe2, 0x86, 0x1c, 0x23, 0x35, 0x37, 0x36, 0x9c, 0xb8, 0x81, 0x1c, 0x43, 0x5e, 0x84, 0xa9, 0xd8,
+	0x09, 0xeb, 0x34, 0x46, 0xba, 0xe7, 0x45, 0x89, 0x1a, 0xa8, 0x54, 0xcf, 0x48, 0xda, 0xa9, 0xad,
+	0x14, 0x16, 0x33, 0xd4, 0xe4, 0x55, 0x18, 0x69, 0xd0, 0x66, 0x4b, 0x5a, 0xb3, 0x9b, 0xf9, 0x29,
+	0x2e, 0xde, 0xd6, 0x2b, 0xb4, 0xd9, 0xaa, 0x96, 0x59, 0x95, 0xd9, 0x2f, 0xe4, 0xa2, 0xc8, 0xcf,
+	0x5a, 0x50, 0xd9, 0xed, 0x44, 0x71, 0xd0, 0xf2, 0x3e, 0x48, 0x67, 0xcb, 0x5c, 0xf0, 0x4f, 0xe5,
+	0x2c, 0xf8, 0x5a, 0xc2, 0x5f, 0xa8, 0x31, 0xf5, 0x89, 0x5a, 0x32, 0xaf, 0x47, 0xcd, 0x0b, 0xa9,
+	0x1b, 0x07, 0x61, 0x77, 0x16, 0x1e, 0x49, 0x3d, 0x56, 0x12, 0xfe, 0xa2, 0x1e, 0xea, 0x13, 0xb5,
+	0x64, 0xd2, 0x85, 0xd1, 0x76, 0xb3, 0x53, 0xf7, 0xfc, 0xd9, 0x71, 0x5e, 0x87, 0x9b, 0x39, 0xd7,
+	0x61, 0x83, 0x33, 0xaf, 0x02, 0x53, 0x04, 0xe2, 0x37, 0x4a, 0x81, 0xe4, 0x69, 0x28, 0xb9, 0x0d,
+	0x27, 0x8c, 0xa5, 0xf1, 0xa7, 0x66, 0xf1, 0x32, 0x03, 0xa2, 0xc0, 0xd9, 0xbf, 0x52, 0x80, 0xb9,
+	0xc1, 0x0d, 0x13, 0xd3, 0xd9, 0xed, 0x84, 0x91, 0xd0, 0xfb, 0x65, 0x73, 0x3a, 0x73, 0x30, 0x26,
+	0x78, 0xf2, 0x09, 0x0b, 0xc6, 0xee, 0x44, 0x81, 0xef, 0xd3, 0x58, 0x6e, 0xce, 0xb7, 0x72, 0x6e,
+	0xeb, 0x55, 0xc1, 0x5d, 0xd7, 0x41, 0x02, 0x30, 0x91, 0xcb, 0xaa, 0x4b, 0xf7, 0xdd, 0x66, 0xa7,
+	0x96, 0x68, 0x5c, 0x45, 0x7a, 0x51, 0x80, 0x31, 0xc1, 0x33, 0x52, 0xcf, 0x17, 0xa4, 0x23, 0x69,
+	0xd2, 0x55, 0x5f, 0x92, 0x4a, 0xbc, 0xfd, 0xc3, 0x12, 0x9c, 0xee, 0x3b, 0xfb, 0xc9, 0x02, 0x00,
+	0xb7, 0x85, 0x2e, 0x79, 0xcc, 0xaf, 0x17, 0xc1, 0x8c, 0x29, 0x66, 0xba, 0xdc, 0x52, 0x50, 0x34,
+	0x28, 0xc8, 0xc7, 0x00, 0xda, 0x4e, 0xe8, 0xb4, 0x68, 0x4c, 0xc3, 0x44, 0x51, 0x5d, 0x1b, 0xae,
+	0x97, 0x58, 0x3d, 0x36, 0x12, 0x9e, 0xda, 0x76, 0x52, 0xa0, 0x08, 0x0d, 0x91, 0xe4, 0x1d, 0x30,
+	0x1e, 0xd2, 0x26, 0x75, 0x22, 0x7a, 0x43, 0x6f, 0x4b, 0x2a, 0x74, 0x81, 0x1a, 0x85, 0x26, 0x1d,
+	0xdb, 0x7b, 0x78, 0x2b, 0x22, 0xd9, 0x57, 0x6a, 0xef, 0xe1, 0xed, 0x8c, 0x50, 0x62, 0xc9, 0xe7,
+	0x2d, 0x98, 0xda, 0xf1, 0x9a, 0x54, 0x4b, 0x97, 0x81, 0x86, 0xf5, 0xe1, 0x1b, 0x79, 0xc9, 0xe4,
+	0xab, 0x55, 0x60, 0x0a, 0x1c, 0x61, 0x46, 0x3c, 0x1b, 0xe6, 0x3d, 0x1a, 0x72, 0xdd, 0x39, 0x9a,
+	0x1e, 0xe6, 0x5b, 0x02, 0x8c, 0x09, 0x9e, 0x2c, 0xc1, 0x89, 0xb6, 0x13, 0x45, 0xcb, 0x21, 0xad,
+	0x51, 0x3f, 0xf6, 0x9c, 0xa6, 0x08, 0x03, 0x94, 0xb5, 0x71, 0xbe, 0x91, 0x46, 0x63, 0x96, 0x9e,
+	0xbc, 0x17, 0x9e, 0xf4, 0xea, 0x7e, 0x10, 0xd2, 0xeb, 0x5e, 0x14, 0x79, 0x7e, 0x5d, 0x4f, 0x03,
+	0xae, 0x0a, 0xcb, 0xd5, 0x79, 0xc9, 0xea, 0xc9, 0xd5, 0xfe, 0x64, 0x38, 0xa8, 0x3c, 0x79, 0x16,
+	0xca, 0xd1, 0xae, 0xd7, 0x5e, 0x0e, 0x6b, 0xd1, 0x6c, 0x85, 0xf3, 0x52, 0x9b, 0xe1, 0xa6, 0x84,
+	0xa3, 0xa2, 0x20, 0x57, 0x81, 0xb4, 0x3d, 0xdf, 0xa7, 0x35, 0xbe, 0xd8, 0x65, 0x53, 0xb9, 0x1a,
+	0xac, 0x54, 0xe7, 0x64, 0x39, 0xb2, 0xd1, 0x43, 0x81, 0x7d, 0x4a, 0xd9, 0x5f, 0x2e, 0xc0, 0xec,
+	0xa0, 0xb5, 0x48, 0x22, 0xb6, 0xe2, 0xe2, 0x5b, 0x4e, 0x18, 0x49, 0x77, 0x69, 0xc8, 0xa0, 0x84,
+	0xe4, 0x7b, 0xcb, 0x09, 0xcd, 0xb5, 0xcb, 0x05, 0x60, 0x22, 0x89, 0xdc, 0x81, 0x91, 0xb8, 0xe9,
+	0xe4, 0x14, 0xc5, 0x34, 0x24, 0x6a, 0xa3, 0x76, 0x6d, 0x29, 0x42, 0x2e, 0x83, 0x3c, 0x05, 0x23,
+	0x4d, 0x6f, 0x9b, 0x19, 0xff, 0x6c, 0x71, 0xf3, 0xed, 0x6e, 0xcd, 0xdb, 0x8e, 0x90, 0x43, 0xed,
+	0x7f, 0x1d, 0xed, 0xa3, 0x3e, 0xd5, 0x86, 0x44, 0xce, 0x03, 0x30, 0x3b, 0x6b, 0x23, 0xa4, 0x3b,
+	0xde, 0xbe, 0x34, 0x08, 0xd4, 0x12, 0xbd, 0xa1, 0x30, 0x68, 0x50, 0x25, 0x65, 0x36, 0x3b, 0x3b,
+	0xac, 0x4c, 0xa1, 0xb7, 0x8c, 0xc0, 0xa0, 0x41, 0x45, 0x9e, 0x87, 0x51, 0xaf, 0xe5, 0xd4, 0x69,
+	0x52, 0xcd, 0xa7, 0xd8, 0xda, 0x5c, 0xe5, 0x90, 0xfb, 0x07, 0xf3, 0x53, 0xaa, 0x42, 0x1c, 0x84,
+	0x92, 0x96, 0xfc, 0xba, 0x05, 0x13, 0x6e, 0xd0, 0x6a, 0x05, 0xfe, 0x9a, 0xb3, 0x4d, 0x9b, 0x49,
+	0x60, 0xf2, 0xce, 0xa3, 0xda, 0xae, 0x17, 0x96, 0x0d, 0x61, 0xc2, 0xef, 0x55, 0xe1, 0x56, 0x13,
+	0x85, 0xa9, 0x5a, 0x99, 0x4b, 0xb8, 0x74, 0xc8, 0x12, 0xfe, 0x3d, 0x0b, 0x66, 0x44, 0xd9, 0x25,
+	0xdf, 0x0f, 0x62, 0x19, 0x2f, 0x16, 0x91, 0xc5, 0xe0, 0x11, 0x37, 0xcb, 0x90, 0x28, 0xda, 0xf6,
+	0x06, 0x59, 0xcd, 0x99, 0x1e, 0x3c, 0xf6, 0x56, 0x92, 0x5c, 0x86, 0x99, 0x9d, 0x20, 0x74, 0xa9,
+	0xd9, 0x11, 0x52, 0xff, 0x28, 0x46, 0x97, 0xb2, 0x04, 0xd8, 0x5b, 0x86, 0xdc, 0x82, 0x33, 0x06,
+	0xd0, 0xec, 0x07, 0xa1, 0x82, 0xce, 0x4a, 0x6e, 0x67, 0x2e, 0xf5, 0xa5, 0xc2, 0x01, 0xa5, 0xe7,
+	0xde, 0x03, 0x33, 0x3d, 0xe3, 0xd7, 0x27, 0xe8, 0x70, 0xca, 0x0c, 0x3a, 0x54, 0x8c, 0x58, 0xc1,
+	0xdc, 0x0a, 0x9c, 0xe9, 0xdf, 0x53, 0xc7, 0xe1, 0x62, 0xff, 0x92, 0x05, 0x4f, 0x0e, 0xb0, 0x82,
+	0x94, 0xb7, 0x65, 0x0d, 0xf2, 0xb6, 0x88, 0x03, 0x45, 0xea, 0xef, 0x49, 0xc5, 0x71, 0x69, 0xb8,
+	0x19, 0x71, 0xd1, 0xdf, 0x13, 0x03, 0x3d, 0x76, 0xef, 0x60, 0xbe, 0x78, 0xd1, 0xdf, 0x43, 0xc6,
+	0xdb, 0xfe, 0xf9, 0xd1, 0x94, 0xe7, 0xb3, 0x99, 0xc4, 0x10, 0x78, 0x45, 0xa5, 0xdf, 0xb3, 0x9e,
+	0xf3, 0x5c, 0x34, 0x9c, 0x41, 0x71, 0x70, 0x22, 0xc5, 0x91, 0x4f, 0x5b, 0xfc, 0xac, 0x22, 0x71,
+	0x29, 0xa5, 0x61, 0xf6, 0x68, 0x8e, 0x4e, 0xcc, 0x13, 0x90, 0x04, 0x88, 0xa6, 0x74, 0xb6, 0x92,
+	0xdb, 0x22, 0x16, 0x96, 0x35, 0xcf, 0x92, 0xd3, 0x8c, 0x04, 0x4f, 0xf6, 0x01, 0xa2, 0xae, 0xef,
+	0xca, 0xf0, 0xa5, 0x88, 0x7e, 0xe4, 0x10, 0xef, 0x16, 0xfc, 0x84, 0x8d, 0xa6, 0xbf, 0xd1, 0x90,
+	0x45, 0xbe, 0x62, 0xc1, 0x8c, 0xd8, 0x84, 0x57, 0xbc, 0x9d, 0x1d, 0x1a, 0x52, 0xdf, 0xa5, 0x89,
+	0x19, 0x73, 0x7b, 0xb8, 0x1a, 0x24, 0xa1, 0xda, 0xd5, 0x2c, 0x7b, 0xbd, 0xc4, 0x7b, 0x50, 0xd8,
+	0x5b, 0x19, 0x52, 0x83, 0x11, 0xcf, 0xdf, 0x09, 0xa4, 0x62, 0xab, 0x0e, 0x57, 0xa9, 0x55, 0x7f,
+	0x27, 0xd0, 0x6b, 0x85, 0x7d, 0x21, 0xe7, 0x4e, 0xd6, 0xe0, 0x54, 0x28, 0x3d, 0xc9, 0x2b, 0x5e,
+	0xc4, 0xdc, 0x81, 0x35, 0xaf, 0xe5, 0xc5, 0x5c, 0x29, 0x15, 0xab, 0xb3, 0xf7, 0x0e, 0xe6, 0x4f,
+	0x61, 0x1f, 0x3c, 0xf6, 0x2d, 0x65, 0xbf, 0x56, 0x49, 0xbb, 0xcb, 0x22, 0xc6, 0xf5, 0x11, 0xa8,
+	0x84, 0xea, 0xd0, 0x45, 0x18, 0x10, 0x6b, 0xf9, 0xf4, 0xb1, 0x0c, 0xae, 0xa9, 0xd0, 0x87, 0x3e,
+	0x5e, 0xd1, 0x12, 0x99, 0x21, 0xc1, 0x46, 0x5e, 0x2e, 0x8b, 0x1c, 0xe6, 0x97, 0x94, 0xaa, 0xe3,
+	0x88, 0x5d, 0xdf, 0x45, 0x2e, 0x83, 0x84, 0x30, 0xda, 0xa0, 0x4e, 0x33, 0x6e, 0xc8, 0x30, 0xd7,
+	0xd5, 0x61, 0x4d, 0x62, 0xc6, 0x2b, 0x1b, 0x42, 0x14, 0x50, 0x94, 0x92, 0xc8, 0x3e, 0x8c, 0x35,
+	0xc4, 0x20, 0xc8, 0xbd, 0xfd, 0xfa, 0xb0, 0x9d, 0x9b, 0x1a, 0x59, 0xbd, 0x7e, 0x25, 0x00, 0x13,
+	0x71, 0xe4, 0xe7, 0x2c, 0x00, 0x37, 0x89, 0x1d, 0x26, 0xcb, 0x07, 0x73, 0xd3, 0x3b, 0x2a, 0x2c,
+	0xa9, 0x4d, 0x23, 0x05, 0x8a, 0xd0, 0x90, 0x4c, 0x5e, 0x81, 0x89, 0x90, 0xba, 0x81, 0xef, 0x7a,
+	0x4d, 0x5a, 0x5b, 0x8a, 0xb9, 0x17, 0x70, 0xbc, 0x18, 0xe3, 0x34, 0xb3, 0x4f, 0xd0, 0xe0, 0x81,
+	0x29, 0x8e, 0xe4, 0x35, 0x0b, 0xa6, 0x54, 0xfc, 0x94, 0x0d, 0x08, 0x95, 0x01, 0x97, 0xb5, 0x9c,
+	0xa2, 0xb5, 0x9c, 0x67, 0x95, 0x30, 0x6f, 0x27, 0x0d, 0xc3, 0x8c, 0x5c, 0xf2, 0x32, 0x40, 0xb0,
+	0xcd, 0xe3, 0x80, 0xac, 0xa9, 0xe5, 0x63, 0x37, 0x75, 0x4a, 0x84, 0xdd, 0x13, 0x0e, 0x68, 0x70,
+	0x23, 0xd7, 0x00, 0xc4, 0xb2, 0xd9, 0xea, 0xb6, 0x29, 0x77, 0x41, 0x2a, 0xd5, 0xb7, 0x25, 0x9d,
+	0xbf, 0xa9, 0x30, 0xf7, 0x0f, 0xe6, 0x7b, 0x9d, 0x65, 0x1e, 0x24, 0x36, 0x8a, 0x93, 0x0f, 0xc1,
+	0x58, 0xd4, 0x69, 0xb5, 0x1c, 0x15, 0x9b, 0xd9, 0xc8, 0x6f, 0x47, 0x14, 0x7c, 0xf5, 0xdc, 0x94,
+	0x00, 0x4c, 0x24, 0xda, 0x3e, 0x90, 0x5e, 0x7a, 0xf2, 0x3c, 0x4c, 0xd0, 0xfd, 0x98, 0x86, 0xbe,
+	0xd3, 0xbc, 0x89, 0x6b, 0x89, 0x37, 0xcf, 0x07, 0xff, 0xa2, 0x01, 0xc7, 0x14, 0x15, 0xb1, 0x95,
+	0xe5, 0x5d, 0xe0, 0xf4, 0xa0, 0x2d, 0xef, 0xc4, 0xce, 0xb6, 0xff, 0xb3, 0x90, 0xb2, 0x08, 0xb6,
+	0x42, 0x4a, 0x49, 0x00, 0x25, 0x3f, 0xa8, 0x29, 0xa5, 0x77, 0x35, 0x1f, 0xa5, 0x77, 0x23, 0xa8,
+	0x19, 0xd9, 0x00, 0xec, 0x2b, 0x42, 0x21, 0x87, 0x1f, 0x97, 0x26, 0xe7, 0xca, 0x1c, 0x21, 0x8d,
+	0xa0, 0x3c, 0x25, 0xab, 0xe3, 0xd2, 0x75, 0x53, 0x10, 0xa6, 0xe5, 0x92, 0x5d, 0x28, 0x35, 0x82,
+	0x28, 0x16, 0xbe, 0xca, 0xd0, 0x56, 0xd8, 0x95, 0x20, 0x8a, 0xf9, 0x16, 0xa6, 0x9a, 0xcd, 0x20,
+	0x11, 0x0a, 0x19, 0xf6, 0x0f, 0xac, 0x54, 0xec, 0xe6, 0xb6, 0x13, 0xbb, 0x8d, 0x8b, 0x7b, 0xd4,
+	0x67, 0xf3, 0xd9, 0x3c, 0xcf, 0xf8, 0x7f, 0xe6, 0x79, 0xc6, 0xfd, 0x83, 0xf9, 0xb7, 0x0c, 0x4a,
+	0xcf, 0xba, 0xcb, 0x38, 0x2c, 0x70, 0x16, 0xc6, 0xd1, 0xc7, 0xc7, 0x2d, 0x18, 0x37, 0xaa, 0x27,
+	0x37, 0x94, 0x1c, 0x63, 0xd0, 0xca, 0xb8, 0x32, 0x80, 0x68, 0x8a, 0xb4, 0xbf, 0x68, 0xc1, 0x58,
+	0xd5, 0x71, 0x77, 0x83, 0x9d, 0x1d, 0xf2, 0x2c, 0x94, 0x6b, 0x1d, 0x79, 0x72, 0x24, 0xda, 0xa7,
+	0x82, 0x05, 0x2b, 0x12, 0x8e, 0x8a, 0x82, 0xcd, 0xe1, 0x1d, 0xc7, 0x8d, 0x83, 0x90, 0x57, 0xbb,
+	0x28, 0xe6, 0xf0, 0x25, 0x0e, 0x41, 0x89, 0x21, 0xef, 0x80, 0xf1, 0x96, 0xb3, 0x9f, 0x14, 0xce,
+	0x06, 0x8e, 0xae, 0x6b, 0x14, 0x9a, 0x74, 0xf6, 0x1f, 0x55, 0x60, 0x4c, 0x9e, 0x60, 0x1f, 0xf9,
+	0x00, 0x23, 0xb1, 0xe2, 0x0b, 0x03, 0xad, 0xf8, 0x08, 0x46, 0x5d, 0x9e, 0xfc, 0x26, 0xb7, 0xd2,
+	0x21, 0x43, 0x68, 0xb2, 0x82, 0x22, 0x9f, 0x4e, 0x57, 0x4b, 0x7c, 0xa3, 0x14, 0x45, 0xbe, 0x60,
+	0xc1, 0x09, 0x37, 0xf0, 0x7d, 0xea, 0x6a, 0x3d, 0x3f, 0x92, 0xc7, 0x21, 0xe4, 0x72, 0x9a, 0xa9,
+	0x0e, 0x37, 0x65, 0x10, 0x98, 0x15, 0x4f, 0x5e, 0x80, 0x49, 0xd1, 0x67, 0xb7, 0x52, 0xfe, 0xb1,
+	0xce, 0x5a, 0x30, 0x91, 0x98, 0xa6, 0x25, 0x0b, 0x22, 0xce, 0xc0, 0xcf, 0x80, 0x84, 0x8f, 0x2c,
+	0x63, 0x97, 0xea, 0x90, 0x28, 0x42, 0x83, 0x82, 0x84, 0x40, 0x42, 0xba, 0x13, 0xd2, 0xa8, 0x81,
+	0xf4, 0xd5, 0x0e, 0x8d, 0x62, 0xbe, 0xc7, 0x8c, 0x3d, 0xdc, 0x91, 0x1d, 0xf6, 0x70, 0xc2, 0x3e,
+	0xdc, 0xc9, 0xae, 0x34, 0x74, 0xcb, 0x79, 0x2c, 0x27, 0x39, 0xcc, 0x03, 0xed, 0xdd, 0x79, 0x28,
+	0x45, 0x0d, 0x27, 0xac, 0xf1, 0xbd, 0xad, 0x58, 0xad, 0x30, 0x5d, 0xb2, 0xc9, 0x00, 0x28, 0xe0,
+	0x64, 0x05, 0xa6, 0x33, 0x39, 0x17, 0x11, 0xdf, 0xbd, 0xca, 0xd5, 0x59, 0xc9, 0x6e, 0x3a, 0x93,
+	0xad, 0x11, 0x61, 0x4f, 0x09, 0xd3, 0x09, 0x1a, 0x3f, 0xc4, 0x09, 0xea, 0xc2, 0x68, 0x53, 0x04,
+	0x02, 0x26, 0xb8, 0xaa, 0x7c, 0x29, 0x97, 0x0e, 0x58, 0x30, 0x03, 0x30, 0x6a, 0xb6, 0xcb, 0x80,
+	0x82, 0x14, 0x48, 0x3e, 0xcb, 0x14, 0x9a, 0x11, 0x3b, 0x10, 0xe9, 0x1d, 0xb7, 0xf2, 0xa9, 0x40,
+	0x4f, 0xa8, 0x44, 0x6b, 0x37, 0x23, 0x10, 0x61, 0xca, 0x9f, 0xfb, 0xff, 0x30, 0xfe, 0xb0, 0x71,
+	0x87, 0x17, 0x61, 0x7a, 0xa8, 0x88, 0xc3, 0x7f, 0x58, 0x90, 0x8c, 0xeb, 0xb2, 0xe3, 0x36, 0x28,
+	0x9b, 0x32, 0xe4, 0x45, 0x98, 0x52, 0x6e, 0xc4, 0x32, 0x3f, 0x2d, 0xb5, 0xf8, 0xac, 0x51, 0x71,
+	0x69, 0x4c, 0x61, 0x31, 0x43, 0x4d, 0x16, 0xa1, 0xc2, 0xfa, 0x49, 0x14, 0x15, 0x6a, 0x57, 0xb9,
+	0x2a, 0x4b, 0x1b, 0xab, 0xb2, 0x94, 0xa6, 0x21, 0x01, 0xcc, 0x34, 0x9d, 0x28, 0xe6, 0x35, 0x60,
+	0x5e, 0xc5, 0x43, 0x1e, 0x98, 0xf3, 0x94, 0xb3, 0xb5, 0x2c, 0x23, 0xec, 0xe5, 0x6d, 0x7f, 0x6b,
+	0x04, 0x26, 0x53, 0x9a, 0x91, 0xed, 0x2a, 0x9d, 0x88, 0x99, 0x3e, 0x2a, 0xc4, 0xa2, 0x76, 0x95,
+	0x9b, 0x12, 0x8e, 0x8a, 0x82, 0x51, 0xb7, 0x9d, 0x28, 0xba, 0x1b, 0x84, 0x35, 0xa9, 0xca, 0x15,
+	0xf5, 0x86, 0x84, 0xa3, 0xa2, 0x60, 0xfb, 0xcb, 0x36, 0x75, 0x42, 0x1a, 0xf2, 0x1c, 0x93, 0xec,
+	0xfe, 0x52, 0xd5, 0x28, 0x34, 0xe9, 0xb8, 0x52, 0x8e, 0x9b, 0xd1, 0x72, 0xd3, 0xa3, 0x7e, 0x2c,
+	0xaa, 0x99, 0x8f, 0x52, 0xde, 0x5a, 0xdb, 0x34, 0x99, 0x6a, 0xa5, 0x9c, 0x41, 0x60, 0x56, 0x3c,
+	0xf9, 0xa4, 0x05, 0x93, 0xce, 0xdd, 0x48, 0x67, 0x68, 0x73, 0xad, 0x3c, 0xf4, 0x26, 0x95, 0x4a,
+	0xfa, 0xae, 0xce, 0x30, 0xf5, 0x9e, 0x02, 0x61, 0x5a, 0x28, 0xf9, 0x92, 0x05, 0x84, 0xee, 0x53,
+	0x77, 0x23, 0x0c, 0xf6, 0xbc, 0x5a, 0x32, 0x86, 0xd2, 0xfd, 0x19, 0xd2, 0xda, 0xbe, 0xd8, 0xc3,
+	0x57, 0x68, 0xf5, 0x5e, 0x38, 0xf6, 0xa9, 0x83, 0xfd, 0xd7, 0x45, 0x18, 0x37, 0x94, 0x71, 0xdf,
+	0x9d, 0xd5, 0xfa, 0x11, 0xdb, 0x59, 0x0b, 0xc7, 0xd8, 0x59, 0x3f, 0x06, 0x15, 0x37, 0x51, 0x14,
+	0xf9, 0x64, 0x94, 0x67, 0xd5, 0x8f, 0xd6, 0x15, 0x0a, 0x84, 0x5a, 0x26, 0xb9, 0x0c, 0x33, 0x06,
+	0x1b, 0xa9, 0x64, 0x46, 0xb8, 0x92, 0x51, 0x81, 0xa6, 0xa5, 0x2c, 0x01, 0xf6, 0x96, 0x21, 0xcf,
+	0x31, 0xab, 0xd6, 0x93, 0xed, 0x12, 0x5e, 0xbc, 0xcc, 0xd6, 0x5e, 0xda, 0x58, 0x4d, 0xc0, 0x68,
+	0xd2, 0xd8, 0xdf, 0xb2, 0xd4, 0xe0, 0x3e, 0x86, 0xa4, 0x8f, 0x3b, 0xe9, 0xa4, 0x8f, 0x8b, 0xb9,
+	0x74, 0xf3, 0x80, 0x84, 0x8f, 0x1b, 0x30, 0xb6, 0x1c, 0xb4, 0x5a, 0x8e, 0x5f, 0x23, 0x6f, 0x82,
+	0x31, 0x57, 0xfc, 0x94, 0x6e, 0xe2, 0x38, 0xdb, 0xbf, 0x25, 0x16, 0x13, 0x1c, 0x79, 0x0a, 0x46,
+	0x9c, 0xb0, 0x9e, 0xb8, 0x86, 0xfc, 0xec, 0x68, 0x29, 0xac, 0x47, 0xc8, 0xa1, 0xf6, 0xeb, 0x05,
+	0x80, 0xe5, 0xa0, 0xd5, 0x76, 0x42, 0x5a, 0xdb, 0x0a, 0xfe, 0x27, 0x46, 0x2c, 0x3c, 0x86, 0xcf,
+	0x58, 0x40, 0x58, 0xaf, 0x04, 0x3e, 0xf5, 0x63, 0x75, 0x90, 0xcb, 0xf6, 0x4b, 0x37, 0x81, 0xca,
+	0xcd, 0x47, 0xaf, 0x81, 0x04, 0x81, 0x9a, 0xe6, 0x08, 0x5e, 0xc4, 0xd3, 0xc9, 0x8e, 0x5f, 0x4c,
+	0xe7, 0x47, 0xf0, 0x43, 0x57, 0x69, 0x00, 0xd8, 0x5f, 0x2b, 0xc0, 0x19, 0xa1, 0xb6, 0xae, 0x3b,
+	0xbe, 0x53, 0xa7, 0x2d, 0x56, 0xab, 0xa3, 0x9e, 0x36, 0xb8, 0xcc, 0x7c, 0xf5, 0x92, 0x74, 0x88,
+	0x61, 0x27, 0xa7, 0x98, 0x54, 0x62, 0x1a, 0xad, 0xfa, 0x5e, 0x8c, 0x9c, 0x39, 0x89, 0xa0, 0x9c,
+	0xdc, 0x11, 0x92, 0xca, 0x26, 0x27, 0x41, 0x6a, 0xdd, 0x5d, 0x96, 0xec, 0x51, 0x09, 0x62, 0x9b,
+	0x7b, 0x33, 0x70, 0x77, 0x91, 0xb6, 0x03, 0xae, 0x58, 0x8c, 0xd3, 0xe8, 0x35, 0x09, 0x47, 0x45,
+	0x61, 0x7f, 0xcd, 0x82, 0xac, 0xca, 0xe5, 0xde, 0xa0, 0x48, 0xab, 0xcc, 0x7a, 0x83, 0xe9, 0x2c,
+	0xc8, 0x63, 0x24, 0x15, 0xbe, 0x1f, 0xc6, 0x9d, 0x38, 0xa6, 0xad, 0xb6, 0x70, 0x4d, 0x8a, 0x0f,
+	0x17, 0xfe, 0xba, 0x1e, 0xd4, 0xbc, 0x1d, 0x8f, 0xbb, 0x24, 0x26, 0x3b, 0xfb, 0x25, 0x28, 0x27,
+	0x27, 0x3e, 0x47, 0x18, 0xfa, 0xa7, 0x53, 0xe6, 0xe4, 0x80, 0xc9, 0x75, 0xbf, 0x00, 0x7d, 0xf6,
+	0x4c, 0xd6, 0x64, 0xad, 0x5d, 0x52, 0x4d, 0x3e, 0x9e, 0x86, 0x21, 0xfb, 0xe2, 0xb4, 0x4b, 0xc4,
+	0x59, 0xde, 0x9b, 0xf7, 0x9e, 0xaf, 0x0f, 0xc0, 0xc6, 0x65, 0xfd, 0xd4, 0x21, 0x18, 0x39, 0x0f,
+	0xa0, 0x37, 0x05, 0x99, 0x34, 0xa2, 0x22, 0xb5, 0x7a, 0xef, 0x40, 0x83, 0x8a, 0x99, 0x80, 0x9e,
+	0x1f, 0xc5, 0x4e, 0xb3, 0x79, 0xc5, 0xf3, 0x63, 0xe9, 0xcb, 0x2a, 0x85, 0xb1, 0xaa, 0x51, 0x68,
+	0xd2, 0xcd, 0xbd, 0xd3, 0x18, 0x97, 0xe3, 0x98, 0xf5, 0x9f, 0x29, 0xc0, 0xd4, 0x65, 0xbf, 0xb3,
+	0x71, 0x79, 0xa3, 0xb3, 0xdd, 0xf4, 0xdc, 0x6b, 0xb4, 0xcb, 0x06, 0x6d, 0x97, 0x76, 0x57, 0x57,
+	0x64, 0xb7, 0xab, 0x41, 0xbb, 0xc6, 0x80, 0x28, 0x70, 0xac, 0x9a, 0x3b, 0x9e, 0x5f, 0xa7, 0x61,
+	0x3b, 0xf4, 0xa4, 0xed, 0x6e, 0x54, 0xf3, 0x92, 0x46, 0xa1, 0x49, 0xc7, 0x78, 0x07, 0x77, 0x7d,
+	0x1a, 0x66, 0xb5, 0xcd, 0x3a, 0x03, 0xa2, 0xc0, 0x31, 0xa2, 0x38, 0xec, 0x44, 0xb1, 0xec, 0x31,
+	0x45, 0xb4, 0xc5, 0x80, 0x28, 0x70, 0x6c, 0x7a, 0x44, 0x9d, 0x6d, 0x1e, 0x85, 0xcd, 0x9c, 0x87,
+	0x6f, 0x0a, 0x30, 0x26, 0x78, 0x46, 0xba, 0x4b, 0xbb, 0x2b, 0x6c, 0xef, 0xcd, 0x64, 0xbf, 0x5c,
+	0x13, 0x60, 0x4c, 0xf0, 0xf6, 0xf7, 0x2d, 0x20, 0xe9, 0xee, 0x78, 0x0c, 0xdb, 0xf7, 0xab, 0xe9,
+	0xed, 0x7b, 0xc8, 0x80, 0x79, 0xba, 0xfa, 0x03, 0x76, 0xf1, 0x5f, 0xb5, 0x60, 0xc2, 0x3c, 0x3b,
+	0x21, 0xf5, 0x8c, 0x22, 0x5a, 0x4f, 0x2b, 0xa2, 0xfb, 0x07, 0xf3, 0x3f, 0xd1, 0xef, 0xc2, 0x6b,
+	0xdd, 0x8b, 0x83, 0x76, 0xf4, 0x76, 0xea, 0xd7, 0x3d, 0x9f, 0xf2, 0xc8, 0xa0, 0x38, 0x73, 0x49,
+	0x1d, 0xcc, 0x2c, 0x07, 0x35, 0xfa, 0x10, 0x9a, 0xcc, 0xbe, 0x0d, 0x33, 0x3d, 0x29, 0x4f, 0x47,
+	0x50, 0x3a, 0x87, 0x66, 0x94, 0xda, 0x08, 0xe3, 0x8c, 0xf1, 0x7a, 0x5b, 0x1c, 0x8e, 0x2c, 0xc3,
+	0x8c, 0xc8, 0xdc, 0x62, 0x92, 0x36, 0xdd, 0x06, 0x6d, 0xa9, 0x34, 0x36, 0xee, 0x28, 0xde, 0xca,
+	0x22, 0xb1, 0x97, 0xde, 0xfe, 0xac, 0x05, 0x93, 0xa9, 0x2c, 0xb4, 0x9c, 0xd4, 0x23, 0x5f, 0x69,
+	0x01, 0x3f, 0xca, 0x0b, 0x3d, 0x5f, 0xc4, 0xfa, 0xca, 0xc6, 0x4a, 0xd3, 0x28, 0x34, 0xe9, 0xec,
+	0x2f, 0x16, 0xa0, 0x9c, 0x44, 0x85, 0x8f, 0x50, 0x95, 0x4f, 0x5b, 0x30, 0xa9, 0x9c, 0x73, 0x6e,
+	0xb2, 0x8b, 0xc9, 0x78, 0x63, 0xf8, 0xb8, 0xb4, 0x3a, 0xef, 0x65, 0x26, 0xbb, 0xf2, 0x1d, 0xd0,
+	0x14, 0x86, 0x69, 0xd9, 0xe4, 0x16, 0x40, 0xd4, 0x8d, 0x62, 0xda, 0x32, 0x9c, 0x07, 0xdb, 0x58,
+	0x71, 0x0b, 0x6e, 0x10, 0x52, 0xb6, 0xbe, 0x6e, 0x04, 0x35, 0xba, 0xa9, 0x28, 0xb5, 0x72, 0xd5,
+	0x30, 0x34, 0x38, 0xd9, 0xbf, 0x5d, 0x80, 0xe9, 0x6c, 0x95, 0xc8, 0xfb, 0x60, 0x22, 0x91, 0x6e,
+	0xdc, 0x1d, 0x4e, 0x42, 0xe1, 0x13, 0x68, 0xe0, 0xee, 0x1f, 0xcc, 0xcf, 0xf7, 0x5e, 0x9e, 0x5e,
+	0x30, 0x49, 0x30, 0xc5, 0x4c, 0x44, 0x48, 0x64, 0x28, 0xaf, 0xda, 0x5d, 0x6a, 0xb7, 0x65, 0x98,
+	0xc3, 0x88, 0x90, 0x98, 0x58, 0xcc, 0x50, 0x93, 0x0d, 0x38, 0x65, 0x40, 0x6e, 0x50, 0xaf, 0xde,
+	0xd8, 0x0e, 0x42, 0x71, 0x0b, 0xa3, 0x58, 0x7d, 0x4a, 0x72, 0x39, 0x85, 0x7d, 0x68, 0xb0, 0x6f,
+	0x49, 0x66, 0xb4, 0xb8, 0x4e, 0xdb, 0x71, 0xbd, 0xb8, 0x2b, 0xbd, 0x21, 0xa5, 0x9b, 0x96, 0x25,
+	0x1c, 0x15, 0x85, 0x7d, 0x1d, 0x46, 0x8e, 0x38, 0x83, 0x8e, 0xb4, 0xd7, 0xbf, 0x04, 0x65, 0xc6,
+	0x8e, 0xe9, 0xa2, 0xbc, 0x58, 0x06, 0x50, 0x4e, 0x2e, 0xe5, 0x10, 0x1b, 0x8a, 0x9e, 0x93, 0x04,
+	0xa1, 0x54, 0xb3, 0x56, 0xa3, 0xa8, 0xc3, 0x2d, 0x19, 0x86, 0x24, 0x4f, 0x43, 0x91, 0xee, 0xb7,
+	0xb3, 0xd1, 0xa6, 0x8b, 0xfb, 0x6d, 0x2f, 0xa4, 0x11, 0x23, 0xa2, 0xfb, 0x6d, 0x32, 0x07, 0x05,
+	0xaf, 0x26, 0x37, 0x29, 0x90, 0x34, 0x85, 0xd5, 0x15, 0x2c, 0x78, 0x35, 0x7b, 0x1f, 0x2a, 0xea,
+	0x16, 0x10, 0xd9, 0x4d, 0x74, 0xb7, 0x95, 0xc7, 0x31, 0x4e, 0xc2, 0x77, 0x80, 0xd6, 0xee, 0x00,
+	0xe8, 0x3c, 0xbd, 0xbc, 0xf4, 0xcb, 0x39, 0x18, 0x71, 0x03, 0x99, 0x2a, 0x5c, 0xd6, 0x6c, 0xb8,
+	0xd2, 0xe6, 0x18, 0xfb, 0x36, 0x4c, 0x5d, 0xf3, 0x83, 0xbb, 0x3e, 0xdb, 0x4c, 0x2f, 0x79, 0xb4,
+	0x59, 0x63, 0x8c, 0x77, 0xd8, 0x8f, 0xac, 0x89, 0xc0, 0xb1, 0x28, 0x70, 0xea, 0xaa, 0x4c, 0x61,
+	0xd0, 0x55, 0x19, 0xfb, 0xe3, 0x16, 0x4c, 0xab, 0x04, 0xb2, 0x44, 0x1b, 0x5f, 0x80, 0x89, 0xed,
+	0x8e, 0xd7, 0xac, 0xc9, 0x6f, 0x29, 0x42, 0xa5, 0xc8, 0x55, 0x0d, 0x1c, 0xa6, 0x28, 0x99, 0xb9,
+	0xb5, 0xed, 0xf9, 0x4e, 0xd8, 0xdd, 0xd0, 0xea, 0x5f, 0x69, 0x84, 0xaa, 0xc2, 0xa0, 0x41, 0x65,
+	0x7f, 0xb3, 0x08, 0xfa, 0x06, 0x10, 0xf1, 0x64, 0x26, 0x84, 0x95, 0x47, 0xac, 0x6a, 0xb3, 0xeb,
+	0xbb, 0xfa, 0xae, 0x51, 0x39, 0x93, 0x08, 0xf1, 0x29, 0x8b, 0x19, 0x7a, 0x5e, 0xec, 0x39, 0x7c,
+	0x7d, 0x4a, 0xef, 0x68, 0x23, 0xa7, 0xc3, 0xf2, 0x55, 0xc1, 0x39, 0x08, 0x4d, 0xd3, 0x51, 0x09,
+	0x43, 0x53, 0x32, 0x79, 0x45, 0x1e, 0x2f, 0x14, 0x73, 0xcb, 0xa3, 0x29, 0x67, 0xce, 0x14, 0xda,
+	0x50, 0x0a, 0x69, 0x1c, 0x26, 0x19, 0x4c, 0xd7, 0x86, 0x3d, 0x6c, 0x8d, 0xc3, 0xee, 0x66, 0xcc,
+	0x3c, 0xb0, 0xba, 0x61, 0xdf, 0x70, 0x30, 0x0a, 0x41, 0x76, 0x04, 0xa4, 0xb7, 0x2f, 0x8e, 0x19,
+	0xba, 0x5d, 0x84, 0x8a, 0xd3, 0x89, 0x83, 0x16, 0xeb, 0x26, 0x3e, 0x3c, 0x65, 0x23, 0x38, 0x9d,
+	0x20, 0x50, 0xd3, 0xd8, 0x9f, 0x2f, 0x41, 0x26, 0x35, 0x81, 0xec, 0x9b, 0xb7, 0xd7, 0xac, 0x7c,
+	0x6f, 0xaf, 0xa9, 0xca, 0xf4, 0xbb, 0xc1, 0x46, 0xea, 0x50, 0x6a, 0x37, 0x9c, 0x28, 0x59, 0x7e,
+	0x2f, 0x25, 0xdd, 0xb4, 0xc1, 0x80, 0xf7, 0x0f, 0xe6, 0x7f, 0xf2, 0x68, 0xe6, 0x1c, 0x9b, 0xab,
+	0x8b, 0x22, 0x4f, 0x53, 0x8b, 0xe6, 0x3c, 0x50, 0xf0, 0x37, 0x0d, 0xba, 0xe2, 0x21, 0xae, 0xe9,
+	0x27, 0x2c, 0x91, 0xcf, 0x86, 0x34, 0xea, 0x34, 0x63, 0x39, 0x1b, 0x5e, 0xca, 0x71, 0x95, 0x09,
+	0xc6, 0x3a, 0xb1, 0x4d, 0x7c, 0xa3, 0x21, 0x94, 0xbc, 0x0f, 0x2a, 0x51, 0xec, 0x84, 0xf1, 0x43,
+	0xa6, 0xc1, 0xa8, 0x4e, 0xdf, 0x4c, 0x98, 0xa0, 0xe6, 0x47, 0x5e, 0x06, 0xd8, 0xf1, 0x7c, 0x2f,
+	0x6a, 0x3c, 0xe4, 0xa9, 0x20, 0xaf, 0xf8, 0x25, 0xc5, 0x01, 0x0d, 0x6e, 0x4c, 0xbb, 0xf1, 0xb9,
+	0x2d, 0xe2, 0x98, 0x65, 0xbe, 0x7d, 0x29, 0xed, 0x86, 0x0a, 0x83, 0x06, 0x95, 0xfd, 0x51, 0x38,
+	0x99, 0xbd, 0x58, 0x2f, 0x3d, 0xbc, 0x7a, 0x18, 0x74, 0xda, 0x59, 0xf5, 0xcd, 0x2f, 0x5e, 0xa3,
+	0xc0, 0x31, 0xf5, 0xbd, 0xeb, 0xf9, 0xb5, 0xac, 0xfa, 0xbe, 0xe6, 0xf9, 0x35, 0xe4, 0x98, 0x23,
+	0x5c, 0xeb, 0xfb, 0x03, 0x0b, 0xce, 0x1d, 0x76, 0xff, 0x9f, 0x79, 0xef, 0x77, 0x9d, 0xd0, 0x97,
+	0x57, 0x6b, 0xb8, 0xee, 0xb8, 0xed, 0x84, 0x3e, 0x72, 0x28, 0xe9, 0xc2, 0xa8, 0x48, 0xfd, 0x93,
+	0x06, 0xe9, 0x4b, 0xf9, 0xbe, 0x46, 0xc0, 0x5c, 0x24, 0x15, 0x74, 0x11, 0x69, 0x87, 0x28, 0x05,
+	0xda, 0xdf, 0x1f, 0x81, 0xd9, 0xf5, 0x3d, 0x1a, 0x86, 0x5e, 0x8d, 0x0a, 0x7f, 0x46, 0x67, 0x57,
+	0x91, 0x17, 0x60, 0x52, 0xe5, 0x57, 0x6d, 0xe9, 0x0c, 0x0a, 0x65, 0xdf, 0x2e, 0x9b, 0x48, 0x4c,
+	0xd3, 0x92, 0x08, 0x40, 0xb8, 0x43, 0x5b, 0xa1, 0xda, 0x9d, 0x37, 0x95, 0xed, 0xaa, 0x30, 0xc3,
+	0x7b, 0x5d, 0x86, 0x18, 0xb2, 0x07, 0xe3, 0xe2, 0xeb, 0x92, 0xd3, 0x8c, 0x92, 0x51, 0xdb, 0x4a,
+	0xb6, 0x87, 0x4d, 0x8d, 0x1a, 0x5e, 0xac, 0x29, 0x88, 0x7c, 0x18, 0x26, 0xc5, 0xe7, 0x4d, 0x7f,
+	0x97, 0x99, 0x11, 0xd2, 0xad, 0xbf, 0xa5, 0x4e, 0x11, 0x4c, 0xe4, 0xf0, 0xb2, 0xd3, 0xc2, 0xc8,
+	0x25, 0x20, 0xed, 0x30, 0xa8, 0x87, 0x94, 0x5f, 0x25, 0x41, 0xea, 0x44, 0x3a, 0x86, 0xcf, 0x8f,
+	0x6b, 0x36, 0x7a, 0xb0, 0xd8, 0xa7, 0x04, 0x79, 0x2f, 0x3c, 0xe9, 0x36, 0xa8, 0xbb, 0x9b, 0xe4,
+	0x85, 0xc9, 0x70, 0x60, 0x72, 0xa5, 0xc6, 0xb8, 0xd4, 0xb2, 0xdc, 0x9f, 0x0c, 0x07, 0x95, 0xb7,
+	0xbf, 0x6b, 0x01, 0x49, 0xe6, 0x99, 0xce, 0x7c, 0x25, 0xcf, 0xc3, 0xc4, 0x9d, 0xcd, 0xf5, 0x1b,
+	0x1b, 0x81, 0xe7, 0xf3, 0x3b, 0x44, 0x46, 0x2a, 0xd6, 0x55, 0x03, 0x8e, 0x29, 0x2a, 0xe6, 0xcc,
+	0xde, 0x79, 0x95, 0x99, 0x36, 0x17, 0xf7, 0xdb, 0xbc, 0x09, 0xc9, 0x5b, 0x31, 0xd2, 0x99, 0xbd,
+	0xfa, 0x52, 0x06, 0x89, 0xbd, 0xf4, 0x64, 0x1d, 0x4e, 0xb7, 0x78, 0xa0, 0xb7, 0xc6, 0x2d, 0xba,
+	0x48, 0x44, 0x7d, 0xc3, 0xe4, 0x62, 0xc5, 0x1b, 0xee, 0x1d, 0xcc, 0x9f, 0xbe, 0xde, 0x8f, 0x00,
+	0xfb, 0x97, 0xb3, 0xbf, 0x5a, 0x80, 0x71, 0xe3, 0xad, 0x96, 0x23, 0xd8, 0xae, 0x99, 0xe7, 0x65,
+	0x0a, 0x47, 0x7c, 0x5e, 0xe6, 0x19, 0x28, 0xb7, 0x83, 0xa6, 0xe7, 0x7a, 0xea, 0x16, 0xc8, 0x04,
+	0x3f, 0x6b, 0x95, 0x30, 0x54, 0x58, 0x72, 0x17, 0x2a, 0xea, 0x55, 0x01, 0x99, 0x17, 0x9a, 0x97,
+	0xf5, 0xae, 0x36, 0x09, 0xfd, 0x5a, 0x80, 0x96, 0x45, 0x6c, 0x18, 0xe5, 0x1a, 0x36, 0x99, 0x85,
+	0x3c, 0xd1, 0x88, 0xab, 0xde, 0x08, 0x25, 0xc6, 0xfe, 0xa7, 0x12, 0x54, 0x90, 0xb6, 0x83, 0xe5,
+	0x90, 0xd6, 0x22, 0xf2, 0x46, 0x28, 0x76, 0xc2, 0xa6, 0xec, 0x2c, 0x15, 0x66, 0xbc, 0x89, 0x6b,
+	0xc8, 0xe0, 0x29, 0xb3, 0xa6, 0x70, 0xac, 0x13, 0xe9, 0xe2, 0xa1, 0x27, 0xd2, 0x2f, 0xc0, 0x64,
+	0x14, 0x35, 0x36, 0x42, 0x6f, 0xcf, 0x89, 0x99, 0xb2, 0x94, 0x8b, 0x57, 0x1f, 0x01, 0x6e, 0x5e,
+	0xd1, 0x48, 0x4c, 0xd3, 0x92, 0xcb, 0x30, 0xa3, 0xcf, 0x85, 0x69, 0x18, 0xf3, 0x10, 0x9c, 0x88,
+	0xd6, 0xa9, 0x13, 0x38, 0x7d, 0x92, 0x2c, 0x09, 0xb0, 0xb7, 0x0c, 0x59, 0x81, 0xe9, 0x14, 0x90,
+	0x55, 0x44, 0x84, 0xf2, 0x54, 0xce, 0x49, 0x8a, 0x0f, 0xab, 0x4b, 0x4f, 0x09, 0x72, 0x1d, 0x4e,
+	0x8a, 0xf1, 0xe5, 0xaf, 0x51, 0xa8, 0x16, 0x8d, 0x71, 0x46, 0xff, 0x4b, 0x32, 0x3a, 0x79, 0xb9,
+	0x97, 0x04, 0xfb, 0x95, 0x63, 0x33, 0x54, 0x81, 0x57, 0x57, 0xe4, 0x8e, 0xac, 0x66, 0xa8, 0x62,
+	0xb3, 0x5a, 0x43, 0x93, 0x8e, 0x29, 0x12, 0xfd, 0x29, 0x22, 0xb8, 0xc2, 0x4c, 0x5d, 0x91, 0x29,
+	0x37, 0x4a, 0x91, 0x5c, 0xee, 0x4b, 0x56, 0xc3, 0x41, 0xe5, 0xc9, 0x36, 0xcc, 0x29, 0xd4, 0x45,
+	0xa6, 0x0e, 0xda, 0xa1, 0x17, 0xd1, 0xaa, 0x13, 0xd1, 0x9b, 0x61, 0x53, 0xde, 0x7b, 0x53, 0xef,
+	0x86, 0x5c, 0xf6, 0xe2, 0x2b, 0xfd, 0x28, 0x71, 0x0d, 0x1f, 0xc0, 0x85, 0x59, 0xc5, 0xd4, 0x77,
+	0xb6, 0x9b, 0x74, 0x7d, 0x79, 0x95, 0xa7, 0xee, 0x18, 0x56, 0xf1, 0xc5, 0x04, 0x81, 0x9a, 0x46,
+	0xb9, 0x81, 0x13, 0x03, 0xdd, 0xc0, 0xef, 0x58, 0x30, 0xa9, 0x26, 0xfb, 0x63, 0x88, 0xb7, 0x36,
+	0xd3, 0xf1, 0xd6, 0xcb, 0xc3, 0xba, 0x23, 0xb2, 0xe6, 0x03, 0x9c, 0xf6, 0x1f, 0x54, 0x00, 0xf8,
+	0x13, 0x5e, 0x1e, 0x4f, 0x09, 0x3f, 0x07, 0x23, 0x21, 0x6d, 0x07, 0x59, 0xcd, 0xc7, 0xcf, 0x8a,
+	0x38, 0xe6, 0x47, 0x77, 0x39, 0xf7, 0xcb, 0x50, 0x28, 0xfd, 0xf7, 0x66, 0x28, 0x6c, 0xc2, 0x69,
+	0xcf, 0x8f, 0xa8, 0xdb, 0x09, 0xe5, 0xce, 0x79, 0x25, 0x88, 0x94, 0x76, 0x28, 0xeb, 0x57, 0x1b,
+	0x56, 0xfb, 0x11, 0x61, 0xff, 0xb2, 0xac, 0x4b, 0x13, 0x84, 0xbc, 0x7b, 0xa6, 0x43, 0x49, 0x12,
+	0x8e, 0x8a, 0x42, 0x2f, 0x88, 0xb5, 0x9d, 0xe4, 0x72, 0x59, 0x66, 0x41, 0xac, 0x5d, 0xda, 0x44,
+	0x4d, 0xd3, 0x5f, 0x2b, 0x56, 0x72, 0xd2, 0x8a, 0x70, 0x6c, 0xad, 0x98, 0xac, 0xcf, 0xf1, 0x81,
+	0x2f, 0x9a, 0x24, 0x9b, 0xf5, 0xc4, 0xc0, 0xcd, 0xfa, 0x45, 0x98, 0xf2, 0xfc, 0x06, 0x0d, 0xbd,
+	0x98, 0xd6, 0xf8, 0x5a, 0x98, 0x9d, 0xe4, 0x1d, 0xa1, 0xa2, 0x9c, 0xab, 0x29, 0x2c, 0x66, 0xa8,
+	0xd3, 0x4a, 0x65, 0xea, 0x08, 0x4a, 0x65, 0x80, 0x2a, 0x3f, 0x91, 0x8f, 0x2a, 0x9f, 0x1e, 0x5e,
+	0x95, 0xcf, 0x3c, 0x52, 0x55, 0x4e, 0x72, 0x51, 0xe5, 0x4f, 0x43, 0xa9, 0x1d, 0x06, 0xfb, 0xdd,
+	0xd9, 0x93, 0x69, 0x37, 0x70, 0x83, 0x01, 0x51, 0xe0, 0xcc, 0x44, 0xcd, 0x53, 0x0f, 0x4e, 0xd4,
+	0xb4, 0x5f, 0x2b, 0xc0, 0x69, 0xad, 0xe9, 0xd8, 0xfc, 0xf2, 0x76, 0xd8, 0x5a, 0xe7, 0x37, 0x80,
+	0x45, 0x72, 0x90, 0x11, 0x60, 0xd7, 0xb1, 0x7a, 0x85, 0x41, 0x83, 0x8a, 0xc7, 0xa9, 0x69, 0xc8,
+	0xd3, 0xcb, 0xb3, 0x6a, 0x70, 0x59, 0xc2, 0x51, 0x51, 0xf0, 0xf7, 0x3f, 0x69, 0x18, 0xcb, 0xb3,
+	0xbf, 0x6c, 0xe6, 0xdc, 0xb2, 0x46, 0xa1, 0x49, 0xc7, 0xcc, 0x45, 0x37, 0x59, 0x82, 0x4c, 0x15,
+	0x4e, 0x08, 0x73, 0x51, 0xad, 0x3a, 0x85, 0x4d, 0xaa, 0xc3, 0x0f, 0x24, 0x4a, 0xbd, 0xd5, 0xe1,
+	0xd1, 0x2e, 0x45, 0x61, 0xff, 0xbb, 0x05, 0x6f, 0xe8, 0xdb, 0x15, 0x8f, 0x61, 0x7b, 0xdb, 0x4f,
+	0x6f, 0x6f, 0x9b, 0xc3, 0x6f, 0x6f, 0x3d, 0xad, 0x18, 0xb0, 0xd5, 0xfd, 0x95, 0x05, 0x53, 0x9a,
+	0xfe, 0x31, 0x34, 0xd5, 0xcb, 0xf5, 0x25, 0x4f, 0x5d, 0x75, 0x91, 0xf6, 0x9c, 0x6a, 0xdb, 0x77,
+	0x78, 0xdb, 0x44, 0xd0, 0x60, 0xc9, 0x4d, 0xde, 0x82, 0x3a, 0xc4, 0x89, 0xe9, 0xc2, 0x28, 0x7f,
+	0x76, 0x22, 0xca, 0x27, 0x78, 0x91, 0x96, 0xcf, 0x4f, 0x1a, 0x75, 0xf0, 0x82, 0x7f, 0x46, 0x28,
+	0x05, 0xf2, 0xcb, 0x0f, 0x5e, 0xc4, 0xf4, 0x65, 0x4d, 0x86, 0xf6, 0xf5, 0xe5, 0x07, 0x09, 0x47,
+	0x45, 0x61, 0xb7, 0x60, 0x36, 0xcd, 0x7c, 0x85, 0xee, 0xf0, 0x18, 0xf1, 0x91, 0x9a, 0xb9, 0x08,
+	0x15, 0x87, 0x97, 0x5a, 0xeb, 0x38, 0xd9, 0xc7, 0x96, 0x96, 0x12, 0x04, 0x6a, 0x1a, 0xfb, 0xb7,
+	0x2c, 0x38, 0xd9, 0xa7, 0x31, 0x39, 0x1e, 0x69, 0xc4, 0x5a, 0x0b, 0x0c, 0x78, 0xa4, 0x4b, 0xbe,
+	0xd8, 0x94, 0x7d, 0xf7, 0x44, 0xbe, 0xef, 0x84, 0x09, 0xde, 0xfe, 0x67, 0x0b, 0x4e, 0xa4, 0xeb,
+	0xca, 0x1f, 0x96, 0x10, 0x8d, 0x59, 0xf1, 0x22, 0x37, 0xd8, 0xa3, 0x61, 0x97, 0xb5, 0xdc, 0x4a,
+	0x3f, 0x2c, 0xb1, 0xd4, 0x43, 0x81, 0x7d, 0x4a, 0xf1, 0x1c, 0xf3, 0x9a, 0xea, 0xed, 0x64, 0xa6,
+	0xdc, 0xca, 0x73, 0xa6, 0xe8, 0xc1, 0x34, 0x3d, 0x68, 0x25, 0x12, 0x4d, 0xf9, 0xf6, 0x2f, 0x94,
+	0x40, 0x9d, 0x79, 0xf2, 0x38, 0x44, 0x4e, 0xd1, 0xc2, 0xd4, 0x8b, 0x5c, 0xc5, 0x63, 0xbc, 0x1a,
+	0x36, 0xf2, 0xa0, 0x18, 0x81, 0x78, 0xeb, 0x49, 0xdb, 0xa2, 0x86, 0xd2, 0xdf, 0xd2, 0x28, 0x34,
+	0xe9, 0x58, 0x4d, 0x9a, 0xde, 0x1e, 0x15, 0x85, 0x46, 0xd3, 0x35, 0x59, 0x4b, 0x10, 0xa8, 0x69,
+	0x58, 0x4d, 0x6a, 0xde, 0xce, 0x8e, 0xf4, 0x14, 0x55, 0x4d, 0x58, 0xef, 0x20, 0xc7, 0x30, 0x8a,
+	0x46, 0x10, 0xec, 0x4a, 0xfb, 0x4f, 0x51, 0x5c, 0x09, 0x82, 0x5d, 0xe4, 0x18, 0x66, 0xb1, 0xf8,
+	0x41, 0xd8, 0x72, 0x9a, 0xde, 0x07, 0x69, 0x4d, 0x49, 0x91, 0x76, 0x9f, 0xb2, 0x58, 0x6e, 0xf4,
+	0x92, 0x60, 0xbf, 0x72, 0xfc, 0x69, 0x93, 0x90, 0xd6, 0x3c, 0x37, 0x36, 0xb9, 0x65, 0x9f, 0x36,
+	0xe9, 0xa1, 0xc0, 0x3e, 0xa5, 0xc8, 0x12, 0x9c, 0x48, 0xce, 0xac, 0x93, 0x5c, 0x25, 0x61, 0x0c,
+	0x2a, 0x3b, 0x1c, 0xd3, 0x68, 0xcc, 0xd2, 0x33, 0x6d, 0xd3, 0x92, 0x19, 0x63, 0xdc, 0x4c, 0x34,
+	0xb4, 0x4d, 0x92, 0x49, 0x86, 0x8a, 0x82, 0x5c, 0x80, 0x09, 0xd6, 0x6b, 0xc9, 0x19, 0x0e, 0x37,
+	0x16, 0x8d, 0x23, 0xbe, 0x15, 0x03, 0x87, 0x29, 0x4a, 0xfb, 0x13, 0x45, 0xb6, 0xaf, 0x0e, 0xb8,
+	0x3f, 0xfe, 0xd8, 0xe2, 0xda, 0xe9, 0xb9, 0x3c, 0x72, 0x84, 0xb9, 0xfc, 0x3c, 0x4c, 0xdc, 0x89,
+	0x02, 0x5f, 0xc5, 0xf2, 0x4a, 0x03, 0x63, 0x79, 0x06, 0x55, 0xff, 0x58, 0xde, 0x68, 0x5e, 0xb1,
+	0xbc, 0xb1, 0x87, 0x8c, 0xe5, 0xfd, 0x79, 0x09, 0xce, 0xa8, 0x8c, 0x07, 0x1a, 0xdf, 0x0d, 0xc2,
+	0x5d, 0xcf, 0xaf, 0xf3, 0x2c, 0x81, 0xaf, 0x58, 0x30, 0x21, 0x56, 0x9a, 0x7c, 0xba, 0x43, 0x9c,
+	0x8a, 0xef, 0xe4, 0x74, 0xbb, 0x32, 0x25, 0x6c, 0x61, 0xcb, 0x10, 0x94, 0x79, 0x47, 0xc5, 0x44,
+	0x61, 0xaa, 0x46, 0xe4, 0x23, 0x00, 0xc9, 0xfb, 0x70, 0x3b, 0x39, 0xbd, 0x92, 0x97, 0xd4, 0x0f,
+	0xe9, 0x8e, 0xb6, 0x6a, 0xb7, 0x94, 0x10, 0x34, 0x04, 0x92, 0xd7, 0x2c, 0x75, 0x9b, 0x49, 0x9c,
+	0xb7, 0xbe, 0xf2, 0x48, 0xfa, 0xe6, 0x28, 0x97, 0x9b, 0x10, 0xc6, 0x3c, 0x9f, 0x87, 0xb9, 0x65,
+	0xf8, 0xf3, 0x2d, 0xfd, 0x32, 0x6c, 0xd6, 0x02, 0xa7, 0x56, 0x75, 0x9a, 0x8e, 0xef, 0xd2, 0x70,
+	0x55, 0x90, 0x9b, 0x8f, 0x84, 0x71, 0x00, 0x26, 0x8c, 0x7a, 0xae, 0x0f, 0x97, 0x8e, 0x72, 0x7d,
+	0x78, 0xee, 0x3d, 0x30, 0xd3, 0x33, 0x98, 0xc7, 0xba, 0xdc, 0xf4, 0xf0, 0xf7, 0xa2, 0xec, 0x3f,
+	0x1c, 0xd5, 0xdb, 0xdd, 0x8d, 0xa0, 0x26, 0x2e, 0xb1, 0x86, 0x7a, 0x44, 0xa5, 0xd5, 0x9a, 0xe3,
+	0x14, 0x31, 0x1e, 0x1a, 0x53, 0x40, 0x34, 0x45, 0xb2, 0x39, 0xda, 0x76, 0x42, 0xea, 0x3f, 0xea,
+	0x39, 0xba, 0xa1, 0x84, 0xa0, 0x21, 0x90, 0x34, 0x52, 0x09, 0x01, 0x97, 0x86, 0x4f, 0x08, 0x60,
+	0x86, 0x74, 0xdf, 0xcb, 0x86, 0x5f, 0xb0, 0x60, 0xca, 0x4f, 0xcd, 0x5c, 0x79, 0x28, 0xbc, 0xf5,
+	0x28, 0x56, 0x85, 0x78, 0x3c, 0x20, 0x0d, 0xc3, 0x8c, 0xfc, 0x7e, 0x9b, 0x61, 0xe9, 0x98, 0x9b,
+	0xa1, 0xbe, 0x0d, 0x3f, 0x3a, 0xe8, 0x36, 0x3c, 0xf1, 0xd5, 0x3b, 0x18, 0x63, 0xb9, 0xbf, 0x83,
+	0x01, 0x7d, 0xde, 0xc0, 0xb8, 0x0d, 0x15, 0x37, 0xa4, 0x4e, 0xfc, 0x90, 0x4f, 0x22, 0xf0, 0xa7,
+	0x1d, 0x97, 0x13, 0x06, 0xa8, 0x79, 0xd9, 0xbf, 0x51, 0x82, 0xe9, 0xa4, 0x47, 0x92, 0x43, 0x2c,
+	0xb6, 0x3f, 0x0a, 0xb9, 0xda, 0x2c, 0x56, 0xfb, 0xe3, 0x95, 0x04, 0x81, 0x9a, 0x86, 0x59, 0x72,
+	0x9d, 0x88, 0xae, 0xb7, 0xa9, 0xbf, 0xe6, 0x6d, 0x47, 0xbc, 0xc7, 0x8d, 0x24, 0xc7, 0x9b, 0x1a,
+	0x85, 0x26, 0x9d, 0x2c, 0xb6, 0xdc, 0xf0, 0x9a, 0xb5, 0x90, 0x26, 0x07, 0x71, 0x66, 0xb1, 0x04,
+	0x85, 0x26, 0x1d, 0xf9, 0x92, 0x05, 0xd3, 0x8d, 0xcc, 0x81, 0xae, 0x1c, 0x87, 0x21, 0xed, 0xee,
+	0x41, 0xc7, 0xc5, 0xd5, 0x53, 0xf7, 0x0e, 0xe6, 0xa7, 0xb3, 0x50, 0xec, 0xa9, 0x05, 0x73, 0x4c,
+	0x84, 0x8f, 0x10, 0x65, 0xb3, 0x29, 0xa4, 0xef, 0x81, 0x09, 0x9e, 0x7c, 0xb9, 0xef, 0x13, 0x3d,
+	0xf9, 0xe4, 0x11, 0xf5, 0x9c, 0x46, 0x1e, 0xf3, 0x6d, 0x9e, 0xcf, 0x5b, 0x70, 0x62, 0x37, 0x95,
+	0x33, 0x96, 0x6c, 0x32, 0x43, 0x66, 0x37, 0xa7, 0x13, 0xd1, 0xf4, 0xa2, 0x4c, 0xc3, 0x23, 0xcc,
+	0x4a, 0xb7, 0xff, 0xcd, 0x02, 0x53, 0xe1, 0x1e, 0xcd, 0x56, 0x34, 0x1e, 0x5d, 0x2b, 0x1c, 0xf2,
+	0xe8, 0x5a, 0x62, 0x56, 0x16, 0x8f, 0xe6, 0x00, 0x8d, 0x1c, 0xc3, 0x01, 0x2a, 0x0d, 0xb4, 0x43,
+	0xdf, 0x08, 0xc5, 0x8e, 0x57, 0x93, 0x3e, 0x8c, 0x3e, 0x18, 0x5c, 0x5d, 0x41, 0x06, 0xb7, 0x7f,
+	0xbf, 0xa4, 0x63, 0x16, 0x32, 0xfd, 0xe5, 0xc7, 0xa2, 0xd9, 0x3b, 0x2a, 0x59, 0x5d, 0xb4, 0xfc,
+	0x46, 0x4f, 0xb2, 0xfa, 0xbb, 0x8f, 0x9f, 0xdd, 0x24, 0x3a, 0x68, 0x50, 0xae, 0xfa, 0xd8, 0x21,
+	0xa9, 0x4d, 0x77, 0xa0, 0xcc, 0xdc, 0x3c, 0x1e, 0x7c, 0x2c, 0xa7, 0x2a, 0x55, 0xbe, 0x22, 0xe1,
+	0xf7, 0x0f, 0xe6, 0xdf, 0x75, 0xfc, 0x6a, 0x25, 0xa5, 0x51, 0xf1, 0x27, 0x11, 0x54, 0xd8, 0x6f,
+	0x9e, 0x85, 0x25, 0x1d, 0xc8, 0x9b, 0x4a, 0xbb, 0x26, 0x88, 0x5c, 0x52, 0xbc, 0xb4, 0x1c, 0xe2,
+	0x43, 0x85, 0x3f, 0x0f, 0xc6, 0x85, 0x0a, 0x3f, 0x73, 0x43, 0xe5, 0x42, 0x25, 0x88, 0xfb, 0x07,
+	0xf3, 0x2f, 0x1c, 0x5f, 0xa8, 0x2a, 0x8e, 0x5a, 0x84, 0xfd, 0xf7, 0x45, 0x3d, 0x77, 0xe5, 0x1d,
+	0x85, 0x1f, 0x8b, 0xb9, 0x7b, 0x21, 0x33, 0x77, 0xcf, 0xf5, 0xcc, 0xdd, 0x29, 0xfd, 0x84, 0x56,
+	0x6a, 0x36, 0x3e, 0x6e, 0x93, 0xe1, 0xf0, 0x98, 0x06, 0xb7, 0x95, 0x5e, 0xed, 0x78, 0x21, 0x8d,
+	0x36, 0xc2, 0x8e, 0xef, 0xf9, 0x75, 0xf9, 0x28, 0xab, 0x61, 0x2b, 0xa5, 0xd0, 0x98, 0xa5, 0xb7,
+	0xbf, 0xca, 0xcf, 0x7e, 0x8d, 0x84, 0x4e, 0x36, 0xca, 0x4d, 0xfe, 0xc2, 0x9a, 0xc8, 0xe2, 0x56,
+	0xa3, 0x2c, 0x9e, 0x55, 0x13, 0x38, 0x72, 0x17, 0xc6, 0xb6, 0xc5, 0x2b, 0x2f, 0xf9, 0x5c, 0x2b,
+	0x94, 0x4f, 0xc6, 0xf0, 0x8c, 0xa0, 0xe4, 0xfd, 0x98, 0xfb, 0xfa, 0x27, 0x26, 0xd2, 0xec, 0x5f,
+	0x2e, 0xc2, 0x89, 0xcc, 0xfb, 0x5f, 0xe4, 0x59, 0x28, 0x27, 0x8f, 0xbd, 0x65, 0x4f, 0x2a, 0xd4,
+	0xa3, 0xe4, 0x8a, 0x82, 0x7c, 0x00, 0xa0, 0x46, 0xdb, 0xcd, 0xa0, 0xcb, 0x4d, 0xb1, 0x91, 0x63,
+	0x9b, 0x62, 0xca, 0x7a, 0x5f, 0x51, 0x5c, 0xd0, 0xe0, 0x28, 0x53, 0xd7, 0x4b, 0xe2, 0x0d, 0x9b,
+	0x74, 0xea, 0xba, 0x71, 0xbb, 0x76, 0xf4, 0xf1, 0xde, 0xae, 0xf5, 0xe0, 0x84, 0xa8, 0xa2, 0x4a,
+	0x9b, 0x7c, 0x88, 0xec, 0xc8, 0x93, 0x6c, 0x46, 0xad, 0xa4, 0xd9, 0x60, 0x96, 0xaf, 0xfd, 0xb9,
+	0x02, 0x33, 0x48, 0x45, 0x67, 0x5f, 0x4f, 0x0e, 0x0a, 0xde, 0x0c, 0xa3, 0x4e, 0x27, 0x6e, 0x04,
+	0x3d, 0xaf, 0xee, 0x2c, 0x71, 0x28, 0x4a, 0x2c, 0x59, 0x83, 0x91, 0x9a, 0x13, 0x27, 0xff, 0x15,
+	0x72, 0x9c, 0xca, 0xe9, 0xa8, 0xa0, 0x13, 0x53, 0xe4, 0x5c, 0xc8, 0x53, 0x30, 0x12, 0x3b, 0xf5,
+	0xd4, 0xab, 0xb9, 0x5b, 0x4e, 0x3d, 0x42, 0x0e, 0x35, 0x77, 0x97, 0x91, 0x43, 0x76, 0x97, 0x17,
+	0x8c, 0x3f, 0xf9, 0x31, 0x4e, 0xa0, 0x7a, 0xff, 0x98, 0x47, 0x5c, 0xa6, 0x49, 0xd1, 0xda, 0x1f,
+	0x81, 0x09, 0xf3, 0x8f, 0x7b, 0x8e, 0x76, 0xbf, 0xef, 0xd0, 0xe4, 0x7d, 0xd6, 0xa5, 0x5e, 0x14,
+	0x75, 0xd4, 0x5d, 0x3e, 0x9d, 0x45, 0xc9, 0xa1, 0x28, 0xb1, 0xf6, 0x3f, 0x8e, 0xc0, 0x64, 0x2a,
+	0x49, 0x37, 0xb5, 0x5e, 0xac, 0x43, 0xd7, 0x0b, 0x3f, 0xa5, 0xec, 0xf8, 0x54, 0xa6, 0x60, 0x1b,
+	0xa7, 0x94, 0x1d, 0x9f, 0xa2, 0xc0, 0xb1, 0xca, 0xd4, 0xc2, 0x2e, 0x76, 0x7c, 0x79, 0xd6, 0xa1,
+	0x2a, 0xb3, 0xc2, 0xa1, 0x28, 0xb1, 0xcc, 0xb9, 0x9f, 0x88, 0xb8, 0x7a, 0x95, 0xa1, 0xc7, 0x91,
+	0x3c, 0x54, 0xe9, 0xa6, 0xc1, 0x51, 0x04, 0x3b, 0x4c, 0x08, 0xa6, 0x24, 0x92, 0x4f, 0x5a, 0xe6,
+	0x6b, 0x8f, 0xa3, 0x79, 0x9c, 0xd1, 0x65, 0x73, 0xa0, 0xc5, 0x5a, 0x7c, 0xf0, 0xa3, 0x8f, 0x91,
+	0x52, 0x05, 0x63, 0x8f, 0x46, 0x15, 0x40, 0x1f, 0x35, 0xf0, 0x36, 0xa8, 0xb4, 0x1c, 0xdf, 0xdb,
+	0xa1, 0x51, 0x2c, 0xfe, 0xbe, 0xab, 0x22, 0x3c, 0xcb, 0xeb, 0x09, 0x10, 0x35, 0x9e, 0xff, 0x49,
+	0x1e, 0x6f, 0x98, 0x70, 0x87, 0x2a, 0xc6, 0x9f, 0xe4, 0x69, 0x30, 0x9a, 0x34, 0xf6, 0xef, 0x58,
+	0x70, 0xba, 0x6f, 0x67, 0xfc, 0xe8, 0x86, 0x86, 0xed, 0xdf, 0x2d, 0xc0, 0xc9, 0x3e, 0x49, 0xec,
+	0xa4, 0xfb, 0xc8, 0x1e, 0x05, 0x95, 0x59, 0xf2, 0x93, 0x03, 0xe7, 0xc6, 0xf1, 0x36, 0x34, 0xbd,
+	0xa9, 0x14, 0x1f, 0xeb, 0xa6, 0x62, 0x7f, 0xb5, 0x00, 0xc6, 0xf3, 0xb5, 0xe4, 0xa3, 0xe6, 0x7d,
+	0x0d, 0x2b, 0xaf, 0xbb, 0x05, 0x82, 0xb9, 0xba, 0xef, 0x21, 0x7a, 0xad, 0xdf, 0xf5, 0x8f, 0xec,
+	0x7c, 0x2d, 0x1c, 0x3e, 0x5f, 0x49, 0x33, 0xb9, 0x18, 0x53, 0xcc, 0xff, 0x62, 0x4c, 0xa5, 0xe7,
+	0x52, 0xcc, 0x2f, 0x5a, 0x62, 0xa6, 0x65, 0x9a, 0xa4, 0x35, 0xac, 0xf5, 0x00, 0x0d, 0xfb, 0x2c,
+	0x94, 0x23, 0xda, 0xdc, 0x61, 0x36, 0xa2, 0xd4, 0xc4, 0xfa, 0xe5, 0x7d, 0x09, 0x47, 0x45, 0xc1,
+	0x6f, 0xbe, 0x37, 0x9b, 0xc1, 0xdd, 0x8b, 0xad, 0x76, 0xdc, 0x95, 0x3a, 0x59, 0xdf, 0x7c, 0x57,
+	0x18, 0x34, 0xa8, 0xec, 0x3f, 0x2b, 0x8a, 0xe1, 0x94, 0xd6, 0xfe, 0x85, 0xcc, 0x8d, 0xe4, 0xa3,
+	0x1b, 0xca, 0x1f, 0x06, 0x70, 0xd5, 0x8b, 0x22, 0xf9, 0xbc, 0x6a, 0xab, 0x5f, 0x28, 0x31, 0x9f,
+	0x5a, 0x4d, 0x60, 0x68, 0xc8, 0x4b, 0x2d, 0x9e, 0xe2, 0xa1, 0x8b, 0x67, 0x05, 0xa6, 0x63, 0xa7,
+	0x9e, 0xda, 0xbf, 0xa5, 0xd6, 0xd0, 0x39, 0x5c, 0x19, 0x3c, 0xf6, 0x94, 0x20, 0x17, 0x60, 0xc2,
+	0x35, 0xff, 0xe2, 0xa0, 0x94, 0x3e, 0x50, 0x4b, 0xfd, 0xb9, 0x41, 0x8a, 0x92, 0xdc, 0x82, 0x33,
+	0xe6, 0xf7, 0x72, 0xe0, 0x47, 0x71, 0xe8, 0x78, 0x7e, 0x2c, 0xdd, 0x13, 0xf5, 0x4e, 0xfa, 0x72,
+	0x5f, 0x2a, 0x1c, 0x50, 0xda, 0xfe, 0x17, 0x0b, 0x52, 0x9b, 0x20, 0x69, 0x43, 0x89, 0xf5, 0x6c,
+	0x37, 0x9f, 0x77, 0x5d, 0x4c, 0xd6, 0x4c, 0x61, 0xc8, 0xe9, 0xce, 0x7f, 0xa2, 0x10, 0x44, 0x9a,
+	0xd2, 0x7f, 0x29, 0xe4, 0xf1, 0xf6, 0x90, 0x29, 0x90, 0x79, 0x40, 0xf2, 0x2f, 0x7f, 0x94, 0x2f,
+	0x64, 0x5f, 0x80, 0x99, 0x9e, 0x4a, 0xf1, 0x7b, 0x92, 0x41, 0xf2, 0x98, 0x8d, 0xb1, 0xb2, 0xf8,
+	0xad, 0x6d, 0x14, 0x38, 0xe6, 0x02, 0x4d, 0x67, 0xd9, 0x93, 0x2f, 0x59, 0x30, 0x13, 0x65, 0xf9,
+	0x3d, 0xaa, 0xbe, 0x53, 0xb1, 0xbd, 0x1e, 0x14, 0xf6, 0x56, 0xc2, 0xfe, 0xa1, 0x54, 0xbb, 0xe2,
+	0x8f, 0x31, 0xd5, 0xa6, 0x69, 0x0d, 0xdc, 0x34, 0x99, 0xea, 0x70, 0x1b, 0xb4, 0xd6, 0x69, 0xf6,
+	0x64, 0x72, 0x6d, 0x4a, 0x38, 0x2a, 0x8a, 0xd4, 0xab, 0x9d, 0xc5, 0x43, 0x5f, 0xed, 0x7c, 0x1e,
+	0x26, 0xcc, 0x07, 0x9b, 0x78, 0x90, 0x51, 0x1e, 0x38, 0x99, 0x6f, 0x3b, 0x61, 0x8a, 0x2a, 0xf3,
+	0xea, 0x63, 0xe9, 0xd0, 0x57, 0x1f, 0x9f, 0x81, 0xb2, 0x7c, 0xc1, 0x30, 0x89, 0xe9, 0x8b, 0x34,
+	0x31, 0x09, 0x43, 0x85, 0x65, 0x8a, 0xaf, 0xe5, 0xf8, 0x1d, 0xa7, 0xc9, 0x7a, 0x48, 0x66, 0x8f,
+	0x2a, 0x8d, 0x71, 0x5d, 0x61, 0xd0, 0xa0, 0x62, 0x2d, 0x8e, 0xbd, 0x16, 0x7d, 0x39, 0xf0, 0x93,
+	0xd8, 0x91, 0x6a, 0xf1, 0x96, 0x84, 0xa3, 0xa2, 0xb0, 0xff, 0xc1, 0x82, 0xec, 0xf3, 0x6b, 0xa9,
+	0x8c, 0x55, 0xeb, 0xd0, 0x8c, 0xd5, 0x74, 0x36, 0x5e, 0xe1, 0x48, 0xd9, 0x78, 0x66, 0xa2, 0x5c,
+	0xf1, 0x81, 0x89, 0x72, 0x6f, 0xd2, 0xaf, 0x6d, 0x88, 0x8c, 0xba, 0xf1, 0x7e, 0x2f, 0x6d, 0x10,
+	0x1b, 0x46, 0x5d, 0x47, 0x5d, 0x08, 0x98, 0x10, 0xe6, 0xe2, 0xf2, 0x12, 0x27, 0x92, 0x98, 0xea,
+	0xf6, 0xd7, 0xbf, 0x77, 0xf6, 0x89, 0x6f, 0x7c, 0xef, 0xec, 0x13, 0xdf, 0xfe, 0xde, 0xd9, 0x27,
+	0x3e, 0x7e, 0xef, 0xac, 0xf5, 0xf5, 0x7b, 0x67, 0xad, 0x6f, 0xdc, 0x3b, 0x6b, 0x7d, 0xfb, 0xde,
+	0x59, 0xeb, 0xbb, 0xf7, 0xce, 0x5a, 0x5f, 0xf8, 0xbb, 0xb3, 0x4f, 0xbc, 0xfc, 0xee, 0x61, 0xfe,
+	0x89, 0xfd, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x15, 0x77, 0xb1, 0xc8, 0x7d, 0x00, 0x00,
 }
 
 func (m *AWSAuthConfig) Marshal() (dAtA []byte, err error) {
@@ -3186,6 +3268,25 @@ func (m *AppProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DestinationServiceAccounts) > 0 {
+		for iNdEx := len(m.DestinationServiceAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DestinationServiceAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	i -= len(m.SignaturePolicy)
+	copy(dAtA[i:], m.SignaturePolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SignaturePolicy)))
+	i--
+	dAtA[i] = 0x62
 	if len(m.ClusterResourceBlacklist) > 0 {
 		for iNdEx := len(m.ClusterResourceBlacklist) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3527,6 +3628,44 @@ func (m *ApplicationDestination) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationDestinationServiceAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationDestinationServiceAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DefaultServiceAccount)
+	copy(dAtA[i:], m.DefaultServiceAccount)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DefaultServiceAccount)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Server)
+	copy(dAtA[i:], m.Server)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Server)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ApplicationList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3736,6 +3875,11 @@ func (m *ApplicationSourceHelm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.PinnedChartVersion)
+	copy(dAtA[i:], m.PinnedChartVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PinnedChartVersion)))
+	i--
+	dAtA[i] = 0x52
 	i--
 	if m.SkipCrds {
 		dAtA[i] = 1
@@ -5985,7 +6129,7 @@ func (m *OrphanedResourcesMonitorSettings) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
+func (m *OverrideHealthConditions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5995,23 +6139,83 @@ func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OverrideIgnoreDiff) MarshalTo(dAtA []byte) (int, error) {
+func (m *OverrideHealthConditions) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OverrideHealthConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ManagedFieldsManagers) > 0 {
-		for iNdEx := len(m.ManagedFieldsManagers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ManagedFieldsManagers[iNdEx])
-			copy(dAtA[i:], m.ManagedFieldsManagers[iNdEx])
-			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManagedFieldsManagers[iNdEx])))
+	i--
+	if m.CheckObservedGeneration {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	if len(m.ProgressingReasons) > 0 {
+		for iNdEx := len(m.ProgressingReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProgressingReasons[iNdEx])
+			copy(dAtA[i:], m.ProgressingReasons[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ProgressingReasons[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x2a
+		}
+	}
+	i -= len(m.StatusUnknown)
+	copy(dAtA[i:], m.StatusUnknown)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusUnknown)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.StatusFalse)
+	copy(dAtA[i:], m.StatusFalse)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusFalse)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.StatusTrue)
+	copy(dAtA[i:], m.StatusTrue)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StatusTrue)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.ConditionType)
+	copy(dAtA[i:], m.ConditionType)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ConditionType)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OverrideIgnoreDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverrideIgnoreDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OverrideIgnoreDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ManagedFieldsManagers) > 0 {
+		for iNdEx := len(m.ManagedFieldsManagers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ManagedFieldsManagers[iNdEx])
+			copy(dAtA[i:], m.ManagedFieldsManagers[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.ManagedFieldsManagers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
 	if len(m.JQPathExpressions) > 0 {
@@ -6710,6 +6914,11 @@ func (m *ResourceDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.DiffStrategy)
+	copy(dAtA[i:], m.DiffStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DiffStrategy)))
+	i--
+	dAtA[i] = 0x6a
 	i--
 	if m.Modified {
 		dAtA[i] = 1
@@ -7088,6 +7297,26 @@ func (m *ResourceOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HealthConditions != nil {
+		{
+			size, err := m.HealthConditions.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i--
+	if m.UseChildren {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
 	i--
 	if m.UseOpenLibs {
 		dAtA[i] = 1
@@ -7518,6 +7747,16 @@ func (m *SignatureKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.Issuer)
+	copy(dAtA[i:], m.Issuer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Issuer)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0x12
 	i -= len(m.KeyID)
 	copy(dAtA[i:], m.KeyID)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KeyID)))
@@ -7844,6 +8083,21 @@ func (m *SyncStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	i -= len(m.ChartVersionConstraint)
+	copy(dAtA[i:], m.ChartVersionConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChartVersionConstraint)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.ChartVersion)
+	copy(dAtA[i:], m.ChartVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ChartVersion)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.TagSignatureInfo)
+	copy(dAtA[i:], m.TagSignatureInfo)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TagSignatureInfo)))
+	i--
+	dAtA[i] = 0x22
 	i -= len(m.Revision)
 	copy(dAtA[i:], m.Revision)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Revision)))
@@ -8235,6 +8489,14 @@ func (m *AppProjectSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.SignaturePolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.DestinationServiceAccounts) > 0 {
+		for _, e := range m.DestinationServiceAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -8307,6 +8569,21 @@ func (m *ApplicationDestination) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationDestinationServiceAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Server)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.DefaultServiceAccount)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *ApplicationList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8406,6 +8683,8 @@ func (m *ApplicationSourceHelm) Size() (n int) {
 	n += 2
 	n += 2
 	n += 2
+	l = len(m.PinnedChartVersion)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9222,6 +9501,30 @@ func (m *OrphanedResourcesMonitorSettings) Size() (n int) {
 	return n
 }
 
+func (m *OverrideHealthConditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConditionType)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StatusTrue)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StatusFalse)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.StatusUnknown)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.ProgressingReasons) > 0 {
+		for _, s := range m.ProgressingReasons {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 2
+	return n
+}
+
 func (m *OverrideIgnoreDiff) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9517,6 +9820,8 @@ func (m *ResourceDiff) Size() (n int) {
 	l = len(m.ResourceVersion)
 	n += 1 + l + sovGenerated(uint64(l))
 	n += 2
+	l = len(m.DiffStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9660,6 +9965,11 @@ func (m *ResourceOverride) Size() (n int) {
 		}
 	}
 	n += 2
+	n += 2
+	if m.HealthConditions != nil {
+		l = m.HealthConditions.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9805,6 +10115,10 @@ func (m *SignatureKey) Size() (n int) {
 	_ = l
 	l = len(m.KeyID)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Issuer)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9930,6 +10244,12 @@ func (m *SyncStatus) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Revision)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TagSignatureInfo)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChartVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ChartVersionConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10120,6 +10440,11 @@ func (this *AppProjectSpec) String() string {
 		repeatedStringForClusterResourceBlacklist += fmt.Sprintf("%v", f) + ","
 	}
 	repeatedStringForClusterResourceBlacklist += "}"
+	repeatedStringForDestinationServiceAccounts := "[]ApplicationDestinationServiceAccount{"
+	for _, f := range this.DestinationServiceAccounts {
+		repeatedStringForDestinationServiceAccounts += strings.Replace(strings.Replace(f.String(), "ApplicationDestinationServiceAccount", "ApplicationDestinationServiceAccount", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDestinationServiceAccounts += "}"
 	s := strings.Join([]string{`&AppProjectSpec{`,
 		`SourceRepos:` + fmt.Sprintf("%v", this.SourceRepos) + `,`,
 		`Destinations:` + repeatedStringForDestinations + `,`,
@@ -10132,6 +10457,8 @@ func (this *AppProjectSpec) String() string {
 		`NamespaceResourceWhitelist:` + repeatedStringForNamespaceResourceWhitelist + `,`,
 		`SignatureKeys:` + repeatedStringForSignatureKeys + `,`,
 		`ClusterResourceBlacklist:` + repeatedStringForClusterResourceBlacklist + `,`,
+		`SignaturePolicy:` + fmt.Sprintf("%v", this.SignaturePolicy) + `,`,
+		`DestinationServiceAccounts:` + repeatedStringForDestinationServiceAccounts + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10193,6 +10520,18 @@ func (this *ApplicationDestination) String() string {
 	}, "")
 	return s
 }
+func (this *ApplicationDestinationServiceAccount) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApplicationDestinationServiceAccount{`,
+		`Server:` + fmt.Sprintf("%v", this.Server) + `,`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`DefaultServiceAccount:` + fmt.Sprintf("%v", this.DefaultServiceAccount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ApplicationList) String() string {
 	if this == nil {
 		return "nil"
@@ -10263,6 +10602,7 @@ func (this *ApplicationSourceHelm) String() string {
 		`PassCredentials:` + fmt.Sprintf("%v", this.PassCredentials) + `,`,
 		`IgnoreMissingValueFiles:` + fmt.Sprintf("%v", this.IgnoreMissingValueFiles) + `,`,
 		`SkipCrds:` + fmt.Sprintf("%v", this.SkipCrds) + `,`,
+		`PinnedChartVersion:` + fmt.Sprintf("%v", this.PinnedChartVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -10917,6 +11257,21 @@ func (this *OrphanedResourcesMonitorSettings) String() string {
 	}, "")
 	return s
 }
+func (this *OverrideHealthConditions) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OverrideHealthConditions{`,
+		`ConditionType:` + fmt.Sprintf("%v", this.ConditionType) + `,`,
+		`StatusTrue:` + fmt.Sprintf("%v", this.StatusTrue) + `,`,
+		`StatusFalse:` + fmt.Sprintf("%v", this.StatusFalse) + `,`,
+		`StatusUnknown:` + fmt.Sprintf("%v", this.StatusUnknown) + `,`,
+		`ProgressingReasons:` + fmt.Sprintf("%v", this.ProgressingReasons) + `,`,
+		`CheckObservedGeneration:` + fmt.Sprintf("%v", this.CheckObservedGeneration) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *OverrideIgnoreDiff) String() string {
 	if this == nil {
 		return "nil"
@@ -11134,6 +11489,7 @@ func (this *ResourceDiff) String() string {
 		`PredictedLiveState:` + fmt.Sprintf("%v", this.PredictedLiveState) + `,`,
 		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`Modified:` + fmt.Sprintf("%v", this.Modified) + `,`,
+		`DiffStrategy:` + fmt.Sprintf("%v", this.DiffStrategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11240,6 +11596,8 @@ func (this *ResourceOverride) String() string {
 		`Actions:` + fmt.Sprintf("%v", this.Actions) + `,`,
 		`KnownTypeFields:` + repeatedStringForKnownTypeFields + `,`,
 		`UseOpenLibs:` + fmt.Sprintf("%v", this.UseOpenLibs) + `,`,
+		`UseChildren:` + fmt.Sprintf("%v", this.UseChildren) + `,`,
+		`HealthConditions:` + strings.Replace(this.HealthConditions.String(), "OverrideHealthConditions", "OverrideHealthConditions", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11341,6 +11699,8 @@ func (this *SignatureKey) String() string {
 	}
 	s := strings.Join([]string{`&SignatureKey{`,
 		`KeyID:` + fmt.Sprintf("%v", this.KeyID) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11429,6 +11789,9 @@ func (this *SyncStatus) String() string {
 		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
 		`ComparedTo:` + strings.Replace(strings.Replace(this.ComparedTo.String(), "ComparedTo", "ComparedTo", 1), `&`, ``, 1) + `,`,
 		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`TagSignatureInfo:` + fmt.Sprintf("%v", this.TagSignatureInfo) + `,`,
+		`ChartVersion:` + fmt.Sprintf("%v", this.ChartVersion) + `,`,
+		`ChartVersionConstraint:` + fmt.Sprintf("%v", this.ChartVersionConstraint) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12284,6 +12647,72 @@ func (m *AppProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaturePolicy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignaturePolicy = SignaturePolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationServiceAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationServiceAccounts = append(m.DestinationServiceAccounts, ApplicationDestinationServiceAccount{})
+			if err := m.DestinationServiceAccounts[len(m.DestinationServiceAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12942,7 +13371,153 @@ func (m *ApplicationDestination) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationDestinationServiceAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationDestinationServiceAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Server = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultServiceAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DefaultServiceAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13856,6 +14431,38 @@ func (m *ApplicationSourceHelm) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.SkipCrds = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PinnedChartVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PinnedChartVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -20883,7 +21490,251 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Ignore", wireType)
 			}
-			var msglen int
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
+			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverrideHealthConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverrideHealthConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverrideHealthConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusTrue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusTrue = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusFalse", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusFalse = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusUnknown", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusUnknown = github_com_argoproj_gitops_engine_pkg_health.HealthStatusCode(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressingReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressingReasons = append(m.ProgressingReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckObservedGeneration", wireType)
+			}
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20893,26 +21744,12 @@ func (m *OrphanedResourcesMonitorSettings) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Ignore = append(m.Ignore, OrphanedResourceKey{})
-			if err := m.Ignore[len(m.Ignore)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.CheckObservedGeneration = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23789,6 +24626,38 @@ func (m *ResourceDiff) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Modified = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiffStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiffStrategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24991,6 +25860,62 @@ func (m *ResourceOverride) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UseOpenLibs = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseChildren", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UseChildren = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthConditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HealthConditions == nil {
+				m.HealthConditions = &OverrideHealthConditions{}
+			}
+			if err := m.HealthConditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26522,6 +27447,70 @@ func (m *SignatureKey) Unmarshal(dAtA []byte) error {
 			}
 			m.KeyID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -27552,6 +28541,102 @@ func (m *SyncStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TagSignatureInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TagSignatureInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChartVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartVersionConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChartVersionConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -245,6 +245,9 @@ type ApplicationSourceHelm struct {
 	IgnoreMissingValueFiles bool `json:"ignoreMissingValueFiles,omitempty" protobuf:"bytes,8,opt,name=ignoreMissingValueFiles"`
 	// SkipCrds skips custom resource definition installation step (Helm's --skip-crds)
 	SkipCrds bool `json:"skipCrds,omitempty" protobuf:"bytes,9,opt,name=skipCrds"`
+	// PinnedChartVersion pins the chart to an exact version while the target revision is a version constraint. Newer
+	// versions satisfying the constraint are reported by the ChartUpdateAvailable condition instead of being deployed.
+	PinnedChartVersion string `json:"pinnedChartVersion,omitempty" protobuf:"bytes,10,opt,name=pinnedChartVersion"`
 }
 
 // HelmParameter is a parameter that's passed to helm template during manifest generation
@@ -326,7 +329,7 @@ func (in *ApplicationSourceHelm) AddFileParameter(p HelmFileParameter) {
 
 // IsZero Returns true if the Helm options in an application source are considered zero
 func (h *ApplicationSourceHelm) IsZero() bool {
-	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds
+	return h == nil || (h.Version == "") && (h.ReleaseName == "") && len(h.ValueFiles) == 0 && len(h.Parameters) == 0 && len(h.FileParameters) == 0 && h.Values == "" && !h.PassCredentials && !h.IgnoreMissingValueFiles && !h.SkipCrds && h.PinnedChartVersion == ""
 }
 
 // KustomizeImage represents a Kustomize image definition in the format [old_image_name=]<image_name>:<image_tag>
@@ -828,7 +831,7 @@ type RevisionMetadata struct {
 	// Message contains the message associated with the revision, most likely the commit message.
 	// The message is truncated to the first newline or 64 characters (which ever comes first)
 	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
-	// SignatureInfo contains a hint on the signer if the revision was signed with GPG, and signature verification is enabled.
+	// SignatureInfo contains a hint on the signer if the revision was signed with GPG, SSH or gitsign, and signature verification is enabled.
 	SignatureInfo string `json:"signatureInfo,omitempty" protobuf:"bytes,5,opt,name=signatureInfo"`
 }
 
@@ -978,6 +981,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionDiffStrategyWarning indicates that application resources could not be compared with the selected diff strategy
+	ApplicationConditionDiffStrategyWarning = "DiffStrategyWarning"
+	// ApplicationConditionChartUpdateAvailable indicates that a newer version of a pinned Helm chart satisfies the version constraint
+	ApplicationConditionChartUpdateAvailable = "ChartUpdateAvailable"
 )
 
 // ApplicationCondition contains details about an application condition, which is usally an error or warning
@@ -1006,6 +1013,14 @@ type SyncStatus struct {
 	ComparedTo ComparedTo `json:"comparedTo,omitempty" protobuf:"bytes,2,opt,name=comparedTo"`
 	// Revision contains information about the revision the comparison has been performed to
 	Revision string `json:"revision,omitempty" protobuf:"bytes,3,opt,name=revision"`
+	// TagSignatureInfo contains a hint on the signer of the annotated tag the comparison has been performed to, if the
+	// project requires signed tags
+	TagSignatureInfo string `json:"tagSignatureInfo,omitempty" protobuf:"bytes,4,opt,name=tagSignatureInfo"`
+	// ChartVersion is the version of the Helm chart the comparison has been performed to
+	ChartVersion string `json:"chartVersion,omitempty" protobuf:"bytes,5,opt,name=chartVersion"`
+	// ChartVersionConstraint is the version constraint the chart version has been resolved from, if the target
+	// revision of the Helm chart is not an exact version
+	ChartVersionConstraint string `json:"chartVersionConstraint,omitempty" protobuf:"bytes,6,opt,name=chartVersionConstraint"`
 }
 
 // HealthStatus contains information about the currently observed health state of an application or resource
@@ -1204,6 +1219,8 @@ type ResourceDiff struct {
 	PredictedLiveState string `json:"predictedLiveState,omitempty" protobuf:"bytes,10,opt,name=predictedLiveState"`
 	ResourceVersion    string `json:"resourceVersion,omitempty" protobuf:"bytes,11,opt,name=resourceVersion"`
 	Modified           bool   `json:"modified,omitempty" protobuf:"bytes,12,opt,name=modified"`
+	// DiffStrategy is the strategy used to calculate the normalized and the predicted live state
+	DiffStrategy string `json:"diffStrategy,omitempty" protobuf:"bytes,13,opt,name=diffStrategy"`
 }
 
 // FullName returns full name of a node that was used for diffing in the format "group/kind/namespace/name"
@@ -1436,6 +1453,8 @@ type OverrideIgnoreDiff struct {
 type rawResourceOverride struct {
 	HealthLua         string           `json:"health.lua,omitempty"`
 	UseOpenLibs       bool             `json:"health.lua.useOpenLibs,omitempty"`
+	UseChildren       bool             `json:"health.lua.useChildren,omitempty"`
+	HealthConditions  string           `json:"health.conditions,omitempty"`
 	Actions           string           `json:"actions,omitempty"`
 	IgnoreDifferences string           `json:"ignoreDifferences,omitempty"`
 	KnownTypeFields   []KnownTypeField `json:"knownTypeFields,omitempty"`
@@ -1444,11 +1463,13 @@ type rawResourceOverride struct {
 // ResourceOverride holds configuration to customize resource diffing and health assessment
 // TODO: describe the members of this type
 type ResourceOverride struct {
-	HealthLua         string             `protobuf:"bytes,1,opt,name=healthLua"`
-	UseOpenLibs       bool               `protobuf:"bytes,5,opt,name=useOpenLibs"`
-	Actions           string             `protobuf:"bytes,3,opt,name=actions"`
-	IgnoreDifferences OverrideIgnoreDiff `protobuf:"bytes,2,opt,name=ignoreDifferences"`
-	KnownTypeFields   []KnownTypeField   `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	HealthLua         string                    `protobuf:"bytes,1,opt,name=healthLua"`
+	UseOpenLibs       bool                      `protobuf:"bytes,5,opt,name=useOpenLibs"`
+	UseChildren       bool                      `protobuf:"bytes,6,opt,name=useChildren"`
+	HealthConditions  *OverrideHealthConditions `protobuf:"bytes,7,opt,name=healthConditions"`
+	Actions           string                    `protobuf:"bytes,3,opt,name=actions"`
+	IgnoreDifferences OverrideIgnoreDiff        `protobuf:"bytes,2,opt,name=ignoreDifferences"`
+	KnownTypeFields   []KnownTypeField          `protobuf:"bytes,4,opt,name=knownTypeFields"`
 }
 
 // TODO: describe this method
@@ -1460,7 +1481,15 @@ func (s *ResourceOverride) UnmarshalJSON(data []byte) error {
 	s.KnownTypeFields = raw.KnownTypeFields
 	s.HealthLua = raw.HealthLua
 	s.UseOpenLibs = raw.UseOpenLibs
+	s.UseChildren = raw.UseChildren
 	s.Actions = raw.Actions
+	s.HealthConditions = nil
+	if raw.HealthConditions != "" {
+		s.HealthConditions = &OverrideHealthConditions{}
+		if err := yaml.Unmarshal([]byte(raw.HealthConditions), s.HealthConditions); err != nil {
+			return err
+		}
+	}
 	return yaml.Unmarshal([]byte(raw.IgnoreDifferences), &s.IgnoreDifferences)
 }
 
@@ -1470,7 +1499,14 @@ func (s ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.Actions, string(ignoreDifferencesData), s.KnownTypeFields}
+	var healthConditionsData []byte
+	if s.HealthConditions != nil {
+		healthConditionsData, err = yaml.Marshal(s.HealthConditions)
+		if err != nil {
+			return nil, err
+		}
+	}
+	raw := &rawResourceOverride{s.HealthLua, s.UseOpenLibs, s.UseChildren, string(healthConditionsData), s.Actions, string(ignoreDifferencesData), s.KnownTypeFields}
 	return json.Marshal(raw)
 }
 
@@ -1484,6 +1520,62 @@ func (o *ResourceOverride) GetActions() (ResourceActions, error) {
 	return actions, nil
 }
 
+// OverrideHealthConditions assesses the health of a resource from a condition in its status, as an alternative to a
+// health script for resources following the status.conditions convention
+type OverrideHealthConditions struct {
+	// ConditionType is the type of the condition which reflects the health of the resource. Defaults to Ready.
+	ConditionType string `json:"conditionType,omitempty" protobuf:"bytes,1,opt,name=conditionType"`
+	// StatusTrue is the health status of the resource if the condition is True. Defaults to Healthy.
+	StatusTrue health.HealthStatusCode `json:"statusTrue,omitempty" protobuf:"bytes,2,opt,name=statusTrue"`
+	// StatusFalse is the health status of the resource if the condition is False. Defaults to Degraded.
+	StatusFalse health.HealthStatusCode `json:"statusFalse,omitempty" protobuf:"bytes,3,opt,name=statusFalse"`
+	// StatusUnknown is the health status of the resource if the condition is Unknown. Defaults to Progressing.
+	StatusUnknown health.HealthStatusCode `json:"statusUnknown,omitempty" protobuf:"bytes,4,opt,name=statusUnknown"`
+	// ProgressingReasons are reasons of the condition for which the resource is considered Progressing, unless the
+	// condition is True
+	ProgressingReasons []string `json:"progressingReasons,omitempty" protobuf:"bytes,5,rep,name=progressingReasons"`
+	// CheckObservedGeneration considers the resource Progressing as long as its observed generation, taken from the
+	// condition or from status.observedGeneration, is behind its generation
+	CheckObservedGeneration bool `json:"checkObservedGeneration,omitempty" protobuf:"varint,6,opt,name=checkObservedGeneration"`
+}
+
+// OverrideKeyMatches returns whether a resource override key matches the given group and kind. Keys are in the format
+// <group>/<kind> or <kind>, where both group and kind may be glob patterns, e.g. '*.crossplane.io/*'.
+func OverrideKeyMatches(key string, gk schema.GroupKind) bool {
+	group, kind := "", key
+	if i := strings.Index(key, "/"); i >= 0 {
+		group, kind = key[:i], key[i+1:]
+	}
+	groupMatches, err := filepath.Match(group, gk.Group)
+	if err != nil || !groupMatches {
+		return false
+	}
+	kindMatches, err := filepath.Match(kind, gk.Kind)
+	return err == nil && kindMatches
+}
+
+// SortOverrideKeys sorts resource override keys from the most to the least specific. Keys without patterns come first,
+// followed by patterns with more literal characters. Keys of the same specificity are sorted alphabetically.
+func SortOverrideKeys(keys []string) {
+	literals := func(key string) int {
+		return len(key) - strings.Count(key, "*") - strings.Count(key, "?")
+	}
+	sort.SliceStable(keys, func(i, j int) bool {
+		iPattern, jPattern := isOverrideKeyPattern(keys[i]), isOverrideKeyPattern(keys[j])
+		if iPattern != jPattern {
+			return !iPattern
+		}
+		if li, lj := literals(keys[i]), literals(keys[j]); li != lj {
+			return li > lj
+		}
+		return keys[i] < keys[j]
+	})
+}
+
+func isOverrideKeyPattern(key string) bool {
+	return strings.ContainsAny(key, "*?[")
+}
+
 // TODO: describe this type
 // TODO: describe members of this type
 type ResourceActions struct {
@@ -1528,6 +1620,8 @@ var validActions = map[string]bool{
 
 var validActionPatterns = []*regexp.Regexp{
 	regexp.MustCompile("action/.*"),
+	regexp.MustCompile("update/.*"),
+	regexp.MustCompile("delete/.*"),
 }
 
 func isValidAction(action string) bool {
@@ -1641,8 +1735,12 @@ func (s *OrphanedResourcesMonitorSettings) IsWarn() bool {
 
 // SignatureKey is the specification of a key required to verify commit signatures with
 type SignatureKey struct {
-	// The ID of the key in hexadecimal notation
+	// The ID of the key in hexadecimal notation for GnuPG keys, the SHA256 fingerprint for SSH keys or the signer identity for gitsign
 	KeyID string `json:"keyID" protobuf:"bytes,1,name=keyID"`
+	// Type of the key, one of gpg (default), ssh or gitsign
+	Type string `json:"type,omitempty" protobuf:"bytes,2,opt,name=type"`
+	// Issuer is the OIDC issuer of the certificates of a gitsign signer, e.g. https://accounts.google.com. Required for gitsign keys.
+	Issuer string `json:"issuer,omitempty" protobuf:"bytes,3,opt,name=issuer"`
 }
 
 // AppProjectSpec is the specification of an AppProject
@@ -1665,10 +1763,49 @@ type AppProjectSpec struct {
 	SyncWindows SyncWindows `json:"syncWindows,omitempty" protobuf:"bytes,8,opt,name=syncWindows"`
 	// NamespaceResourceWhitelist contains list of whitelisted namespace level resources
 	NamespaceResourceWhitelist []metav1.GroupKind `json:"namespaceResourceWhitelist,omitempty" protobuf:"bytes,9,opt,name=namespaceResourceWhitelist"`
-	// SignatureKeys contains a list of PGP key IDs that commits in Git must be signed with in order to be allowed for sync
+	// SignatureKeys contains a list of keys that commits in Git must be signed with in order to be allowed for sync
 	SignatureKeys []SignatureKey `json:"signatureKeys,omitempty" protobuf:"bytes,10,opt,name=signatureKeys"`
 	// ClusterResourceBlacklist contains list of blacklisted cluster level resources
 	ClusterResourceBlacklist []metav1.GroupKind `json:"clusterResourceBlacklist,omitempty" protobuf:"bytes,11,opt,name=clusterResourceBlacklist"`
+	// SignaturePolicy defines which Git objects must be signed with one of the SignatureKeys: commit, tag or both.
+	// If unset, the annotated tag is verified if the target revision points to one, and the commit otherwise.
+	SignaturePolicy SignaturePolicy `json:"signaturePolicy,omitempty" protobuf:"bytes,12,opt,name=signaturePolicy,casttype=SignaturePolicy"`
+	// DestinationServiceAccounts holds the service accounts impersonated when syncing to the matching destinations.
+	// The first matching entry is used. Impersonation must be enabled in argocd-cm.
+	DestinationServiceAccounts []ApplicationDestinationServiceAccount `json:"destinationServiceAccounts,omitempty" protobuf:"bytes,13,rep,name=destinationServiceAccounts"`
+}
+
+// ApplicationDestinationServiceAccount holds the service account impersonated when syncing resources to a destination
+type ApplicationDestinationServiceAccount struct {
+	// Server specifies the URL of the target cluster's Kubernetes control plane API. Glob patterns are supported.
+	Server string `json:"server" protobuf:"bytes,1,opt,name=server"`
+	// Namespace specifies the target namespace of the application. Glob patterns are supported.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// DefaultServiceAccount is the name of the service account to impersonate. It is looked up in the application
+	// destination namespace unless prefixed with a namespace, as in <namespace>:<name>.
+	DefaultServiceAccount string `json:"defaultServiceAccount" protobuf:"bytes,3,opt,name=defaultServiceAccount"`
+}
+
+// SignaturePolicy defines which Git objects must be signed
+type SignaturePolicy string
+
+const (
+	// SignaturePolicyCommit requires the commit the target revision resolves to to be signed
+	SignaturePolicyCommit SignaturePolicy = "commit"
+	// SignaturePolicyTag requires the target revision to be an annotated tag, which must be signed
+	SignaturePolicyTag SignaturePolicy = "tag"
+	// SignaturePolicyBoth requires the target revision to be a signed annotated tag pointing to a signed commit
+	SignaturePolicyBoth SignaturePolicy = "both"
+)
+
+// RequiresTagSignature returns true if the target revision must be a signed annotated tag
+func (p SignaturePolicy) RequiresTagSignature() bool {
+	return p == SignaturePolicyTag || p == SignaturePolicyBoth
+}
+
+// RequiresCommitSignature returns true if the commit the target revision resolves to must be signed
+func (p SignaturePolicy) RequiresCommitSignature() bool {
+	return p == SignaturePolicyCommit || p == SignaturePolicyBoth
 }
 
 // SyncWindows is a collection of sync windows in this project
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"encoding/json"
 	fmt "fmt"
 	"io/ioutil"
 	"os"
@@ -12,6 +13,7 @@ import (
 	argocdcommon "github.com/argoproj/argo-cd/v2/common"
 	"k8s.io/utils/pointer"
 
+	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -490,6 +492,52 @@ func TestAppProject_ValidateDestinations(t *testing.T) {
 }
 
 // TestValidateRoleName tests for an invalid role name
+func TestAppProject_ValidateDestinationServiceAccounts(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "argocd:sync"},
+	}
+	assert.NoError(t, p.ValidateProject())
+
+	for _, invalid := range []ApplicationDestinationServiceAccount{
+		{Server: "", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "!https://kubernetes.default.svc", Namespace: "*", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "!kube-system", DefaultServiceAccount: "deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: ""},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: ":deployer"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "argocd:"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "deploy er"},
+		{Server: "*", Namespace: "*", DefaultServiceAccount: "deploy*"},
+	} {
+		p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{invalid}
+		assert.Error(t, p.ValidateProject(), "%v", invalid)
+	}
+}
+
+func TestAppProject_GetImpersonatedServiceAccount(t *testing.T) {
+	p := newTestProject()
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{
+		{Server: "https://kubernetes.default.svc", Namespace: "team-*", DefaultServiceAccount: "deployer"},
+		{Server: "https://kubernetes.default.svc", Namespace: "*", DefaultServiceAccount: "argocd:sync"},
+	}
+
+	sa, err := p.GetImpersonatedServiceAccount("https://kubernetes.default.svc", "team-a")
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:team-a:deployer", sa)
+
+	sa, err = p.GetImpersonatedServiceAccount("https://kubernetes.default.svc", "other")
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:argocd:sync", sa)
+
+	_, err = p.GetImpersonatedServiceAccount("https://remote-cluster", "team-a")
+	assert.ErrorContains(t, err, "no destination service account configured")
+
+	p.Spec.DestinationServiceAccounts = []ApplicationDestinationServiceAccount{{Server: "*", Namespace: "*", DefaultServiceAccount: "deployer"}}
+	_, err = p.GetImpersonatedServiceAccount("https://kubernetes.default.svc", "")
+	assert.ErrorContains(t, err, "must be specified")
+}
+
 func TestAppProject_ValidateRoleName(t *testing.T) {
 	p := newTestProject()
 	err := p.ValidateProject()
@@ -634,6 +682,9 @@ func TestAppProject_ValidPolicyRules(t *testing.T) {
 		"p, proj:my-proj:my-role, applications, delete, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/*, my-proj/foo, allow",
 		"p, proj:my-proj:my-role, applications, action/apps/Deployment/restart, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, delete/Pod/*, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, update/apps/Deployment/*, my-proj/foo, allow",
+		"p, proj:my-proj:my-role, applications, delete/Secret/*, my-proj/foo, deny",
 	}
 	for _, good := range goodPolicies {
 		p.Spec.Roles[0].Policies = []string{good}
@@ -2884,3 +2935,40 @@ func TestGetCAPath(t *testing.T) {
 		assert.Empty(t, path)
 	}
 }
+
+func TestOverrideKeyMatches(t *testing.T) {
+	vpc := schema.GroupKind{Group: "ec2.aws.crossplane.io", Kind: "VPC"}
+	assert.True(t, OverrideKeyMatches("ec2.aws.crossplane.io/VPC", vpc))
+	assert.True(t, OverrideKeyMatches("*.crossplane.io/*", vpc))
+	assert.True(t, OverrideKeyMatches("*/*", vpc))
+	assert.False(t, OverrideKeyMatches("*.crossplane.io/Subnet", vpc))
+	assert.False(t, OverrideKeyMatches("VPC", vpc))
+
+	configMap := schema.GroupKind{Kind: "ConfigMap"}
+	assert.True(t, OverrideKeyMatches("ConfigMap", configMap))
+	assert.True(t, OverrideKeyMatches("*", configMap))
+	assert.True(t, OverrideKeyMatches("*/*", configMap))
+}
+
+func TestSortOverrideKeys(t *testing.T) {
+	keys := []string{"*/*", "*.crossplane.io/*", "apps/Deployment", "ec2.aws.crossplane.io/*", "*.crossplane.io/VPC", "Service"}
+	SortOverrideKeys(keys)
+	assert.Equal(t, []string{"apps/Deployment", "Service", "ec2.aws.crossplane.io/*", "*.crossplane.io/VPC", "*.crossplane.io/*", "*/*"}, keys)
+}
+
+func TestResourceOverrideHealthConditions(t *testing.T) {
+	override := ResourceOverride{}
+	err := json.Unmarshal([]byte(`{"health.conditions": "conditionType: Available\nstatusFalse: Progressing\n"}`), &override)
+	assert.NoError(t, err)
+	assert.Equal(t, &OverrideHealthConditions{ConditionType: "Available", StatusFalse: health.HealthStatusProgressing}, override.HealthConditions)
+
+	data, err := json.Marshal(override)
+	assert.NoError(t, err)
+	roundTripped := ResourceOverride{}
+	assert.NoError(t, json.Unmarshal(data, &roundTripped))
+	assert.Equal(t, override, roundTripped)
+
+	data, err = json.Marshal(ResourceOverride{})
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "health.conditions")
+}
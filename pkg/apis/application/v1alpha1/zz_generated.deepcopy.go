@@ -149,6 +149,11 @@ func (in *AppProjectSpec) DeepCopyInto(out *AppProjectSpec) {
 		*out = make([]v1.GroupKind, len(*in))
 		copy(*out, *in)
 	}
+	if in.DestinationServiceAccounts != nil {
+		in, out := &in.DestinationServiceAccounts, &out.DestinationServiceAccounts
+		*out = make([]ApplicationDestinationServiceAccount, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -254,6 +259,22 @@ func (in *ApplicationDestination) DeepCopy() *ApplicationDestination {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationDestinationServiceAccount) DeepCopyInto(out *ApplicationDestinationServiceAccount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationDestinationServiceAccount.
+func (in *ApplicationDestinationServiceAccount) DeepCopy() *ApplicationDestinationServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationDestinationServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationList) DeepCopyInto(out *ApplicationList) {
 	*out = *in
@@ -1372,6 +1393,27 @@ func (in *OrphanedResourcesMonitorSettings) DeepCopy() *OrphanedResourcesMonitor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideHealthConditions) DeepCopyInto(out *OverrideHealthConditions) {
+	*out = *in
+	if in.ProgressingReasons != nil {
+		in, out := &in.ProgressingReasons, &out.ProgressingReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideHealthConditions.
+func (in *OverrideHealthConditions) DeepCopy() *OverrideHealthConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideHealthConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverrideIgnoreDiff) DeepCopyInto(out *OverrideIgnoreDiff) {
 	*out = *in
@@ -1805,6 +1847,11 @@ func (in *ResourceNode) DeepCopy() *ResourceNode {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 	*out = *in
+	if in.HealthConditions != nil {
+		in, out := &in.HealthConditions, &out.HealthConditions
+		*out = new(OverrideHealthConditions)
+		(*in).DeepCopyInto(*out)
+	}
 	in.IgnoreDifferences.DeepCopyInto(&out.IgnoreDifferences)
 	if in.KnownTypeFields != nil {
 		in, out := &in.KnownTypeFields, &out.KnownTypeFields
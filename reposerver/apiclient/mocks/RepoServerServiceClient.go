@@ -19,6 +19,36 @@ type RepoServerServiceClient struct {
 	mock.Mock
 }
 
+// EvictCheckouts provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) EvictCheckouts(ctx context.Context, in *apiclient.EvictCheckoutsRequest, opts ...grpc.CallOption) (*apiclient.EvictCheckoutsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.EvictCheckoutsResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.EvictCheckoutsRequest, ...grpc.CallOption) *apiclient.EvictCheckoutsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.EvictCheckoutsResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.EvictCheckoutsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GenerateManifest provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) GenerateManifest(ctx context.Context, in *apiclient.ManifestRequest, opts ...grpc.CallOption) (*apiclient.ManifestResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -49,6 +79,36 @@ func (_m *RepoServerServiceClient) GenerateManifest(ctx context.Context, in *api
 	return r0, r1
 }
 
+// GenerateManifestWithFiles provides a mock function with given fields: ctx, opts
+func (_m *RepoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (apiclient.RepoServerService_GenerateManifestWithFilesClient, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 apiclient.RepoServerService_GenerateManifestWithFilesClient
+	if rf, ok := ret.Get(0).(func(context.Context, ...grpc.CallOption) apiclient.RepoServerService_GenerateManifestWithFilesClient); ok {
+		r0 = rf(ctx, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apiclient.RepoServerService_GenerateManifestWithFilesClient)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAppDetails provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) GetAppDetails(ctx context.Context, in *apiclient.RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*apiclient.RepoAppDetailsResponse, error) {
 	_va := make([]interface{}, len(opts))
@@ -169,6 +229,36 @@ func (_m *RepoServerServiceClient) ListApps(ctx context.Context, in *apiclient.L
 	return r0, r1
 }
 
+// ListCheckouts provides a mock function with given fields: ctx, in, opts
+func (_m *RepoServerServiceClient) ListCheckouts(ctx context.Context, in *apiclient.ListCheckoutsRequest, opts ...grpc.CallOption) (*apiclient.CheckoutList, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *apiclient.CheckoutList
+	if rf, ok := ret.Get(0).(func(context.Context, *apiclient.ListCheckoutsRequest, ...grpc.CallOption) *apiclient.CheckoutList); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.CheckoutList)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *apiclient.ListCheckoutsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListRefs provides a mock function with given fields: ctx, in, opts
 func (_m *RepoServerServiceClient) ListRefs(ctx context.Context, in *apiclient.ListRefsRequest, opts ...grpc.CallOption) (*apiclient.Refs, error) {
 	_va := make([]interface{}, len(opts))
@@ -12,6 +12,7 @@ import (
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 	math_bits "math/bits"
 )
@@ -44,15 +45,19 @@ type ManifestRequest struct {
 	KubeVersion       string                             `protobuf:"bytes,14,opt,name=kubeVersion,proto3" json:"kubeVersion,omitempty"`
 	ApiVersions       []string                           `protobuf:"bytes,15,rep,name=apiVersions,proto3" json:"apiVersions,omitempty"`
 	// Request to verify the signature when generating the manifests (only for Git repositories)
-	VerifySignature      bool                  `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
-	HelmRepoCreds        []*v1alpha1.RepoCreds `protobuf:"bytes,17,rep,name=helmRepoCreds,proto3" json:"helmRepoCreds,omitempty"`
-	NoRevisionCache      bool                  `protobuf:"varint,18,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
-	TrackingMethod       string                `protobuf:"bytes,19,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
-	EnabledSourceTypes   map[string]bool       `protobuf:"bytes,20,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	HelmOptions          *v1alpha1.HelmOptions `protobuf:"bytes,21,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+	VerifySignature    bool                  `protobuf:"varint,16,opt,name=verifySignature,proto3" json:"verifySignature,omitempty"`
+	HelmRepoCreds      []*v1alpha1.RepoCreds `protobuf:"bytes,17,rep,name=helmRepoCreds,proto3" json:"helmRepoCreds,omitempty"`
+	NoRevisionCache    bool                  `protobuf:"varint,18,opt,name=noRevisionCache,proto3" json:"noRevisionCache,omitempty"`
+	TrackingMethod     string                `protobuf:"bytes,19,opt,name=trackingMethod,proto3" json:"trackingMethod,omitempty"`
+	EnabledSourceTypes map[string]bool       `protobuf:"bytes,20,rep,name=enabledSourceTypes,proto3" json:"enabledSourceTypes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	HelmOptions        *v1alpha1.HelmOptions `protobuf:"bytes,21,opt,name=helmOptions,proto3" json:"helmOptions,omitempty"`
+	// Which Git objects to verify the signature of, if verifySignature is set (commit, tag or both)
+	SignaturePolicy string `protobuf:"bytes,22,opt,name=signaturePolicy,proto3" json:"signaturePolicy,omitempty"`
+	// Keys the signatures must be made with, used to validate the certificate claims of gitsign signatures
+	SignatureKeys        []*v1alpha1.SignatureKey `protobuf:"bytes,23,rep,name=signatureKeys,proto3" json:"signatureKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
 func (m *ManifestRequest) Reset()         { *m = ManifestRequest{} }
@@ -214,6 +219,228 @@ func (m *ManifestRequest) GetHelmOptions() *v1alpha1.HelmOptions {
 	return nil
 }
 
+func (m *ManifestRequest) GetSignaturePolicy() string {
+	if m != nil {
+		return m.SignaturePolicy
+	}
+	return ""
+}
+
+func (m *ManifestRequest) GetSignatureKeys() []*v1alpha1.SignatureKey {
+	if m != nil {
+		return m.SignatureKeys
+	}
+	return nil
+}
+
+// ManifestRequestWithFiles is a message of a stream used to generate manifests from local files. The stream starts
+// with the request, followed by the metadata of the tgz file and its chunks.
+type ManifestRequestWithFiles struct {
+	// Types that are valid to be assigned to Part:
+	//	*ManifestRequestWithFiles_Request
+	//	*ManifestRequestWithFiles_Metadata
+	//	*ManifestRequestWithFiles_Chunk
+	Part                 isManifestRequestWithFiles_Part `protobuf_oneof:"part"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
+}
+
+func (m *ManifestRequestWithFiles) Reset()         { *m = ManifestRequestWithFiles{} }
+func (m *ManifestRequestWithFiles) String() string { return proto.CompactTextString(m) }
+func (*ManifestRequestWithFiles) ProtoMessage()    {}
+func (*ManifestRequestWithFiles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{1}
+}
+func (m *ManifestRequestWithFiles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestRequestWithFiles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestRequestWithFiles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestRequestWithFiles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestRequestWithFiles.Merge(m, src)
+}
+func (m *ManifestRequestWithFiles) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestRequestWithFiles) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestRequestWithFiles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestRequestWithFiles proto.InternalMessageInfo
+
+type isManifestRequestWithFiles_Part interface {
+	isManifestRequestWithFiles_Part()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ManifestRequestWithFiles_Request struct {
+	Request *ManifestRequest `protobuf:"bytes,1,opt,name=request,proto3,oneof" json:"request,omitempty"`
+}
+type ManifestRequestWithFiles_Metadata struct {
+	Metadata *ManifestFileMetadata `protobuf:"bytes,2,opt,name=metadata,proto3,oneof" json:"metadata,omitempty"`
+}
+type ManifestRequestWithFiles_Chunk struct {
+	Chunk *ManifestFileChunk `protobuf:"bytes,3,opt,name=chunk,proto3,oneof" json:"chunk,omitempty"`
+}
+
+func (*ManifestRequestWithFiles_Request) isManifestRequestWithFiles_Part()  {}
+func (*ManifestRequestWithFiles_Metadata) isManifestRequestWithFiles_Part() {}
+func (*ManifestRequestWithFiles_Chunk) isManifestRequestWithFiles_Part()    {}
+
+func (m *ManifestRequestWithFiles) GetPart() isManifestRequestWithFiles_Part {
+	if m != nil {
+		return m.Part
+	}
+	return nil
+}
+
+func (m *ManifestRequestWithFiles) GetRequest() *ManifestRequest {
+	if x, ok := m.GetPart().(*ManifestRequestWithFiles_Request); ok {
+		return x.Request
+	}
+	return nil
+}
+
+func (m *ManifestRequestWithFiles) GetMetadata() *ManifestFileMetadata {
+	if x, ok := m.GetPart().(*ManifestRequestWithFiles_Metadata); ok {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (m *ManifestRequestWithFiles) GetChunk() *ManifestFileChunk {
+	if x, ok := m.GetPart().(*ManifestRequestWithFiles_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ManifestRequestWithFiles) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ManifestRequestWithFiles_Request)(nil),
+		(*ManifestRequestWithFiles_Metadata)(nil),
+		(*ManifestRequestWithFiles_Chunk)(nil),
+	}
+}
+
+// ManifestFileMetadata defines the metadata of the tgz file streamed to generate manifests
+type ManifestFileMetadata struct {
+	// checksum is used to verify the integrity of the file
+	Checksum string `protobuf:"bytes,1,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// size relates to the file size in bytes
+	Size_                int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestFileMetadata) Reset()         { *m = ManifestFileMetadata{} }
+func (m *ManifestFileMetadata) String() string { return proto.CompactTextString(m) }
+func (*ManifestFileMetadata) ProtoMessage()    {}
+func (*ManifestFileMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{2}
+}
+func (m *ManifestFileMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestFileMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestFileMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestFileMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestFileMetadata.Merge(m, src)
+}
+func (m *ManifestFileMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestFileMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestFileMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestFileMetadata proto.InternalMessageInfo
+
+func (m *ManifestFileMetadata) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *ManifestFileMetadata) GetSize_() int64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+type ManifestFileChunk struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestFileChunk) Reset()         { *m = ManifestFileChunk{} }
+func (m *ManifestFileChunk) String() string { return proto.CompactTextString(m) }
+func (*ManifestFileChunk) ProtoMessage()    {}
+func (*ManifestFileChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{3}
+}
+func (m *ManifestFileChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestFileChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestFileChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ManifestFileChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestFileChunk.Merge(m, src)
+}
+func (m *ManifestFileChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestFileChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestFileChunk.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestFileChunk proto.InternalMessageInfo
+
+func (m *ManifestFileChunk) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
 // TestRepositoryRequest is a query to test repository is valid or not and has valid access.
 type TestRepositoryRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
@@ -226,7 +453,7 @@ func (m *TestRepositoryRequest) Reset()         { *m = TestRepositoryRequest{} }
 func (m *TestRepositoryRequest) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryRequest) ProtoMessage()    {}
 func (*TestRepositoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{1}
+	return fileDescriptor_dd8723cfcc820480, []int{4}
 }
 func (m *TestRepositoryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +502,7 @@ func (m *TestRepositoryResponse) Reset()         { *m = TestRepositoryResponse{}
 func (m *TestRepositoryResponse) String() string { return proto.CompactTextString(m) }
 func (*TestRepositoryResponse) ProtoMessage()    {}
 func (*TestRepositoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{2}
+	return fileDescriptor_dd8723cfcc820480, []int{5}
 }
 func (m *TestRepositoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +552,7 @@ func (m *ResolveRevisionRequest) Reset()         { *m = ResolveRevisionRequest{}
 func (m *ResolveRevisionRequest) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionRequest) ProtoMessage()    {}
 func (*ResolveRevisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{3}
+	return fileDescriptor_dd8723cfcc820480, []int{6}
 }
 func (m *ResolveRevisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -389,7 +616,7 @@ func (m *ResolveRevisionResponse) Reset()         { *m = ResolveRevisionResponse
 func (m *ResolveRevisionResponse) String() string { return proto.CompactTextString(m) }
 func (*ResolveRevisionResponse) ProtoMessage()    {}
 func (*ResolveRevisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{4}
+	return fileDescriptor_dd8723cfcc820480, []int{7}
 }
 func (m *ResolveRevisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,7 +667,13 @@ type ManifestResponse struct {
 	Revision   string `protobuf:"bytes,4,opt,name=revision,proto3" json:"revision,omitempty"`
 	SourceType string `protobuf:"bytes,6,opt,name=sourceType,proto3" json:"sourceType,omitempty"`
 	// Raw response of git verify-commit operation (always the empty string for Helm)
-	VerifyResult         string   `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
+	VerifyResult string `protobuf:"bytes,7,opt,name=verifyResult,proto3" json:"verifyResult,omitempty"`
+	// Raw response of git verify-tag operation on the unresolved revision, if the signature policy requires signed tags
+	VerifyTagResult string `protobuf:"bytes,8,opt,name=verifyTagResult,proto3" json:"verifyTagResult,omitempty"`
+	// Version constraint the Helm chart version has been resolved from, if the target revision is not an exact version
+	ChartVersionConstraint string `protobuf:"bytes,9,opt,name=chartVersionConstraint,proto3" json:"chartVersionConstraint,omitempty"`
+	// Newest version of a pinned Helm chart satisfying the version constraint, if it is newer than the pinned version
+	ChartUpdateVersion   string   `protobuf:"bytes,10,opt,name=chartUpdateVersion,proto3" json:"chartUpdateVersion,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -450,7 +683,7 @@ func (m *ManifestResponse) Reset()         { *m = ManifestResponse{} }
 func (m *ManifestResponse) String() string { return proto.CompactTextString(m) }
 func (*ManifestResponse) ProtoMessage()    {}
 func (*ManifestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{5}
+	return fileDescriptor_dd8723cfcc820480, []int{8}
 }
 func (m *ManifestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -521,6 +754,27 @@ func (m *ManifestResponse) GetVerifyResult() string {
 	return ""
 }
 
+func (m *ManifestResponse) GetVerifyTagResult() string {
+	if m != nil {
+		return m.VerifyTagResult
+	}
+	return ""
+}
+
+func (m *ManifestResponse) GetChartVersionConstraint() string {
+	if m != nil {
+		return m.ChartVersionConstraint
+	}
+	return ""
+}
+
+func (m *ManifestResponse) GetChartUpdateVersion() string {
+	if m != nil {
+		return m.ChartUpdateVersion
+	}
+	return ""
+}
+
 type ListRefsRequest struct {
 	Repo                 *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
@@ -532,7 +786,7 @@ func (m *ListRefsRequest) Reset()         { *m = ListRefsRequest{} }
 func (m *ListRefsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRefsRequest) ProtoMessage()    {}
 func (*ListRefsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{6}
+	return fileDescriptor_dd8723cfcc820480, []int{9}
 }
 func (m *ListRefsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +835,7 @@ func (m *Refs) Reset()         { *m = Refs{} }
 func (m *Refs) String() string { return proto.CompactTextString(m) }
 func (*Refs) ProtoMessage()    {}
 func (*Refs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{7}
+	return fileDescriptor_dd8723cfcc820480, []int{10}
 }
 func (m *Refs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -638,7 +892,7 @@ func (m *ListAppsRequest) Reset()         { *m = ListAppsRequest{} }
 func (m *ListAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppsRequest) ProtoMessage()    {}
 func (*ListAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{8}
+	return fileDescriptor_dd8723cfcc820480, []int{11}
 }
 func (m *ListAppsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -700,7 +954,7 @@ func (m *AppList) Reset()         { *m = AppList{} }
 func (m *AppList) String() string { return proto.CompactTextString(m) }
 func (*AppList) ProtoMessage()    {}
 func (*AppList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{9}
+	return fileDescriptor_dd8723cfcc820480, []int{12}
 }
 func (m *AppList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,7 +1011,7 @@ func (m *RepoServerAppDetailsQuery) Reset()         { *m = RepoServerAppDetailsQ
 func (m *RepoServerAppDetailsQuery) String() string { return proto.CompactTextString(m) }
 func (*RepoServerAppDetailsQuery) ProtoMessage()    {}
 func (*RepoServerAppDetailsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{10}
+	return fileDescriptor_dd8723cfcc820480, []int{13}
 }
 func (m *RepoServerAppDetailsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +1125,7 @@ func (m *RepoAppDetailsResponse) Reset()         { *m = RepoAppDetailsResponse{}
 func (m *RepoAppDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*RepoAppDetailsResponse) ProtoMessage()    {}
 func (*RepoAppDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{11}
+	return fileDescriptor_dd8723cfcc820480, []int{14}
 }
 func (m *RepoAppDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,7 +1198,7 @@ func (m *RepoServerRevisionMetadataRequest) Reset()         { *m = RepoServerRev
 func (m *RepoServerRevisionMetadataRequest) String() string { return proto.CompactTextString(m) }
 func (*RepoServerRevisionMetadataRequest) ProtoMessage()    {}
 func (*RepoServerRevisionMetadataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{12}
+	return fileDescriptor_dd8723cfcc820480, []int{15}
 }
 func (m *RepoServerRevisionMetadataRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1267,7 @@ func (m *HelmAppSpec) Reset()         { *m = HelmAppSpec{} }
 func (m *HelmAppSpec) String() string { return proto.CompactTextString(m) }
 func (*HelmAppSpec) ProtoMessage()    {}
 func (*HelmAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{13}
+	return fileDescriptor_dd8723cfcc820480, []int{16}
 }
 func (m *HelmAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1090,7 +1344,7 @@ func (m *KustomizeAppSpec) Reset()         { *m = KustomizeAppSpec{} }
 func (m *KustomizeAppSpec) String() string { return proto.CompactTextString(m) }
 func (*KustomizeAppSpec) ProtoMessage()    {}
 func (*KustomizeAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{14}
+	return fileDescriptor_dd8723cfcc820480, []int{17}
 }
 func (m *KustomizeAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1391,7 @@ func (m *DirectoryAppSpec) Reset()         { *m = DirectoryAppSpec{} }
 func (m *DirectoryAppSpec) String() string { return proto.CompactTextString(m) }
 func (*DirectoryAppSpec) ProtoMessage()    {}
 func (*DirectoryAppSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{15}
+	return fileDescriptor_dd8723cfcc820480, []int{18}
 }
 func (m *DirectoryAppSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1177,7 +1431,7 @@ func (m *HelmChartsRequest) Reset()         { *m = HelmChartsRequest{} }
 func (m *HelmChartsRequest) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsRequest) ProtoMessage()    {}
 func (*HelmChartsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{16}
+	return fileDescriptor_dd8723cfcc820480, []int{19}
 }
 func (m *HelmChartsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1225,7 +1479,7 @@ func (m *HelmChart) Reset()         { *m = HelmChart{} }
 func (m *HelmChart) String() string { return proto.CompactTextString(m) }
 func (*HelmChart) ProtoMessage()    {}
 func (*HelmChart) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{17}
+	return fileDescriptor_dd8723cfcc820480, []int{20}
 }
 func (m *HelmChart) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1279,7 +1533,7 @@ func (m *HelmChartsResponse) Reset()         { *m = HelmChartsResponse{} }
 func (m *HelmChartsResponse) String() string { return proto.CompactTextString(m) }
 func (*HelmChartsResponse) ProtoMessage()    {}
 func (*HelmChartsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd8723cfcc820480, []int{18}
+	return fileDescriptor_dd8723cfcc820480, []int{21}
 }
 func (m *HelmChartsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,165 +1569,486 @@ func (m *HelmChartsResponse) GetItems() []*HelmChart {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
-	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
-	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
-	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
-	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
-	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
-	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
-	proto.RegisterType((*Refs)(nil), "repository.Refs")
-	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.ListAppsRequest.EnabledSourceTypesEntry")
-	proto.RegisterType((*AppList)(nil), "repository.AppList")
-	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
-	proto.RegisterType((*RepoServerAppDetailsQuery)(nil), "repository.RepoServerAppDetailsQuery")
-	proto.RegisterMapType((map[string]bool)(nil), "repository.RepoServerAppDetailsQuery.EnabledSourceTypesEntry")
-	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
-	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
-	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
-	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
-	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
-	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
-	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
-	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
+// ListCheckoutsRequest requests the list of repositories checked out by the repo server
+type ListCheckoutsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_dd8723cfcc820480)
+func (m *ListCheckoutsRequest) Reset()         { *m = ListCheckoutsRequest{} }
+func (m *ListCheckoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCheckoutsRequest) ProtoMessage()    {}
+func (*ListCheckoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{22}
 }
-
-var fileDescriptor_dd8723cfcc820480 = []byte{
-	// 1419 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xdd, 0x6f, 0x1b, 0xc5,
-	0x16, 0xcf, 0xda, 0x8e, 0x63, 0x1f, 0xb7, 0x89, 0x33, 0xfd, 0xda, 0xeb, 0x9b, 0x46, 0xe9, 0x5e,
-	0xdd, 0x2a, 0xba, 0xbd, 0xb5, 0xd5, 0x54, 0x02, 0xd4, 0x0a, 0x24, 0x93, 0xb6, 0x29, 0x6a, 0xd3,
-	0x84, 0x4d, 0x85, 0x04, 0x54, 0xa0, 0xf1, 0xfa, 0x64, 0x3d, 0x78, 0x3f, 0xa6, 0xfb, 0x61, 0xe4,
-	0x4a, 0x3c, 0xf0, 0x2f, 0xf0, 0xc0, 0xbf, 0xc2, 0x23, 0x4f, 0x20, 0x1e, 0xe1, 0x3f, 0x40, 0xe5,
-	0x81, 0x7f, 0x03, 0xcd, 0xec, 0xa7, 0xd7, 0x9b, 0xb6, 0xc8, 0x6d, 0x2a, 0x5e, 0x92, 0x99, 0x33,
-	0x33, 0xe7, 0x6b, 0x7e, 0x73, 0xce, 0x6f, 0x0d, 0x57, 0x3d, 0xe4, 0xae, 0x8f, 0xde, 0x04, 0xbd,
-	0x9e, 0x1c, 0xb2, 0xc0, 0xf5, 0xa6, 0xb9, 0x61, 0x97, 0x7b, 0x6e, 0xe0, 0x12, 0xc8, 0x24, 0x9d,
-	0x87, 0x26, 0x0b, 0x46, 0xe1, 0xa0, 0x6b, 0xb8, 0x76, 0x8f, 0x7a, 0xa6, 0xcb, 0x3d, 0xf7, 0x2b,
-	0x39, 0xb8, 0x6e, 0x0c, 0x7b, 0x93, 0x9d, 0x1e, 0x1f, 0x9b, 0x3d, 0xca, 0x99, 0xdf, 0xa3, 0x9c,
-	0x5b, 0xcc, 0xa0, 0x01, 0x73, 0x9d, 0xde, 0xe4, 0x06, 0xb5, 0xf8, 0x88, 0xde, 0xe8, 0x99, 0xe8,
-	0xa0, 0x47, 0x03, 0x1c, 0x46, 0x9a, 0xb5, 0xef, 0x00, 0xd6, 0xf6, 0xa9, 0xc3, 0x8e, 0xd1, 0x0f,
-	0x74, 0x7c, 0x1a, 0xa2, 0x1f, 0x90, 0x27, 0x50, 0x13, 0xf6, 0x54, 0x65, 0x4b, 0xd9, 0x6e, 0xed,
-	0xdc, 0xef, 0x66, 0x06, 0xbb, 0x89, 0x41, 0x39, 0xf8, 0xd2, 0x18, 0x76, 0x27, 0x3b, 0x5d, 0x3e,
-	0x36, 0xbb, 0xc2, 0x60, 0x37, 0x67, 0xb0, 0x9b, 0x18, 0xec, 0xea, 0xa9, 0xe7, 0xba, 0xd4, 0x4a,
-	0x3a, 0xd0, 0xf0, 0x70, 0xc2, 0x7c, 0xe6, 0x3a, 0x6a, 0x65, 0x4b, 0xd9, 0x6e, 0xea, 0xe9, 0x9c,
-	0xa8, 0xb0, 0xe2, 0xb8, 0xbb, 0xd4, 0x18, 0xa1, 0x5a, 0xdd, 0x52, 0xb6, 0x1b, 0x7a, 0x32, 0x25,
-	0x5b, 0xd0, 0xa2, 0x9c, 0x3f, 0xa4, 0x03, 0xb4, 0x1e, 0xe0, 0x54, 0xad, 0xc9, 0x83, 0x79, 0x91,
-	0x38, 0x4b, 0x39, 0x7f, 0x44, 0x6d, 0x54, 0x97, 0xe5, 0x6a, 0x32, 0x25, 0x1b, 0xd0, 0x74, 0xa8,
-	0x8d, 0x3e, 0xa7, 0x06, 0xaa, 0x0d, 0xb9, 0x96, 0x09, 0xc8, 0x37, 0xb0, 0x9e, 0x73, 0xfc, 0xc8,
-	0x0d, 0x3d, 0x03, 0x55, 0x90, 0xa1, 0x1f, 0x2c, 0x16, 0x7a, 0xbf, 0xa8, 0x56, 0x9f, 0xb7, 0x44,
-	0xbe, 0x80, 0x65, 0x79, 0xb9, 0x6a, 0x6b, 0xab, 0xfa, 0x5a, 0xb3, 0x1d, 0xa9, 0x25, 0x0e, 0xac,
-	0x70, 0x2b, 0x34, 0x99, 0xe3, 0xab, 0x67, 0xa4, 0x85, 0xc7, 0x8b, 0x59, 0xd8, 0x75, 0x9d, 0x63,
-	0x66, 0xee, 0x53, 0x87, 0x9a, 0x68, 0xa3, 0x13, 0x1c, 0x4a, 0xe5, 0x7a, 0x62, 0x84, 0x3c, 0x83,
-	0xf6, 0x38, 0xf4, 0x03, 0xd7, 0x66, 0xcf, 0xf0, 0x80, 0x8b, 0xb3, 0xbe, 0x7a, 0x56, 0x66, 0xf3,
-	0xd1, 0x62, 0x86, 0x1f, 0x14, 0xb4, 0xea, 0x73, 0x76, 0x04, 0x48, 0xc6, 0xe1, 0x00, 0x3f, 0x41,
-	0x4f, 0xa2, 0x6b, 0x35, 0x02, 0x49, 0x4e, 0x14, 0xc1, 0x88, 0xc5, 0x33, 0x5f, 0x5d, 0xdb, 0xaa,
-	0x46, 0x30, 0x4a, 0x45, 0x64, 0x1b, 0xd6, 0x26, 0xe8, 0xb1, 0xe3, 0xe9, 0x11, 0x33, 0x1d, 0x1a,
-	0x84, 0x1e, 0xaa, 0x6d, 0x09, 0xc5, 0xa2, 0x98, 0xd8, 0x70, 0x76, 0x84, 0x96, 0x2d, 0x52, 0xbe,
-	0xeb, 0xe1, 0xd0, 0x57, 0xd7, 0x65, 0x7e, 0xf7, 0x16, 0xbf, 0x41, 0xa9, 0x4e, 0x9f, 0xd5, 0x2e,
-	0x1c, 0x73, 0x5c, 0x3d, 0x7e, 0x29, 0xd1, 0x1b, 0x21, 0x91, 0x63, 0x05, 0x31, 0xb9, 0x0a, 0xab,
-	0x81, 0x47, 0x8d, 0x31, 0x73, 0xcc, 0x7d, 0x0c, 0x46, 0xee, 0x50, 0x3d, 0x27, 0x33, 0x51, 0x90,
-	0x12, 0x03, 0x08, 0x3a, 0x74, 0x60, 0xe1, 0x30, 0xc2, 0xe2, 0xe3, 0x29, 0x47, 0x5f, 0x3d, 0x2f,
-	0xa3, 0xb8, 0xd9, 0xcd, 0x15, 0xa1, 0x42, 0x81, 0xe8, 0xde, 0x9d, 0x3b, 0x75, 0xd7, 0x09, 0xbc,
-	0xa9, 0x5e, 0xa2, 0x8e, 0x8c, 0xa1, 0x25, 0xe2, 0x48, 0xa0, 0x70, 0x41, 0x42, 0xe1, 0xa3, 0xc5,
-	0x72, 0x74, 0x3f, 0x53, 0xa8, 0xe7, 0xb5, 0x77, 0xee, 0xc2, 0xa5, 0x13, 0x7c, 0x23, 0x6d, 0xa8,
-	0x8e, 0x71, 0x2a, 0x6b, 0x5a, 0x53, 0x17, 0x43, 0x72, 0x1e, 0x96, 0x27, 0xd4, 0x0a, 0x51, 0x56,
-	0xa1, 0x86, 0x1e, 0x4d, 0x6e, 0x55, 0xde, 0x53, 0xb4, 0x10, 0x2e, 0x3c, 0x96, 0xe1, 0xa6, 0x8f,
-	0xe9, 0x34, 0x2a, 0xa3, 0x76, 0x1f, 0x2e, 0x16, 0xcd, 0xfa, 0xdc, 0x75, 0x7c, 0x24, 0x5d, 0x20,
-	0x12, 0x7d, 0x0c, 0x87, 0xd9, 0xaa, 0xf4, 0xa2, 0xa1, 0x97, 0xac, 0x68, 0xdf, 0x56, 0xe0, 0xa2,
-	0x8e, 0xbe, 0x6b, 0x4d, 0x30, 0x81, 0xc6, 0xe9, 0x14, 0xf7, 0xcf, 0xa1, 0x4a, 0x39, 0x97, 0x19,
-	0x5d, 0xf8, 0x96, 0x73, 0xe5, 0x53, 0x17, 0x5a, 0xc9, 0xff, 0x61, 0x9d, 0xda, 0x03, 0x66, 0x86,
-	0x6e, 0xe8, 0x27, 0x61, 0xc9, 0x3e, 0xd1, 0xd4, 0xe7, 0x17, 0x34, 0x03, 0x2e, 0xcd, 0xa5, 0x20,
-	0x4e, 0x67, 0xbe, 0x05, 0x29, 0x85, 0x16, 0x54, 0x6a, 0xa4, 0x72, 0x92, 0x91, 0x9f, 0x15, 0x68,
-	0x67, 0xaf, 0x23, 0x56, 0xbf, 0x01, 0x4d, 0x3b, 0x96, 0xf9, 0xaa, 0x22, 0x4b, 0x4c, 0x26, 0x98,
-	0xed, 0x46, 0x95, 0x62, 0x37, 0xba, 0x08, 0xf5, 0x88, 0x0f, 0xc4, 0x81, 0xc5, 0xb3, 0x19, 0x97,
-	0x6b, 0x05, 0x97, 0x37, 0x01, 0xfc, 0x14, 0xee, 0x6a, 0x5d, 0xae, 0xe6, 0x24, 0x44, 0x83, 0x33,
-	0x51, 0xed, 0xd2, 0xd1, 0x0f, 0xad, 0x40, 0x5d, 0x91, 0x3b, 0x66, 0x64, 0x9a, 0x0b, 0x6b, 0x0f,
-	0x99, 0x88, 0xe1, 0xd8, 0x3f, 0x1d, 0xb0, 0xbf, 0x03, 0x35, 0x61, 0x4c, 0x04, 0x36, 0xf0, 0xa8,
-	0x63, 0x8c, 0x30, 0xc9, 0x55, 0x3a, 0x27, 0x04, 0x6a, 0x01, 0x35, 0x7d, 0xb5, 0x22, 0xe5, 0x72,
-	0xac, 0xfd, 0x50, 0x89, 0x3c, 0xed, 0x73, 0xee, 0xbf, 0x7d, 0xc2, 0x52, 0x5e, 0x42, 0xab, 0xf3,
-	0x25, 0xb4, 0xe0, 0xf2, 0xdf, 0x29, 0xa1, 0xaf, 0xaf, 0xaa, 0xad, 0xf4, 0x39, 0x17, 0x8e, 0x90,
-	0x1b, 0x50, 0xa3, 0x9c, 0x47, 0x09, 0x6f, 0xed, 0x5c, 0xce, 0x3b, 0x1a, 0x6f, 0x11, 0xff, 0x63,
-	0x97, 0xe4, 0xd6, 0xce, 0xbb, 0xd0, 0x4c, 0x45, 0x2f, 0x33, 0xdb, 0xcc, 0x9b, 0xfd, 0xb3, 0x0e,
-	0xff, 0x12, 0x39, 0x3d, 0x92, 0x40, 0xee, 0x73, 0x7e, 0x07, 0x03, 0xca, 0x2c, 0xff, 0xe3, 0x10,
-	0xbd, 0xe9, 0x1b, 0xbe, 0x3a, 0x13, 0xea, 0xd1, 0x3b, 0x88, 0x2b, 0xd2, 0x6b, 0x27, 0x74, 0xb1,
-	0xfa, 0x8c, 0xc5, 0x55, 0xdf, 0x0c, 0x8b, 0x2b, 0x63, 0x55, 0xb5, 0x53, 0x62, 0x55, 0x27, 0x13,
-	0xeb, 0x1c, 0x5d, 0xaf, 0xcf, 0xd2, 0xf5, 0x12, 0xb2, 0xb2, 0xf2, 0xaa, 0x64, 0xa5, 0x51, 0x4a,
-	0x56, 0xec, 0xd2, 0x97, 0xd6, 0x94, 0xe9, 0x7e, 0x3f, 0x0f, 0xe0, 0x13, 0xb1, 0xb6, 0x08, 0x6d,
-	0x81, 0x7f, 0x02, 0x6d, 0xf9, 0x4d, 0x11, 0x5d, 0x9f, 0xbb, 0x59, 0xdc, 0x69, 0x4b, 0x12, 0x95,
-	0x54, 0x34, 0x87, 0x48, 0x8f, 0x1c, 0x93, 0x6b, 0x50, 0x13, 0x4e, 0xc8, 0x46, 0xd3, 0xda, 0xb9,
-	0x94, 0xcf, 0xa1, 0xf0, 0xb4, 0xcf, 0xf9, 0x11, 0x47, 0x43, 0x97, 0x9b, 0xc8, 0x2d, 0x68, 0xa6,
-	0xc0, 0x88, 0x91, 0xb7, 0x91, 0x3f, 0x91, 0xe2, 0x28, 0x39, 0x96, 0x6d, 0x17, 0x67, 0x87, 0xcc,
-	0x43, 0x43, 0x92, 0x96, 0xe5, 0xf9, 0xb3, 0x77, 0x92, 0xc5, 0xf4, 0x6c, 0xba, 0x5d, 0xfb, 0x49,
-	0x81, 0x2b, 0xd9, 0x8d, 0x26, 0xd0, 0xd9, 0xc7, 0x80, 0x0e, 0x69, 0x40, 0xdf, 0x7e, 0x03, 0xb8,
-	0x0a, 0xab, 0xc6, 0x08, 0x8d, 0x71, 0xf6, 0xb5, 0x10, 0x7d, 0xb8, 0x16, 0xa4, 0xda, 0x8f, 0x15,
-	0x68, 0xe5, 0xb2, 0x2a, 0x2e, 0x44, 0x34, 0xfd, 0xe4, 0x42, 0xc4, 0x58, 0xf4, 0x71, 0x79, 0x99,
-	0xf7, 0x98, 0x15, 0x37, 0x91, 0xa6, 0x9e, 0x93, 0x90, 0x31, 0x00, 0xa7, 0x1e, 0xb5, 0x31, 0x40,
-	0x4f, 0x3c, 0x7f, 0x01, 0xfd, 0x07, 0x8b, 0x43, 0xf2, 0x30, 0xd1, 0xa9, 0xe7, 0xd4, 0x0b, 0x22,
-	0x22, 0x4d, 0xfb, 0xf1, 0xa3, 0x8f, 0x67, 0xe4, 0x6b, 0x58, 0x3d, 0x66, 0x16, 0x1e, 0x66, 0x8e,
-	0xd4, 0xa5, 0x23, 0x07, 0x8b, 0x3b, 0x72, 0x2f, 0xaf, 0x57, 0x2f, 0x98, 0xd1, 0xfe, 0x07, 0xed,
-	0x22, 0xc8, 0x84, 0x93, 0xcc, 0xa6, 0x66, 0x9a, 0xad, 0x78, 0xa6, 0x11, 0x68, 0x17, 0x41, 0xa5,
-	0x3d, 0x85, 0x75, 0x61, 0x64, 0x77, 0x44, 0xbd, 0xe0, 0x94, 0x38, 0xce, 0x6d, 0x68, 0xa6, 0x26,
-	0x4b, 0x6f, 0xbc, 0x03, 0x8d, 0x49, 0xf2, 0x2d, 0x1a, 0x91, 0x9c, 0x74, 0xae, 0xf5, 0x81, 0xe4,
-	0xfd, 0x8d, 0x1f, 0xf2, 0x35, 0x58, 0x66, 0x01, 0xda, 0x49, 0xeb, 0xbe, 0x50, 0x7c, 0xb5, 0x72,
-	0xbb, 0x1e, 0xed, 0xd9, 0xf9, 0x63, 0x19, 0xd6, 0xb3, 0xc7, 0x23, 0xfe, 0x32, 0x03, 0xc9, 0x01,
-	0xb4, 0xf7, 0xe2, 0x5f, 0x81, 0x12, 0xea, 0x4a, 0xfe, 0xfd, 0x82, 0xcf, 0xbd, 0xce, 0x46, 0xf9,
-	0x62, 0xe4, 0x91, 0xb6, 0x44, 0x3e, 0x85, 0xd5, 0xd9, 0xef, 0x16, 0x72, 0x25, 0x7f, 0xa2, 0xf4,
-	0x53, 0xaa, 0xa3, 0xbd, 0x68, 0x4b, 0xaa, 0xfa, 0x09, 0xac, 0x15, 0x48, 0x3c, 0xd1, 0x66, 0x8b,
-	0x7d, 0xd9, 0x47, 0x4e, 0xe7, 0x3f, 0x2f, 0xdc, 0x93, 0x6a, 0xbf, 0x0d, 0x8d, 0x84, 0xf4, 0xce,
-	0x66, 0xa0, 0x40, 0x85, 0x3b, 0xed, 0x59, 0x7d, 0xc7, 0xbe, 0xb6, 0x44, 0x3e, 0x88, 0x0e, 0x0b,
-	0x52, 0x34, 0x7f, 0x38, 0x47, 0xf5, 0x3a, 0xe7, 0x4a, 0xe8, 0x95, 0x0c, 0xed, 0xec, 0x1e, 0x06,
-	0x59, 0xad, 0x26, 0xff, 0x7d, 0xa5, 0x2e, 0xd6, 0xd1, 0x8a, 0xdb, 0xe6, 0xcb, 0xbd, 0xb6, 0x44,
-	0xbe, 0x57, 0xe0, 0xdc, 0x1e, 0x06, 0xc5, 0x82, 0x49, 0xae, 0x97, 0x1b, 0x39, 0xa1, 0xb0, 0x76,
-	0x1e, 0x2d, 0xfa, 0x22, 0x66, 0xd5, 0x6a, 0x4b, 0xe4, 0x50, 0x86, 0x9d, 0x21, 0x9b, 0x5c, 0x2e,
-	0x85, 0x70, 0x9a, 0xbd, 0xcd, 0x93, 0x96, 0x93, 0x50, 0x3f, 0xec, 0xff, 0xf2, 0x7c, 0x53, 0xf9,
-	0xf5, 0xf9, 0xa6, 0xf2, 0xfb, 0xf3, 0x4d, 0xe5, 0xb3, 0x9b, 0x2f, 0xf9, 0x79, 0x34, 0xf7, 0x8b,
-	0x2b, 0xe5, 0xcc, 0xb0, 0x18, 0x3a, 0xc1, 0xa0, 0x2e, 0x7f, 0x0c, 0xbd, 0xf9, 0x57, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x65, 0x04, 0x2b, 0x5c, 0x90, 0x15, 0x00, 0x00,
+func (m *ListCheckoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListCheckoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListCheckoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ListCheckoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCheckoutsRequest.Merge(m, src)
+}
+func (m *ListCheckoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListCheckoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCheckoutsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_ListCheckoutsRequest proto.InternalMessageInfo
 
-// RepoServerServiceClient is the client API for RepoServerService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RepoServerServiceClient interface {
-	// GenerateManifest generates manifest for application in specified repo name and revision
-	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
-	// Returns a bool val if the repository is valid and has proper access
-	TestRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*TestRepositoryResponse, error)
-	// Returns a valid revision
-	ResolveRevision(ctx context.Context, in *ResolveRevisionRequest, opts ...grpc.CallOption) (*ResolveRevisionResponse, error)
-	// Returns a list of refs (e.g. branches and tags) in the repo
-	ListRefs(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (*Refs, error)
-	// ListApps returns a list of apps in the repo
-	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error)
-	// Generate manifest for application in specified repo name and revision
-	GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
-	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
-	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
-	// GetHelmCharts returns list of helm charts in the specified repository
-	GetHelmCharts(ctx context.Context, in *HelmChartsRequest, opts ...grpc.CallOption) (*HelmChartsResponse, error)
+// RepositoryCheckout describes a repository checked out in the repo server working directory
+type RepositoryCheckout struct {
+	// URL of the repository as requested by the client
+	Repo string `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// disk space used by the checkout, in bytes
+	SizeBytes int64 `protobuf:"varint,2,opt,name=sizeBytes,proto3" json:"sizeBytes,omitempty"`
+	// time the checkout was last used
+	LastUsed *v1.Time `protobuf:"bytes,3,opt,name=lastUsed,proto3" json:"lastUsed,omitempty"`
+	// whether an operation is currently in progress on the checkout
+	InUse                bool     `protobuf:"varint,4,opt,name=inUse,proto3" json:"inUse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type repoServerServiceClient struct {
-	cc *grpc.ClientConn
+func (m *RepositoryCheckout) Reset()         { *m = RepositoryCheckout{} }
+func (m *RepositoryCheckout) String() string { return proto.CompactTextString(m) }
+func (*RepositoryCheckout) ProtoMessage()    {}
+func (*RepositoryCheckout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{23}
 }
-
-func NewRepoServerServiceClient(cc *grpc.ClientConn) RepoServerServiceClient {
-	return &repoServerServiceClient{cc}
+func (m *RepositoryCheckout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RepositoryCheckout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RepositoryCheckout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RepositoryCheckout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RepositoryCheckout.Merge(m, src)
+}
+func (m *RepositoryCheckout) XXX_Size() int {
+	return m.Size()
+}
+func (m *RepositoryCheckout) XXX_DiscardUnknown() {
+	xxx_messageInfo_RepositoryCheckout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RepositoryCheckout proto.InternalMessageInfo
+
+func (m *RepositoryCheckout) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *RepositoryCheckout) GetSizeBytes() int64 {
+	if m != nil {
+		return m.SizeBytes
+	}
+	return 0
+}
+
+func (m *RepositoryCheckout) GetLastUsed() *v1.Time {
+	if m != nil {
+		return m.LastUsed
+	}
+	return nil
+}
+
+func (m *RepositoryCheckout) GetInUse() bool {
+	if m != nil {
+		return m.InUse
+	}
+	return false
+}
+
+// CheckoutList contains the repositories checked out by the repo server
+type CheckoutList struct {
+	Items []*RepositoryCheckout `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// combined disk space used by all checkouts, in bytes
+	TotalSizeBytes int64 `protobuf:"varint,2,opt,name=totalSizeBytes,proto3" json:"totalSizeBytes,omitempty"`
+	// configured maximum combined disk space, in bytes (0 means unlimited)
+	MaxSizeBytes         int64    `protobuf:"varint,3,opt,name=maxSizeBytes,proto3" json:"maxSizeBytes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckoutList) Reset()         { *m = CheckoutList{} }
+func (m *CheckoutList) String() string { return proto.CompactTextString(m) }
+func (*CheckoutList) ProtoMessage()    {}
+func (*CheckoutList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{24}
+}
+func (m *CheckoutList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckoutList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckoutList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CheckoutList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckoutList.Merge(m, src)
+}
+func (m *CheckoutList) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckoutList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckoutList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckoutList proto.InternalMessageInfo
+
+func (m *CheckoutList) GetItems() []*RepositoryCheckout {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *CheckoutList) GetTotalSizeBytes() int64 {
+	if m != nil {
+		return m.TotalSizeBytes
+	}
+	return 0
+}
+
+func (m *CheckoutList) GetMaxSizeBytes() int64 {
+	if m != nil {
+		return m.MaxSizeBytes
+	}
+	return 0
+}
+
+// EvictCheckoutsRequest requests the removal of repository checkouts from the repo server disk
+type EvictCheckoutsRequest struct {
+	// URL of the repository to evict. All idle checkouts are evicted if empty.
+	Repo                 string   `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvictCheckoutsRequest) Reset()         { *m = EvictCheckoutsRequest{} }
+func (m *EvictCheckoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*EvictCheckoutsRequest) ProtoMessage()    {}
+func (*EvictCheckoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{25}
+}
+func (m *EvictCheckoutsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictCheckoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictCheckoutsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictCheckoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictCheckoutsRequest.Merge(m, src)
+}
+func (m *EvictCheckoutsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictCheckoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictCheckoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictCheckoutsRequest proto.InternalMessageInfo
+
+func (m *EvictCheckoutsRequest) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+// EvictCheckoutsResponse contains the repositories whose checkout was evicted
+type EvictCheckoutsResponse struct {
+	Repos                []string `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EvictCheckoutsResponse) Reset()         { *m = EvictCheckoutsResponse{} }
+func (m *EvictCheckoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*EvictCheckoutsResponse) ProtoMessage()    {}
+func (*EvictCheckoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd8723cfcc820480, []int{26}
+}
+func (m *EvictCheckoutsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvictCheckoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvictCheckoutsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EvictCheckoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvictCheckoutsResponse.Merge(m, src)
+}
+func (m *EvictCheckoutsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvictCheckoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvictCheckoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvictCheckoutsResponse proto.InternalMessageInfo
+
+func (m *EvictCheckoutsResponse) GetRepos() []string {
+	if m != nil {
+		return m.Repos
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ManifestRequest)(nil), "repository.ManifestRequest")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.ManifestRequest.EnabledSourceTypesEntry")
+	proto.RegisterType((*ManifestRequestWithFiles)(nil), "repository.ManifestRequestWithFiles")
+	proto.RegisterType((*ManifestFileMetadata)(nil), "repository.ManifestFileMetadata")
+	proto.RegisterType((*ManifestFileChunk)(nil), "repository.ManifestFileChunk")
+	proto.RegisterType((*TestRepositoryRequest)(nil), "repository.TestRepositoryRequest")
+	proto.RegisterType((*TestRepositoryResponse)(nil), "repository.TestRepositoryResponse")
+	proto.RegisterType((*ResolveRevisionRequest)(nil), "repository.ResolveRevisionRequest")
+	proto.RegisterType((*ResolveRevisionResponse)(nil), "repository.ResolveRevisionResponse")
+	proto.RegisterType((*ManifestResponse)(nil), "repository.ManifestResponse")
+	proto.RegisterType((*ListRefsRequest)(nil), "repository.ListRefsRequest")
+	proto.RegisterType((*Refs)(nil), "repository.Refs")
+	proto.RegisterType((*ListAppsRequest)(nil), "repository.ListAppsRequest")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.ListAppsRequest.EnabledSourceTypesEntry")
+	proto.RegisterType((*AppList)(nil), "repository.AppList")
+	proto.RegisterMapType((map[string]string)(nil), "repository.AppList.AppsEntry")
+	proto.RegisterType((*RepoServerAppDetailsQuery)(nil), "repository.RepoServerAppDetailsQuery")
+	proto.RegisterMapType((map[string]bool)(nil), "repository.RepoServerAppDetailsQuery.EnabledSourceTypesEntry")
+	proto.RegisterType((*RepoAppDetailsResponse)(nil), "repository.RepoAppDetailsResponse")
+	proto.RegisterType((*RepoServerRevisionMetadataRequest)(nil), "repository.RepoServerRevisionMetadataRequest")
+	proto.RegisterType((*HelmAppSpec)(nil), "repository.HelmAppSpec")
+	proto.RegisterType((*KustomizeAppSpec)(nil), "repository.KustomizeAppSpec")
+	proto.RegisterType((*DirectoryAppSpec)(nil), "repository.DirectoryAppSpec")
+	proto.RegisterType((*HelmChartsRequest)(nil), "repository.HelmChartsRequest")
+	proto.RegisterType((*HelmChart)(nil), "repository.HelmChart")
+	proto.RegisterType((*HelmChartsResponse)(nil), "repository.HelmChartsResponse")
+	proto.RegisterType((*ListCheckoutsRequest)(nil), "repository.ListCheckoutsRequest")
+	proto.RegisterType((*RepositoryCheckout)(nil), "repository.RepositoryCheckout")
+	proto.RegisterType((*CheckoutList)(nil), "repository.CheckoutList")
+	proto.RegisterType((*EvictCheckoutsRequest)(nil), "repository.EvictCheckoutsRequest")
+	proto.RegisterType((*EvictCheckoutsResponse)(nil), "repository.EvictCheckoutsResponse")
+}
+
+func init() {
+	proto.RegisterFile("reposerver/repository/repository.proto", fileDescriptor_dd8723cfcc820480)
+}
+
+var fileDescriptor_dd8723cfcc820480 = []byte{
+	// 1859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x4d, 0x73, 0x1b, 0x49,
+	0x55, 0x23, 0xc9, 0xb6, 0xf4, 0x1c, 0xdb, 0x72, 0xc7, 0x71, 0x66, 0x85, 0xd7, 0xa5, 0x1d, 0x20,
+	0x65, 0x36, 0xec, 0xa8, 0xe2, 0x2c, 0xbb, 0x5b, 0xbb, 0xc5, 0x56, 0x39, 0xde, 0x24, 0x5e, 0x12,
+	0x27, 0x66, 0xec, 0x40, 0x01, 0x5b, 0x50, 0xed, 0x51, 0x5b, 0x6a, 0x34, 0x1f, 0xbd, 0xd3, 0x3d,
+	0x02, 0xa5, 0x8a, 0x03, 0xff, 0x80, 0x13, 0xbf, 0x80, 0x33, 0x57, 0x8e, 0x9c, 0xa8, 0xe2, 0x08,
+	0x9c, 0x38, 0x52, 0xb9, 0x70, 0xe7, 0x17, 0x50, 0xdd, 0xf3, 0x3d, 0x1a, 0x39, 0xbb, 0x25, 0xc7,
+	0x7b, 0xb1, 0xbb, 0x5f, 0xbf, 0xef, 0x7e, 0xfd, 0x3e, 0x46, 0x70, 0x27, 0x20, 0xcc, 0xe7, 0x24,
+	0x98, 0x90, 0xa0, 0xaf, 0x96, 0x54, 0xf8, 0xc1, 0x34, 0xb7, 0x34, 0x59, 0xe0, 0x0b, 0x1f, 0x41,
+	0x06, 0xe9, 0x3e, 0x1d, 0x52, 0x31, 0x0a, 0xcf, 0x4d, 0xdb, 0x77, 0xfb, 0x38, 0x18, 0xfa, 0x2c,
+	0xf0, 0x7f, 0xad, 0x16, 0xef, 0xd9, 0x83, 0xfe, 0x64, 0xbf, 0xcf, 0xc6, 0xc3, 0x3e, 0x66, 0x94,
+	0xf7, 0x31, 0x63, 0x0e, 0xb5, 0xb1, 0xa0, 0xbe, 0xd7, 0x9f, 0xdc, 0xc3, 0x0e, 0x1b, 0xe1, 0x7b,
+	0xfd, 0x21, 0xf1, 0x48, 0x80, 0x05, 0x19, 0x44, 0x9c, 0xbb, 0xef, 0x8f, 0x3f, 0xe2, 0x26, 0xf5,
+	0x25, 0x85, 0x8b, 0xed, 0x11, 0xf5, 0x48, 0x30, 0xcd, 0x58, 0xb8, 0x44, 0xe0, 0xfe, 0x64, 0x86,
+	0xca, 0xf8, 0xf3, 0x2a, 0x6c, 0x1c, 0x63, 0x8f, 0x5e, 0x10, 0x2e, 0x2c, 0xf2, 0x65, 0x48, 0xb8,
+	0x40, 0x5f, 0x40, 0x53, 0x6a, 0xa9, 0x6b, 0x3d, 0x6d, 0x6f, 0x75, 0xff, 0xc8, 0xcc, 0xd4, 0x34,
+	0x13, 0x35, 0xd5, 0xe2, 0x57, 0xf6, 0xc0, 0x9c, 0xec, 0x9b, 0x6c, 0x3c, 0x34, 0xa5, 0x0c, 0x33,
+	0xa7, 0xa6, 0x99, 0xa8, 0x69, 0x5a, 0xa9, 0xbd, 0x96, 0xe2, 0x8a, 0xba, 0xd0, 0x0a, 0xc8, 0x84,
+	0x72, 0xea, 0x7b, 0x7a, 0xbd, 0xa7, 0xed, 0xb5, 0xad, 0x74, 0x8f, 0x74, 0x58, 0xf1, 0xfc, 0x43,
+	0x6c, 0x8f, 0x88, 0xde, 0xe8, 0x69, 0x7b, 0x2d, 0x2b, 0xd9, 0xa2, 0x1e, 0xac, 0x62, 0xc6, 0x9e,
+	0xe2, 0x73, 0xe2, 0x3c, 0x21, 0x53, 0xbd, 0xa9, 0x08, 0xf3, 0x20, 0x49, 0x8b, 0x19, 0x7b, 0x86,
+	0x5d, 0xa2, 0x2f, 0xa9, 0xd3, 0x64, 0x8b, 0x76, 0xa0, 0xed, 0x61, 0x97, 0x70, 0x86, 0x6d, 0xa2,
+	0xb7, 0xd4, 0x59, 0x06, 0x40, 0xbf, 0x83, 0xcd, 0x9c, 0xe2, 0xa7, 0x7e, 0x18, 0xd8, 0x44, 0x07,
+	0x65, 0xfa, 0xf3, 0xc5, 0x4c, 0x3f, 0x28, 0xb3, 0xb5, 0x66, 0x25, 0xa1, 0x5f, 0xc2, 0x92, 0x0a,
+	0x09, 0x7d, 0xb5, 0xd7, 0xb8, 0x52, 0x6f, 0x47, 0x6c, 0x91, 0x07, 0x2b, 0xcc, 0x09, 0x87, 0xd4,
+	0xe3, 0xfa, 0x0d, 0x25, 0xe1, 0x6c, 0x31, 0x09, 0x87, 0xbe, 0x77, 0x41, 0x87, 0xc7, 0xd8, 0xc3,
+	0x43, 0xe2, 0x12, 0x4f, 0x9c, 0x28, 0xe6, 0x56, 0x22, 0x04, 0xbd, 0x84, 0xce, 0x38, 0xe4, 0xc2,
+	0x77, 0xe9, 0x4b, 0xf2, 0x9c, 0x49, 0x5a, 0xae, 0xaf, 0x29, 0x6f, 0x3e, 0x5b, 0x4c, 0xf0, 0x93,
+	0x12, 0x57, 0x6b, 0x46, 0x8e, 0x0c, 0x92, 0x71, 0x78, 0x4e, 0x7e, 0x42, 0x02, 0x15, 0x5d, 0xeb,
+	0x51, 0x90, 0xe4, 0x40, 0x51, 0x18, 0xd1, 0x78, 0xc7, 0xf5, 0x8d, 0x5e, 0x23, 0x0a, 0xa3, 0x14,
+	0x84, 0xf6, 0x60, 0x63, 0x42, 0x02, 0x7a, 0x31, 0x3d, 0xa5, 0x43, 0x0f, 0x8b, 0x30, 0x20, 0x7a,
+	0x47, 0x85, 0x62, 0x19, 0x8c, 0x5c, 0x58, 0x1b, 0x11, 0xc7, 0x95, 0x2e, 0x3f, 0x0c, 0xc8, 0x80,
+	0xeb, 0x9b, 0xca, 0xbf, 0x8f, 0x17, 0xbf, 0x41, 0xc5, 0xce, 0x2a, 0x72, 0x97, 0x8a, 0x79, 0xbe,
+	0x15, 0xbf, 0x94, 0xe8, 0x8d, 0xa0, 0x48, 0xb1, 0x12, 0x18, 0xdd, 0x81, 0x75, 0x11, 0x60, 0x7b,
+	0x4c, 0xbd, 0xe1, 0x31, 0x11, 0x23, 0x7f, 0xa0, 0xdf, 0x54, 0x9e, 0x28, 0x41, 0x91, 0x0d, 0x88,
+	0x78, 0xf8, 0xdc, 0x21, 0x83, 0x28, 0x16, 0xcf, 0xa6, 0x8c, 0x70, 0x7d, 0x4b, 0x59, 0x71, 0xdf,
+	0xcc, 0xa5, 0xae, 0x52, 0x82, 0x30, 0x1f, 0xce, 0x50, 0x3d, 0xf4, 0x44, 0x30, 0xb5, 0x2a, 0xd8,
+	0xa1, 0x31, 0xac, 0x4a, 0x3b, 0x92, 0x50, 0xb8, 0xa5, 0x42, 0xe1, 0xf3, 0xc5, 0x7c, 0x74, 0x94,
+	0x31, 0xb4, 0xf2, 0xdc, 0xa5, 0x8f, 0x78, 0x72, 0x3f, 0x27, 0xbe, 0x43, 0xed, 0xa9, 0xbe, 0xad,
+	0x4c, 0x2f, 0x83, 0x11, 0x83, 0xb5, 0x14, 0xf4, 0x84, 0x4c, 0xb9, 0x7e, 0x5b, 0x99, 0xfd, 0xa3,
+	0xc5, 0x14, 0x3b, 0xcd, 0xb1, 0xb4, 0x8a, 0x02, 0xba, 0x0f, 0xe1, 0xf6, 0x1c, 0xbf, 0xa1, 0x0e,
+	0x34, 0xc6, 0x64, 0xaa, 0xf2, 0x6d, 0xdb, 0x92, 0x4b, 0xb4, 0x05, 0x4b, 0x13, 0xec, 0x84, 0x44,
+	0x65, 0xc8, 0x96, 0x15, 0x6d, 0x3e, 0xae, 0x7f, 0xa4, 0x19, 0xff, 0xd2, 0x40, 0x2f, 0xdd, 0xc7,
+	0x4f, 0xa9, 0x18, 0x3d, 0xa2, 0x0e, 0xe1, 0xe8, 0x43, 0x58, 0x09, 0x22, 0x58, 0x9c, 0xbc, 0xbf,
+	0x75, 0xc9, 0x35, 0x1e, 0xd5, 0xac, 0x04, 0x1b, 0x7d, 0x0a, 0x2d, 0x59, 0x21, 0x06, 0x58, 0x60,
+	0x25, 0x72, 0x75, 0xbf, 0x57, 0x45, 0x29, 0xa5, 0x1c, 0xc7, 0x78, 0x47, 0x35, 0x2b, 0xa5, 0x41,
+	0x3f, 0x80, 0x25, 0x7b, 0x14, 0x7a, 0x63, 0x95, 0xb6, 0x57, 0xf7, 0xdf, 0x9e, 0x47, 0x7c, 0x28,
+	0x91, 0x8e, 0x6a, 0x56, 0x84, 0xfd, 0x60, 0x19, 0x9a, 0x0c, 0x07, 0xc2, 0x78, 0x04, 0x5b, 0x55,
+	0x22, 0x64, 0xad, 0xb0, 0x47, 0xc4, 0x1e, 0xf3, 0xd0, 0x8d, 0xbd, 0x93, 0xee, 0x11, 0x82, 0x26,
+	0xa7, 0x2f, 0x23, 0x0f, 0x35, 0x2c, 0xb5, 0x36, 0xbe, 0x07, 0x9b, 0x33, 0xd2, 0xa4, 0x2f, 0x23,
+	0xdd, 0x24, 0x87, 0x1b, 0xb1, 0x68, 0x23, 0x84, 0x5b, 0x67, 0xca, 0x17, 0x69, 0xc2, 0xbc, 0x8e,
+	0xea, 0x67, 0x1c, 0xc1, 0x76, 0x59, 0x2c, 0x67, 0xbe, 0xc7, 0x09, 0x32, 0x01, 0xa9, 0x0c, 0x43,
+	0xc9, 0x20, 0x3b, 0x55, 0x5a, 0xb4, 0xac, 0x8a, 0x13, 0xe3, 0xf7, 0x75, 0xd8, 0xb6, 0x08, 0xf7,
+	0x9d, 0x09, 0x49, 0x9e, 0xff, 0xf5, 0x14, 0xf0, 0x5f, 0x40, 0x03, 0x33, 0x16, 0x87, 0xc9, 0xe7,
+	0x57, 0x56, 0x22, 0x2d, 0xc9, 0x15, 0x7d, 0x1f, 0x36, 0xb1, 0x7b, 0x4e, 0x87, 0xa1, 0x1f, 0xf2,
+	0xc4, 0x2c, 0x15, 0x54, 0x6d, 0x6b, 0xf6, 0xc0, 0xb0, 0xe1, 0xf6, 0x8c, 0x0b, 0x62, 0x77, 0xe6,
+	0xdb, 0x0c, 0xad, 0xd4, 0x66, 0x54, 0x0a, 0xa9, 0xcf, 0x13, 0xf2, 0xef, 0x3a, 0x74, 0xb2, 0xa7,
+	0x13, 0xb3, 0xdf, 0x81, 0xb6, 0x1b, 0xc3, 0xb8, 0xae, 0xa9, 0x32, 0x92, 0x01, 0x8a, 0x1d, 0x47,
+	0xbd, 0xdc, 0x71, 0x6c, 0xc3, 0x72, 0xd4, 0x29, 0xc6, 0x86, 0xc5, 0xbb, 0x82, 0xca, 0xcd, 0x92,
+	0xca, 0xbb, 0x00, 0x3c, 0x4d, 0x1b, 0xfa, 0xb2, 0x3a, 0xcd, 0x41, 0x90, 0x01, 0x37, 0xa2, 0xfa,
+	0x64, 0x11, 0x1e, 0x3a, 0x42, 0x5f, 0x51, 0x18, 0x05, 0x58, 0x56, 0xda, 0xce, 0xf0, 0x30, 0x46,
+	0x8b, 0xba, 0xa1, 0x32, 0x18, 0x7d, 0x00, 0xdb, 0xf6, 0x08, 0x07, 0x22, 0xae, 0x8a, 0x87, 0xbe,
+	0xc7, 0x45, 0x80, 0xa9, 0x27, 0xf4, 0xb6, 0x22, 0x98, 0x73, 0x2a, 0x63, 0x58, 0x9d, 0xbc, 0x60,
+	0x03, 0x2c, 0xd2, 0x3a, 0x0c, 0x8a, 0xa6, 0xe2, 0xc4, 0xf0, 0x61, 0xe3, 0x29, 0x95, 0x5e, 0xbd,
+	0xe0, 0xd7, 0xf3, 0xfc, 0x3e, 0x80, 0xa6, 0x14, 0x26, 0x5d, 0x7d, 0x1e, 0x60, 0xcf, 0x1e, 0x91,
+	0xe4, 0xf6, 0xd2, 0xbd, 0x4c, 0x2c, 0x02, 0x0f, 0xb9, 0x5e, 0x57, 0x70, 0xb5, 0x36, 0xfe, 0x52,
+	0x8f, 0x34, 0x3d, 0x60, 0x8c, 0x7f, 0xf3, 0x6d, 0x72, 0x75, 0xe1, 0x6e, 0xcc, 0x16, 0xee, 0x92,
+	0xca, 0x5f, 0xa7, 0x70, 0x5f, 0x55, 0xbd, 0x0a, 0x61, 0xe5, 0x80, 0x31, 0xa9, 0x08, 0xba, 0x07,
+	0x4d, 0xcc, 0x58, 0xe4, 0xf0, 0x52, 0x8d, 0x88, 0x51, 0xe4, 0xff, 0x58, 0x25, 0x85, 0xda, 0xfd,
+	0x10, 0xda, 0x29, 0xe8, 0x75, 0x62, 0xdb, 0x79, 0xb1, 0xff, 0x5d, 0x86, 0xb7, 0xa4, 0x4f, 0x4f,
+	0xd5, 0xd3, 0x3a, 0x60, 0xec, 0x33, 0x22, 0x30, 0x75, 0xf8, 0x8f, 0x43, 0x12, 0x4c, 0xdf, 0xf0,
+	0xd5, 0x0d, 0x61, 0x39, 0x7a, 0x99, 0x71, 0x8e, 0xbc, 0xf2, 0x31, 0x22, 0x66, 0x9f, 0xcd, 0x0e,
+	0x8d, 0x37, 0x33, 0x3b, 0x54, 0xf5, 0xf2, 0xcd, 0x6b, 0xea, 0xe5, 0xe7, 0x8f, 0x73, 0xb9, 0x21,
+	0x71, 0xb9, 0x38, 0x24, 0x56, 0xb4, 0xc8, 0x2b, 0x5f, 0xb5, 0x45, 0x6e, 0x55, 0xb6, 0xc8, 0x6e,
+	0xe5, 0x4b, 0x6b, 0x2b, 0x77, 0xff, 0x30, 0x1f, 0xc0, 0x73, 0x63, 0x6d, 0x91, 0x66, 0x19, 0xde,
+	0x64, 0xb3, 0x7c, 0x55, 0x0f, 0xfc, 0x9f, 0x9a, 0xec, 0x43, 0x98, 0x9f, 0xd9, 0x9d, 0x16, 0x49,
+	0x99, 0x49, 0x65, 0xb9, 0x8a, 0xf8, 0xa8, 0x35, 0xba, 0x0b, 0x4d, 0xa9, 0x44, 0xdc, 0x28, 0xde,
+	0xce, 0xfb, 0x50, 0x6a, 0x7a, 0xc0, 0xd8, 0x29, 0x23, 0xb6, 0xa5, 0x90, 0xd0, 0xc7, 0xd0, 0x4e,
+	0x03, 0x23, 0x8e, 0xbc, 0x9d, 0x3c, 0x45, 0x1a, 0x47, 0x09, 0x59, 0x86, 0x2e, 0x69, 0x07, 0x34,
+	0x20, 0xb6, 0x6a, 0xa3, 0x96, 0x66, 0x69, 0x3f, 0x4b, 0x0e, 0x53, 0xda, 0x14, 0xdd, 0xf8, 0x9b,
+	0x06, 0xef, 0x64, 0x37, 0x9a, 0x84, 0x4e, 0xd2, 0x96, 0x7e, 0xf3, 0x05, 0xe0, 0x0e, 0xac, 0xab,
+	0x3e, 0x38, 0x9b, 0x51, 0xa3, 0xcf, 0x25, 0x25, 0xa8, 0xf1, 0xd7, 0x3a, 0xac, 0xe6, 0xbc, 0x2a,
+	0x2f, 0x44, 0xb6, 0x21, 0xc9, 0x85, 0xc8, 0xb5, 0xec, 0x2c, 0xd4, 0x65, 0xaa, 0x09, 0x42, 0x65,
+	0x92, 0xb6, 0x95, 0x83, 0xa0, 0x31, 0x00, 0xc3, 0x01, 0x76, 0x89, 0x20, 0x81, 0x7c, 0xfe, 0x32,
+	0xf4, 0x9f, 0x2c, 0x1e, 0x92, 0x27, 0x09, 0x4f, 0x2b, 0xc7, 0x5e, 0xb6, 0x46, 0x4a, 0x34, 0x8f,
+	0x1f, 0x7d, 0xbc, 0x43, 0xbf, 0x81, 0xf5, 0x0b, 0xea, 0x90, 0x93, 0x4c, 0x91, 0x65, 0xa5, 0xc8,
+	0xf3, 0xc5, 0x15, 0x79, 0x94, 0xe7, 0x6b, 0x95, 0xc4, 0x18, 0xef, 0x42, 0xa7, 0x1c, 0x64, 0x52,
+	0x49, 0xea, 0xe2, 0x61, 0xea, 0xad, 0x78, 0x67, 0x20, 0xe8, 0x94, 0x83, 0xca, 0xf8, 0x12, 0x36,
+	0xa5, 0x90, 0x43, 0xd9, 0xfb, 0x5c, 0x53, 0x8f, 0xf3, 0x09, 0xb4, 0x53, 0x91, 0x95, 0x37, 0xde,
+	0x85, 0xd6, 0x24, 0xf9, 0x02, 0x12, 0x35, 0x39, 0xe9, 0xde, 0x38, 0x00, 0x94, 0xd7, 0x37, 0x7e,
+	0xc8, 0x77, 0x61, 0x89, 0x0a, 0xe2, 0x26, 0xa5, 0xfb, 0x56, 0xf9, 0xd5, 0x2a, 0x74, 0x2b, 0xc2,
+	0x31, 0xb6, 0x61, 0x4b, 0xd6, 0xf2, 0x43, 0x19, 0x8a, 0x7e, 0x98, 0x5a, 0x6d, 0xfc, 0x49, 0x03,
+	0x94, 0x29, 0x9b, 0x1c, 0x4b, 0x0d, 0x53, 0x67, 0xb4, 0xe3, 0xd8, 0xdf, 0x81, 0xb6, 0x9c, 0xe7,
+	0x1e, 0x4c, 0x05, 0xe1, 0xf1, 0x80, 0x97, 0x01, 0xd0, 0x23, 0x68, 0x39, 0x98, 0x8b, 0x17, 0x9c,
+	0x0c, 0xe2, 0x34, 0xf2, 0xae, 0x19, 0x7d, 0xfc, 0x34, 0xf3, 0x1f, 0x3f, 0x33, 0xbf, 0xc9, 0x31,
+	0xd5, 0x9c, 0xdc, 0x33, 0xcf, 0xa8, 0x4b, 0xac, 0x94, 0x56, 0xe6, 0x34, 0xea, 0xbd, 0xe0, 0x51,
+	0x66, 0x69, 0x59, 0xd1, 0xc6, 0xf8, 0x83, 0x06, 0x37, 0x12, 0xe5, 0x54, 0xdb, 0xf2, 0x7e, 0xd1,
+	0xf8, 0xdd, 0x72, 0xda, 0x2f, 0xda, 0x13, 0x7b, 0x41, 0x55, 0x18, 0x5f, 0x60, 0xe7, 0xb4, 0x64,
+	0x47, 0x09, 0x2a, 0x1b, 0x77, 0x17, 0xff, 0x36, 0xc3, 0x6a, 0x28, 0xac, 0x02, 0xcc, 0xb8, 0x0b,
+	0xb7, 0x1e, 0x4e, 0xa8, 0x3d, 0xe3, 0xd2, 0x2a, 0xdf, 0x19, 0x26, 0x6c, 0x97, 0x91, 0xe3, 0x5b,
+	0xdc, 0x4a, 0xda, 0x85, 0xa8, 0xe3, 0x8d, 0x36, 0xfb, 0xff, 0x5b, 0x81, 0xcd, 0x2c, 0xd7, 0xc9,
+	0xbf, 0xd4, 0x26, 0xe8, 0x39, 0x74, 0x1e, 0xc7, 0x9f, 0x8a, 0x93, 0xd9, 0x07, 0x5d, 0xf6, 0x31,
+	0xa1, 0xbb, 0x53, 0x7d, 0x18, 0x89, 0x36, 0x6a, 0xc8, 0x86, 0xb7, 0xca, 0x0c, 0xb3, 0xef, 0x16,
+	0xdf, 0xb9, 0x84, 0x73, 0x8a, 0xf5, 0x3a, 0x11, 0x7b, 0x1a, 0xfa, 0x19, 0xac, 0x17, 0xa7, 0x6b,
+	0xf4, 0x4e, 0x9e, 0xa6, 0x72, 0xe0, 0xef, 0x1a, 0x97, 0xa1, 0xa4, 0xfa, 0x7f, 0x01, 0x1b, 0xa5,
+	0x51, 0x13, 0x19, 0xc5, 0x48, 0xa8, 0x1a, 0xc5, 0xbb, 0xdf, 0xbe, 0x14, 0x27, 0xe5, 0xfe, 0x09,
+	0xb4, 0x92, 0x41, 0xa8, 0xe8, 0xe6, 0xd2, 0x78, 0xd4, 0xed, 0x14, 0xf9, 0x5d, 0x70, 0xa3, 0x86,
+	0x3e, 0x8d, 0x88, 0x65, 0xa3, 0x3c, 0x4b, 0x9c, 0x6b, 0xff, 0xbb, 0x37, 0x2b, 0x5a, 0x6e, 0x65,
+	0xda, 0xda, 0x63, 0x22, 0xb2, 0xfa, 0x8d, 0xbe, 0xfb, 0x95, 0x3a, 0x9b, 0xae, 0x51, 0x46, 0x9b,
+	0x6d, 0x01, 0x8c, 0x1a, 0xfa, 0xa3, 0x06, 0x37, 0x1f, 0x13, 0x51, 0x2e, 0xa2, 0xe8, 0xbd, 0x6a,
+	0x21, 0x73, 0x8a, 0x6d, 0xf7, 0xd9, 0xa2, 0x59, 0xb2, 0xc8, 0xd6, 0xa8, 0xa1, 0x13, 0x65, 0x76,
+	0x96, 0xed, 0xd0, 0xdb, 0x95, 0x69, 0x2d, 0xf5, 0xde, 0xee, 0xbc, 0xe3, 0xd4, 0xd4, 0x63, 0x58,
+	0x2b, 0x64, 0x3e, 0xd4, 0x2b, 0xdf, 0x46, 0xf9, 0x05, 0x77, 0xf5, 0x3c, 0x46, 0x3e, 0xed, 0x18,
+	0x35, 0x19, 0xcd, 0xc5, 0x97, 0x5c, 0x8c, 0xe6, 0xca, 0x94, 0x50, 0xbc, 0x94, 0xea, 0x44, 0x60,
+	0xd4, 0x1e, 0x1c, 0xfc, 0xfd, 0xd5, 0xae, 0xf6, 0x8f, 0x57, 0xbb, 0xda, 0x7f, 0x5e, 0xed, 0x6a,
+	0x3f, 0xbf, 0xff, 0x9a, 0x1f, 0xa2, 0x72, 0xbf, 0x6d, 0x61, 0x46, 0x6d, 0x87, 0x12, 0x4f, 0x9c,
+	0x2f, 0xab, 0x1f, 0x90, 0xee, 0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x25, 0x3d, 0x5b, 0xfa,
+	0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RepoServerServiceClient is the client API for RepoServerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RepoServerServiceClient interface {
+	// GenerateManifest generates manifest for application in specified repo name and revision
+	GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error)
+	// GenerateManifestWithFiles generates manifest for application using the files streamed by the client instead of a repository
+	GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error)
+	// Returns a bool val if the repository is valid and has proper access
+	TestRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*TestRepositoryResponse, error)
+	// Returns a valid revision
+	ResolveRevision(ctx context.Context, in *ResolveRevisionRequest, opts ...grpc.CallOption) (*ResolveRevisionResponse, error)
+	// Returns a list of refs (e.g. branches and tags) in the repo
+	ListRefs(ctx context.Context, in *ListRefsRequest, opts ...grpc.CallOption) (*Refs, error)
+	// ListApps returns a list of apps in the repo
+	ListApps(ctx context.Context, in *ListAppsRequest, opts ...grpc.CallOption) (*AppList, error)
+	// Generate manifest for application in specified repo name and revision
+	GetAppDetails(ctx context.Context, in *RepoServerAppDetailsQuery, opts ...grpc.CallOption) (*RepoAppDetailsResponse, error)
+	// Get the meta-data (author, date, tags, message) for a specific revision of the repo
+	GetRevisionMetadata(ctx context.Context, in *RepoServerRevisionMetadataRequest, opts ...grpc.CallOption) (*v1alpha1.RevisionMetadata, error)
+	// GetHelmCharts returns list of helm charts in the specified repository
+	GetHelmCharts(ctx context.Context, in *HelmChartsRequest, opts ...grpc.CallOption) (*HelmChartsResponse, error)
+	// ListCheckouts returns the repositories checked out on the repo server disk
+	ListCheckouts(ctx context.Context, in *ListCheckoutsRequest, opts ...grpc.CallOption) (*CheckoutList, error)
+	// EvictCheckouts removes idle repository checkouts from the repo server disk
+	EvictCheckouts(ctx context.Context, in *EvictCheckoutsRequest, opts ...grpc.CallOption) (*EvictCheckoutsResponse, error)
+}
+
+type repoServerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRepoServerServiceClient(cc *grpc.ClientConn) RepoServerServiceClient {
+	return &repoServerServiceClient{cc}
 }
 
 func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *ManifestRequest, opts ...grpc.CallOption) (*ManifestResponse, error) {
@@ -1485,6 +2060,40 @@ func (c *repoServerServiceClient) GenerateManifest(ctx context.Context, in *Mani
 	return out, nil
 }
 
+func (c *repoServerServiceClient) GenerateManifestWithFiles(ctx context.Context, opts ...grpc.CallOption) (RepoServerService_GenerateManifestWithFilesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_RepoServerService_serviceDesc.Streams[0], "/repository.RepoServerService/GenerateManifestWithFiles", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &repoServerServiceGenerateManifestWithFilesClient{stream}
+	return x, nil
+}
+
+type RepoServerService_GenerateManifestWithFilesClient interface {
+	Send(*ManifestRequestWithFiles) error
+	CloseAndRecv() (*ManifestResponse, error)
+	grpc.ClientStream
+}
+
+type repoServerServiceGenerateManifestWithFilesClient struct {
+	grpc.ClientStream
+}
+
+func (x *repoServerServiceGenerateManifestWithFilesClient) Send(m *ManifestRequestWithFiles) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *repoServerServiceGenerateManifestWithFilesClient) CloseAndRecv() (*ManifestResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ManifestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *repoServerServiceClient) TestRepository(ctx context.Context, in *TestRepositoryRequest, opts ...grpc.CallOption) (*TestRepositoryResponse, error) {
 	out := new(TestRepositoryResponse)
 	err := c.cc.Invoke(ctx, "/repository.RepoServerService/TestRepository", in, out, opts...)
@@ -1548,10 +2157,30 @@ func (c *repoServerServiceClient) GetHelmCharts(ctx context.Context, in *HelmCha
 	return out, nil
 }
 
+func (c *repoServerServiceClient) ListCheckouts(ctx context.Context, in *ListCheckoutsRequest, opts ...grpc.CallOption) (*CheckoutList, error) {
+	out := new(CheckoutList)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/ListCheckouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *repoServerServiceClient) EvictCheckouts(ctx context.Context, in *EvictCheckoutsRequest, opts ...grpc.CallOption) (*EvictCheckoutsResponse, error) {
+	out := new(EvictCheckoutsResponse)
+	err := c.cc.Invoke(ctx, "/repository.RepoServerService/EvictCheckouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RepoServerServiceServer is the server API for RepoServerService service.
 type RepoServerServiceServer interface {
 	// GenerateManifest generates manifest for application in specified repo name and revision
 	GenerateManifest(context.Context, *ManifestRequest) (*ManifestResponse, error)
+	// GenerateManifestWithFiles generates manifest for application using the files streamed by the client instead of a repository
+	GenerateManifestWithFiles(RepoServerService_GenerateManifestWithFilesServer) error
 	// Returns a bool val if the repository is valid and has proper access
 	TestRepository(context.Context, *TestRepositoryRequest) (*TestRepositoryResponse, error)
 	// Returns a valid revision
@@ -1566,6 +2195,10 @@ type RepoServerServiceServer interface {
 	GetRevisionMetadata(context.Context, *RepoServerRevisionMetadataRequest) (*v1alpha1.RevisionMetadata, error)
 	// GetHelmCharts returns list of helm charts in the specified repository
 	GetHelmCharts(context.Context, *HelmChartsRequest) (*HelmChartsResponse, error)
+	// ListCheckouts returns the repositories checked out on the repo server disk
+	ListCheckouts(context.Context, *ListCheckoutsRequest) (*CheckoutList, error)
+	// EvictCheckouts removes idle repository checkouts from the repo server disk
+	EvictCheckouts(context.Context, *EvictCheckoutsRequest) (*EvictCheckoutsResponse, error)
 }
 
 // UnimplementedRepoServerServiceServer can be embedded to have forward compatible implementations.
@@ -1575,6 +2208,9 @@ type UnimplementedRepoServerServiceServer struct {
 func (*UnimplementedRepoServerServiceServer) GenerateManifest(ctx context.Context, req *ManifestRequest) (*ManifestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenerateManifest not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) GenerateManifestWithFiles(srv RepoServerService_GenerateManifestWithFilesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GenerateManifestWithFiles not implemented")
+}
 func (*UnimplementedRepoServerServiceServer) TestRepository(ctx context.Context, req *TestRepositoryRequest) (*TestRepositoryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TestRepository not implemented")
 }
@@ -1596,6 +2232,12 @@ func (*UnimplementedRepoServerServiceServer) GetRevisionMetadata(ctx context.Con
 func (*UnimplementedRepoServerServiceServer) GetHelmCharts(ctx context.Context, req *HelmChartsRequest) (*HelmChartsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHelmCharts not implemented")
 }
+func (*UnimplementedRepoServerServiceServer) ListCheckouts(ctx context.Context, req *ListCheckoutsRequest) (*CheckoutList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCheckouts not implemented")
+}
+func (*UnimplementedRepoServerServiceServer) EvictCheckouts(ctx context.Context, req *EvictCheckoutsRequest) (*EvictCheckoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvictCheckouts not implemented")
+}
 
 func RegisterRepoServerServiceServer(s *grpc.Server, srv RepoServerServiceServer) {
 	s.RegisterService(&_RepoServerService_serviceDesc, srv)
@@ -1619,6 +2261,32 @@ func _RepoServerService_GenerateManifest_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RepoServerService_GenerateManifestWithFiles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RepoServerServiceServer).GenerateManifestWithFiles(&repoServerServiceGenerateManifestWithFilesServer{stream})
+}
+
+type RepoServerService_GenerateManifestWithFilesServer interface {
+	SendAndClose(*ManifestResponse) error
+	Recv() (*ManifestRequestWithFiles, error)
+	grpc.ServerStream
+}
+
+type repoServerServiceGenerateManifestWithFilesServer struct {
+	grpc.ServerStream
+}
+
+func (x *repoServerServiceGenerateManifestWithFilesServer) SendAndClose(m *ManifestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *repoServerServiceGenerateManifestWithFilesServer) Recv() (*ManifestRequestWithFiles, error) {
+	m := new(ManifestRequestWithFiles)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _RepoServerService_TestRepository_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TestRepositoryRequest)
 	if err := dec(in); err != nil {
@@ -1745,14 +2413,50 @@ func _RepoServerService_GetHelmCharts_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
-var _RepoServerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "repository.RepoServerService",
-	HandlerType: (*RepoServerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GenerateManifest",
-			Handler:    _RepoServerService_GenerateManifest_Handler,
-		},
+func _RepoServerService_ListCheckouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCheckoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).ListCheckouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/ListCheckouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).ListCheckouts(ctx, req.(*ListCheckoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RepoServerService_EvictCheckouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvictCheckoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RepoServerServiceServer).EvictCheckouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/repository.RepoServerService/EvictCheckouts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RepoServerServiceServer).EvictCheckouts(ctx, req.(*EvictCheckoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RepoServerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "repository.RepoServerService",
+	HandlerType: (*RepoServerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GenerateManifest",
+			Handler:    _RepoServerService_GenerateManifest_Handler,
+		},
 		{
 			MethodName: "TestRepository",
 			Handler:    _RepoServerService_TestRepository_Handler,
@@ -1781,8 +2485,22 @@ var _RepoServerService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHelmCharts",
 			Handler:    _RepoServerService_GetHelmCharts_Handler,
 		},
+		{
+			MethodName: "ListCheckouts",
+			Handler:    _RepoServerService_ListCheckouts_Handler,
+		},
+		{
+			MethodName: "EvictCheckouts",
+			Handler:    _RepoServerService_EvictCheckouts_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GenerateManifestWithFiles",
+			Handler:       _RepoServerService_GenerateManifestWithFiles_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "reposerver/repository/repository.proto",
 }
 
@@ -1810,6 +2528,31 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SignatureKeys) > 0 {
+		for iNdEx := len(m.SignatureKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SignatureKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.SignaturePolicy) > 0 {
+		i -= len(m.SignaturePolicy)
+		copy(dAtA[i:], m.SignaturePolicy)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.SignaturePolicy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.HelmOptions != nil {
 		{
 			size, err := m.HelmOptions.MarshalToSizedBuffer(dAtA[:i])
@@ -2018,6 +2761,178 @@ func (m *ManifestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManifestRequestWithFiles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestRequestWithFiles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestRequestWithFiles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Part != nil {
+		{
+			size := m.Part.Size()
+			i -= size
+			if _, err := m.Part.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestRequestWithFiles_Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestRequestWithFiles_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Request != nil {
+		{
+			size, err := m.Request.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ManifestRequestWithFiles_Metadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestRequestWithFiles_Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ManifestRequestWithFiles_Chunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestRequestWithFiles_Chunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Chunk != nil {
+		{
+			size, err := m.Chunk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ManifestFileMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestFileMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestFileMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Checksum) > 0 {
+		i -= len(m.Checksum)
+		copy(dAtA[i:], m.Checksum)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Checksum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestFileChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestFileChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestFileChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Chunk) > 0 {
+		i -= len(m.Chunk)
+		copy(dAtA[i:], m.Chunk)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Chunk)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TestRepositoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2217,6 +3132,27 @@ func (m *ManifestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ChartUpdateVersion) > 0 {
+		i -= len(m.ChartUpdateVersion)
+		copy(dAtA[i:], m.ChartUpdateVersion)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.ChartUpdateVersion)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ChartVersionConstraint) > 0 {
+		i -= len(m.ChartVersionConstraint)
+		copy(dAtA[i:], m.ChartVersionConstraint)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.ChartVersionConstraint)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.VerifyTagResult) > 0 {
+		i -= len(m.VerifyTagResult)
+		copy(dAtA[i:], m.VerifyTagResult)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.VerifyTagResult)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.VerifyResult) > 0 {
 		i -= len(m.VerifyResult)
 		copy(dAtA[i:], m.VerifyResult)
@@ -2997,41 +3933,250 @@ func (m *HelmChartsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRepository(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ListCheckoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ManifestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ListCheckoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListCheckoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	l = len(m.Revision)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
-	}
-	if m.NoCache {
-		n += 2
-	}
-	l = len(m.AppLabelKey)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.AppName)
-	if l > 0 {
-		n += 1 + l + sovRepository(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *RepositoryCheckout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepositoryCheckout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepositoryCheckout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.InUse {
+		i--
+		if m.InUse {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastUsed != nil {
+		{
+			size, err := m.LastUsed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRepository(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SizeBytes != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.SizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckoutList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckoutList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckoutList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxSizeBytes != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.MaxSizeBytes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalSizeBytes != 0 {
+		i = encodeVarintRepository(dAtA, i, uint64(m.TotalSizeBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRepository(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictCheckoutsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictCheckoutsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictCheckoutsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Repo) > 0 {
+		i -= len(m.Repo)
+		copy(dAtA[i:], m.Repo)
+		i = encodeVarintRepository(dAtA, i, uint64(len(m.Repo)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvictCheckoutsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvictCheckoutsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvictCheckoutsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Repos) > 0 {
+		for iNdEx := len(m.Repos) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Repos[iNdEx])
+			copy(dAtA[i:], m.Repos[iNdEx])
+			i = encodeVarintRepository(dAtA, i, uint64(len(m.Repos[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRepository(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRepository(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ManifestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.Revision)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.NoCache {
+		n += 2
+	}
+	l = len(m.AppLabelKey)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.AppName)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
 	}
 	l = len(m.Namespace)
 	if l > 0 {
@@ -3095,6 +4240,102 @@ func (m *ManifestRequest) Size() (n int) {
 		l = m.HelmOptions.Size()
 		n += 2 + l + sovRepository(uint64(l))
 	}
+	l = len(m.SignaturePolicy)
+	if l > 0 {
+		n += 2 + l + sovRepository(uint64(l))
+	}
+	if len(m.SignatureKeys) > 0 {
+		for _, e := range m.SignatureKeys {
+			l = e.Size()
+			n += 2 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestRequestWithFiles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Part != nil {
+		n += m.Part.Size()
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestRequestWithFiles_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Request != nil {
+		l = m.Request.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	return n
+}
+func (m *ManifestRequestWithFiles_Metadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	return n
+}
+func (m *ManifestRequestWithFiles_Chunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Chunk != nil {
+		l = m.Chunk.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	return n
+}
+func (m *ManifestFileMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Checksum)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovRepository(uint64(m.Size_))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestFileChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Chunk)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3208,10 +4449,22 @@ func (m *ManifestResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRepository(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.VerifyTagResult)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
 	}
-	return n
+	l = len(m.ChartVersionConstraint)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	l = len(m.ChartUpdateVersion)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
 func (m *ListRefsRequest) Size() (n int) {
@@ -3533,6 +4786,102 @@ func (m *HelmChartsResponse) Size() (n int) {
 	return n
 }
 
+func (m *ListCheckoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RepositoryCheckout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.SizeBytes != 0 {
+		n += 1 + sovRepository(uint64(m.SizeBytes))
+	}
+	if m.LastUsed != nil {
+		l = m.LastUsed.Size()
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.InUse {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CheckoutList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.TotalSizeBytes != 0 {
+		n += 1 + sovRepository(uint64(m.TotalSizeBytes))
+	}
+	if m.MaxSizeBytes != 0 {
+		n += 1 + sovRepository(uint64(m.MaxSizeBytes))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvictCheckoutsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Repo)
+	if l > 0 {
+		n += 1 + l + sovRepository(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *EvictCheckoutsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Repos) > 0 {
+		for _, s := range m.Repos {
+			l = len(s)
+			n += 1 + l + sovRepository(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovRepository(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4213,62 +5562,11 @@ func (m *ManifestRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TestRepositoryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TestRepositoryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TestRepositoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SignaturePolicy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4278,84 +5576,29 @@ func (m *TestRepositoryRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SignaturePolicy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TestRepositoryResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TestRepositoryResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TestRepositoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRepository", wireType)
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureKeys", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4365,12 +5608,26 @@ func (m *TestRepositoryResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.VerifiedRepository = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureKeys = append(m.SignatureKeys, &v1alpha1.SignatureKey{})
+			if err := m.SignatureKeys[len(m.SignatureKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4393,7 +5650,7 @@ func (m *TestRepositoryResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
+func (m *ManifestRequestWithFiles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4416,15 +5673,15 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResolveRevisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestRequestWithFiles: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResolveRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestRequestWithFiles: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Request", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4451,16 +5708,15 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ManifestRequest{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Part = &ManifestRequestWithFiles_Request{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4487,18 +5743,17 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.App == nil {
-				m.App = &v1alpha1.Application{}
-			}
-			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &ManifestFileMetadata{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Part = &ManifestRequestWithFiles_Metadata{v}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4508,23 +5763,26 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
+			v := &ManifestFileChunk{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Part = &ManifestRequestWithFiles_Chunk{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4548,7 +5806,7 @@ func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
+func (m *ManifestFileMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4571,15 +5829,15 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResolveRevisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestFileMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResolveRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestFileMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checksum", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4607,13 +5865,13 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Checksum = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			var stringLen uint64
+			m.Size_ = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4623,24 +5881,11 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Size_ |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4663,7 +5908,7 @@ func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
+func (m *ManifestFileChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4686,17 +5931,17 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestFileChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestFileChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Chunk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4706,125 +5951,82 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
+			m.Chunk = append(m.Chunk[:0], dAtA[iNdEx:postIndex]...)
+			if m.Chunk == nil {
+				m.Chunk = []byte{}
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Server = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TestRepositoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TestRepositoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TestRepositoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4834,55 +6036,27 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SourceType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.VerifyResult = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4906,7 +6080,7 @@ func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListRefsRequest) Unmarshal(dAtA []byte) error {
+func (m *TestRepositoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4929,17 +6103,17 @@ func (m *ListRefsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListRefsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TestRepositoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListRefsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TestRepositoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedRepository", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -4949,28 +6123,12 @@ func (m *ListRefsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.VerifiedRepository = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -4993,7 +6151,7 @@ func (m *ListRefsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Refs) Unmarshal(dAtA []byte) error {
+func (m *ResolveRevisionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5016,17 +6174,17 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Refs: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResolveRevisionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Refs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResolveRevisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5036,27 +6194,67 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branches = append(m.Branches, string(dAtA[iNdEx:postIndex]))
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field App", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.App == nil {
+				m.App = &v1alpha1.Application{}
+			}
+			if err := m.App.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5084,7 +6282,7 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5108,7 +6306,7 @@ func (m *Refs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
+func (m *ResolveRevisionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5131,49 +6329,13 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListAppsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ResolveRevisionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ResolveRevisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
@@ -5205,11 +6367,11 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmbiguousRevision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5219,106 +6381,23 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnabledSourceTypes == nil {
-				m.EnabledSourceTypes = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.EnabledSourceTypes[mapkey] = mapvalue
+			m.AmbiguousRevision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5342,7 +6421,7 @@ func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppList) Unmarshal(dAtA []byte) error {
+func (m *ManifestResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5365,17 +6444,17 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifests", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5385,211 +6464,61 @@ func (m *AppList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Apps == nil {
-				m.Apps = make(map[string]string)
+			m.Manifests = append(m.Manifests, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowRepository
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Apps[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRepository(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRepository
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerAppDetailsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Server", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5599,33 +6528,29 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Source == nil {
-				m.Source = &v1alpha1.ApplicationSource{}
-			}
-			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Server = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5635,31 +6560,29 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Repos = append(m.Repos, &v1alpha1.Repository{})
-			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5669,31 +6592,27 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KustomizeOptions == nil {
-				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
-			}
-			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SourceType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyResult", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5721,13 +6640,13 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppName = string(dAtA[iNdEx:postIndex])
+			m.VerifyResult = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifyTagResult", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5737,35 +6656,27 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoCache = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoRevisionCache", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
 			}
-			m.NoRevisionCache = bool(v != 0)
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerifyTagResult = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartVersionConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5793,13 +6704,13 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			m.ChartVersionConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChartUpdateVersion", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -5809,27 +6720,970 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnabledSourceTypes == nil {
-				m.EnabledSourceTypes = make(map[string]bool)
-			}
-			var mapkey string
-			var mapvalue bool
-			for iNdEx < postIndex {
+			m.ChartUpdateVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListRefsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListRefsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListRefsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Refs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Refs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Refs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branches", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branches = append(m.Branches, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListAppsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListAppsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListAppsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnabledSourceTypes == nil {
+				m.EnabledSourceTypes = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnabledSourceTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AppList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AppList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Apps == nil {
+				m.Apps = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowRepository
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Apps[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerAppDetailsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerAppDetailsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Source == nil {
+				m.Source = &v1alpha1.ApplicationSource{}
+			}
+			if err := m.Source.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repos = append(m.Repos, &v1alpha1.Repository{})
+			if err := m.Repos[len(m.Repos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KustomizeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KustomizeOptions == nil {
+				m.KustomizeOptions = &v1alpha1.KustomizeOptions{}
+			}
+			if err := m.KustomizeOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoCache = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoRevisionCache", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoRevisionCache = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingMethod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingMethod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledSourceTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnabledSourceTypes == nil {
+				m.EnabledSourceTypes = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5846,73 +7700,351 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 						break
 					}
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapvaluetemp int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowRepository
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvaluetemp |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					mapvalue = bool(mapvaluetemp != 0)
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipRepository(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthRepository
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowRepository
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipRepository(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthRepository
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnabledSourceTypes[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HelmOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.HelmOptions == nil {
+				m.HelmOptions = &v1alpha1.HelmOptions{}
+			}
+			if err := m.HelmOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoAppDetailsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoAppDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Helm == nil {
+				m.Helm = &HelmAppSpec{}
+			}
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kustomize == nil {
+				m.Kustomize = &KustomizeAppSpec{}
+			}
+			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.EnabledSourceTypes[mapkey] = mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Directory == nil {
+				m.Directory = &DirectoryAppSpec{}
+			}
+			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HelmOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5939,13 +8071,65 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.HelmOptions == nil {
-				m.HelmOptions = &v1alpha1.HelmOptions{}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
 			}
-			if err := m.HelmOptions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckSignature", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CheckSignature = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -5968,7 +8152,7 @@ func (m *RepoServerAppDetailsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
+func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5991,15 +8175,15 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoAppDetailsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoAppDetailsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6027,11 +8211,43 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6058,18 +8274,16 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Helm == nil {
-				m.Helm = &HelmAppSpec{}
-			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Parameters = append(m.Parameters, &v1alpha1.HelmParameter{})
+			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kustomize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -6079,31 +8293,27 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kustomize == nil {
-				m.Kustomize = &KustomizeAppSpec{}
-			}
-			if err := m.Kustomize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Directory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6130,10 +8340,8 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Directory == nil {
-				m.Directory = &DirectoryAppSpec{}
-			}
-			if err := m.Directory.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FileParameters = append(m.FileParameters, &v1alpha1.HelmFileParameter{})
+			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6159,7 +8367,7 @@ func (m *RepoAppDetailsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6182,51 +8390,15 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: KustomizeAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoServerRevisionMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KustomizeAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
-			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6254,28 +8426,8 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
+			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CheckSignature", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.CheckSignature = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -6298,7 +8450,7 @@ func (m *RepoServerRevisionMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
+func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6321,79 +8473,66 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: DirectoryAppSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DirectoryAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthRepository
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueFiles", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRepository
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRepository
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRepository
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueFiles = append(m.ValueFiles, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmChartsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmChartsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Parameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6420,14 +8559,67 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Parameters = append(m.Parameters, &v1alpha1.HelmParameter{})
-			if err := m.Parameters[len(m.Parameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HelmChart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HelmChart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HelmChart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6455,13 +8647,13 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Values = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -6471,25 +8663,23 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FileParameters = append(m.FileParameters, &v1alpha1.HelmFileParameter{})
-			if err := m.FileParameters[len(m.FileParameters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6513,7 +8703,7 @@ func (m *HelmAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
+func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6536,17 +8726,17 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KustomizeAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: HelmChartsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KustomizeAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HelmChartsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 3:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -6556,23 +8746,25 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, string(dAtA[iNdEx:postIndex]))
+			m.Items = append(m.Items, &HelmChart{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6596,7 +8788,7 @@ func (m *KustomizeAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
+func (m *ListCheckoutsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6619,10 +8811,10 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DirectoryAppSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListCheckoutsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DirectoryAppSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListCheckoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6647,7 +8839,7 @@ func (m *DirectoryAppSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
+func (m *RepositoryCheckout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6670,16 +8862,67 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChartsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepositoryCheckout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChartsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepositoryCheckout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRepository
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Repo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUsed", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -6705,13 +8948,33 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Repo == nil {
-				m.Repo = &v1alpha1.Repository{}
+			if m.LastUsed == nil {
+				m.LastUsed = &v1.Time{}
 			}
-			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InUse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InUse = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRepository(dAtA[iNdEx:])
@@ -6734,7 +8997,7 @@ func (m *HelmChartsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChart) Unmarshal(dAtA []byte) error {
+func (m *CheckoutList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6757,17 +9020,17 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChart: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckoutList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckoutList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -6777,27 +9040,118 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &RepositoryCheckout{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSizeBytes", wireType)
+			}
+			m.TotalSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSizeBytes", wireType)
+			}
+			m.MaxSizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRepository
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRepository(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRepository
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvictCheckoutsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRepository
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvictCheckoutsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvictCheckoutsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6825,7 +9179,7 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			m.Repo = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6849,7 +9203,7 @@ func (m *HelmChart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
+func (m *EvictCheckoutsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6872,17 +9226,17 @@ func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HelmChartsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvictCheckoutsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HelmChartsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvictCheckoutsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Repos", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRepository
@@ -6892,25 +9246,23 @@ func (m *HelmChartsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRepository
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRepository
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &HelmChart{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Repos = append(m.Repos, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
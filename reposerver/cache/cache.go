@@ -21,6 +21,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/env"
+	"github.com/argoproj/argo-cd/v2/util/gpg"
 	"github.com/argoproj/argo-cd/v2/util/hash"
 )
 
@@ -40,6 +41,17 @@ type ClusterRuntimeInfo interface {
 	GetKubeVersion() string
 }
 
+// SignatureVerificationInfo is implemented by manifest requests which verify the signatures of the revisions. The
+// cached verification results are only valid for the same verification settings.
+type SignatureVerificationInfo interface {
+	// GetVerifySignature returns whether signatures are verified
+	GetVerifySignature() bool
+	// GetSignaturePolicy returns which Git objects must be signed
+	GetSignaturePolicy() string
+	// GetSignatureKeys returns the keys signatures must be made with
+	GetSignatureKeys() []*appv1.SignatureKey
+}
+
 func NewCache(cache *cacheutil.Cache, repoCacheExpiration time.Duration, revisionCacheExpiration time.Duration) *Cache {
 	return &Cache{cache, repoCacheExpiration, revisionCacheExpiration}
 }
@@ -94,6 +106,33 @@ func clusterRuntimeInfoKeyUnhashed(info ClusterRuntimeInfo) string {
 	return info.GetKubeVersion() + "|" + strings.Join(apiVersions, ",")
 }
 
+// signatureVerificationKey gets the signature verification settings for a cache key. It is empty if the cluster info
+// is not a request which verifies signatures, so that results of such requests are never shared with other requests.
+func signatureVerificationKey(info ClusterRuntimeInfo, appSrc *appv1.ApplicationSource) string {
+	verification, ok := info.(SignatureVerificationInfo)
+	if !ok || !verification.GetVerifySignature() {
+		return ""
+	}
+	policy := appv1.SignaturePolicy(verification.GetSignaturePolicy())
+	key := "|sig:" + string(policy)
+	// gitsign signatures are validated against the identities and issuers of the gitsign keys
+	var gitsignKeys []string
+	for _, k := range verification.GetSignatureKeys() {
+		if k != nil && k.Type == gpg.SignatureKeyTypeGitsign {
+			gitsignKeys = append(gitsignKeys, k.KeyID+"@"+k.Issuer)
+		}
+	}
+	if len(gitsignKeys) > 0 {
+		sort.Strings(gitsignKeys)
+		key += fmt.Sprintf(":%d", hash.FNVa(strings.Join(gitsignKeys, ",")))
+	}
+	if policy.RequiresTagSignature() && appSrc != nil {
+		// the tag signature is only verified if the target revision is a tag, which is not part of the source key
+		key += ":" + appSrc.TargetRevision
+	}
+	return key
+}
+
 func listApps(repoURL, revision string) string {
 	return fmt.Sprintf("ldir|%s|%s", repoURL, revision)
 }
@@ -112,9 +151,9 @@ func helmIndexRefsKey(repo string) string {
 	return fmt.Sprintf("helm-index|%s", repo)
 }
 
-// SetHelmIndex stores helm repository index.yaml content to cache
+// SetHelmIndex stores helm repository index.yaml content to cache. Passing nil index data removes the cached index.
 func (c *Cache) SetHelmIndex(repo string, indexData []byte) error {
-	return c.cache.SetItem(helmIndexRefsKey(repo), indexData, c.revisionCacheExpiration, false)
+	return c.cache.SetItem(helmIndexRefsKey(repo), indexData, c.revisionCacheExpiration, indexData == nil)
 }
 
 // GetHelmIndex retrieves helm repository index.yaml content from cache
@@ -151,7 +190,7 @@ func (c *Cache) GetGitReferences(repo string, references *[]*plumbing.Reference)
 
 func manifestCacheKey(revision string, appSrc *appv1.ApplicationSource, namespace string, trackingMethod string, appLabelKey string, appName string, info ClusterRuntimeInfo) string {
 	trackingKey := trackingKey(appLabelKey, trackingMethod)
-	return fmt.Sprintf("mfst|%s|%s|%s|%s|%d", trackingKey, appName, revision, namespace, appSourceKey(appSrc)+clusterRuntimeInfoKey(info))
+	return fmt.Sprintf("mfst|%s|%s|%s|%s|%d%s", trackingKey, appName, revision, namespace, appSourceKey(appSrc)+clusterRuntimeInfoKey(info), signatureVerificationKey(info, appSrc))
 }
 
 func trackingKey(appLabelKey string, trackingMethod string) string {
@@ -173,6 +212,7 @@ func LogDebugManifestCacheKeyFields(message string, reason string, revision stri
 			"trackingKey": trackingKey(appLabelKey, trackingMethod),
 			"appName":     appName,
 			"clusterInfo": clusterRuntimeInfoKeyUnhashed(clusterInfo),
+			"signature":   signatureVerificationKey(clusterInfo, appSrc),
 			"reason":      reason,
 		}).Debug(message)
 	}
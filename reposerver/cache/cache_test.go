@@ -100,6 +100,33 @@ func TestCache_GetManifests(t *testing.T) {
 	assert.Equal(t, &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{SourceType: "my-source-type"}}, value)
 }
 
+func TestCache_GetManifestsSignatureVerification(t *testing.T) {
+	cache := newFixtures().Cache
+	src := &ApplicationSource{TargetRevision: "v1.0.0"}
+	commitPolicy := &apiclient.ManifestRequest{VerifySignature: true, SignaturePolicy: string(SignaturePolicyCommit)}
+	res := &CachedManifestResponse{ManifestResponse: &apiclient.ManifestResponse{VerifyResult: "my-signature"}}
+	err := cache.SetManifests("my-revision", src, commitPolicy, "my-namespace", "", "my-app-label-key", "my-app-label-value", res)
+	assert.NoError(t, err)
+	value := &CachedManifestResponse{}
+	// cache miss, signatures are not verified
+	err = cache.GetManifests("my-revision", src, &apiclient.ManifestRequest{}, "my-namespace", "", "my-app-label-key", "my-app-label-value", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache miss, other signature policy
+	tagPolicy := &apiclient.ManifestRequest{VerifySignature: true, SignaturePolicy: string(SignaturePolicyTag)}
+	err = cache.GetManifests("my-revision", src, tagPolicy, "my-namespace", "", "my-app-label-key", "my-app-label-value", value)
+	assert.Equal(t, ErrCacheMiss, err)
+	// cache hit
+	err = cache.GetManifests("my-revision", src, commitPolicy, "my-namespace", "", "my-app-label-key", "my-app-label-value", value)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-signature", value.ManifestResponse.VerifyResult)
+
+	// tag signatures depend on the target revision
+	err = cache.SetManifests("my-revision", src, tagPolicy, "my-namespace", "", "my-app-label-key", "my-app-label-value", res)
+	assert.NoError(t, err)
+	err = cache.GetManifests("my-revision", &ApplicationSource{TargetRevision: "main"}, tagPolicy, "my-namespace", "", "my-app-label-key", "my-app-label-value", value)
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestCache_GetAppDetails(t *testing.T) {
 	cache := newFixtures().Cache
 	// cache miss
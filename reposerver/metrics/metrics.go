@@ -17,6 +17,9 @@ type MetricsServer struct {
 	repoPendingRequestsGauge *prometheus.GaugeVec
 	redisRequestCounter      *prometheus.CounterVec
 	redisRequestHistogram    *prometheus.HistogramVec
+	checkoutSizeGauge        *prometheus.GaugeVec
+	checkoutRequestCounter   *prometheus.CounterVec
+	checkoutEvictionCounter  *prometheus.CounterVec
 }
 
 type GitRequestType string
@@ -26,6 +29,13 @@ const (
 	GitRequestTypeFetch    = "fetch"
 )
 
+type CheckoutEvictionReason string
+
+const (
+	CheckoutEvictionReasonSize   = "size"
+	CheckoutEvictionReasonManual = "manual"
+)
+
 // NewMetricsServer returns a new prometheus server which collects application metrics.
 func NewMetricsServer() *MetricsServer {
 	registry := prometheus.NewRegistry()
@@ -79,6 +89,33 @@ func NewMetricsServer() *MetricsServer {
 	)
 	registry.MustRegister(redisRequestHistogram)
 
+	checkoutSizeGauge := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "argocd_repo_checkout_size_bytes",
+			Help: "Disk space used by the repository checkouts of the repo server.",
+		},
+		[]string{"repo"},
+	)
+	registry.MustRegister(checkoutSizeGauge)
+
+	checkoutRequestCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_checkout_request_total",
+			Help: "Number of repository checkouts performed by repo server, labeled by whether an existing checkout was reused.",
+		},
+		[]string{"repo", "hit"},
+	)
+	registry.MustRegister(checkoutRequestCounter)
+
+	checkoutEvictionCounter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "argocd_repo_checkout_eviction_total",
+			Help: "Number of repository checkouts evicted from the repo server disk.",
+		},
+		[]string{"repo", "reason"},
+	)
+	registry.MustRegister(checkoutEvictionCounter)
+
 	return &MetricsServer{
 		handler:                  promhttp.HandlerFor(registry, promhttp.HandlerOpts{}),
 		gitRequestCounter:        gitRequestCounter,
@@ -86,6 +123,9 @@ func NewMetricsServer() *MetricsServer {
 		repoPendingRequestsGauge: repoPendingRequestsGauge,
 		redisRequestCounter:      redisRequestCounter,
 		redisRequestHistogram:    redisRequestHistogram,
+		checkoutSizeGauge:        checkoutSizeGauge,
+		checkoutRequestCounter:   checkoutRequestCounter,
+		checkoutEvictionCounter:  checkoutEvictionCounter,
 	}
 }
 
@@ -117,3 +157,19 @@ func (m *MetricsServer) IncRedisRequest(failed bool) {
 func (m *MetricsServer) ObserveRedisRequestDuration(duration time.Duration) {
 	m.redisRequestHistogram.WithLabelValues("argocd-repo-server").Observe(duration.Seconds())
 }
+
+// SetCheckoutSize sets the disk space used by the checkout of the given repository
+func (m *MetricsServer) SetCheckoutSize(repo string, size int64) {
+	m.checkoutSizeGauge.WithLabelValues(repo).Set(float64(size))
+}
+
+// IncCheckoutRequest increments the checkout requests counter
+func (m *MetricsServer) IncCheckoutRequest(repo string, hit bool) {
+	m.checkoutRequestCounter.WithLabelValues(repo, strconv.FormatBool(hit)).Inc()
+}
+
+// IncCheckoutEviction increments the checkout evictions counter and drops the size of the evicted checkout
+func (m *MetricsServer) IncCheckoutEviction(repo string, reason CheckoutEvictionReason) {
+	m.checkoutEvictionCounter.WithLabelValues(repo, string(reason)).Inc()
+	m.checkoutSizeGauge.DeleteLabelValues(repo)
+}
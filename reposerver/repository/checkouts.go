@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/git"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+// checkoutSizeRefreshInterval is the minimum interval between measuring the size of the same checkout, which requires
+// walking all of its files
+const checkoutSizeRefreshInterval = time.Minute
+
+// repositoryCheckouts keeps track of the repositories checked out under the repo server root directory. Once the combined
+// size of the checkouts exceeds the configured maximum, the least recently used idle checkouts are removed from disk.
+// The sizes of the checkouts are only measured if a maximum is configured.
+type repositoryCheckouts struct {
+	lock          sync.Mutex
+	maxSize       int64
+	byPath        map[string]*repositoryCheckout
+	repoLock      *repositoryLock
+	metricsServer *metrics.MetricsServer
+	// now is usually just time.Now, but may be replaced by unit tests for testing purposes
+	now func() time.Time
+}
+
+type repositoryCheckout struct {
+	repo     string
+	path     string
+	size     int64
+	lastUsed time.Time
+	// sizedAt is the time the size has been measured, or zero if it has not been measured
+	sizedAt time.Time
+}
+
+func newRepositoryCheckouts(maxSize int64, repoLock *repositoryLock, metricsServer *metrics.MetricsServer) *repositoryCheckouts {
+	return &repositoryCheckouts{
+		maxSize:       maxSize,
+		byPath:        map[string]*repositoryCheckout{},
+		repoLock:      repoLock,
+		metricsServer: metricsServer,
+		now:           time.Now,
+	}
+}
+
+// add registers an existing checkout, e.g. one restored from a previous run of the repo server.
+func (c *repositoryCheckouts) add(repo string, path string, lastUsed time.Time) {
+	checkout := &repositoryCheckout{repo: repo, path: path, lastUsed: lastUsed}
+	if c.maxSize > 0 {
+		c.measure(checkout)
+	}
+	c.lock.Lock()
+	c.byPath[path] = checkout
+	c.lock.Unlock()
+}
+
+// measure updates the size of the checkout and the corresponding metric
+func (c *repositoryCheckouts) measure(checkout *repositoryCheckout) {
+	size, err := getDirectorySize(checkout.path)
+	if err != nil {
+		log.Warnf("Failed to get size of repository checkout %s: %v", checkout.path, err)
+	}
+	checkout.size = size
+	checkout.sizedAt = c.now()
+	c.metricsServer.SetCheckoutSize(checkout.repo, size)
+}
+
+// used records that the checkout at the given path has just been used and evicts the least recently used idle
+// checkouts if the maximum size is exceeded. The size of the checkout is measured at most once per
+// checkoutSizeRefreshInterval, so that manifest generation does not walk the checkout every time. The caller is
+// expected to hold the repository lock of the path.
+func (c *repositoryCheckouts) used(repo string, path string) {
+	checkout := &repositoryCheckout{repo: repo, path: path}
+	c.lock.Lock()
+	if existing, ok := c.byPath[path]; ok {
+		checkout.size = existing.size
+		checkout.sizedAt = existing.sizedAt
+	}
+	c.lock.Unlock()
+	if c.maxSize > 0 && (checkout.sizedAt.IsZero() || c.now().Sub(checkout.sizedAt) >= checkoutSizeRefreshInterval) {
+		c.measure(checkout)
+	}
+	checkout.lastUsed = c.now()
+
+	c.lock.Lock()
+	c.byPath[path] = checkout
+	total := c.totalSize()
+	if c.maxSize <= 0 || total <= c.maxSize {
+		c.lock.Unlock()
+		return
+	}
+	var candidates []*repositoryCheckout
+	for _, checkout := range c.byPath {
+		if checkout.path != path {
+			candidates = append(candidates, checkout)
+		}
+	}
+	c.lock.Unlock()
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].lastUsed.Before(candidates[j].lastUsed)
+	})
+	for _, checkout := range candidates {
+		if total <= c.maxSize {
+			return
+		}
+		if c.remove(checkout, metrics.CheckoutEvictionReasonSize) {
+			total -= checkout.size
+		}
+	}
+	if total > c.maxSize {
+		log.Warnf("Repository checkouts use %d bytes which exceeds the maximum of %d bytes, but no idle checkout is left to evict", total, c.maxSize)
+	}
+}
+
+// evict removes the idle checkouts of the given repository, or all idle checkouts if repo is empty. It returns the
+// repositories whose checkout has been removed and the ones which were skipped because they are in use.
+func (c *repositoryCheckouts) evict(repo string) (evicted []string, inUse []string) {
+	c.lock.Lock()
+	var candidates []*repositoryCheckout
+	for _, checkout := range c.byPath {
+		if repo == "" || git.SameURL(checkout.repo, repo) {
+			candidates = append(candidates, checkout)
+		}
+	}
+	c.lock.Unlock()
+
+	for _, checkout := range candidates {
+		if c.remove(checkout, metrics.CheckoutEvictionReasonManual) {
+			evicted = append(evicted, checkout.repo)
+		} else {
+			inUse = append(inUse, checkout.repo)
+		}
+	}
+	sort.Strings(evicted)
+	sort.Strings(inUse)
+	return evicted, inUse
+}
+
+// list returns all known checkouts, the most recently used first
+func (c *repositoryCheckouts) list() *apiclient.CheckoutList {
+	c.lock.Lock()
+	res := &apiclient.CheckoutList{MaxSizeBytes: c.maxSize, TotalSizeBytes: c.totalSize()}
+	var paths []string
+	for path, checkout := range c.byPath {
+		lastUsed := metav1.NewTime(checkout.lastUsed)
+		res.Items = append(res.Items, &apiclient.RepositoryCheckout{
+			Repo:      checkout.repo,
+			SizeBytes: checkout.size,
+			LastUsed:  &lastUsed,
+		})
+		paths = append(paths, path)
+	}
+	c.lock.Unlock()
+
+	// the repository lock must not be acquired while holding the checkouts lock
+	for i := range res.Items {
+		res.Items[i].InUse = c.repoLock.IsLocked(paths[i])
+	}
+	sort.Slice(res.Items, func(i, j int) bool {
+		return res.Items[j].LastUsed.Before(res.Items[i].LastUsed)
+	})
+	return res
+}
+
+// totalSize must be called while holding the lock
+func (c *repositoryCheckouts) totalSize() int64 {
+	var total int64
+	for _, checkout := range c.byPath {
+		total += checkout.size
+	}
+	return total
+}
+
+// remove deletes the checkout from disk unless an operation is in progress for it
+func (c *repositoryCheckouts) remove(checkout *repositoryCheckout, reason metrics.CheckoutEvictionReason) bool {
+	closer, ok := c.repoLock.TryLock(checkout.path)
+	if !ok {
+		return false
+	}
+	defer io.Close(closer)
+
+	// the checkout directory has no permissions while it is not in use
+	if err := os.Chmod(checkout.path, 0700); err != nil && !os.IsNotExist(err) {
+		log.Warnf("Failed to restore permissions on %s: %v", checkout.path, err)
+		return false
+	}
+	if err := os.RemoveAll(checkout.path); err != nil {
+		log.Warnf("Failed to remove repository checkout %s: %v", checkout.path, err)
+		return false
+	}
+
+	c.lock.Lock()
+	delete(c.byPath, checkout.path)
+	c.lock.Unlock()
+	c.metricsServer.IncCheckoutEviction(checkout.repo, reason)
+	log.WithFields(log.Fields{
+		"repo":   checkout.repo,
+		"size":   checkout.size,
+		"reason": reason,
+	}).Info("Evicted repository checkout")
+	return true
+}
+
+// getDirectorySize returns the combined size of the regular files in the given directory, without following symlinks
+func getDirectorySize(path string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	})
+	return size, err
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/reposerver/metrics"
+	"github.com/argoproj/argo-cd/v2/util/io"
+)
+
+func newCheckoutDir(t *testing.T, root string, name string, size int) string {
+	path := filepath.Join(root, name)
+	require.NoError(t, os.MkdirAll(filepath.Join(path, ".git"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(path, ".git", "data"), make([]byte, size), 0600))
+	return path
+}
+
+func TestRepositoryCheckouts_EvictLeastRecentlyUsed(t *testing.T) {
+	root := t.TempDir()
+	checkouts := newRepositoryCheckouts(250, NewRepositoryLock(), metrics.NewMetricsServer())
+	now := time.Now()
+	checkouts.now = func() time.Time { return now }
+
+	repo1 := newCheckoutDir(t, root, "repo1", 100)
+	repo2 := newCheckoutDir(t, root, "repo2", 100)
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+	now = now.Add(time.Minute)
+	checkouts.used("https://github.com/argoproj/repo2", repo2)
+	assert.DirExists(t, repo1)
+	assert.DirExists(t, repo2)
+
+	now = now.Add(time.Minute)
+	repo3 := newCheckoutDir(t, root, "repo3", 100)
+	checkouts.used("https://github.com/argoproj/repo3", repo3)
+
+	assert.NoDirExists(t, repo1)
+	assert.DirExists(t, repo2)
+	assert.DirExists(t, repo3)
+
+	list := checkouts.list()
+	assert.Equal(t, int64(200), list.TotalSizeBytes)
+	assert.Equal(t, int64(250), list.MaxSizeBytes)
+	if assert.Len(t, list.Items, 2) {
+		assert.Equal(t, "https://github.com/argoproj/repo3", list.Items[0].Repo)
+		assert.Equal(t, "https://github.com/argoproj/repo2", list.Items[1].Repo)
+		assert.Equal(t, int64(100), list.Items[0].SizeBytes)
+	}
+}
+
+func TestRepositoryCheckouts_DoNotEvictInUse(t *testing.T) {
+	root := t.TempDir()
+	repoLock := NewRepositoryLock()
+	checkouts := newRepositoryCheckouts(150, repoLock, metrics.NewMetricsServer())
+
+	repo1 := newCheckoutDir(t, root, "repo1", 100)
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+
+	closer, ok := repoLock.TryLock(repo1)
+	require.True(t, ok)
+	defer io.Close(closer)
+
+	repo2 := newCheckoutDir(t, root, "repo2", 100)
+	checkouts.used("https://github.com/argoproj/repo2", repo2)
+
+	assert.DirExists(t, repo1)
+	assert.DirExists(t, repo2)
+
+	list := checkouts.list()
+	assert.Equal(t, int64(200), list.TotalSizeBytes)
+	for _, item := range list.Items {
+		assert.Equal(t, item.Repo == "https://github.com/argoproj/repo1", item.InUse)
+	}
+}
+
+func TestRepositoryCheckouts_MeasureSize(t *testing.T) {
+	root := t.TempDir()
+	repo1 := newCheckoutDir(t, root, "repo1", 100)
+
+	// sizes are not measured without a maximum size
+	checkouts := newRepositoryCheckouts(0, NewRepositoryLock(), metrics.NewMetricsServer())
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+	assert.Equal(t, int64(0), checkouts.list().TotalSizeBytes)
+
+	checkouts = newRepositoryCheckouts(1000, NewRepositoryLock(), metrics.NewMetricsServer())
+	now := time.Now()
+	checkouts.now = func() time.Time { return now }
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+	assert.Equal(t, int64(100), checkouts.list().TotalSizeBytes)
+
+	// the size is measured again only once the refresh interval has passed
+	require.NoError(t, os.WriteFile(filepath.Join(repo1, ".git", "data"), make([]byte, 200), 0600))
+	now = now.Add(checkoutSizeRefreshInterval / 2)
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+	assert.Equal(t, int64(100), checkouts.list().TotalSizeBytes)
+	now = now.Add(checkoutSizeRefreshInterval)
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+	assert.Equal(t, int64(200), checkouts.list().TotalSizeBytes)
+}
+
+func TestRepositoryCheckouts_Evict(t *testing.T) {
+	root := t.TempDir()
+	repoLock := NewRepositoryLock()
+	checkouts := newRepositoryCheckouts(0, repoLock, metrics.NewMetricsServer())
+
+	repo1 := newCheckoutDir(t, root, "repo1", 100)
+	repo2 := newCheckoutDir(t, root, "repo2", 100)
+	checkouts.used("https://github.com/argoproj/repo1", repo1)
+	checkouts.used("https://github.com/argoproj/repo2", repo2)
+
+	evicted, inUse := checkouts.evict("https://github.com/argoproj/repo1.git")
+	assert.Equal(t, []string{"https://github.com/argoproj/repo1"}, evicted)
+	assert.Empty(t, inUse)
+	assert.NoDirExists(t, repo1)
+	assert.DirExists(t, repo2)
+
+	closer, ok := repoLock.TryLock(repo2)
+	require.True(t, ok)
+	evicted, inUse = checkouts.evict("")
+	assert.Empty(t, evicted)
+	assert.Equal(t, []string{"https://github.com/argoproj/repo2"}, inUse)
+	io.Close(closer)
+
+	evicted, _ = checkouts.evict("")
+	assert.Equal(t, []string{"https://github.com/argoproj/repo2"}, evicted)
+	assert.NoDirExists(t, repo2)
+	assert.Empty(t, checkouts.list().Items)
+}
@@ -7,6 +7,9 @@ import (
 	ioutil "github.com/argoproj/argo-cd/v2/util/io"
 )
 
+// exclusiveLockRevision is the revision recorded for locks acquired by TryLock
+const exclusiveLockRevision = "<exclusive>"
+
 func NewRepositoryLock() *repositoryLock {
 	return &repositoryLock{stateByKey: map[string]*repositoryState{}}
 }
@@ -72,6 +75,56 @@ func (r *repositoryLock) Lock(path string, revision string, allowConcurrent bool
 	}
 }
 
+// TryLock acquires lock for the given path only if no operation is in progress for it. Unlike Lock it never waits, so it
+// is safe to call while holding the lock of another path.
+func (r *repositoryLock) TryLock(path string) (io.Closer, bool) {
+	r.lock.Lock()
+	state, ok := r.stateByKey[path]
+	if !ok {
+		state = &repositoryState{cond: &sync.Cond{L: &sync.Mutex{}}}
+		r.stateByKey[path] = state
+	}
+	r.lock.Unlock()
+
+	if !state.tryLock() {
+		return nil, false
+	}
+	defer state.cond.L.Unlock()
+	if state.revision != "" {
+		return nil, false
+	}
+	// any non-empty revision makes concurrent Lock calls wait until the returned closer is closed
+	state.revision = exclusiveLockRevision
+	state.processCount = 1
+	state.allowConcurrent = false
+	state.initCloser = ioutil.NopCloser
+
+	return ioutil.NewCloser(func() error {
+		state.cond.L.Lock()
+		state.processCount = 0
+		state.revision = ""
+		state.cond.L.Unlock()
+		state.cond.Broadcast()
+		return nil
+	}), true
+}
+
+// IsLocked returns true if an operation is in progress for the given path.
+func (r *repositoryLock) IsLocked(path string) bool {
+	r.lock.Lock()
+	state, ok := r.stateByKey[path]
+	r.lock.Unlock()
+	if !ok {
+		return false
+	}
+	if !state.tryLock() {
+		// the lock is held while the repository is being initialized
+		return true
+	}
+	defer state.cond.L.Unlock()
+	return state.revision != ""
+}
+
 type repositoryState struct {
 	cond            *sync.Cond
 	revision        string
@@ -79,3 +132,7 @@ type repositoryState struct {
 	processCount    int
 	allowConcurrent bool
 }
+
+func (s *repositoryState) tryLock() bool {
+	return s.cond.L.(*sync.Mutex).TryLock()
+}
@@ -168,3 +168,36 @@ func TestLock_SameRevisionFirstNotConcurrent(t *testing.T) {
 
 	util.Close(closer1)
 }
+
+func TestLock_TryLock(t *testing.T) {
+	lock := NewRepositoryLock()
+	initializedTimes := 0
+	init := numberOfInits(&initializedTimes)
+
+	closer1, done := lockQuickly(func() (io.Closer, error) {
+		return lock.Lock("myRepo", "1", true, init)
+	})
+	if !assert.True(t, done) {
+		return
+	}
+	assert.True(t, lock.IsLocked("myRepo"))
+
+	_, ok := lock.TryLock("myRepo")
+	assert.False(t, ok)
+
+	util.Close(closer1)
+	assert.False(t, lock.IsLocked("myRepo"))
+
+	closer2, ok := lock.TryLock("myRepo")
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.True(t, lock.IsLocked("myRepo"))
+
+	_, done = lockQuickly(func() (io.Closer, error) {
+		return lock.Lock("myRepo", "1", true, init)
+	})
+	assert.False(t, done)
+
+	util.Close(closer2)
+}
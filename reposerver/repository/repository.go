@@ -85,6 +85,7 @@ type Service struct {
 	chartPaths                *io.TempPaths
 	gitRepoInitializer        func(rootPath string) goio.Closer
 	repoLock                  *repositoryLock
+	repoCheckouts             *repositoryCheckouts
 	cache                     *reposervercache.Cache
 	parallelismLimitSemaphore *semaphore.Weighted
 	metricsServer             *metrics.MetricsServer
@@ -103,6 +104,8 @@ type RepoServerInitConstants struct {
 	PauseGenerationOnFailureForRequests          int
 	SubmoduleEnabled                             bool
 	MaxCombinedDirectoryManifestsSize            resource.Quantity
+	MaxCheckoutsSize                             resource.Quantity
+	StreamedManifestMaxTarSize                   resource.Quantity
 	CMPTarExcludedGlobs                          []string
 	AllowOutOfBoundsSymlinks                     bool
 }
@@ -117,6 +120,7 @@ func NewService(metricsServer *metrics.MetricsServer, cache *reposervercache.Cac
 	return &Service{
 		parallelismLimitSemaphore: parallelismLimitSemaphore,
 		repoLock:                  repoLock,
+		repoCheckouts:             newRepositoryCheckouts(initConstants.MaxCheckoutsSize.Value(), repoLock, metricsServer),
 		cache:                     cache,
 		metricsServer:             metricsServer,
 		newGitClient:              git.NewClientExt,
@@ -161,6 +165,7 @@ func (s *Service) Init() error {
 		if repo, err := gogit.PlainOpen(fullPath); err == nil {
 			if remotes, err := repo.Remotes(); err == nil && len(remotes) > 0 && len(remotes[0].Config().URLs) > 0 {
 				s.gitRepoPaths.Add(git.NormalizeGitURL(remotes[0].Config().URLs[0]), fullPath)
+				s.repoCheckouts.add(remotes[0].Config().URLs[0], fullPath, file.ModTime())
 			}
 		}
 		io.Close(closer)
@@ -207,7 +212,7 @@ func (s *Service) ListApps(ctx context.Context, q *apiclient.ListAppsRequest) (*
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), commitSHA, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, commitSHA, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(gitClient, q.Repo.Repo, commitSHA, s.initConstants.SubmoduleEnabled)
 	})
 
 	if err != nil {
@@ -232,6 +237,10 @@ type operationSettings struct {
 	noCache         bool
 	noRevisionCache bool
 	allowConcurrent bool
+	// signaturePolicy defines which Git objects are verified if signature verification is requested
+	signaturePolicy v1alpha1.SignaturePolicy
+	// signatureKeys are the keys signatures must be made with, used to validate gitsign signatures
+	signatureKeys []*v1alpha1.SignatureKey
 }
 
 // operationContext contains request values which are generated by runRepoOperation (on demand) by a call to the
@@ -243,6 +252,9 @@ type operationContext struct {
 
 	// output of 'git verify-(tag/commit)', if signature verification is enabled (otherwise "")
 	verificationResult string
+
+	// output of 'git verify-tag' on the unresolved revision, if the signature policy requires signed tags (otherwise "")
+	tagVerificationResult string
 }
 
 // The 'operation' function parameter of 'runRepoOperation' may call this function to retrieve
@@ -275,6 +287,11 @@ func (s *Service) runRepoOperation(
 	var err error
 	revision = textutils.FirstNonEmpty(revision, source.TargetRevision)
 	if source.IsHelm() {
+		pinnedVersion, err := pinnedChartVersion(source, revision)
+		if err != nil {
+			return err
+		}
+		revision = textutils.FirstNonEmpty(pinnedVersion, revision)
 		helmClient, revision, err = s.newHelmClientResolveRevision(repo, revision, source.Chart, settings.noCache || settings.noRevisionCache)
 		if err != nil {
 			return err
@@ -336,11 +353,11 @@ func (s *Service) runRepoOperation(
 			}
 		}
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, ""}, nil
+			return &operationContext{chartPath, "", ""}, nil
 		})
 	} else {
 		closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func() (goio.Closer, error) {
-			return s.checkoutRevision(gitClient, revision, s.initConstants.SubmoduleEnabled)
+			return s.checkoutRevision(gitClient, repo.Repo, revision, s.initConstants.SubmoduleEnabled)
 		})
 
 		if err != nil {
@@ -381,22 +398,76 @@ func (s *Service) runRepoOperation(
 		// Here commitSHA refers to the SHA of the actual commit, whereas revision refers to the branch/tag name etc
 		// We use the commitSHA to generate manifests and store them in cache, and revision to retrieve them from cache
 		return operation(gitClient.Root(), commitSHA, revision, func() (*operationContext, error) {
-			var signature string
+			var signature, tagSignature string
 			if verifyCommit {
-				signature, err = gitClient.VerifyCommitSignature(revision)
+				switch {
+				case settings.signaturePolicy.RequiresCommitSignature():
+					signature, err = gitClient.VerifySignature(revision, git.SignedObjectCommit)
+				case !settings.signaturePolicy.RequiresTagSignature():
+					signature, err = gitClient.VerifyCommitSignature(revision)
+				}
 				if err != nil {
 					return nil, err
 				}
+				signature = validateGitsignClaims(gitClient, revision, git.SignedObjectCommit, signature, settings.signatureKeys)
+				if settings.signaturePolicy.RequiresTagSignature() {
+					var tag string
+					tag, tagSignature, err = verifyTargetTagSignature(gitClient, source.TargetRevision, commitSHA)
+					if err != nil {
+						return nil, err
+					}
+					tagSignature = validateGitsignClaims(gitClient, tag, git.SignedObjectTag, tagSignature, settings.signatureKeys)
+				}
 			}
 			appPath, err := argopath.Path(gitClient.Root(), source.Path)
 			if err != nil {
 				return nil, err
 			}
-			return &operationContext{appPath, signature}, nil
+			return &operationContext{appPath, signature, tagSignature}, nil
 		})
 	}
 }
 
+// verifyTargetTagSignature runs verify-tag on the target revision if it is a tag pointing to the given commit, and
+// returns the tag and the output. The output is empty if the target revision is no such tag, or not signed.
+func verifyTargetTagSignature(gitClient git.Client, targetRevision string, commitSHA string) (string, string, error) {
+	m, err := gitClient.RevisionMetadata(commitSHA)
+	if err != nil {
+		return "", "", err
+	}
+	targetRevision = strings.TrimPrefix(targetRevision, "refs/tags/")
+	for _, tag := range m.Tags {
+		if tag == targetRevision {
+			signature, err := gitClient.VerifySignature(tag, git.SignedObjectTag)
+			return tag, signature, err
+		}
+	}
+	return "", "", nil
+}
+
+// validateGitsignClaims verifies a gitsign signature again with gitsign for each of the gitsign keys, since Git does not
+// validate that the signing certificate was issued for the identity of the key by its issuer. The output of the first
+// successful verification is returned, otherwise the given output, whose certificate claims are not validated.
+func validateGitsignClaims(gitClient git.Client, revision string, objectType string, signature string, keys []*v1alpha1.SignatureKey) string {
+	if signature == "" || gpg.ParseSignatureVerification(signature).Type != gpg.SignatureKeyTypeGitsign {
+		return signature
+	}
+	for _, key := range keys {
+		if key.Type != gpg.SignatureKeyTypeGitsign || key.Issuer == "" {
+			continue
+		}
+		out, err := gitClient.VerifyGitsignSignature(revision, objectType, key.KeyID, key.Issuer)
+		if err != nil {
+			log.Warnf("Failed to verify gitsign signature of %s %s: %v", objectType, revision, err)
+			continue
+		}
+		if res := gpg.ParseSignatureVerification(out); res.Result == gpg.VerifyResultGood && gpg.SignatureKeyMatches(*key, res) {
+			return out
+		}
+	}
+	return signature
+}
+
 func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
 	var res *apiclient.ManifestResponse
 	var err error
@@ -428,7 +499,7 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		return nil
 	}
 
-	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing()}
+	settings := operationSettings{sem: s.parallelismLimitSemaphore, noCache: q.NoCache, noRevisionCache: q.NoRevisionCache, allowConcurrent: q.ApplicationSource.AllowsConcurrentProcessing(), signaturePolicy: v1alpha1.SignaturePolicy(q.SignaturePolicy), signatureKeys: q.SignatureKeys}
 	err = s.runRepoOperation(ctx, q.Revision, q.Repo, q.ApplicationSource, q.VerifySignature, cacheFn, operation, settings)
 
 	// if the tarDoneCh message is sent it means that the manifest
@@ -444,9 +515,143 @@ func (s *Service) GenerateManifest(ctx context.Context, q *apiclient.ManifestReq
 		}
 	}
 
+	if err == nil && res != nil && q.ApplicationSource.IsHelm() {
+		s.setChartVersionInfo(q, res)
+	}
+
 	return res, err
 }
 
+// GenerateManifestWithFiles generates manifests from the files streamed by the client instead of the files of a
+// repository checkout. The stream starts with the manifest request, followed by the metadata of the tgz file of the
+// repository root and its chunks. The path of the application source is resolved within the streamed files.
+func (s *Service) GenerateManifestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) error {
+	ctx := stream.Context()
+	workDir, err := files.CreateTempDir(os.TempDir())
+	if err != nil {
+		return fmt.Errorf("error creating temp dir: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(workDir); err != nil {
+			log.Warnf("error removing temp dir %q: %v", workDir, err)
+		}
+	}()
+
+	q, metadata, err := receiveManifestRequestWithFiles(stream)
+	if err != nil {
+		return err
+	}
+	recvChunk := func() ([]byte, error) {
+		part, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if part.GetChunk() == nil {
+			return nil, fmt.Errorf("expected a file chunk")
+		}
+		return part.GetChunk().Chunk, nil
+	}
+	maxSize := s.initConstants.StreamedManifestMaxTarSize.Value()
+	if maxSize > 0 && metadata.Size_ > maxSize {
+		return status.Errorf(codes.InvalidArgument, "the size of the files exceeds the maximum size of %d bytes", maxSize)
+	}
+	err = cmp.ReceiveCompressedFiles(ctx, recvChunk, metadata.Checksum, workDir, maxSize)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "error receiving files: %v", err)
+	}
+	if !s.initConstants.AllowOutOfBoundsSymlinks {
+		if err := argopath.CheckOutOfBoundsSymlinks(workDir); err != nil {
+			oobError := &argopath.OutOfBoundsSymlinkError{}
+			if errors.As(err, &oobError) {
+				return status.Errorf(codes.InvalidArgument, "files contain out-of-bounds symlinks. file: %s", oobError.File)
+			}
+			return err
+		}
+	}
+	appPath, err := argopath.Path(workDir, q.ApplicationSource.Path)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "error resolving application path: %v", err)
+	}
+
+	if s.parallelismLimitSemaphore != nil {
+		err = s.parallelismLimitSemaphore.Acquire(ctx, 1)
+		if err != nil {
+			return err
+		}
+		defer s.parallelismLimitSemaphore.Release(1)
+	}
+	res, err := GenerateManifests(ctx, appPath, workDir, "", q, false, s.gitCredsStore, s.initConstants.MaxCombinedDirectoryManifestsSize, WithCMPTarExcludedGlobs(s.initConstants.CMPTarExcludedGlobs))
+	if err != nil {
+		return fmt.Errorf("error generating manifests: %w", err)
+	}
+	return stream.SendAndClose(res)
+}
+
+// receiveManifestRequestWithFiles receives the manifest request and the file metadata starting a stream of files
+func receiveManifestRequestWithFiles(stream apiclient.RepoServerService_GenerateManifestWithFilesServer) (*apiclient.ManifestRequest, *apiclient.ManifestFileMetadata, error) {
+	part, err := stream.Recv()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error receiving manifest request: %w", err)
+	}
+	q := part.GetRequest()
+	if q == nil || q.ApplicationSource == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "the stream must start with a manifest request")
+	}
+	part, err = stream.Recv()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error receiving file metadata: %w", err)
+	}
+	metadata := part.GetMetadata()
+	if metadata == nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "the manifest request must be followed by the file metadata")
+	}
+	return q, metadata, nil
+}
+
+// setChartVersionInfo records the version constraint the Helm chart version has been resolved from. If the chart is
+// pinned, the newest version satisfying the constraint is recorded as well, if it is newer than the pinned version.
+// This is not part of the cached manifests, since new chart versions may be released at any time.
+func (s *Service) setChartVersionInfo(q *apiclient.ManifestRequest, res *apiclient.ManifestResponse) {
+	source := q.ApplicationSource
+	// OCI registries don't support version constraints, the target revision is always an exact version
+	if q.Repo.EnableOCI || helm.IsHelmOciRepo(q.Repo.Repo) || source.TargetRevision == "" || helm.IsVersion(source.TargetRevision) {
+		return
+	}
+	constraints, err := semver.NewConstraint(source.TargetRevision)
+	if err != nil {
+		return
+	}
+	res.ChartVersionConstraint = source.TargetRevision
+	if source.Helm == nil || source.Helm.PinnedChartVersion == "" {
+		return
+	}
+	logCtx := log.WithFields(log.Fields{"repo": q.Repo.Repo, "chart": source.Chart})
+	current, err := semver.NewVersion(res.Revision)
+	if err != nil {
+		logCtx.Warnf("Failed to parse chart version %s: %v", res.Revision, err)
+		return
+	}
+	helmClient := s.newHelmClient(q.Repo.Repo, q.Repo.GetHelmCreds(), false, q.Repo.Proxy, helm.WithIndexCache(s.cache), helm.WithChartPaths(s.chartPaths))
+	index, err := helmClient.GetIndex(q.NoCache || q.NoRevisionCache)
+	if err != nil {
+		logCtx.Warnf("Failed to check for chart updates: %v", err)
+		return
+	}
+	entries, err := index.GetEntries(source.Chart)
+	if err != nil {
+		logCtx.Warnf("Failed to check for chart updates: %v", err)
+		return
+	}
+	latest, err := entries.MaxVersion(constraints)
+	if err != nil {
+		logCtx.Warnf("Failed to check for chart updates: %v", err)
+		return
+	}
+	if latest.GreaterThan(current) {
+		res.ChartUpdateVersion = latest.String()
+	}
+}
+
 type ManifestResponsePromise struct {
 	responseCh <-chan *apiclient.ManifestResponse
 	tarDoneCh  <-chan bool
@@ -553,6 +758,7 @@ func (s *Service) runManifestGenAsync(ctx context.Context, repoRoot, commitSHA,
 	}
 	manifestGenResult.Revision = commitSHA
 	manifestGenResult.VerifyResult = opContext.verificationResult
+	manifestGenResult.VerifyTagResult = opContext.tagVerificationResult
 	err = s.cache.SetManifests(cacheKey, appSourceCopy, q, q.Namespace, q.TrackingMethod, q.AppLabelKey, q.AppName, &manifestGenCacheEntry)
 	if err != nil {
 		log.Warnf("manifest cache set error %s/%s: %v", appSourceCopy.String(), cacheKey, err)
@@ -1789,7 +1995,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 	defer s.metricsServer.DecPendingRepoRequest(q.Repo.Repo)
 
 	closer, err := s.repoLock.Lock(gitClient.Root(), q.Revision, true, func() (goio.Closer, error) {
-		return s.checkoutRevision(gitClient, q.Revision, s.initConstants.SubmoduleEnabled)
+		return s.checkoutRevision(gitClient, q.Repo.Repo, q.Revision, s.initConstants.SubmoduleEnabled)
 	})
 
 	if err != nil {
@@ -1803,7 +2009,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 		return nil, err
 	}
 
-	// Run gpg verify-commit on the revision
+	// Run git verify-commit on the revision, which verifies GnuPG, SSH and gitsign signatures
 	signatureInfo := ""
 	if gpg.IsGPGEnabled() && q.CheckSignature {
 		cs, err := gitClient.VerifyCommitSignature(q.Revision)
@@ -1812,16 +2018,7 @@ func (s *Service) GetRevisionMetadata(ctx context.Context, q *apiclient.RepoServ
 			return nil, err
 		}
 
-		if cs != "" {
-			vr := gpg.ParseGitCommitVerification(cs)
-			if vr.Result == gpg.VerifyResultUnknown {
-				signatureInfo = fmt.Sprintf("UNKNOWN signature: %s", vr.Message)
-			} else {
-				signatureInfo = fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, gpg.KeyID(vr.KeyID))
-			}
-		} else {
-			signatureInfo = "Revision is not signed."
-		}
+		signatureInfo = gpg.SignatureInfo(cs)
 	}
 
 	metadata = &v1alpha1.RevisionMetadata{Author: m.Author, Date: metav1.Time{Time: m.Date}, Tags: m.Tags, Message: m.Message, SignatureInfo: signatureInfo}
@@ -1885,6 +2082,26 @@ func (s *Service) newHelmClientResolveRevision(repo *v1alpha1.Repository, revisi
 	return helmClient, version.String(), nil
 }
 
+// pinnedChartVersion returns the exact version a Helm chart is pinned to, if the source pins the chart and the revision
+// is a version constraint. The pinned version must satisfy the constraint.
+func pinnedChartVersion(source *v1alpha1.ApplicationSource, revision string) (string, error) {
+	if source.Helm == nil || source.Helm.PinnedChartVersion == "" || revision == "" || helm.IsVersion(revision) {
+		return "", nil
+	}
+	constraints, err := semver.NewConstraint(revision)
+	if err != nil {
+		return "", fmt.Errorf("invalid revision '%s': %v", revision, err)
+	}
+	version, err := semver.NewVersion(source.Helm.PinnedChartVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid pinned chart version '%s': %v", source.Helm.PinnedChartVersion, err)
+	}
+	if !constraints.Check(version) {
+		return "", fmt.Errorf("pinned chart version %s does not satisfy the version constraint '%s'", source.Helm.PinnedChartVersion, revision)
+	}
+	return source.Helm.PinnedChartVersion, nil
+}
+
 // directoryPermissionInitializer ensures the directory has read/write/execute permissions and returns
 // a function that can be used to remove all permissions.
 func directoryPermissionInitializer(rootPath string) goio.Closer {
@@ -1909,9 +2126,15 @@ func directoryPermissionInitializer(rootPath string) goio.Closer {
 // checkoutRevision is a convenience function to initialize a repo, fetch, and checkout a revision
 // Returns the 40 character commit SHA after the checkout has been performed
 // nolint:unparam
-func (s *Service) checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) (goio.Closer, error) {
+func (s *Service) checkoutRevision(gitClient git.Client, repoURL string, revision string, submoduleEnabled bool) (goio.Closer, error) {
 	closer := s.gitRepoInitializer(gitClient.Root())
-	return closer, checkoutRevision(gitClient, revision, submoduleEnabled)
+	_, err := os.Stat(gitClient.Root())
+	s.metricsServer.IncCheckoutRequest(repoURL, err == nil)
+	err = checkoutRevision(gitClient, revision, submoduleEnabled)
+	if err == nil {
+		s.repoCheckouts.used(repoURL, gitClient.Root())
+	}
+	return closer, err
 }
 
 func checkoutRevision(gitClient git.Client, revision string, submoduleEnabled bool) error {
@@ -1997,6 +2220,23 @@ func (s *Service) TestRepository(ctx context.Context, q *apiclient.TestRepositor
 	return apiResp, nil
 }
 
+// ListCheckouts returns the repositories checked out on the repo server disk
+func (s *Service) ListCheckouts(ctx context.Context, q *apiclient.ListCheckoutsRequest) (*apiclient.CheckoutList, error) {
+	return s.repoCheckouts.list(), nil
+}
+
+// EvictCheckouts removes idle repository checkouts from the repo server disk
+func (s *Service) EvictCheckouts(ctx context.Context, q *apiclient.EvictCheckoutsRequest) (*apiclient.EvictCheckoutsResponse, error) {
+	evicted, inUse := s.repoCheckouts.evict(q.Repo)
+	if q.Repo != "" && len(evicted) == 0 {
+		if len(inUse) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "checkout of repository %s is in use", q.Repo)
+		}
+		return nil, status.Errorf(codes.NotFound, "repository %s is not checked out", q.Repo)
+	}
+	return &apiclient.EvictCheckoutsResponse{Repos: evicted}, nil
+}
+
 // ResolveRevision resolves the revision/ambiguousRevision specified in the ResolveRevisionRequest request into a concrete revision.
 func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevisionRequest) (*apiclient.ResolveRevisionResponse, error) {
 
@@ -2009,6 +2249,16 @@ func (s *Service) ResolveRevision(ctx context.Context, q *apiclient.ResolveRevis
 		if helm.IsVersion(ambiguousRevision) {
 			return &apiclient.ResolveRevisionResponse{Revision: ambiguousRevision, AmbiguousRevision: ambiguousRevision}, nil
 		}
+		pinnedVersion, err := pinnedChartVersion(&app.Spec.Source, ambiguousRevision)
+		if err != nil {
+			return &apiclient.ResolveRevisionResponse{Revision: "", AmbiguousRevision: ""}, err
+		}
+		if pinnedVersion != "" {
+			return &apiclient.ResolveRevisionResponse{
+				Revision:          pinnedVersion,
+				AmbiguousRevision: fmt.Sprintf("%v (%v)", ambiguousRevision, pinnedVersion),
+			}, nil
+		}
 		client := helm.NewClient(repo.Repo, repo.GetHelmCreds(), repo.EnableOCI || app.Spec.Source.IsHelmOci(), repo.Proxy, helm.WithChartPaths(s.chartPaths))
 		index, err := client.GetIndex(false)
 		if err != nil {
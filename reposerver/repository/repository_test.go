@@ -25,6 +25,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,8 +39,10 @@ import (
 	fileutil "github.com/argoproj/argo-cd/v2/test/fixture/path"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
+	"github.com/argoproj/argo-cd/v2/util/cmp"
 	"github.com/argoproj/argo-cd/v2/util/git"
 	gitmocks "github.com/argoproj/argo-cd/v2/util/git/mocks"
+	gpgtestdata "github.com/argoproj/argo-cd/v2/util/gpg/testdata"
 	"github.com/argoproj/argo-cd/v2/util/helm"
 	helmmocks "github.com/argoproj/argo-cd/v2/util/helm/mocks"
 	"github.com/argoproj/argo-cd/v2/util/io"
@@ -88,8 +93,10 @@ func newServiceWithOpt(cf clientFunc, root string) (*Service, *gitmocks.Client)
 		oobChart: {{Version: "1.0.0"}, {Version: version}},
 	}}, nil)
 	helmClient.On("ExtractChart", chart, version).Return("./testdata/my-chart", io.NopCloser, nil)
+	helmClient.On("ExtractChart", chart, "1.0.0").Return("./testdata/my-chart", io.NopCloser, nil)
 	helmClient.On("ExtractChart", oobChart, version).Return("./testdata2/out-of-bounds-chart", io.NopCloser, nil)
 	helmClient.On("CleanChartCache", chart, version).Return(nil)
+	helmClient.On("CleanChartCache", chart, "1.0.0").Return(nil)
 	helmClient.On("CleanChartCache", oobChart, version).Return(nil)
 
 	service.newGitClient = func(rawRepoURL string, root string, creds git.Creds, insecure bool, enableLfs bool, prosy string, opts ...git.ClientOpts) (client git.Client, e error) {
@@ -303,14 +310,40 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, &apiclient.ManifestResponse{
-		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
-		Namespace:  "",
-		Server:     "",
-		Revision:   "1.1.0",
-		SourceType: "Helm",
+		Manifests:              []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
+		Namespace:              "",
+		Server:                 "",
+		Revision:               "1.1.0",
+		SourceType:             "Helm",
+		ChartVersionConstraint: ">= 1.0.0",
 	}, response)
 }
 
+func TestHelmManifestFromChartRepoPinnedVersion(t *testing.T) {
+	service := newService(".")
+	source := &argoappv1.ApplicationSource{Chart: "my-chart", TargetRevision: ">= 1.0.0", Helm: &argoappv1.ApplicationSourceHelm{PinnedChartVersion: "1.0.0"}}
+	request := &apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: source, NoCache: true}
+	response, err := service.GenerateManifest(context.Background(), request)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.0.0", response.Revision)
+	assert.Equal(t, ">= 1.0.0", response.ChartVersionConstraint)
+	assert.Equal(t, "1.1.0", response.ChartUpdateVersion)
+
+	t.Run("Latest version is pinned", func(t *testing.T) {
+		source.Helm.PinnedChartVersion = "1.1.0"
+		response, err := service.GenerateManifest(context.Background(), request)
+		assert.NoError(t, err)
+		assert.Equal(t, "1.1.0", response.Revision)
+		assert.Empty(t, response.ChartUpdateVersion)
+	})
+
+	t.Run("Pinned version does not satisfy the constraint", func(t *testing.T) {
+		source.Helm.PinnedChartVersion = "0.9.0"
+		_, err := service.GenerateManifest(context.Background(), request)
+		assert.ErrorContains(t, err, "does not satisfy the version constraint")
+	})
+}
+
 func TestGenerateManifestsUseExactRevision(t *testing.T) {
 	service, gitClient := newServiceWithMocks(".", false)
 
@@ -824,11 +857,12 @@ func TestHelmManifestFromChartRepoWithValueFile(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, response)
 	assert.Equal(t, &apiclient.ManifestResponse{
-		Manifests:  []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
-		Namespace:  "",
-		Server:     "",
-		Revision:   "1.1.0",
-		SourceType: "Helm",
+		Manifests:              []string{"{\"apiVersion\":\"v1\",\"kind\":\"ConfigMap\",\"metadata\":{\"name\":\"my-map\"}}"},
+		Namespace:              "",
+		Server:                 "",
+		Revision:               "1.1.0",
+		SourceType:             "Helm",
+		ChartVersionConstraint: ">= 1.0.0",
 	}, response)
 }
 
@@ -1349,6 +1383,125 @@ func TestGetSignatureVerificationResult(t *testing.T) {
 	}
 }
 
+func TestGetGitsignSignatureVerificationResult(t *testing.T) {
+	newServiceWithGitsign := func() (*Service, *gitmocks.Client) {
+		root, err := filepath.Abs("../../manifests/base")
+		require.NoError(t, err)
+		return newServiceWithOpt(func(gitClient *gitmocks.Client) {
+			gitClient.On("Init").Return(nil)
+			gitClient.On("Fetch", mock.Anything).Return(nil)
+			gitClient.On("Checkout", mock.Anything, mock.Anything).Return(nil)
+			gitClient.On("LsRemote", mock.Anything).Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+			gitClient.On("CommitSHA").Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+			gitClient.On("Root").Return(root)
+			gitClient.On("VerifyCommitSignature", mock.Anything).Return(gpgtestdata.Gitsign_unvalidated_claims_txt, nil)
+			gitClient.On("VerifyGitsignSignature", mock.Anything, git.SignedObjectCommit, "jane@example.com", "https://accounts.google.com").Return(gpgtestdata.Gitsign_good_signature_txt, nil)
+			gitClient.On("VerifyGitsignSignature", mock.Anything, git.SignedObjectCommit, mock.Anything, mock.Anything).Return("", fmt.Errorf("none of the expected identities matched"))
+		}, root)
+	}
+	src := argoappv1.ApplicationSource{Path: "."}
+
+	// The signer matches the identity and issuer of a gitsign key
+	{
+		service, _ := newServiceWithGitsign()
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignatureKeys: []*argoappv1.SignatureKey{
+			{KeyID: "jane@example.com", Type: "gitsign", Issuer: "https://token.actions.githubusercontent.com"},
+			{KeyID: "jane@example.com", Type: "gitsign", Issuer: "https://accounts.google.com"},
+		}}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, gpgtestdata.Gitsign_good_signature_txt, res.VerifyResult)
+	}
+	// The signer matches no gitsign key, the claims stay unvalidated
+	{
+		service, _ := newServiceWithGitsign()
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignatureKeys: []*argoappv1.SignatureKey{
+			{KeyID: "jane@example.com", Type: "gitsign", Issuer: "https://token.actions.githubusercontent.com"},
+		}}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, gpgtestdata.Gitsign_unvalidated_claims_txt, res.VerifyResult)
+	}
+	// gitsign keys without issuer are not used
+	{
+		service, gitClient := newServiceWithGitsign()
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignatureKeys: []*argoappv1.SignatureKey{
+			{KeyID: "jane@example.com", Type: "gitsign"},
+		}}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, gpgtestdata.Gitsign_unvalidated_claims_txt, res.VerifyResult)
+		gitClient.AssertNotCalled(t, "VerifyGitsignSignature", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	}
+}
+
+func TestGetTagSignatureVerificationResult(t *testing.T) {
+	newServiceWithTag := func(tags []string) (*Service, *gitmocks.Client) {
+		root, err := filepath.Abs("../../manifests/base")
+		require.NoError(t, err)
+		return newServiceWithOpt(func(gitClient *gitmocks.Client) {
+			gitClient.On("Init").Return(nil)
+			gitClient.On("Fetch", mock.Anything).Return(nil)
+			gitClient.On("Checkout", mock.Anything, mock.Anything).Return(nil)
+			gitClient.On("LsRemote", mock.Anything).Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+			gitClient.On("CommitSHA").Return("632039659e542ed7de0c170a4fcc1c571b288fc0", nil)
+			gitClient.On("Root").Return(root)
+			gitClient.On("RevisionMetadata", "632039659e542ed7de0c170a4fcc1c571b288fc0").Return(&git.RevisionMetadata{Tags: tags}, nil)
+			gitClient.On("VerifySignature", "632039659e542ed7de0c170a4fcc1c571b288fc0", git.SignedObjectCommit).Return("", nil)
+			gitClient.On("VerifySignature", "v1.0.0", git.SignedObjectTag).Return(testSignature, nil)
+		}, root)
+	}
+
+	// Target revision is a signed tag and the tag signature is requested
+	{
+		service, gitClient := newServiceWithTag([]string{"v1.0.0"})
+		src := argoappv1.ApplicationSource{Path: ".", TargetRevision: "v1.0.0"}
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignaturePolicy: string(argoappv1.SignaturePolicyTag)}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, testSignature, res.VerifyTagResult)
+		assert.Empty(t, res.VerifyResult)
+		gitClient.AssertNotCalled(t, "VerifySignature", mock.Anything, git.SignedObjectCommit)
+	}
+	// Both the tag and the commit signature are requested
+	{
+		service, gitClient := newServiceWithTag([]string{"v1.0.0"})
+		src := argoappv1.ApplicationSource{Path: ".", TargetRevision: "v1.0.0"}
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignaturePolicy: string(argoappv1.SignaturePolicyBoth)}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, testSignature, res.VerifyTagResult)
+		assert.Empty(t, res.VerifyResult)
+		gitClient.AssertCalled(t, "VerifySignature", "632039659e542ed7de0c170a4fcc1c571b288fc0", git.SignedObjectCommit)
+	}
+	// Target revision is given as fully qualified tag reference
+	{
+		service, _ := newServiceWithTag([]string{"v1.0.0"})
+		src := argoappv1.ApplicationSource{Path: ".", TargetRevision: "refs/tags/v1.0.0"}
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignaturePolicy: string(argoappv1.SignaturePolicyTag)}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Equal(t, testSignature, res.VerifyTagResult)
+	}
+	// Target revision does not point to the tagged commit
+	{
+		service, gitClient := newServiceWithTag([]string{"v0.9.0"})
+		src := argoappv1.ApplicationSource{Path: ".", TargetRevision: "v1.0.0"}
+		q := apiclient.ManifestRequest{Repo: &argoappv1.Repository{}, ApplicationSource: &src, VerifySignature: true, SignaturePolicy: string(argoappv1.SignaturePolicyTag)}
+
+		res, err := service.GenerateManifest(context.Background(), &q)
+		require.NoError(t, err)
+		assert.Empty(t, res.VerifyTagResult)
+		gitClient.AssertNotCalled(t, "VerifySignature", mock.Anything, git.SignedObjectTag)
+	}
+}
+
 func Test_newEnv(t *testing.T) {
 	assert.Equal(t, &argoappv1.Env{
 		&argoappv1.EnvEntry{Name: "ARGOCD_APP_NAME", Value: "my-app-name"},
@@ -2487,3 +2640,123 @@ func Test_populateHelmAppDetails_values_symlinks(t *testing.T) {
 		assert.Empty(t, res.Helm.Parameters)
 	})
 }
+
+func TestEvictCheckouts(t *testing.T) {
+	service := newService(".")
+
+	_, err := service.EvictCheckouts(context.Background(), &apiclient.EvictCheckoutsRequest{Repo: "https://github.com/argoproj/argo-cd"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	res, err := service.EvictCheckouts(context.Background(), &apiclient.EvictCheckoutsRequest{})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Repos)
+}
+
+type generateManifestWithFilesStream struct {
+	grpc.ServerStream
+	parts    []*apiclient.ManifestRequestWithFiles
+	response *apiclient.ManifestResponse
+}
+
+func (s *generateManifestWithFilesStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *generateManifestWithFilesStream) Recv() (*apiclient.ManifestRequestWithFiles, error) {
+	if len(s.parts) == 0 {
+		return nil, goio.EOF
+	}
+	part := s.parts[0]
+	s.parts = s.parts[1:]
+	return part, nil
+}
+
+func (s *generateManifestWithFilesStream) SendAndClose(res *apiclient.ManifestResponse) error {
+	s.response = res
+	return nil
+}
+
+func newGenerateManifestWithFilesStream(t *testing.T, q *apiclient.ManifestRequest, dir string) *generateManifestWithFilesStream {
+	t.Helper()
+	stream := &generateManifestWithFilesStream{}
+	if q != nil {
+		stream.parts = append(stream.parts, &apiclient.ManifestRequestWithFiles{Part: &apiclient.ManifestRequestWithFiles_Request{Request: q}})
+	}
+	sendMetadata := func(checksum string, size int64) error {
+		stream.parts = append(stream.parts, &apiclient.ManifestRequestWithFiles{Part: &apiclient.ManifestRequestWithFiles_Metadata{Metadata: &apiclient.ManifestFileMetadata{Checksum: checksum, Size_: size}}})
+		return nil
+	}
+	sendChunk := func(chunk []byte) error {
+		stream.parts = append(stream.parts, &apiclient.ManifestRequestWithFiles{Part: &apiclient.ManifestRequestWithFiles_Chunk{Chunk: &apiclient.ManifestFileChunk{Chunk: append([]byte{}, chunk...)}}})
+		return nil
+	}
+	require.NoError(t, cmp.SendCompressedFiles(context.Background(), dir, nil, sendMetadata, sendChunk))
+	return stream
+}
+
+func TestGenerateManifestWithFiles(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "cm.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-config\n"), 0644))
+	q := &apiclient.ManifestRequest{
+		Repo:              &argoappv1.Repository{},
+		AppName:           "my-app",
+		AppLabelKey:       "app.kubernetes.io/instance",
+		Namespace:         "default",
+		ApplicationSource: &argoappv1.ApplicationSource{Path: "."},
+	}
+	service := newService(".")
+	service.initConstants.MaxCombinedDirectoryManifestsSize = resource.MustParse("10M")
+
+	t.Run("Success", func(t *testing.T) {
+		stream := newGenerateManifestWithFilesStream(t, q, dir)
+		require.NoError(t, service.GenerateManifestWithFiles(stream))
+		require.Len(t, stream.response.Manifests, 1)
+		obj, err := argoappv1.UnmarshalToUnstructured(stream.response.Manifests[0])
+		require.NoError(t, err)
+		assert.Equal(t, "my-config", obj.GetName())
+		assert.Equal(t, "my-app", obj.GetLabels()["app.kubernetes.io/instance"])
+	})
+	t.Run("MissingRequest", func(t *testing.T) {
+		stream := newGenerateManifestWithFilesStream(t, nil, dir)
+		err := service.GenerateManifestWithFiles(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("MaxTarSize", func(t *testing.T) {
+		service := newService(".")
+		service.initConstants.StreamedManifestMaxTarSize = resource.MustParse("1")
+		stream := newGenerateManifestWithFilesStream(t, q, dir)
+		err := service.GenerateManifestWithFiles(stream)
+		assert.ErrorContains(t, err, "exceeds the maximum size")
+	})
+	t.Run("NestedPath", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "apps", "guestbook"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "root.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: root-config\n"), 0644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "apps", "guestbook", "cm.yaml"), []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: guestbook-config\n"), 0644))
+		q := &apiclient.ManifestRequest{
+			Repo:              q.Repo,
+			AppName:           q.AppName,
+			AppLabelKey:       q.AppLabelKey,
+			Namespace:         q.Namespace,
+			ApplicationSource: &argoappv1.ApplicationSource{Path: "apps/guestbook"},
+		}
+		stream := newGenerateManifestWithFilesStream(t, q, dir)
+		require.NoError(t, service.GenerateManifestWithFiles(stream))
+		require.Len(t, stream.response.Manifests, 1)
+		obj, err := argoappv1.UnmarshalToUnstructured(stream.response.Manifests[0])
+		require.NoError(t, err)
+		assert.Equal(t, "guestbook-config", obj.GetName())
+	})
+	t.Run("PathOutsideRoot", func(t *testing.T) {
+		q := &apiclient.ManifestRequest{
+			Repo:              q.Repo,
+			AppName:           q.AppName,
+			AppLabelKey:       q.AppLabelKey,
+			Namespace:         q.Namespace,
+			ApplicationSource: &argoappv1.ApplicationSource{Path: "../"},
+		}
+		stream := newGenerateManifestWithFilesStream(t, q, dir)
+		err := service.GenerateManifestWithFiles(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -0,0 +1,47 @@
+-- Health of Crossplane resources, which report their state in the Synced and Ready conditions
+hs = {}
+
+-- Kinds which configure Crossplane and do not report any status
+no_status_kinds = {
+  Composition = true,
+  CompositionRevision = true,
+  ControllerConfig = true,
+  ProviderConfig = true,
+  ProviderConfigUsage = true,
+}
+
+if obj.status == nil or obj.status.conditions == nil then
+  if no_status_kinds[obj.kind] then
+    hs.status = "Healthy"
+    hs.message = "Resource is up-to-date"
+    return hs
+  end
+  hs.status = "Progressing"
+  hs.message = "Waiting for the resource to be reconciled"
+  return hs
+end
+
+for i, condition in ipairs(obj.status.conditions) do
+  if (condition.type == "Synced" or condition.type == "LastAsyncOperation") and condition.status == "False" then
+    hs.status = "Degraded"
+    hs.message = condition.message
+    return hs
+  end
+end
+
+for i, condition in ipairs(obj.status.conditions) do
+  if condition.type == "Ready" or condition.type == "Healthy" or condition.type == "Established" or condition.type == "Offered" then
+    if condition.status == "True" then
+      hs.status = "Healthy"
+      hs.message = "Resource is up-to-date"
+      return hs
+    end
+    hs.status = "Progressing"
+    hs.message = condition.reason
+    return hs
+  end
+end
+
+hs.status = "Progressing"
+hs.message = "Waiting for the resource to become ready"
+return hs
@@ -4,6 +4,7 @@ import (
 	"embed"
 )
 
-// Embedded contains embedded resource customization
-//go:embed *
+// Embedded contains embedded resource customization. The 'all:' prefix is required to embed the customizations of
+// groups and kinds matching glob patterns, which are stored in directories starting with '_'.
+//go:embed all:*
 var Embedded embed.FS
@@ -8,31 +8,52 @@ import (
 	"time"
 
 	"context"
+	jwt "github.com/golang-jwt/jwt/v4"
 	"github.com/google/uuid"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/util/slice"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/argo"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/password"
+	"github.com/argoproj/argo-cd/v2/util/rand"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
 
+const (
+	// maxAccountNameLength is the maximum length of local account names, which matches the maximum length of usernames
+	// accepted on login
+	maxAccountNameLength = 32
+	// generatedPasswordLength is the minimum length of generated temporary passwords
+	generatedPasswordLength = 16
+	// generatedPasswordCharset is the charset of generated temporary passwords
+	generatedPasswordCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789!#%+-=_"
+)
+
+// accountNameRegexp matches valid local account names. Dots are not allowed since they separate the account name from
+// the setting name in the argocd-cm keys.
+var accountNameRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([-_a-zA-Z0-9]*[a-zA-Z0-9])?$`)
+
 // Server provides a Session service
 type Server struct {
 	sessionMgr  *session.SessionManager
 	settingsMgr *settings.SettingsManager
 	enf         *rbac.Enforcer
+	auditLogger *argo.AuditLogger
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer) *Server {
-	return &Server{sessionMgr, settingsMgr, enf}
+func NewServer(sessionMgr *session.SessionManager, settingsMgr *settings.SettingsManager, enf *rbac.Enforcer, namespace string, kubeclientset kubernetes.Interface) *Server {
+	return &Server{sessionMgr, settingsMgr, enf, argo.NewAuditLogger(namespace, kubeclientset, "argocd-server")}
 }
 
 // UpdatePassword updates the password of the currently authenticated account or the account specified in the request.
@@ -46,7 +67,7 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 	}
 	// check for permission is user is trying to change someone else's password
 	// assuming user is trying to update someone else if username is different or issuer is not Argo CD
-	if updatedUsername != username || issuer != session.SessionManagerClaimsIssuer {
+	if updatedUsername != username || issuer != session.SessionManagerClaimsIssuer || isScopedToken(ctx) || isExchangedSession(ctx) {
 		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, q.Name); err != nil {
 			return nil, err
 		}
@@ -77,19 +98,12 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 		}
 	}
 
-	//Need to validate password complexity with regular expression
-	passwordPattern, err := s.settingsMgr.GetPasswordPattern()
+	// Need to validate password length and complexity
+	passwordPolicy, err := s.settingsMgr.GetPasswordPolicy()
 	if err != nil {
 		return nil, err
 	}
-
-	validPasswordRegexp, err := regexp.Compile(passwordPattern)
-	if err != nil {
-		return nil, err
-	}
-
-	if !validPasswordRegexp.Match([]byte(q.NewPassword)) {
-		err := fmt.Errorf("New password does not match the following expression: %s.", passwordPattern)
+	if err := passwordPolicy.Validate(q.NewPassword); err != nil {
 		return nil, err
 	}
 
@@ -102,6 +116,9 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 		acc.PasswordHash = hashedPassword
 		now := time.Now().UTC()
 		acc.PasswordMtime = &now
+		if updatedUsername == username {
+			acc.PasswordChangeRequired = false
+		}
 		return nil
 	})
 
@@ -118,13 +135,14 @@ func (s *Server) UpdatePassword(ctx context.Context, q *account.UpdatePasswordRe
 
 }
 
-// CanI checks if the current account has permission to perform an action
-func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.CanIResponse, error) {
+// validateCanIRequest validates the request and returns whether the permission check should be skipped because
+// RBAC is not enforced for the requested resource
+func (s *Server) validateCanIRequest(r *account.CanIRequest) (bool, error) {
 	if !slice.ContainsString(rbacpolicy.Actions, r.Action, nil) {
-		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbacpolicy.Actions, r.Action)
+		return false, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbacpolicy.Actions, r.Action)
 	}
 	if !slice.ContainsString(rbacpolicy.Resources, r.Resource, nil) {
-		return nil, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbacpolicy.Resources, r.Resource)
+		return false, status.Errorf(codes.InvalidArgument, "%v does not contain %s", rbacpolicy.Resources, r.Resource)
 	}
 
 	// Temporarily, logs RBAC will be enforced only if an internal var serverRBACLogEnforceEnable (representing server.rbac.log.enforce.enable env var)
@@ -135,13 +153,25 @@ func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.Can
 	if r.Resource == "logs" {
 		serverRBACLogEnforceEnable, err := s.settingsMgr.GetServerRBACLogEnforceEnable()
 		if err != nil {
-			return nil, err
+			return false, err
 		}
 
 		if !serverRBACLogEnforceEnable {
-			return &account.CanIResponse{Value: "yes"}, nil
+			return true, nil
 		}
 	}
+	return false, nil
+}
+
+// CanI checks if the current account has permission to perform an action
+func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.CanIResponse, error) {
+	skip, err := s.validateCanIRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if skip {
+		return &account.CanIResponse{Value: "yes"}, nil
+	}
 
 	ok := s.enf.Enforce(ctx.Value("claims"), r.Resource, r.Action, r.Subresource)
 	if ok {
@@ -151,6 +181,27 @@ func (s *Server) CanI(ctx context.Context, r *account.CanIRequest) (*account.Can
 	}
 }
 
+// ExplainCanI explains how the permission check of a CanI request is decided for the current account
+func (s *Server) ExplainCanI(ctx context.Context, r *account.CanIRequest) (*account.CanIExplanation, error) {
+	skip, err := s.validateCanIRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	if skip {
+		return &account.CanIExplanation{Allowed: true, Subject: session.Sub(ctx), Reason: "RBAC is not enforced for logs"}, nil
+	}
+
+	res := s.enf.Explain(ctx.Value("claims"), r.Resource, r.Action, r.Subresource)
+	return &account.CanIExplanation{
+		Allowed: res.Allowed,
+		Subject: res.Subject,
+		Groups:  res.Groups,
+		Roles:   res.Roles,
+		Policy:  res.Policy,
+		Reason:  res.Reason,
+	}, nil
+}
+
 func toApiAccount(name string, a settings.Account) *account.Account {
 	var capabilities []string
 	for _, c := range a.Capabilities {
@@ -158,22 +209,25 @@ func toApiAccount(name string, a settings.Account) *account.Account {
 	}
 	var tokens []*account.Token
 	for _, t := range a.Tokens {
-		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt})
+		tokens = append(tokens, &account.Token{Id: t.ID, ExpiresAt: t.ExpiresAt, IssuedAt: t.IssuedAt, Scopes: t.Scopes})
 	}
 	sort.Slice(tokens, func(i, j int) bool {
 		return tokens[i].IssuedAt > tokens[j].IssuedAt
 	})
 	return &account.Account{
-		Name:         name,
-		Enabled:      a.Enabled,
-		Capabilities: capabilities,
-		Tokens:       tokens,
+		Name:                   name,
+		Enabled:                a.Enabled,
+		Capabilities:           capabilities,
+		Tokens:                 tokens,
+		PasswordChangeRequired: a.PasswordChangeRequired,
+		PasswordMtime:          a.FormatPasswordMtime(),
+		TotpEnabled:            a.TOTPEnabled,
 	}
 }
 
 func (s *Server) ensureHasAccountPermission(ctx context.Context, action string, account string) error {
-	// account has always has access to itself
-	if session.Sub(ctx) == account && session.Iss(ctx) == session.SessionManagerClaimsIssuer {
+	// account has always has access to itself, unless the token is restricted to scopes
+	if isCurrentAccount(ctx, account) && !isScopedToken(ctx) {
 		return nil
 	}
 	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, action, account); err != nil {
@@ -218,6 +272,14 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 		return nil, err
 	}
 
+	scopes, err := rbac.NormalizeTokenScopes(r.Scopes)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := rbac.EnsureTokenScopesWithinClaims(ctx.Value("claims"), scopes); err != nil {
+		return nil, err
+	}
+
 	id := r.Id
 	if id == "" {
 		uniqueId, err := uuid.NewRandom()
@@ -228,7 +290,7 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 	}
 
 	var tokenString string
-	err := s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
+	err = s.settingsMgr.UpdateAccount(r.Name, func(account *settings.Account) error {
 		if account.TokenIndex(id) > -1 {
 			return fmt.Errorf("account already has token with id '%s'", id)
 		}
@@ -238,7 +300,7 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 
 		now := time.Now()
 		var err error
-		tokenString, err = s.sessionMgr.Create(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id)
+		tokenString, err = s.sessionMgr.CreateScoped(fmt.Sprintf("%s:%s", r.Name, settings.AccountCapabilityApiKey), r.ExpiresIn, id, scopes)
 		if err != nil {
 			return err
 		}
@@ -251,6 +313,7 @@ func (s *Server) CreateToken(ctx context.Context, r *account.CreateTokenRequest)
 			ID:        id,
 			IssuedAt:  now.Unix(),
 			ExpiresAt: expiresAt,
+			Scopes:    scopes,
 		})
 		return nil
 	})
@@ -278,3 +341,299 @@ func (s *Server) DeleteToken(ctx context.Context, r *account.DeleteTokenRequest)
 	}
 	return &account.EmptyResponse{}, nil
 }
+
+func (s *Server) logAccountEvent(ctx context.Context, name string, reason string, action string) {
+	eventInfo := argo.EventInfo{Type: v1.EventTypeNormal, Reason: reason}
+	user := session.Username(ctx)
+	if user == "" {
+		user = "Unknown user"
+	}
+	message := fmt.Sprintf("%s %s", user, action)
+	s.auditLogger.LogAccountEvent(name, eventInfo, message)
+}
+
+// isCurrentAccount returns whether the given account is the local account of the current user
+func isCurrentAccount(ctx context.Context, name string) bool {
+	return session.Sub(ctx) == name && session.Iss(ctx) == session.SessionManagerClaimsIssuer && !isExchangedSession(ctx)
+}
+
+// isScopedToken returns whether the token of the current user is restricted to scopes, in which case it is not granted
+// any implicit permissions on its own account
+func isScopedToken(ctx context.Context) bool {
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok {
+		return false
+	}
+	_, restricted := rbac.GetTokenScopes(claims)
+	return restricted
+}
+
+// isExchangedSession returns whether the session of the current user was issued for a token of an external issuer. Its
+// subject is mapped from the external token and does not belong to a local account, so like a scoped token it is not
+// granted any implicit permissions on the local account of the same name.
+func isExchangedSession(ctx context.Context) bool {
+	claims, ok := ctx.Value("claims").(jwt.Claims)
+	if !ok {
+		return false
+	}
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return false
+	}
+	_, ok = mapClaims[session.TokenExchangeIssuerClaim]
+	return ok
+}
+
+// newPasswordHash validates the given password against the password policy, or generates a temporary password if it is
+// empty, and returns the password hash together with the generated password
+func (s *Server) newPasswordHash(newPassword string) (string, string, error) {
+	passwordPolicy, err := s.settingsMgr.GetPasswordPolicy()
+	if err != nil {
+		return "", "", err
+	}
+	var generated string
+	if newPassword == "" {
+		if generated, err = generatePassword(passwordPolicy); err != nil {
+			return "", "", err
+		}
+		newPassword = generated
+	} else if err := passwordPolicy.Validate(newPassword); err != nil {
+		return "", "", err
+	}
+	hashedPassword, err := password.HashPassword(newPassword)
+	if err != nil {
+		return "", "", err
+	}
+	return hashedPassword, generated, nil
+}
+
+// generatePassword generates a temporary password which satisfies the given password policy
+func generatePassword(passwordPolicy *settings.PasswordPolicy) (string, error) {
+	length := generatedPasswordLength
+	if passwordPolicy.MinLength > length {
+		length = passwordPolicy.MinLength
+	}
+	for i := 0; i < 10; i++ {
+		generated, err := rand.StringFromCharset(length, generatedPasswordCharset)
+		if err != nil {
+			return "", err
+		}
+		if passwordPolicy.Validate(generated) == nil {
+			return generated, nil
+		}
+	}
+	return "", status.Errorf(codes.FailedPrecondition, "unable to generate a temporary password which satisfies the password policy, please specify a password")
+}
+
+// CreateAccount creates a local account
+func (s *Server) CreateAccount(ctx context.Context, r *account.CreateAccountRequest) (*account.CreateAccountResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionCreate, r.Name); err != nil {
+		return nil, err
+	}
+	if len(r.Name) > maxAccountNameLength || !accountNameRegexp.MatchString(r.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid account name '%s': must consist of at most %d alphanumeric characters, '-' or '_', and start and end with an alphanumeric character", r.Name, maxAccountNameLength)
+	}
+
+	acc := settings.Account{Enabled: !r.Disabled}
+	capabilities := r.Capabilities
+	if len(capabilities) == 0 {
+		capabilities = []string{string(settings.AccountCapabilityLogin)}
+	}
+	for _, capability := range capabilities {
+		switch settings.AccountCapability(capability) {
+		case settings.AccountCapabilityLogin, settings.AccountCapabilityApiKey:
+			if !acc.HasCapability(settings.AccountCapability(capability)) {
+				acc.Capabilities = append(acc.Capabilities, settings.AccountCapability(capability))
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unsupported account capability '%s'", capability)
+		}
+	}
+
+	var generated string
+	if acc.HasCapability(settings.AccountCapabilityLogin) {
+		hashedPassword, generatedPassword, err := s.newPasswordHash(r.Password)
+		if err != nil {
+			return nil, err
+		}
+		now := time.Now().UTC()
+		acc.PasswordHash = hashedPassword
+		acc.PasswordMtime = &now
+		acc.PasswordChangeRequired = true
+		generated = generatedPassword
+	} else if r.Password != "" {
+		return nil, status.Errorf(codes.InvalidArgument, "a password can only be set for accounts with the %s capability", settings.AccountCapabilityLogin)
+	}
+
+	if err := s.settingsMgr.AddAccount(r.Name, acc); err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountCreated, fmt.Sprintf("created account '%s'", r.Name))
+	return &account.CreateAccountResponse{Account: toApiAccount(r.Name, acc), Password: generated}, nil
+}
+
+// DeleteAccount deletes a local account
+func (s *Server) DeleteAccount(ctx context.Context, r *account.DeleteAccountRequest) (*account.EmptyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionDelete, r.Name); err != nil {
+		return nil, err
+	}
+	if isCurrentAccount(ctx, r.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "the account of the current user cannot be deleted")
+	}
+	if err := s.settingsMgr.DeleteAccount(r.Name); err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountDeleted, fmt.Sprintf("deleted account '%s'", r.Name))
+	return &account.EmptyResponse{}, nil
+}
+
+// SetAccountEnabled enables or disables a local account
+func (s *Server) SetAccountEnabled(ctx context.Context, r *account.SetAccountEnabledRequest) (*account.Account, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	if !r.Enabled && isCurrentAccount(ctx, r.Name) {
+		return nil, status.Errorf(codes.InvalidArgument, "the account of the current user cannot be disabled")
+	}
+	var updated settings.Account
+	err := s.settingsMgr.UpdateAccount(r.Name, func(acc *settings.Account) error {
+		acc.Enabled = r.Enabled
+		updated = *acc
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	action := "disabled"
+	if r.Enabled {
+		action = "enabled"
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountUpdated, fmt.Sprintf("%s account '%s'", action, r.Name))
+	return toApiAccount(r.Name, updated), nil
+}
+
+// ResetPassword resets the password of a local account, which must be changed on the next login
+func (s *Server) ResetPassword(ctx context.Context, r *account.ResetPasswordRequest) (*account.ResetPasswordResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	acc, err := s.settingsMgr.GetAccount(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !acc.HasCapability(settings.AccountCapabilityLogin) {
+		return nil, status.Errorf(codes.InvalidArgument, "account '%s' does not have %s capability", r.Name, settings.AccountCapabilityLogin)
+	}
+	hashedPassword, generated, err := s.newPasswordHash(r.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	err = s.settingsMgr.UpdateAccount(r.Name, func(acc *settings.Account) error {
+		now := time.Now().UTC()
+		acc.PasswordHash = hashedPassword
+		acc.PasswordMtime = &now
+		acc.PasswordChangeRequired = true
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountUpdated, fmt.Sprintf("reset password of account '%s'", r.Name))
+	return &account.ResetPasswordResponse{Password: generated}, nil
+}
+
+// EnrollTOTP generates a TOTP secret for an account, which is enabled once confirmed using ConfirmTOTP
+func (s *Server) EnrollTOTP(ctx context.Context, r *account.TOTPRequest) (*account.TOTPEnrollment, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	acc, err := s.settingsMgr.GetAccount(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !acc.HasCapability(settings.AccountCapabilityLogin) {
+		return nil, status.Errorf(codes.InvalidArgument, "account '%s' does not have %s capability", r.Name, settings.AccountCapabilityLogin)
+	}
+	secret, url, err := s.sessionMgr.EnrollTOTP(r.Name)
+	if err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountUpdated, fmt.Sprintf("started TOTP enrollment of account '%s'", r.Name))
+	return &account.TOTPEnrollment{Secret: secret, Url: url}, nil
+}
+
+// ConfirmTOTP confirms the pending TOTP enrollment of an account with a one-time password, which enables TOTP
+func (s *Server) ConfirmTOTP(ctx context.Context, r *account.TOTPRequest) (*account.TOTPRecoveryCodes, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	recoveryCodes, err := s.sessionMgr.ConfirmTOTP(r.Name, r.Code)
+	if err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountUpdated, fmt.Sprintf("enabled TOTP for account '%s'", r.Name))
+	return &account.TOTPRecoveryCodes{Codes: recoveryCodes}, nil
+}
+
+// DisableTOTP disables TOTP for an account. Users disabling TOTP for their own account have to supply a one-time
+// password or recovery code, so that a stolen session cannot be used to remove the second factor.
+func (s *Server) DisableTOTP(ctx context.Context, r *account.TOTPRequest) (*account.EmptyResponse, error) {
+	if isCurrentAccount(ctx, r.Name) && !isScopedToken(ctx) {
+		if r.Code == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "a one-time password or recovery code is required to disable TOTP")
+		}
+		if err := s.sessionMgr.VerifyOTP(r.Name, r.Code); err != nil {
+			return nil, err
+		}
+	} else if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAccounts, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	if err := s.sessionMgr.DisableTOTP(r.Name); err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountUpdated, fmt.Sprintf("disabled TOTP for account '%s'", r.Name))
+	return &account.EmptyResponse{}, nil
+}
+
+func toApiSession(ctx context.Context, info session.SessionInfo) *account.Session {
+	apiSession := &account.Session{
+		Id:        info.ID,
+		IssuedAt:  info.IssuedAt.Unix(),
+		LastUsed:  info.LastUsed.Unix(),
+		UserAgent: info.UserAgent,
+		ClientIP:  info.ClientIP,
+		Current:   info.ID == session.Jti(ctx),
+	}
+	if info.ExpiresAt != nil {
+		apiSession.ExpiresAt = info.ExpiresAt.Unix()
+	}
+	return apiSession
+}
+
+// ListSessions returns the active sessions of an account
+func (s *Server) ListSessions(ctx context.Context, r *account.ListSessionsRequest) (*account.SessionsList, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionGet, r.Name); err != nil {
+		return nil, err
+	}
+	sessions, err := s.sessionMgr.GetSessions(ctx, r.Name)
+	if err != nil {
+		return nil, err
+	}
+	resp := &account.SessionsList{Items: []*account.Session{}}
+	for _, info := range sessions {
+		resp.Items = append(resp.Items, toApiSession(ctx, info))
+	}
+	return resp, nil
+}
+
+// RevokeSession revokes an active session of an account
+func (s *Server) RevokeSession(ctx context.Context, r *account.RevokeSessionRequest) (*account.EmptyResponse, error) {
+	if err := s.ensureHasAccountPermission(ctx, rbacpolicy.ActionUpdate, r.Name); err != nil {
+		return nil, err
+	}
+	if err := s.sessionMgr.RevokeSession(ctx, r.Name, r.Id); err != nil {
+		return nil, err
+	}
+	s.logAccountEvent(ctx, r.Name, argo.EventReasonAccountUpdated, fmt.Sprintf("revoked session '%s' of account '%s'", r.Id, r.Name))
+	return &account.EmptyResponse{}, nil
+}
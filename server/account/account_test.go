@@ -2,12 +2,16 @@ package account
 
 import (
 	"context"
+	"net"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,13 +20,18 @@ import (
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/server/session"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	sessionutil "github.com/argoproj/argo-cd/v2/util/session"
 	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/totp"
 )
 
 const (
@@ -68,7 +77,7 @@ func newTestAccountServerExt(ctx context.Context, enforceFn rbac.ClaimsEnforcerF
 	enforcer := rbac.NewEnforcer(kubeclientset, testNamespace, common.ArgoCDRBACConfigMapName, nil)
 	enforcer.SetClaimsEnforcerFunc(enforceFn)
 
-	return NewServer(sessionMgr, settingsMgr, enforcer), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil)
+	return NewServer(sessionMgr, settingsMgr, enforcer, testNamespace, kubeclientset), session.NewServer(sessionMgr, settingsMgr, nil, nil, nil, nil)
 }
 
 func getAdminAccount(mgr *settings.SettingsManager) (*settings.Account, error) {
@@ -296,6 +305,102 @@ func TestCreateToken_UserSpecifiedID(t *testing.T) {
 	assert.Contains(t, "account already has token with id 'test'", err.Error())
 }
 
+func TestCreateToken_Scopes(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey"
+	})
+
+	res, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scopes: []string{"applications,sync,team-a/*"}})
+	require.NoError(t, err)
+
+	claims, _, err := accountServer.sessionMgr.Parse(res.Token)
+	require.NoError(t, err)
+	scopes, restricted := rbac.GetTokenScopes(claims)
+	assert.True(t, restricted)
+	assert.Equal(t, []string{"applications, sync, team-a/*"}, scopes)
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	require.Len(t, acc.Tokens, 1)
+	assert.Equal(t, []string{"applications, sync, team-a/*"}, acc.Tokens[0].Scopes)
+
+	_, err = accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scopes: []string{"applications, sync"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateToken_ScopedToken(t *testing.T) {
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey, login"
+	})
+
+	scopedContext := func(scopes ...string) context.Context {
+		res, err := accountServer.CreateToken(ctx, &account.CreateTokenRequest{Name: "account1", Scopes: scopes})
+		require.NoError(t, err)
+		claims, _, err := accountServer.sessionMgr.Parse(res.Token)
+		require.NoError(t, err)
+		// nolint:staticcheck
+		return context.WithValue(context.Background(), "claims", claims)
+	}
+
+	t.Run("NoAccountsScope", func(t *testing.T) {
+		scopedCtx := scopedContext("applications, sync, *")
+		acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+		require.NoError(t, err)
+
+		_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = accountServer.DeleteToken(scopedCtx, &account.DeleteTokenRequest{Name: "account1", Id: acc.Tokens[0].Id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = accountServer.EnrollTOTP(scopedCtx, &account.TOTPRequest{Name: "account1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = accountServer.RevokeSession(scopedCtx, &account.RevokeSessionRequest{Name: "account1", Id: acc.Tokens[0].Id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = accountServer.UpdatePassword(scopedCtx, &account.UpdatePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("WithinScopes", func(t *testing.T) {
+		scopedCtx := scopedContext("accounts, update, account1", "applications, sync, team-a/*")
+
+		_, err := accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1", Scopes: []string{"applications, delete, team-a/*"}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = accountServer.CreateToken(scopedCtx, &account.CreateTokenRequest{Name: "account1", Scopes: []string{"applications, sync, team-a/guestbook"}})
+		assert.NoError(t, err)
+	})
+}
+
+func TestCreateToken_ExchangedSession(t *testing.T) {
+	enforceFn := func(claims jwt.Claims, rvals ...interface{}) bool {
+		mapClaims, err := jwtutil.MapClaims(claims)
+		return err == nil && jwtutil.StringField(mapClaims, "sub") == "admin"
+	}
+	ctx := adminContext(context.Background())
+	accountServer, _ := newTestAccountServerExt(ctx, enforceFn, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.account1"] = "apiKey, login"
+	})
+	// nolint:staticcheck
+	exchangedCtx := context.WithValue(context.Background(), "claims", jwt.MapClaims{
+		"sub":                                "account1",
+		"iss":                                sessionutil.SessionManagerClaimsIssuer,
+		sessionutil.TokenExchangeIssuerClaim: "github",
+	})
+
+	_, err := accountServer.CreateToken(exchangedCtx, &account.CreateTokenRequest{Name: "account1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = accountServer.EnrollTOTP(exchangedCtx, &account.TOTPRequest{Name: "account1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = accountServer.UpdatePassword(exchangedCtx, &account.UpdatePasswordRequest{CurrentPassword: "oldpassword", NewPassword: "newpassword"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "account1"})
+	require.NoError(t, err)
+	assert.Empty(t, acc.Tokens)
+}
+
 func TestDeleteToken_SuccessfullyRemoved(t *testing.T) {
 	ctx := adminContext(context.Background())
 	accountServer, _ := newTestAccountServer(ctx, func(cm *v1.ConfigMap, secret *v1.Secret) {
@@ -361,3 +466,353 @@ func TestCanI_GetLogsAllowSwitchOff(t *testing.T) {
 	assert.NoError(t, err)
 	assert.EqualValues(t, "yes", resp.Value)
 }
+
+func TestExplainCanI(t *testing.T) {
+	accountServer, _ := newTestAccountServer(context.Background())
+	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(accountServer.enf, test.NewFakeProjLister())
+	accountServer.enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	accountServer.enf.SetClaimsExplainFunc(policyEnf.ExplainClaims)
+	assert.NoError(t, accountServer.enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV))
+	assert.NoError(t, accountServer.enf.SetUserPolicy(`
+g, my-org:ops, role:ops
+g, role:ops, role:readonly
+p, role:ops, clusters, update, *, allow
+p, role:ops, clusters, update, https://prod, deny
+`))
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"sub": "alice", "groups": []string{"my-org:dev", "my-org:ops"}})
+
+	t.Run("AllowedThroughGroup", func(t *testing.T) {
+		res, err := accountServer.ExplainCanI(ctx, &account.CanIRequest{Resource: "clusters", Action: "update", Subresource: "https://staging"})
+		assert.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, "alice", res.Subject)
+		assert.Equal(t, []string{"my-org:dev", "my-org:ops"}, res.Groups)
+		assert.ElementsMatch(t, []string{"role:ops", "role:readonly"}, res.Roles)
+		assert.Equal(t, "p, role:ops, clusters, update, *, allow", res.Policy)
+		assert.Equal(t, "allowed through group 'my-org:ops'", res.Reason)
+	})
+	t.Run("AllowedThroughInheritedRole", func(t *testing.T) {
+		res, err := accountServer.ExplainCanI(ctx, &account.CanIRequest{Resource: "applications", Action: "get", Subresource: "default/my-app"})
+		assert.NoError(t, err)
+		assert.True(t, res.Allowed)
+		assert.Equal(t, "p, role:readonly, applications, get, */*, allow", res.Policy)
+	})
+	t.Run("DeniedByPolicy", func(t *testing.T) {
+		res, err := accountServer.ExplainCanI(ctx, &account.CanIRequest{Resource: "clusters", Action: "update", Subresource: "https://prod"})
+		assert.NoError(t, err)
+		assert.False(t, res.Allowed)
+		assert.Equal(t, "p, role:ops, clusters, update, https://prod, deny", res.Policy)
+		assert.Equal(t, "denied by policy through group 'my-org:ops'", res.Reason)
+	})
+	t.Run("NoMatchingPolicy", func(t *testing.T) {
+		res, err := accountServer.ExplainCanI(ctx, &account.CanIRequest{Resource: "clusters", Action: "delete", Subresource: "https://staging"})
+		assert.NoError(t, err)
+		assert.False(t, res.Allowed)
+		assert.Empty(t, res.Policy)
+		assert.Equal(t, "no matching policy", res.Reason)
+	})
+	t.Run("InvalidAction", func(t *testing.T) {
+		_, err := accountServer.ExplainCanI(ctx, &account.CanIRequest{Resource: "clusters", Action: "destroy", Subresource: "*"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestCreateAccount(t *testing.T) {
+	t.Run("GeneratedPassword", func(t *testing.T) {
+		accountServer, sessionServer := newTestAccountServer(context.Background())
+		ctx := adminContext(context.Background())
+
+		res, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "alice"})
+		assert.NoError(t, err)
+		assert.Equal(t, "alice", res.Account.Name)
+		assert.True(t, res.Account.Enabled)
+		assert.True(t, res.Account.PasswordChangeRequired)
+		assert.Equal(t, []string{"login"}, res.Account.Capabilities)
+		assert.NotEmpty(t, res.Password)
+
+		_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "alice", Password: res.Password})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("ApiKeyOnly", func(t *testing.T) {
+		accountServer, _ := newTestAccountServer(context.Background())
+		ctx := adminContext(context.Background())
+
+		res, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "ci", Capabilities: []string{"apiKey"}, Disabled: true})
+		assert.NoError(t, err)
+		assert.Empty(t, res.Password)
+		acc, err := accountServer.settingsMgr.GetAccount("ci")
+		assert.NoError(t, err)
+		assert.False(t, acc.Enabled)
+		assert.Empty(t, acc.PasswordHash)
+
+		_, err = accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "ci2", Capabilities: []string{"apiKey"}, Password: "password"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("InvalidRequests", func(t *testing.T) {
+		accountServer, _ := newTestAccountServer(context.Background())
+		ctx := adminContext(context.Background())
+
+		for _, name := range []string{"", "with.dot", "-leading", "a-name-which-is-longer-than-thirty-two"} {
+			_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: name})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		}
+		_, err := accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "alice", Capabilities: []string{"magic"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "alice", Password: "short"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = accountServer.CreateAccount(ctx, &account.CreateAccountRequest{Name: "admin"})
+		assert.Error(t, err)
+	})
+
+	t.Run("DoesNotHavePermissions", func(t *testing.T) {
+		accountServer, _ := newTestAccountServerExt(context.Background(), func(claims jwt.Claims, rvals ...interface{}) bool {
+			return false
+		})
+		_, err := accountServer.CreateAccount(adminContext(context.Background()), &account.CreateAccountRequest{Name: "alice"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestDeleteAccount(t *testing.T) {
+	accountServer, _ := newTestAccountServer(context.Background(), func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "login"
+		cm.Data["accounts.alice.enabled"] = "true"
+		secret.Data["accounts.alice.password"] = []byte("hash")
+	})
+	ctx := adminContext(context.Background())
+
+	_, err := accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "alice"})
+	assert.NoError(t, err)
+	_, err = accountServer.settingsMgr.GetAccount("alice")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "alice"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = accountServer.DeleteAccount(ctx, &account.DeleteAccountRequest{Name: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSetAccountEnabled(t *testing.T) {
+	accountServer, _ := newTestAccountServer(context.Background(), func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "login"
+	})
+	ctx := adminContext(context.Background())
+
+	acc, err := accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "alice", Enabled: false})
+	assert.NoError(t, err)
+	assert.False(t, acc.Enabled)
+	acc, err = accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "alice", Enabled: true})
+	assert.NoError(t, err)
+	assert.True(t, acc.Enabled)
+
+	_, err = accountServer.SetAccountEnabled(ctx, &account.SetAccountEnabledRequest{Name: "admin", Enabled: false})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestResetPassword(t *testing.T) {
+	accountServer, sessionServer := newTestAccountServer(context.Background(), func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "login"
+		cm.Data["accounts.ci"] = "apiKey"
+	})
+	ctx := adminContext(context.Background())
+
+	res, err := accountServer.ResetPassword(ctx, &account.ResetPasswordRequest{Name: "alice", NewPassword: "temporary"})
+	assert.NoError(t, err)
+	assert.Empty(t, res.Password)
+	acc, err := accountServer.settingsMgr.GetAccount("alice")
+	assert.NoError(t, err)
+	assert.True(t, acc.PasswordChangeRequired)
+	assert.NotNil(t, acc.PasswordMtime)
+
+	// the password has to be changed on login
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "alice", Password: "temporary"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "alice", Password: "temporary", NewPassword: "temporary"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "alice", Password: "temporary", NewPassword: "short"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "alice", Password: "temporary", NewPassword: "newpassword"})
+	assert.NoError(t, err)
+	acc, err = accountServer.settingsMgr.GetAccount("alice")
+	assert.NoError(t, err)
+	assert.False(t, acc.PasswordChangeRequired)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "alice", Password: "newpassword"})
+	assert.NoError(t, err)
+
+	res, err = accountServer.ResetPassword(ctx, &account.ResetPasswordRequest{Name: "alice"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Password)
+	assert.NoError(t, accountServer.sessionMgr.VerifyUsernamePassword("alice", res.Password))
+
+	_, err = accountServer.ResetPassword(ctx, &account.ResetPasswordRequest{Name: "ci"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestCreateSession_PasswordExpired(t *testing.T) {
+	accountServer, sessionServer := newTestAccountServer(context.Background(), func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["passwordPolicy.maxAge"] = "24h"
+		secret.Data["admin.passwordMtime"] = []byte(time.Now().Add(-48 * time.Hour).Format(time.RFC3339))
+	})
+	ctx := adminContext(context.Background())
+
+	_, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", NewPassword: "newpassword"})
+	assert.NoError(t, err)
+	assert.NoError(t, accountServer.sessionMgr.VerifyUsernamePassword("admin", "newpassword"))
+}
+
+func TestTOTP(t *testing.T) {
+	accountServer, sessionServer := newTestAccountServer(context.Background())
+	ctx := adminContext(context.Background())
+
+	enrollment, err := accountServer.EnrollTOTP(ctx, &account.TOTPRequest{Name: "admin"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, enrollment.Secret)
+	assert.Contains(t, enrollment.Url, "otpauth://totp/")
+	// login does not require a one-time password before the enrollment is confirmed
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	assert.NoError(t, err)
+
+	code, err := totp.GenerateCode(enrollment.Secret, time.Now())
+	assert.NoError(t, err)
+	recoveryCodes, err := accountServer.ConfirmTOTP(ctx, &account.TOTPRequest{Name: "admin", Code: code})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, recoveryCodes.Codes)
+	acc, err := accountServer.GetAccount(ctx, &account.GetAccountRequest{Name: "admin"})
+	assert.NoError(t, err)
+	assert.True(t, acc.TotpEnabled)
+
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	assert.Equal(t, sessionutil.OTPRequiredErr, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: "abcdef"})
+	assert.Equal(t, sessionutil.InvalidOTPErr, err)
+	// one-time passwords are accepted only once, including the one which confirmed the enrollment
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: code})
+	assert.Equal(t, sessionutil.InvalidOTPErr, err)
+	nextCode, err := totp.GenerateCode(enrollment.Secret, time.Now().Add(totp.Period))
+	assert.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: nextCode})
+	assert.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: nextCode})
+	assert.Equal(t, sessionutil.InvalidOTPErr, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: recoveryCodes.Codes[0]})
+	assert.NoError(t, err)
+
+	// re-enrolling requires disabling TOTP first, which requires a one-time password for the own account
+	_, err = accountServer.EnrollTOTP(ctx, &account.TOTPRequest{Name: "admin"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = accountServer.DisableTOTP(ctx, &account.TOTPRequest{Name: "admin"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = accountServer.DisableTOTP(ctx, &account.TOTPRequest{Name: "admin", Code: recoveryCodes.Codes[0]})
+	assert.Error(t, err)
+	_, err = accountServer.DisableTOTP(ctx, &account.TOTPRequest{Name: "admin", Code: recoveryCodes.Codes[1]})
+	assert.NoError(t, err)
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	assert.NoError(t, err)
+}
+
+func TestTOTP_DisableForAnotherAccount(t *testing.T) {
+	accountServer, _ := newTestAccountServerExt(context.Background(), func(claims jwt.Claims, rvals ...interface{}) bool {
+		return false
+	}, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "login"
+		secret.Data["accounts.alice.totpSecret"] = []byte("SECRET")
+		secret.Data["accounts.alice.totpEnabled"] = []byte("true")
+	})
+	ctx := adminContext(context.Background())
+
+	_, err := accountServer.DisableTOTP(ctx, &account.TOTPRequest{Name: "alice"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = accountServer.EnrollTOTP(ctx, &account.TOTPRequest{Name: "alice"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestTOTP_RequiredEnrollmentOnLogin(t *testing.T) {
+	accountServer, sessionServer := newTestAccountServer(context.Background(), func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["users.mfa.required"] = "true"
+	})
+	ctx := context.Background()
+
+	_, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+	assert.Equal(t, sessionutil.TOTPEnrollmentRequiredErr, err)
+	_, err = sessionServer.EnrollTOTP(ctx, &sessionpkg.TOTPEnrollRequest{Username: "admin", Password: "badpassword"})
+	assert.Error(t, err)
+	enrollment, err := sessionServer.EnrollTOTP(ctx, &sessionpkg.TOTPEnrollRequest{Username: "admin", Password: "oldpassword"})
+	assert.NoError(t, err)
+
+	_, err = sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: "abcdef"})
+	assert.Equal(t, sessionutil.InvalidOTPErr, err)
+	code, err := totp.GenerateCode(enrollment.Secret, time.Now())
+	assert.NoError(t, err)
+	res, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword", Otp: code})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Token)
+	assert.NotEmpty(t, res.RecoveryCodes)
+
+	adminAccount, err := getAdminAccount(accountServer.settingsMgr)
+	assert.NoError(t, err)
+	assert.True(t, adminAccount.TOTPEnabled)
+	// the secret cannot be replaced once the enrollment was confirmed
+	_, err = sessionServer.EnrollTOTP(ctx, &sessionpkg.TOTPEnrollRequest{Username: "admin", Password: "oldpassword"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestSessions(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+	accountServer, _ := newTestAccountServerExt(context.Background(), func(claims jwt.Claims, rvals ...interface{}) bool {
+		return false
+	}, func(cm *v1.ConfigMap, secret *v1.Secret) {
+		cm.Data["accounts.alice"] = "login"
+	})
+	accountServer.sessionMgr = sessionutil.NewSessionManager(accountServer.settingsMgr, test.NewFakeProjLister(), "", nil, sessionutil.NewUserStateStorage(redisClient))
+	trustedProxies, err := grpc_util.ParseTrustedProxies([]string{"192.168.0.0/16"})
+	require.NoError(t, err)
+	sessionServer := session.NewServer(accountServer.sessionMgr, accountServer.settingsMgr, nil, nil, nil, trustedProxies)
+
+	login := func(userAgent string, peerIP string) (string, context.Context) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerIP), Port: 41234}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent", userAgent, "x-forwarded-for", "10.0.0.2, 10.0.0.1"))
+		res, err := sessionServer.Create(ctx, &sessionpkg.SessionCreateRequest{Username: "admin", Password: "oldpassword"})
+		require.NoError(t, err)
+		claims, _, err := accountServer.sessionMgr.Parse(res.Token)
+		require.NoError(t, err)
+		// nolint:staticcheck
+		return res.Token, context.WithValue(context.Background(), "claims", claims)
+	}
+	// the forwarded client IP is only used for requests of trusted proxies
+	cliToken, _ := login("argocd-client/v2.6.0", "172.16.0.1")
+	_, ctx := login("Mozilla/5.0", "192.168.0.1")
+
+	sessions, err := accountServer.ListSessions(ctx, &account.ListSessionsRequest{Name: "admin"})
+	require.NoError(t, err)
+	require.Len(t, sessions.Items, 2)
+	assert.Equal(t, "Mozilla/5.0", sessions.Items[0].UserAgent)
+	assert.Equal(t, "10.0.0.1", sessions.Items[0].ClientIP)
+	assert.True(t, sessions.Items[0].Current)
+	assert.Equal(t, "argocd-client/v2.6.0", sessions.Items[1].UserAgent)
+	assert.Equal(t, "172.16.0.1", sessions.Items[1].ClientIP)
+	assert.False(t, sessions.Items[1].Current)
+
+	// sessions of other accounts require permissions
+	_, err = accountServer.ListSessions(ctx, &account.ListSessionsRequest{Name: "alice"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = accountServer.RevokeSession(ctx, &account.RevokeSessionRequest{Name: "alice", Id: sessions.Items[1].Id})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = accountServer.RevokeSession(ctx, &account.RevokeSessionRequest{Name: "admin", Id: sessions.Items[1].Id})
+	require.NoError(t, err)
+	_, _, err = accountServer.sessionMgr.Parse(cliToken)
+	assert.ErrorContains(t, err, "token is revoked")
+	sessions, err = accountServer.ListSessions(ctx, &account.ListSessionsRequest{Name: "admin"})
+	require.NoError(t, err)
+	require.Len(t, sessions.Items, 1)
+	assert.Equal(t, "Mozilla/5.0", sessions.Items[0].UserAgent)
+
+	_, err = accountServer.RevokeSession(ctx, &account.RevokeSessionRequest{Name: "admin", Id: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
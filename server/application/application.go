@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	goio "io"
 	"math"
 	"reflect"
 	"sort"
@@ -15,10 +16,13 @@ import (
 	kubecache "github.com/argoproj/gitops-engine/pkg/cache"
 	"github.com/argoproj/gitops-engine/pkg/diff"
 	"github.com/argoproj/gitops-engine/pkg/sync/common"
+	"github.com/argoproj/gitops-engine/pkg/sync/hook"
+	"github.com/argoproj/gitops-engine/pkg/sync/ignore"
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/argoproj/gitops-engine/pkg/utils/text"
 	"github.com/argoproj/pkg/sync"
 	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/ghodss/yaml"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -28,11 +32,14 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 	"k8s.io/utils/pointer"
 
 	argocommon "github.com/argoproj/argo-cd/v2/common"
@@ -47,6 +54,8 @@ import (
 	apputil "github.com/argoproj/argo-cd/v2/util/app"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	argoutil "github.com/argoproj/argo-cd/v2/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/audit"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/env"
 	"github.com/argoproj/argo-cd/v2/util/git"
@@ -289,22 +298,21 @@ func (s *Server) queryRepoServer(ctx context.Context, a *v1alpha1.Application, a
 	return action(client, repo, permittedHelmRepos, permittedHelmCredentials, helmOptions, kustomizeOptions, enabledSourceTypes)
 }
 
-// GetManifests returns application manifests
-func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
-	a, err := s.appLister.Get(*q.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error getting application: %w", err)
-	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
-		return nil, err
-	}
+// generateManifests generates the manifests of an application for the given revision, or its target revision if empty
+func (s *Server) generateManifests(ctx context.Context, a *appv1.Application, revision string) (*apiclient.ManifestResponse, error) {
+	return s.generateManifestsWith(ctx, a, revision, func(client apiclient.RepoServerServiceClient, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+		return client.GenerateManifest(ctx, q)
+	})
+}
 
+// generateManifestsWith builds the request to generate the manifests of an application for the given revision, and
+// passes it to the generate function along with a repo server client
+func (s *Server) generateManifestsWith(ctx context.Context, a *appv1.Application, revision string, generate func(client apiclient.RepoServerServiceClient, q *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error)) (*apiclient.ManifestResponse, error) {
 	var manifestInfo *apiclient.ManifestResponse
-	err = s.queryRepoServer(ctx, a, func(
+	err := s.queryRepoServer(ctx, a, func(
 		client apiclient.RepoServerServiceClient, repo *appv1.Repository, helmRepos []*appv1.Repository, helmCreds []*appv1.RepoCreds, helmOptions *appv1.HelmOptions, kustomizeOptions *appv1.KustomizeOptions, enableGenerateManifests map[string]bool) error {
-		revision := a.Spec.Source.TargetRevision
-		if q.GetRevision() != "" {
-			revision = q.GetRevision()
+		if revision == "" {
+			revision = a.Spec.Source.TargetRevision
 		}
 		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
 		if err != nil {
@@ -330,7 +338,7 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 			return fmt.Errorf("error getting API resources: %w", err)
 		}
 
-		manifestInfo, err = client.GenerateManifest(ctx, &apiclient.ManifestRequest{
+		manifestInfo, err = generate(client, &apiclient.ManifestRequest{
 			Repo:               repo,
 			Revision:           revision,
 			AppLabelKey:        appInstanceLabelKey,
@@ -356,27 +364,112 @@ func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationMan
 	if err != nil {
 		return nil, err
 	}
+	return manifestInfo, nil
+}
+
+// GetManifests returns application manifests
+func (s *Server) GetManifests(ctx context.Context, q *application.ApplicationManifestQuery) (*apiclient.ManifestResponse, error) {
+	a, err := s.appLister.Get(*q.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+		return nil, err
+	}
+
+	manifestInfo, err := s.generateManifests(ctx, a, q.GetRevision())
+	if err != nil {
+		return nil, err
+	}
+	err = hideManifestsSecretData(manifestInfo)
+	if err != nil {
+		return nil, err
+	}
+	return manifestInfo, nil
+}
+
+// GetManifestsWithFiles returns application manifests generated by the repo server from the local files streamed by
+// the client, using the source configuration of the application
+func (s *Server) GetManifestsWithFiles(stream application.ApplicationService_GetManifestsWithFilesServer) error {
+	ctx := stream.Context()
+	part, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("error receiving manifest query: %w", err)
+	}
+	q := part.GetQuery()
+	if q == nil {
+		return status.Errorf(codes.InvalidArgument, "the stream must start with a manifest query")
+	}
+	a, err := s.appLister.Get(q.GetName())
+	if err != nil {
+		return fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+		return err
+	}
+
+	manifestInfo, err := s.generateManifestsWith(ctx, a, "", func(client apiclient.RepoServerServiceClient, req *apiclient.ManifestRequest) (*apiclient.ManifestResponse, error) {
+		repoStream, err := client.GenerateManifestWithFiles(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error opening repo server stream: %w", err)
+		}
+		err = repoStream.Send(&apiclient.ManifestRequestWithFiles{Part: &apiclient.ManifestRequestWithFiles_Request{Request: req}})
+		if err != nil {
+			return nil, fmt.Errorf("error sending manifest request: %w", err)
+		}
+		err = repoStream.Send(&apiclient.ManifestRequestWithFiles{Part: &apiclient.ManifestRequestWithFiles_Metadata{Metadata: &apiclient.ManifestFileMetadata{Checksum: q.GetChecksum()}}})
+		if err != nil {
+			return nil, fmt.Errorf("error sending file metadata: %w", err)
+		}
+		for {
+			part, err := stream.Recv()
+			if err == goio.EOF {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("error receiving file chunk: %w", err)
+			}
+			if part.GetChunk() == nil {
+				return nil, status.Errorf(codes.InvalidArgument, "the manifest query must be followed by file chunks")
+			}
+			err = repoStream.Send(&apiclient.ManifestRequestWithFiles{Part: &apiclient.ManifestRequestWithFiles_Chunk{Chunk: &apiclient.ManifestFileChunk{Chunk: part.GetChunk().Chunk}}})
+			if err != nil {
+				return nil, fmt.Errorf("error sending file chunk: %w", err)
+			}
+		}
+		return repoStream.CloseAndRecv()
+	})
+	if err != nil {
+		return err
+	}
+	err = hideManifestsSecretData(manifestInfo)
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(manifestInfo)
+}
 
+// hideManifestsSecretData replaces the data of the secrets of a manifest response with stars
+func hideManifestsSecretData(manifestInfo *apiclient.ManifestResponse) error {
 	for i, manifest := range manifestInfo.Manifests {
 		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(manifest), obj)
+		err := json.Unmarshal([]byte(manifest), obj)
 		if err != nil {
-			return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			return fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
 		}
 		if obj.GetKind() == kube.SecretKind && obj.GroupVersionKind().Group == "" {
 			obj, _, err = diff.HideSecretData(obj, nil)
 			if err != nil {
-				return nil, fmt.Errorf("error hiding secret data: %w", err)
+				return fmt.Errorf("error hiding secret data: %w", err)
 			}
 			data, err := json.Marshal(obj)
 			if err != nil {
-				return nil, fmt.Errorf("error marshaling manifest: %w", err)
+				return fmt.Errorf("error marshaling manifest: %w", err)
 			}
 			manifestInfo.Manifests[i] = string(data)
 		}
 	}
-
-	return manifestInfo, nil
+	return nil
 }
 
 // Get returns an application by name
@@ -602,6 +695,7 @@ func (s *Server) waitSync(app *appv1.Application) {
 
 func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ctx context.Context, merge bool) (*appv1.Application, error) {
 	for i := 0; i < 10; i++ {
+		live := app.DeepCopy()
 		app.Spec = newApp.Spec
 		if merge {
 			app.Labels = mergeStringMaps(app.Labels, newApp.Labels)
@@ -616,6 +710,7 @@ func (s *Server) updateApp(app *appv1.Application, newApp *appv1.Application, ct
 		res, err := s.appclientset.ArgoprojV1alpha1().Applications(s.ns).Update(ctx, app, metav1.UpdateOptions{})
 		if err == nil {
 			s.logAppEvent(app, ctx, argo.EventReasonResourceUpdated, "updated application spec")
+			audit.RecordChange(ctx, live, res)
 			s.waitSync(res)
 			return res, nil
 		}
@@ -1043,13 +1138,11 @@ func (s *Server) PatchResource(ctx context.Context, q *application.ApplicationRe
 		Version:      q.Version,
 		Group:        q.Group,
 	}
-	res, config, a, err := s.getAppLiveResource(ctx, rbacpolicy.ActionUpdate, resourceRequest)
+	updateRequest := rbacpolicy.ResourceAction(rbacpolicy.ActionUpdate, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
+	res, config, a, err := s.getAppLiveResource(ctx, updateRequest, resourceRequest)
 	if err != nil {
 		return nil, fmt.Errorf("error getting app live resource: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionUpdate, apputil.AppRBACName(*a)); err != nil {
-		return nil, err
-	}
 
 	manifest, err := s.kubectl.PatchResource(ctx, config, res.GroupKindVersion(), res.Name, res.Namespace, types.PatchType(q.GetPatchType()), []byte(q.GetPatch()))
 	if err != nil {
@@ -1084,13 +1177,11 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 		Version:      q.Version,
 		Group:        q.Group,
 	}
-	res, config, a, err := s.getAppLiveResource(ctx, rbacpolicy.ActionDelete, resourceRequest)
+	deleteRequest := rbacpolicy.ResourceAction(rbacpolicy.ActionDelete, q.GetGroup(), q.GetKind(), q.GetNamespace(), q.GetResourceName())
+	res, config, a, err := s.getAppLiveResource(ctx, deleteRequest, resourceRequest)
 	if err != nil {
 		return nil, fmt.Errorf("error getting live resource for delete: %w", err)
 	}
-	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionDelete, apputil.AppRBACName(*a)); err != nil {
-		return nil, err
-	}
 	var deleteOption metav1.DeleteOptions
 	if q.GetOrphan() {
 		propagationPolicy := metav1.DeletePropagationOrphan
@@ -1111,6 +1202,159 @@ func (s *Server) DeleteResource(ctx context.Context, q *application.ApplicationR
 	return &application.ApplicationResponse{}, nil
 }
 
+// getAppOrphanedResources returns the top-level orphaned resources of the application along with its project
+func (s *Server) getAppOrphanedResources(ctx context.Context, a *appv1.Application) ([]*appv1.ResourceNode, *appv1.AppProject, error) {
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting app project: %w", err)
+	}
+	if proj.Spec.OrphanedResources == nil {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "orphaned resources monitoring is not enabled in project '%s'", proj.Name)
+	}
+	tree, err := s.GetAppResources(ctx, a)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting app resources: %w", err)
+	}
+	orphans := make([]*appv1.ResourceNode, 0)
+	for i := range tree.OrphanedNodes {
+		// children of orphaned resources are adopted or deleted along with their parents
+		if len(tree.OrphanedNodes[i].ParentRefs) > 0 {
+			continue
+		}
+		orphans = append(orphans, &tree.OrphanedNodes[i])
+	}
+	return orphans, proj, nil
+}
+
+// selectOrphanedResources returns the orphaned resources matching the requested resources. Every requested resource
+// must be a top-level orphaned resource permitted in the project.
+func selectOrphanedResources(a *appv1.Application, proj *appv1.AppProject, orphans []*appv1.ResourceNode, requested []*appv1.SyncOperationResource) ([]*appv1.ResourceNode, error) {
+	if len(requested) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "at least one orphaned resource must be specified")
+	}
+	selected := make([]*appv1.ResourceNode, 0, len(requested))
+	for _, r := range requested {
+		var found *appv1.ResourceNode
+		for _, orphan := range orphans {
+			if orphan.Group == r.Group && orphan.Kind == r.Kind && orphan.Name == r.Name && orphan.Namespace == r.Namespace {
+				found = orphan
+				break
+			}
+		}
+		if found == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%s/%s %s/%s is not an orphaned resource of application %s", r.Group, r.Kind, r.Namespace, r.Name, a.Name)
+		}
+		if !proj.IsResourcePermitted(schema.GroupKind{Group: found.Group, Kind: found.Kind}, found.Namespace, a.Spec.Destination) {
+			return nil, status.Errorf(codes.PermissionDenied, "%s/%s %s/%s is not permitted in project '%s'", found.Group, found.Kind, found.Namespace, found.Name, proj.Name)
+		}
+		selected = append(selected, found)
+	}
+	return selected, nil
+}
+
+// ListOrphanedResources returns the top-level orphaned resources found in the application destination namespaces
+func (s *Server) ListOrphanedResources(ctx context.Context, q *application.ApplicationOrphanedResourcesQuery) (*application.ApplicationOrphanedResourcesResponse, error) {
+	a, err := s.appLister.Get(q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+		return nil, err
+	}
+	orphans, _, err := s.getAppOrphanedResources(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return &application.ApplicationOrphanedResourcesResponse{Items: orphans}, nil
+}
+
+// AdoptOrphanedResources sets the application tracking metadata on the selected orphaned resources
+func (s *Server) AdoptOrphanedResources(ctx context.Context, q *application.ApplicationOrphanedResourcesActionRequest) (*application.ApplicationOrphanedResourcesActionResponse, error) {
+	a, selected, config, err := s.getSelectedOrphanedResources(ctx, rbacpolicy.ActionUpdate, q)
+	if err != nil {
+		return nil, err
+	}
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	trackingMethod := argo.GetTrackingMethod(s.settingsMgr)
+	resourceTracking := argo.NewResourceTracking()
+	res := &application.ApplicationOrphanedResourcesActionResponse{DryRun: pointer.Bool(q.GetDryRun())}
+	for _, node := range selected {
+		if !q.GetDryRun() {
+			liveObj, err := s.kubectl.GetResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace)
+			if err != nil {
+				return nil, fmt.Errorf("error getting resource: %w", err)
+			}
+			adoptedObj := liveObj.DeepCopy()
+			err = resourceTracking.SetAppInstance(adoptedObj, appInstanceLabelKey, a.Name, a.Spec.Destination.Namespace, trackingMethod)
+			if err != nil {
+				return nil, fmt.Errorf("error setting app instance: %w", err)
+			}
+			if err := s.patchResource(ctx, config, liveObj, adoptedObj); err != nil {
+				return nil, err
+			}
+			s.logResourceEvent(node, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("adopted orphaned resource into application %s", a.Name))
+			s.logAppEvent(a, ctx, argo.EventReasonResourceUpdated, fmt.Sprintf("adopted orphaned resource %s/%s '%s'", node.Group, node.Kind, node.Name))
+		}
+		res.Items = append(res.Items, &node.ResourceRef)
+	}
+	return res, nil
+}
+
+// DeleteOrphanedResources deletes the selected orphaned resources
+func (s *Server) DeleteOrphanedResources(ctx context.Context, q *application.ApplicationOrphanedResourcesActionRequest) (*application.ApplicationOrphanedResourcesActionResponse, error) {
+	a, selected, config, err := s.getSelectedOrphanedResources(ctx, rbacpolicy.ActionDelete, q)
+	if err != nil {
+		return nil, err
+	}
+	propagationPolicy := metav1.DeletePropagationForeground
+	res := &application.ApplicationOrphanedResourcesActionResponse{DryRun: pointer.Bool(q.GetDryRun())}
+	for _, node := range selected {
+		if !q.GetDryRun() {
+			err := s.kubectl.DeleteResource(ctx, config, node.GroupKindVersion(), node.Name, node.Namespace, metav1.DeleteOptions{PropagationPolicy: &propagationPolicy})
+			if err != nil {
+				return nil, fmt.Errorf("error deleting resource: %w", err)
+			}
+			s.logResourceEvent(node, ctx, argo.EventReasonResourceDeleted, fmt.Sprintf("deleted orphaned resource of application %s", a.Name))
+			s.logAppEvent(a, ctx, argo.EventReasonResourceDeleted, fmt.Sprintf("deleted orphaned resource %s/%s '%s'", node.Group, node.Kind, node.Name))
+		}
+		res.Items = append(res.Items, &node.ResourceRef)
+	}
+	return res, nil
+}
+
+// getSelectedOrphanedResources returns the requested orphaned resources after enforcing the given action on each of them
+func (s *Server) getSelectedOrphanedResources(ctx context.Context, action string, q *application.ApplicationOrphanedResourcesActionRequest) (*appv1.Application, []*appv1.ResourceNode, *rest.Config, error) {
+	a, err := s.appLister.Get(q.GetName())
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting application by name: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+		return nil, nil, nil, err
+	}
+	orphans, proj, err := s.getAppOrphanedResources(ctx, a)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	selected, err := selectOrphanedResources(a, proj, orphans, q.Resources)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, node := range selected {
+		resourceAction := rbacpolicy.ResourceAction(action, node.Group, node.Kind, node.Namespace, node.Name)
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, resourceAction, apputil.AppRBACName(*a)); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	return a, selected, config, nil
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *application.ResourcesQuery) (*appv1.ApplicationTree, error) {
 	a, err := s.appLister.Get(q.GetApplicationName())
 	if err != nil {
@@ -1205,6 +1449,402 @@ func (s *Server) ManagedResources(ctx context.Context, q *application.ResourcesQ
 	return res, nil
 }
 
+// SimulateIgnoreDifferences returns the diffs of the managed resources of an application, or of the given live and
+// target states of a resource, without and with the given ignore difference rules
+func (s *Server) SimulateIgnoreDifferences(ctx context.Context, q *application.ApplicationIgnoreDifferencesSimulationRequest) (*application.ApplicationIgnoreDifferencesSimulationResponse, error) {
+	var lives, targets []*unstructured.Unstructured
+	// resources of an application are only reported if one of the rules applies to them
+	onlyMatching := false
+	// resources of an application are compared the same way the application controller compares them
+	var diffConfig argodiff.DiffConfig
+	switch {
+	case q.GetLiveState() != "" || q.GetTargetState() != "":
+		live, err := parseResourceState(q.GetLiveState())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error parsing live state: %v", err)
+		}
+		target, err := parseResourceState(q.GetTargetState())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error parsing target state: %v", err)
+		}
+		lives, targets = append(lives, live), append(targets, target)
+	case q.GetName() != "":
+		a, err := s.appLister.Get(q.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("error getting application: %w", err)
+		}
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+			return nil, fmt.Errorf("error verifying rbac: %w", err)
+		}
+		items := make([]*appv1.ResourceDiff, 0)
+		err = s.getCachedAppState(ctx, a, func() error {
+			return s.cache.GetAppManagedResources(a.Name, &items)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error getting cached app state: %w", err)
+		}
+		for _, item := range items {
+			if item.Hook {
+				continue
+			}
+			// secret data has already been hidden by the application controller
+			live, err := parseResourceState(item.LiveState)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing live state of %s/%s: %w", item.Kind, item.Name, err)
+			}
+			target, err := parseResourceState(item.TargetState)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing target state of %s/%s: %w", item.Kind, item.Name, err)
+			}
+			lives, targets = append(lives, live), append(targets, target)
+		}
+		onlyMatching = true
+
+		config, err := s.getApplicationClusterConfig(ctx, a)
+		if err != nil {
+			return nil, fmt.Errorf("error getting application cluster config: %w", err)
+		}
+		apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+		if err != nil {
+			return nil, fmt.Errorf("error getting API resources: %w", err)
+		}
+		diffConfig, err = s.appDiffConfig(a, config, apiResources)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "either an application name or the live and target states of a resource are required")
+	}
+
+	rules := make([]appv1.ResourceIgnoreDifferences, len(q.IgnoreDifferences))
+	for i := range q.IgnoreDifferences {
+		if q.IgnoreDifferences[i] != nil {
+			rules[i] = *q.IgnoreDifferences[i]
+		}
+	}
+	if diffConfig == nil {
+		// the given states of a resource are not associated with a cluster, so they are compared with the legacy diff
+		resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+		if err != nil {
+			return nil, fmt.Errorf("error getting resource overrides: %w", err)
+		}
+		compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+		if err != nil {
+			return nil, fmt.Errorf("error getting resource compare options: %w", err)
+		}
+		appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+		if err != nil {
+			return nil, fmt.Errorf("error getting app instance label key: %w", err)
+		}
+		diffConfig, err = argodiff.NewDiffConfigBuilder().
+			WithDiffSettings([]appv1.ResourceIgnoreDifferences{}, resourceOverrides, compareOptions.IgnoreAggregatedRoles).
+			WithTracking(appInstanceLabelKey, string(argo.GetTrackingMethod(s.settingsMgr))).
+			WithNoCache().
+			Build()
+		if err != nil {
+			return nil, fmt.Errorf("error building diff config: %w", err)
+		}
+	}
+
+	res := &application.ApplicationIgnoreDifferencesSimulationResponse{}
+	for i := range lives {
+		simulation, err := argodiff.SimulateIgnoreDifferences(lives[i], targets[i], rules, diffConfig)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "error simulating ignore differences: %v", err)
+		}
+		if onlyMatching && len(simulation.Rules) == 0 {
+			continue
+		}
+		obj := targets[i]
+		if obj == nil {
+			obj = lives[i]
+		}
+		item := &application.ResourceIgnoreDifferencesSimulation{
+			Group:                     pointer.String(obj.GroupVersionKind().Group),
+			Kind:                      pointer.String(obj.GetKind()),
+			Namespace:                 pointer.String(obj.GetNamespace()),
+			Name:                      pointer.String(obj.GetName()),
+			NormalizedLiveStateBefore: pointer.String(string(simulation.Before.NormalizedLive)),
+			PredictedLiveStateBefore:  pointer.String(string(simulation.Before.PredictedLive)),
+			ModifiedBefore:            pointer.Bool(simulation.Before.Modified),
+			NormalizedLiveStateAfter:  pointer.String(string(simulation.After.NormalizedLive)),
+			PredictedLiveStateAfter:   pointer.String(string(simulation.After.PredictedLive)),
+			ModifiedAfter:             pointer.Bool(simulation.After.Modified),
+		}
+		for j := range simulation.Rules {
+			rule := simulation.Rules[j]
+			item.Rules = append(item.Rules, &application.IgnoreDifferencesRuleResult{
+				Index:        pointer.Int32(int32(rule.Index)),
+				Rule:         &rule.Rule,
+				IgnoredPaths: rule.IgnoredPaths,
+			})
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
+// ServerSideDiff returns the diff between the live state of the managed resources of an application and the target
+// state generated by the repo server for the given revision, or the given manifests. The data of Secrets is hidden the
+// same way the application controller hides it.
+func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationServerSideDiffQuery) (*application.ApplicationServerSideDiffResponse, error) {
+	a, err := s.appLister.Get(q.GetName())
+	if err != nil {
+		return nil, fmt.Errorf("error getting application: %w", err)
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, rbacpolicy.ActionGet, apputil.AppRBACName(*a)); err != nil {
+		return nil, err
+	}
+	if q.GetRevision() != "" && len(q.Manifests) > 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "either a revision or manifests can be specified, not both")
+	}
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	trackingMethod := argoutil.GetTrackingMethod(s.settingsMgr)
+
+	var targets []*unstructured.Unstructured
+	if len(q.Manifests) > 0 {
+		resourceTracking := argoutil.NewResourceTracking()
+		for _, manifest := range q.Manifests {
+			objs, err := kube.SplitYAML([]byte(manifest))
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "error parsing manifests: %v", err)
+			}
+			for _, obj := range objs {
+				if !kube.IsCRD(obj) {
+					if err := resourceTracking.SetAppInstance(obj, appInstanceLabelKey, a.Name, a.Spec.Destination.Namespace, trackingMethod); err != nil {
+						return nil, fmt.Errorf("error setting app instance: %w", err)
+					}
+				}
+				targets = append(targets, obj)
+			}
+		}
+	} else {
+		manifestInfo, err := s.generateManifests(ctx, a, q.GetRevision())
+		if err != nil {
+			return nil, err
+		}
+		for _, manifest := range manifestInfo.Manifests {
+			obj := &unstructured.Unstructured{}
+			if err := json.Unmarshal([]byte(manifest), obj); err != nil {
+				return nil, fmt.Errorf("error unmarshaling manifest into unstructured: %w", err)
+			}
+			targets = append(targets, obj)
+		}
+	}
+
+	config, err := s.getApplicationClusterConfig(ctx, a)
+	if err != nil {
+		return nil, fmt.Errorf("error getting application cluster config: %w", err)
+	}
+	apiResources, err := s.kubectl.GetAPIResources(config, false, kubecache.NewNoopSettings())
+	if err != nil {
+		return nil, fmt.Errorf("error getting API resources: %w", err)
+	}
+	namespacedByGk := make(map[schema.GroupKind]bool)
+	for _, apiResource := range apiResources {
+		namespacedByGk[apiResource.GroupKind] = apiResource.Meta.Namespaced
+	}
+	targetByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, obj := range targets {
+		if hook.IsHook(obj) || ignore.Ignore(obj) {
+			continue
+		}
+		if obj.GetNamespace() == "" && namespacedByGk[obj.GroupVersionKind().GroupKind()] {
+			obj.SetNamespace(a.Spec.Destination.Namespace)
+		}
+		targetByKey[kube.GetResourceKey(obj)] = obj
+	}
+
+	diffConfig, err := s.appDiffConfig(a, config, apiResources)
+	if err != nil {
+		return nil, err
+	}
+	// secrets are compared with their hidden data, which cannot be applied in a dry-run
+	secretDiffConfig := diffConfig
+	if diffConfig.Strategy() == argodiff.DiffStrategyServerSide {
+		secretDiffConfig, err = s.buildAppDiffConfig(a, config, apiResources, argodiff.DiffStrategyLegacy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	items := make([]*appv1.ResourceDiff, 0)
+	err = s.getCachedAppState(ctx, a, func() error {
+		return s.cache.GetAppManagedResources(a.Name, &items)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached app state: %w", err)
+	}
+	type liveTarget struct {
+		key    kube.ResourceKey
+		live   *unstructured.Unstructured
+		target *unstructured.Unstructured
+	}
+	var pairs []liveTarget
+	for _, item := range items {
+		if item.Hook {
+			continue
+		}
+		key := kube.NewResourceKey(item.Group, item.Kind, item.Namespace, item.Name)
+		target := targetByKey[key]
+		delete(targetByKey, key)
+		live, err := parseResourceState(item.LiveState)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing live state of %s/%s: %w", item.Kind, item.Name, err)
+		}
+		if live == nil && target == nil {
+			continue
+		}
+		pairs = append(pairs, liveTarget{key: key, live: live, target: target})
+	}
+	var newKeys []kube.ResourceKey
+	for key := range targetByKey {
+		newKeys = append(newKeys, key)
+	}
+	sort.Slice(newKeys, func(i, j int) bool {
+		return newKeys[i].String() < newKeys[j].String()
+	})
+	for _, key := range newKeys {
+		pairs = append(pairs, liveTarget{key: key, target: targetByKey[key]})
+	}
+
+	res := &application.ApplicationServerSideDiffResponse{Items: make([]*appv1.ResourceDiff, 0), Modified: pointer.Bool(false)}
+	for _, pair := range pairs {
+		pairDiffConfig := diffConfig
+		if pair.key.Kind == kube.SecretKind && pair.key.Group == "" {
+			pair.live, pair.target, err = s.hideSecretData(ctx, config, pair.live, pair.target)
+			if err != nil {
+				return nil, fmt.Errorf("error hiding data of secret %s: %w", pair.key.Name, err)
+			}
+			pairDiffConfig = secretDiffConfig
+		}
+		diffRes, err := argodiff.StateDiff(pair.live, pair.target, pairDiffConfig)
+		if err != nil {
+			return nil, fmt.Errorf("error calculating diff of %s/%s: %w", pair.key.Kind, pair.key.Name, err)
+		}
+		item := &appv1.ResourceDiff{
+			Group:               pair.key.Group,
+			Kind:                pair.key.Kind,
+			Namespace:           pair.key.Namespace,
+			Name:                pair.key.Name,
+			NormalizedLiveState: string(diffRes.NormalizedLive),
+			PredictedLiveState:  string(diffRes.PredictedLive),
+			Modified:            diffRes.Modified || pair.live == nil || pair.target == nil,
+			DiffStrategy:        string(pairDiffConfig.Strategy()),
+		}
+		item.LiveState, err = marshalResourceState(pair.live)
+		if err != nil {
+			return nil, err
+		}
+		item.TargetState, err = marshalResourceState(pair.target)
+		if err != nil {
+			return nil, err
+		}
+		if pair.live != nil {
+			item.ResourceVersion = pair.live.GetResourceVersion()
+		}
+		if item.Modified {
+			res.Modified = pointer.Bool(true)
+		}
+		res.Items = append(res.Items, item)
+	}
+	return res, nil
+}
+
+// hideSecretData replaces the data of a secret in its live state in the cluster and its target state, the same way the
+// application controller does. The cached live state cannot be used since its data is already hidden.
+func (s *Server) hideSecretData(ctx context.Context, config *rest.Config, live, target *unstructured.Unstructured) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	if live != nil {
+		var err error
+		live, err = s.kubectl.GetResource(ctx, config, live.GroupVersionKind(), live.GetName(), live.GetNamespace())
+		if apierr.IsNotFound(err) {
+			live = nil
+		} else if err != nil {
+			return nil, nil, fmt.Errorf("error getting resource: %w", err)
+		}
+	}
+	return diff.HideSecretData(target, live)
+}
+
+// appDiffConfig returns the diff config used to compare the resources of an application, with the diff strategy
+// selected for the application and the schema of the destination cluster
+func (s *Server) appDiffConfig(a *appv1.Application, config *rest.Config, apiResources []kube.APIResourceInfo) (argodiff.DiffConfig, error) {
+	compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource compare options: %w", err)
+	}
+	diffStrategy, err := argodiff.GetAppDiffStrategy(a, compareOptions.DiffStrategy)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error getting diff strategy: %v", err)
+	}
+	return s.buildAppDiffConfig(a, config, apiResources, diffStrategy)
+}
+
+// buildAppDiffConfig returns the diff config used to compare the resources of an application with the given strategy
+func (s *Server) buildAppDiffConfig(a *appv1.Application, config *rest.Config, apiResources []kube.APIResourceInfo, diffStrategy argodiff.DiffStrategy) (argodiff.DiffConfig, error) {
+	resourceOverrides, err := s.settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	compareOptions, err := s.settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource compare options: %w", err)
+	}
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	_, gvkParser, err := s.kubectl.LoadOpenAPISchema(config)
+	if err != nil {
+		return nil, fmt.Errorf("error loading OpenAPI schema: %w", err)
+	}
+	diffConfigBuilder := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(a.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles).
+		WithTracking(appInstanceLabelKey, string(argoutil.GetTrackingMethod(s.settingsMgr))).
+		WithNoCache().
+		WithGVKParser(gvkParser).
+		WithStrategy(diffStrategy)
+	if diffStrategy == argodiff.DiffStrategyServerSide {
+		dynamicIf, err := dynamic.NewForConfig(config)
+		if err != nil {
+			return nil, fmt.Errorf("error creating dynamic client: %w", err)
+		}
+		diffConfigBuilder.WithServerSideDryRunner(argodiff.NewServerSideDryRunner(dynamicIf, apiResources, argodiff.ServerSideDiffManager))
+	}
+	diffConfig, err := diffConfigBuilder.Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building diff config: %w", err)
+	}
+	return diffConfig, nil
+}
+
+// marshalResourceState returns the JSON state of a resource, or null if the resource is missing
+func marshalResourceState(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "null", nil
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return string(data), nil
+}
+
+// parseResourceState parses the YAML or JSON state of a resource. An empty or null state denotes a missing resource.
+func parseResourceState(state string) (*unstructured.Unstructured, error) {
+	if state == "" || state == "null" {
+		return nil, nil
+	}
+	obj := make(map[string]interface{})
+	if err := yaml.Unmarshal([]byte(state), &obj); err != nil {
+		return nil, err
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
 func (s *Server) PodLogs(q *application.ApplicationPodLogsQuery, ws application.ApplicationService_PodLogsServer) error {
 	if q.PodName != nil {
 		podKind := "Pod"
@@ -1762,28 +2402,141 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	if err != nil {
 		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
 	}
+	declaredParams, err := luaVM.GetResourceActionParams(liveObj, q.GetAction())
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lua resource action parameters: %w", err)
+	}
+	givenParams := make([]appv1.ResourceActionParam, 0, len(q.Params))
+	for _, param := range q.Params {
+		givenParams = append(givenParams, *param)
+	}
+	params, err := lua.ParseResourceActionParams(declaredParams, givenParams)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parameters of action %s: %v", q.GetAction(), err)
+	}
 
-	newObj, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua)
+	impactedResources, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, params)
 	if err != nil {
 		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
 	}
 
+	proj, err := argo.GetAppProject(&a.Spec, applisters.NewAppProjectLister(s.projInformer.GetIndexer()), s.ns, s.settingsMgr, s.db, ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting app project: %w", err)
+	}
+	// Validate all operations before performing any of them, so that an action is either applied entirely or not at all
+	// as far as possible.
+	var tree *appv1.ApplicationTree
+	targetObjs := make([]*unstructured.Unstructured, len(impactedResources))
+	for i, impactedResource := range impactedResources {
+		newObj := impactedResource.UnstructuredObj
+		if !proj.IsResourcePermitted(newObj.GroupVersionKind().GroupKind(), newObj.GetNamespace(), a.Spec.Destination) {
+			return nil, status.Errorf(codes.PermissionDenied, "application %s is not permitted to manage %s/%s in namespace %s", a.Name, newObj.GetKind(), newObj.GetName(), newObj.GetNamespace())
+		}
+		if impactedResource.K8SOperation == lua.PatchOperation {
+			targetObjs[i] = liveObj
+			if newObj.GroupVersionKind().GroupKind() != liveObj.GroupVersionKind().GroupKind() || newObj.GetName() != liveObj.GetName() || newObj.GetNamespace() != liveObj.GetNamespace() {
+				// Resources other than the one the action runs on may only be patched if they are managed by the
+				// application and the user is allowed to update them.
+				if tree == nil {
+					if tree, err = s.GetAppResources(ctx, a); err != nil {
+						return nil, fmt.Errorf("error getting app resources: %w", err)
+					}
+				}
+				gvk := newObj.GroupVersionKind()
+				if !isManagedNode(tree, gvk.Group, gvk.Kind, newObj.GetNamespace(), newObj.GetName()) {
+					return nil, status.Errorf(codes.InvalidArgument, "%s %s %s not found as part of application %s", gvk.Kind, gvk.Group, newObj.GetName(), a.Name)
+				}
+				updateRequest := rbacpolicy.ResourceAction(rbacpolicy.ActionUpdate, gvk.Group, gvk.Kind, newObj.GetNamespace(), newObj.GetName())
+				if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceApplications, updateRequest, apputil.AppRBACName(*a)); err != nil {
+					return nil, err
+				}
+				targetObjs[i], err = s.kubectl.GetResource(ctx, config, gvk, newObj.GetName(), newObj.GetNamespace())
+				if err != nil {
+					return nil, fmt.Errorf("error getting resource: %w", err)
+				}
+			}
+		}
+		if impactedResource.K8SOperation == lua.CreateOperation {
+			if err := s.setAppInstance(a, newObj); err != nil {
+				return nil, err
+			}
+			if _, err := s.createResource(ctx, config, newObj, cmdutil.DryRunServer); err != nil {
+				return nil, fmt.Errorf("error validating resource %s/%s to create: %w", newObj.GetKind(), newObj.GetName(), err)
+			}
+		}
+	}
+
+	for i, impactedResource := range impactedResources {
+		newObj := impactedResource.UnstructuredObj
+		switch impactedResource.K8SOperation {
+		case lua.PatchOperation:
+			if err := s.patchResource(ctx, config, targetObjs[i], newObj); err != nil {
+				return nil, err
+			}
+		case lua.CreateOperation:
+			if _, err := s.createResource(ctx, config, newObj, cmdutil.DryRunNone); err != nil {
+				return nil, fmt.Errorf("error creating resource %s/%s: %w", newObj.GetKind(), newObj.GetName(), err)
+			}
+			s.logAppEvent(a, ctx, argo.EventReasonResourceCreated, fmt.Sprintf("created resource %s/%s/%s by running action %s on resource %s/%s/%s", newObj.GroupVersionKind().Group, newObj.GetKind(), newObj.GetName(), q.GetAction(), res.Group, res.Kind, res.Name))
+		}
+	}
+
+	s.logAppEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
+	s.logResourceEvent(res, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s", q.GetAction()))
+	return &application.ApplicationResponse{}, nil
+}
+
+// isManagedNode returns whether the given resource is part of the application's resource tree, excluding orphaned resources
+func isManagedNode(tree *appv1.ApplicationTree, group, kind, namespace, name string) bool {
+	for _, n := range tree.Nodes {
+		if n.Group == group && n.Kind == kind && n.Namespace == namespace && n.Name == name {
+			return n.ResourceRef.UID != ""
+		}
+	}
+	return false
+}
+
+// setAppInstance marks a resource created by a resource action as belonging to the application
+func (s *Server) setAppInstance(a *appv1.Application, obj *unstructured.Unstructured) error {
+	appInstanceLabelKey, err := s.settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	trackingMethod := argo.GetTrackingMethod(s.settingsMgr)
+	if err := argo.NewResourceTracking().SetAppInstance(obj, appInstanceLabelKey, a.Name, a.Spec.Destination.Namespace, trackingMethod); err != nil {
+		return fmt.Errorf("error setting app instance on resource: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) createResource(ctx context.Context, config *rest.Config, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (string, error) {
+	resourceOps, cleanup, err := s.kubectl.ManageResources(config, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creating kubectl ResourceOperations: %w", err)
+	}
+	defer cleanup()
+	return resourceOps.CreateResource(ctx, obj, dryRunStrategy, false)
+}
+
+// patchResource patches the live resource with the changes of the resource returned by a resource action
+func (s *Server) patchResource(ctx context.Context, config *rest.Config, liveObj, newObj *unstructured.Unstructured) error {
 	newObjBytes, err := json.Marshal(newObj)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling new object: %w", err)
+		return fmt.Errorf("error marshaling new object: %w", err)
 	}
 
 	liveObjBytes, err := json.Marshal(liveObj)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling live object: %w", err)
+		return fmt.Errorf("error marshaling live object: %w", err)
 	}
 
 	diffBytes, err := jsonpatch.CreateMergePatch(liveObjBytes, newObjBytes)
 	if err != nil {
-		return nil, fmt.Errorf("error calculating merge patch: %w", err)
+		return fmt.Errorf("error calculating merge patch: %w", err)
 	}
 	if string(diffBytes) == "{}" {
-		return &application.ApplicationResponse{}, nil
+		return nil
 	}
 
 	// The following logic detects if the resource action makes a modification to status and/or spec.
@@ -1795,13 +2548,13 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	// * the other to update only status.
 	nonStatusPatch, statusPatch, err := splitStatusPatch(diffBytes)
 	if err != nil {
-		return nil, fmt.Errorf("error splitting status patch: %w", err)
+		return fmt.Errorf("error splitting status patch: %w", err)
 	}
 	if statusPatch != nil {
 		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes, "status")
 		if err != nil {
 			if !apierr.IsNotFound(err) {
-				return nil, fmt.Errorf("error patching resource: %w", err)
+				return fmt.Errorf("error patching resource: %w", err)
 			}
 			// K8s API server returns 404 NotFound when the CRD does not support the status subresource
 			// if we get here, the CRD does not use the status subresource. We will fall back to a normal patch
@@ -1814,13 +2567,10 @@ func (s *Server) RunResourceAction(ctx context.Context, q *application.ResourceA
 	if diffBytes != nil {
 		_, err = s.kubectl.PatchResource(ctx, config, newObj.GroupVersionKind(), newObj.GetName(), newObj.GetNamespace(), types.MergePatchType, diffBytes)
 		if err != nil {
-			return nil, fmt.Errorf("error patching resource: %w", err)
+			return fmt.Errorf("error patching resource: %w", err)
 		}
 	}
-
-	s.logAppEvent(a, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s on resource %s/%s/%s", q.GetAction(), res.Group, res.Kind, res.Name))
-	s.logResourceEvent(res, ctx, argo.EventReasonResourceActionRan, fmt.Sprintf("ran action %s", q.GetAction()))
-	return &application.ApplicationResponse{}, nil
+	return nil
 }
 
 // splitStatusPatch splits a patch into two: one for a non-status patch, and the status-only patch.
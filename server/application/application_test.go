@@ -4,6 +4,7 @@ import (
 	"context"
 	coreerrors "errors"
 	"fmt"
+	"io"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -16,14 +17,19 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
 	kubetesting "k8s.io/client-go/testing"
 	k8scache "k8s.io/client-go/tools/cache"
 	"k8s.io/utils/pointer"
@@ -35,13 +41,15 @@ import (
 	appinformer "github.com/argoproj/argo-cd/v2/pkg/client/informers/externalversions"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient"
 	"github.com/argoproj/argo-cd/v2/reposerver/apiclient/mocks"
+	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/assets"
 	"github.com/argoproj/argo-cd/v2/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v2/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/errors"
-	"github.com/argoproj/argo-cd/v2/util/grpc"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
@@ -92,6 +100,9 @@ func fakeRepoServerClient(isHelm bool) *mocks.RepoServerServiceClient {
 	mockRepoServiceClient.On("ListApps", mock.Anything, mock.Anything).Return(fakeAppList(), nil)
 	mockRepoServiceClient.On("GenerateManifest", mock.Anything, mock.Anything).Return(&apiclient.ManifestResponse{}, nil)
 	mockRepoServiceClient.On("GetAppDetails", mock.Anything, mock.Anything).Return(&apiclient.RepoAppDetailsResponse{}, nil)
+	mockRepoServiceClient.On("GenerateManifestWithFiles", mock.Anything).Return(func(context.Context, ...grpc.CallOption) apiclient.RepoServerService_GenerateManifestWithFilesClient {
+		return &fakeGenerateManifestWithFilesClient{}
+	}, nil)
 	mockRepoServiceClient.On("TestRepository", mock.Anything, mock.Anything).Return(&apiclient.TestRepositoryResponse{}, nil)
 
 	if isHelm {
@@ -644,7 +655,7 @@ p, admin, applications, update, default/test-app, allow
 p, admin, applications, update, my-proj/test-app, allow
 `)
 	_, err = appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
-	statusErr := grpc.UnwrapGRPCStatus(err)
+	statusErr := grpc_util.UnwrapGRPCStatus(err)
 	assert.NotNil(t, statusErr)
 	assert.Equal(t, codes.PermissionDenied, statusErr.Code())
 
@@ -662,7 +673,7 @@ p, admin, applications, create, my-proj/test-app, allow
 p, admin, applications, update, my-proj/test-app, allow
 `)
 	_, err = appServer.Update(ctx, &application.ApplicationUpdateRequest{Application: testApp})
-	statusErr = grpc.UnwrapGRPCStatus(err)
+	statusErr = grpc_util.UnwrapGRPCStatus(err)
 	assert.NotNil(t, statusErr)
 	assert.Equal(t, codes.PermissionDenied, statusErr.Code())
 
@@ -983,3 +994,537 @@ func TestGetAppRefresh_HardRefresh(t *testing.T) {
 		assert.Fail(t, "Out of time ( 10 seconds )")
 	}
 }
+
+type fakeGenerateManifestWithFilesClient struct {
+	grpc.ClientStream
+	parts []*apiclient.ManifestRequestWithFiles
+}
+
+func (c *fakeGenerateManifestWithFilesClient) Send(part *apiclient.ManifestRequestWithFiles) error {
+	c.parts = append(c.parts, part)
+	return nil
+}
+
+func (c *fakeGenerateManifestWithFilesClient) CloseAndRecv() (*apiclient.ManifestResponse, error) {
+	if len(c.parts) < 3 || c.parts[0].GetRequest() == nil || c.parts[1].GetMetadata() == nil || c.parts[2].GetChunk() == nil {
+		return nil, fmt.Errorf("unexpected stream")
+	}
+	return &apiclient.ManifestResponse{Manifests: []string{
+		fmt.Sprintf(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"%s"}}`, c.parts[0].GetRequest().AppName),
+		`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret"},"data":{"key":"dmFsdWU="}}`,
+	}}, nil
+}
+
+type fakeGetManifestsWithFilesServer struct {
+	grpc.ServerStream
+	parts    []*application.ApplicationManifestQueryWithFilesWrapper
+	response *apiclient.ManifestResponse
+}
+
+func (s *fakeGetManifestsWithFilesServer) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeGetManifestsWithFilesServer) Recv() (*application.ApplicationManifestQueryWithFilesWrapper, error) {
+	if len(s.parts) == 0 {
+		return nil, io.EOF
+	}
+	part := s.parts[0]
+	s.parts = s.parts[1:]
+	return part, nil
+}
+
+func (s *fakeGetManifestsWithFilesServer) SendAndClose(res *apiclient.ManifestResponse) error {
+	s.response = res
+	return nil
+}
+
+func TestGetManifestsWithFiles(t *testing.T) {
+	query := &application.ApplicationManifestQueryWithFilesWrapper{Part: &application.ApplicationManifestQueryWithFilesWrapper_Query{
+		Query: &application.ApplicationManifestQueryWithFiles{Name: pointer.String("test-app"), Checksum: pointer.String("abc")},
+	}}
+	chunk := &application.ApplicationManifestQueryWithFilesWrapper{Part: &application.ApplicationManifestQueryWithFilesWrapper_Chunk{
+		Chunk: &application.FileChunk{Chunk: []byte("data")},
+	}}
+	t.Run("Success", func(t *testing.T) {
+		appServer := newTestAppServer(newTestApp())
+		stream := &fakeGetManifestsWithFilesServer{parts: []*application.ApplicationManifestQueryWithFilesWrapper{query, chunk}}
+		require.NoError(t, appServer.GetManifestsWithFiles(stream))
+		require.Len(t, stream.response.Manifests, 2)
+		assert.Contains(t, stream.response.Manifests[0], `"name":"test-app"`)
+		assert.NotContains(t, stream.response.Manifests[1], "dmFsdWU=")
+	})
+	t.Run("MissingQuery", func(t *testing.T) {
+		appServer := newTestAppServer(newTestApp())
+		stream := &fakeGetManifestsWithFilesServer{parts: []*application.ApplicationManifestQueryWithFilesWrapper{chunk}}
+		err := appServer.GetManifestsWithFiles(stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("")
+		}, newTestApp())
+		stream := &fakeGetManifestsWithFilesServer{parts: []*application.ApplicationManifestQueryWithFilesWrapper{query, chunk}}
+		err := appServer.GetManifestsWithFiles(stream)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestServerSideDiff(t *testing.T) {
+	t.Run("RevisionAndManifests", func(t *testing.T) {
+		appServer := newTestAppServer(newTestApp())
+		_, err := appServer.ServerSideDiff(context.Background(), &application.ApplicationServerSideDiffQuery{
+			Name:      pointer.String("test-app"),
+			Revision:  pointer.String("HEAD"),
+			Manifests: []string{"apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-config\n"},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("PermissionDenied", func(t *testing.T) {
+		appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("")
+		}, newTestApp())
+		_, err := appServer.ServerSideDiff(context.Background(), &application.ApplicationServerSideDiffQuery{
+			Name: pointer.String("test-app"),
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("Secret", func(t *testing.T) {
+		appServer := newTestAppServer(newTestApp())
+		appStateCache := appstatecache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+		appServer.cache = servercache.NewCache(appStateCache, time.Hour, time.Hour, time.Hour)
+		require.NoError(t, appStateCache.SetAppManagedResources("test-app", []*appsv1.ResourceDiff{{
+			Kind:      "Secret",
+			Namespace: test.FakeDestNamespace,
+			Name:      "my-secret",
+			LiveState: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"my-secret","namespace":"` + test.FakeDestNamespace + `"},"data":{"password":"++++++++"}}`,
+		}}))
+		kubectl := newRecordingKubectl()
+		kubectl.WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			obj.SetName(name)
+			obj.SetNamespace(namespace)
+			obj.Object["data"] = map[string]interface{}{"password": "b2xk"}
+			return obj, nil
+		})
+		appServer.kubectl = kubectl
+
+		res, err := appServer.ServerSideDiff(context.Background(), &application.ApplicationServerSideDiffQuery{
+			Name:      pointer.String("test-app"),
+			Manifests: []string{"apiVersion: v1\nkind: Secret\nmetadata:\n  name: my-secret\n  namespace: " + test.FakeDestNamespace + "\ndata:\n  password: bmV3\n"},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		item := res.Items[0]
+		assert.Equal(t, "my-secret", item.Name)
+		assert.True(t, item.Modified)
+		for _, state := range []string{item.LiveState, item.TargetState, item.NormalizedLiveState, item.PredictedLiveState} {
+			assert.NotContains(t, state, "b2xk")
+			assert.NotContains(t, state, "bmV3")
+		}
+		assert.Contains(t, item.LiveState, "++++++++")
+		assert.Contains(t, item.TargetState, "+++++++++")
+	})
+}
+
+func TestSimulateIgnoreDifferences(t *testing.T) {
+	appServer := newTestAppServer()
+	deployment := `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: %d
+  revisionHistoryLimit: %d
+`
+	t.Run("LiveAndTargetStates", func(t *testing.T) {
+		res, err := appServer.SimulateIgnoreDifferences(context.Background(), &application.ApplicationIgnoreDifferencesSimulationRequest{
+			LiveState:   pointer.String(fmt.Sprintf(deployment, 3, 10)),
+			TargetState: pointer.String(fmt.Sprintf(deployment, 1, 3)),
+			IgnoreDifferences: []*appsv1.ResourceIgnoreDifferences{
+				{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}},
+				{Kind: "Service", JSONPointers: []string{"/spec"}},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		item := res.Items[0]
+		assert.Equal(t, "guestbook", item.GetName())
+		assert.True(t, item.GetModifiedBefore())
+		assert.True(t, item.GetModifiedAfter())
+		require.Len(t, item.Rules, 1)
+		assert.Equal(t, int32(0), item.Rules[0].GetIndex())
+		assert.Equal(t, []string{"/spec/replicas"}, item.Rules[0].IgnoredPaths)
+	})
+	t.Run("InvalidState", func(t *testing.T) {
+		_, err := appServer.SimulateIgnoreDifferences(context.Background(), &application.ApplicationIgnoreDifferencesSimulationRequest{
+			LiveState: pointer.String("{"),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("NoResources", func(t *testing.T) {
+		_, err := appServer.SimulateIgnoreDifferences(context.Background(), &application.ApplicationIgnoreDifferencesSimulationRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	newAppServer := func(t *testing.T, opts ...func(app *appsv1.Application)) *Server {
+		appServer := newTestAppServer(newTestApp(opts...))
+		appStateCache := appstatecache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+		appServer.cache = servercache.NewCache(appStateCache, time.Hour, time.Hour, time.Hour)
+		require.NoError(t, appStateCache.SetAppManagedResources("test-app", []*appsv1.ResourceDiff{{
+			Group:       "apps",
+			Kind:        "Deployment",
+			Namespace:   "default",
+			Name:        "guestbook",
+			LiveState:   fmt.Sprintf(deployment, 3, 10),
+			TargetState: fmt.Sprintf(deployment, 1, 10),
+		}}))
+		return appServer
+	}
+	rules := []*appsv1.ResourceIgnoreDifferences{{Group: "apps", Kind: "Deployment", JSONPointers: []string{"/spec/replicas"}}}
+	t.Run("Application", func(t *testing.T) {
+		res, err := newAppServer(t).SimulateIgnoreDifferences(context.Background(), &application.ApplicationIgnoreDifferencesSimulationRequest{
+			Name:              pointer.String("test-app"),
+			IgnoreDifferences: rules,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 1)
+		item := res.Items[0]
+		assert.True(t, item.GetModifiedBefore())
+		assert.False(t, item.GetModifiedAfter())
+		require.Len(t, item.Rules, 1)
+		assert.Equal(t, []string{"/spec/replicas"}, item.Rules[0].IgnoredPaths)
+	})
+	t.Run("ApplicationDiffStrategy", func(t *testing.T) {
+		appServer := newAppServer(t, func(app *appsv1.Application) {
+			app.Annotations = map[string]string{common.AnnotationCompareOptions: "DiffStrategy=unknown"}
+		})
+		_, err := appServer.SimulateIgnoreDifferences(context.Background(), &application.ApplicationIgnoreDifferencesSimulationRequest{
+			Name:              pointer.String("test-app"),
+			IgnoreDifferences: rules,
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+type recordingKubectl struct {
+	*kubetest.MockKubectlCmd
+	patches map[string]string
+	deleted []string
+}
+
+func (k *recordingKubectl) PatchResource(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string, _ types.PatchType, patchBytes []byte, _ ...string) (*unstructured.Unstructured, error) {
+	k.patches[name] = string(patchBytes)
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	return obj, nil
+}
+
+func (k *recordingKubectl) DeleteResource(_ context.Context, _ *rest.Config, _ schema.GroupVersionKind, name string, _ string, _ metav1.DeleteOptions) error {
+	k.deleted = append(k.deleted, name)
+	return nil
+}
+
+func newRecordingKubectl() *recordingKubectl {
+	kubectl := &recordingKubectl{MockKubectlCmd: &kubetest.MockKubectlCmd{}, patches: map[string]string{}}
+	kubectl.WithGetResourceFunc(func(_ context.Context, _ *rest.Config, gvk schema.GroupVersionKind, name string, namespace string) (*unstructured.Unstructured, error) {
+		obj := &unstructured.Unstructured{}
+		obj.SetGroupVersionKind(gvk)
+		obj.SetName(name)
+		obj.SetNamespace(namespace)
+		return obj, nil
+	})
+	return kubectl
+}
+
+func setTestAppResourcesTree(t *testing.T, appServer *Server, appName string, tree *appsv1.ApplicationTree) {
+	t.Helper()
+	appStateCache := appstatecache.NewCache(cache.NewCache(cache.NewInMemoryCache(time.Hour)), time.Hour)
+	appServer.cache = servercache.NewCache(appStateCache, time.Hour, time.Hour, time.Hour)
+	require.NoError(t, appStateCache.SetAppResourcesTree(appName, tree))
+}
+
+func newTestOrphansAppServer(t *testing.T, f func(*rbac.Enforcer)) (*Server, *recordingKubectl) {
+	t.Helper()
+	testApp := newTestApp(func(app *appsv1.Application) {
+		app.Spec.Project = "orphans"
+	})
+	proj := &appsv1.AppProject{
+		ObjectMeta: metav1.ObjectMeta{Name: "orphans", Namespace: testNamespace},
+		Spec: appsv1.AppProjectSpec{
+			SourceRepos:                []string{"*"},
+			Destinations:               []appsv1.ApplicationDestination{{Server: "*", Namespace: "*"}},
+			OrphanedResources:          &appsv1.OrphanedResourcesMonitorSettings{},
+			NamespaceResourceBlacklist: []metav1.GroupKind{{Group: "", Kind: "ConfigMap"}},
+		},
+	}
+	appServer := newTestAppServerWithEnforcerConfigure(f, testApp, proj)
+	setTestAppResourcesTree(t, appServer, testApp.Name, &appsv1.ApplicationTree{
+		OrphanedNodes: []appsv1.ResourceNode{
+			{ResourceRef: appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "orphan"}},
+			{ResourceRef: appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "ReplicaSet", Namespace: test.FakeDestNamespace, Name: "orphan-rs"},
+				ParentRefs: []appsv1.ResourceRef{{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "orphan"}}},
+			{ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "forbidden"}},
+		},
+	})
+	kubectl := newRecordingKubectl()
+	appServer.kubectl = kubectl
+	return appServer, kubectl
+}
+
+func TestOrphanedResources(t *testing.T) {
+	adminEnforcer := func(enf *rbac.Enforcer) {
+		_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+		enf.SetDefaultRole("role:admin")
+	}
+	orphan := &appsv1.SyncOperationResource{Group: "apps", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "orphan"}
+
+	t.Run("List", func(t *testing.T) {
+		appServer, _ := newTestOrphansAppServer(t, adminEnforcer)
+		res, err := appServer.ListOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesQuery{Name: pointer.String("test-app")})
+		require.NoError(t, err)
+		require.Len(t, res.Items, 2)
+		assert.Equal(t, "forbidden", res.Items[0].Name)
+		assert.Equal(t, "orphan", res.Items[1].Name)
+	})
+	t.Run("ListMonitoringDisabled", func(t *testing.T) {
+		appServer := newTestAppServer(newTestApp())
+		_, err := appServer.ListOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesQuery{Name: pointer.String("test-app")})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("AdoptDryRun", func(t *testing.T) {
+		appServer, kubectl := newTestOrphansAppServer(t, adminEnforcer)
+		res, err := appServer.AdoptOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name:      pointer.String("test-app"),
+			Resources: []*appsv1.SyncOperationResource{orphan},
+			DryRun:    pointer.Bool(true),
+		})
+		require.NoError(t, err)
+		assert.True(t, res.GetDryRun())
+		require.Len(t, res.Items, 1)
+		assert.Equal(t, "orphan", res.Items[0].Name)
+		assert.Empty(t, kubectl.patches)
+	})
+	t.Run("Adopt", func(t *testing.T) {
+		appServer, kubectl := newTestOrphansAppServer(t, adminEnforcer)
+		_, err := appServer.AdoptOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name:      pointer.String("test-app"),
+			Resources: []*appsv1.SyncOperationResource{orphan},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `{"metadata":{"labels":{"app.kubernetes.io/instance":"test-app"}}}`, kubectl.patches["orphan"])
+	})
+	t.Run("AdoptChildResource", func(t *testing.T) {
+		appServer, _ := newTestOrphansAppServer(t, adminEnforcer)
+		_, err := appServer.AdoptOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name:      pointer.String("test-app"),
+			Resources: []*appsv1.SyncOperationResource{{Group: "apps", Kind: "ReplicaSet", Namespace: test.FakeDestNamespace, Name: "orphan-rs"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("AdoptNotPermittedInProject", func(t *testing.T) {
+		appServer, kubectl := newTestOrphansAppServer(t, adminEnforcer)
+		_, err := appServer.AdoptOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name:      pointer.String("test-app"),
+			Resources: []*appsv1.SyncOperationResource{orphan, {Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "forbidden"}},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, kubectl.patches)
+	})
+	t.Run("Delete", func(t *testing.T) {
+		appServer, kubectl := newTestOrphansAppServer(t, adminEnforcer)
+		res, err := appServer.DeleteOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name:      pointer.String("test-app"),
+			Resources: []*appsv1.SyncOperationResource{orphan},
+		})
+		require.NoError(t, err)
+		assert.False(t, res.GetDryRun())
+		assert.Equal(t, []string{"orphan"}, kubectl.deleted)
+	})
+	t.Run("DeleteRequiresResources", func(t *testing.T) {
+		appServer, _ := newTestOrphansAppServer(t, adminEnforcer)
+		_, err := appServer.DeleteOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name: pointer.String("test-app"),
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+	t.Run("DeletePermissionDenied", func(t *testing.T) {
+		appServer, kubectl := newTestOrphansAppServer(t, func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			enf.SetDefaultRole("role:readonly")
+		})
+		_, err := appServer.DeleteOrphanedResources(context.Background(), &application.ApplicationOrphanedResourcesActionRequest{
+			Name:      pointer.String("test-app"),
+			Resources: []*appsv1.SyncOperationResource{orphan},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, kubectl.deleted)
+	})
+}
+
+func TestResourceLevelRBAC(t *testing.T) {
+	newServer := func(t *testing.T) (*Server, *recordingKubectl) {
+		appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			_ = enf.SetUserPolicy(`
+p, role:dev, applications, delete/Pod/*, default/*, allow
+p, role:dev, applications, update/apps/Deployment/*, default/*, allow
+p, role:dev, applications, update/apps/Deployment/*/locked, default/*, deny
+`)
+			enf.SetDefaultRole("role:dev")
+		}, newTestApp())
+		setTestAppResourcesTree(t, appServer, "test-app", &appsv1.ApplicationTree{
+			Nodes: []appsv1.ResourceNode{
+				{ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "Pod", Namespace: test.FakeDestNamespace, Name: "my-pod", UID: "1"}},
+				{ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "Secret", Namespace: test.FakeDestNamespace, Name: "my-secret", UID: "2"}},
+				{ResourceRef: appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "my-deploy", UID: "3"}},
+				{ResourceRef: appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "locked", UID: "4"}},
+			},
+		})
+		kubectl := newRecordingKubectl()
+		appServer.kubectl = kubectl
+		return appServer, kubectl
+	}
+	deleteRequest := func(group, kind, name string) *application.ApplicationResourceDeleteRequest {
+		return &application.ApplicationResourceDeleteRequest{
+			Name:         pointer.String("test-app"),
+			Namespace:    pointer.String(test.FakeDestNamespace),
+			ResourceName: pointer.String(name),
+			Version:      pointer.String("v1"),
+			Group:        pointer.String(group),
+			Kind:         pointer.String(kind),
+		}
+	}
+	patchRequest := func(name string) *application.ApplicationResourcePatchRequest {
+		return &application.ApplicationResourcePatchRequest{
+			Name:         pointer.String("test-app"),
+			Namespace:    pointer.String(test.FakeDestNamespace),
+			ResourceName: pointer.String(name),
+			Version:      pointer.String("v1"),
+			Group:        pointer.String("apps"),
+			Kind:         pointer.String("Deployment"),
+			Patch:        pointer.String(`{"spec":{"replicas":2}}`),
+			PatchType:    pointer.String("application/merge-patch+json"),
+		}
+	}
+
+	t.Run("DeletePermitted", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.DeleteResource(context.Background(), deleteRequest("", "Pod", "my-pod"))
+		require.NoError(t, err)
+		assert.Equal(t, []string{"my-pod"}, kubectl.deleted)
+	})
+	t.Run("DeleteNotPermitted", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.DeleteResource(context.Background(), deleteRequest("", "Secret", "my-secret"))
+		assert.Equal(t, codes.PermissionDenied, status.Code(coreerrors.Unwrap(err)))
+		assert.Empty(t, kubectl.deleted)
+	})
+	t.Run("PatchPermitted", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.PatchResource(context.Background(), patchRequest("my-deploy"))
+		require.NoError(t, err)
+		assert.Contains(t, kubectl.patches, "my-deploy")
+	})
+	t.Run("PatchDenied", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.PatchResource(context.Background(), patchRequest("locked"))
+		assert.Equal(t, codes.PermissionDenied, status.Code(coreerrors.Unwrap(err)))
+		assert.Empty(t, kubectl.patches)
+	})
+}
+
+func TestRunResourceActionPatchOtherResource(t *testing.T) {
+	newServer := func(t *testing.T) (*Server, *recordingKubectl) {
+		appServer := newTestAppServerWithEnforcerConfigure(func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+			_ = enf.SetUserPolicy(`
+p, role:dev, applications, action/apps/Deployment/*, default/*, allow
+p, role:dev, applications, update/ConfigMap/*/managed, default/*, allow
+`)
+			enf.SetDefaultRole("role:dev")
+		}, newTestApp())
+		setTestAppResourcesTree(t, appServer, "test-app", &appsv1.ApplicationTree{
+			Nodes: []appsv1.ResourceNode{
+				{ResourceRef: appsv1.ResourceRef{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: test.FakeDestNamespace, Name: "my-deploy", UID: "1"}},
+				{ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "managed", UID: "2"}},
+				{ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "locked", UID: "3"}},
+			},
+			OrphanedNodes: []appsv1.ResourceNode{
+				{ResourceRef: appsv1.ResourceRef{Version: "v1", Kind: "ConfigMap", Namespace: test.FakeDestNamespace, Name: "orphan"}},
+			},
+		})
+		kubeclientset := fake.NewSimpleClientset(&v1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      "argocd-cm",
+				Labels: map[string]string{
+					"app.kubernetes.io/part-of": "argocd",
+				},
+			},
+			Data: map[string]string{
+				"resource.customizations.actions.apps_Deployment": `discovery.lua: |
+  actions = {}
+  actions["touch"] = {["params"] = {{name = "configmap"}}}
+  return actions
+definitions:
+- name: touch
+  action.lua: |
+    cm = {}
+    cm.apiVersion = "v1"
+    cm.kind = "ConfigMap"
+    cm.metadata = {}
+    cm.metadata.name = actionParams["configmap"]
+    cm.metadata.namespace = obj.metadata.namespace
+    cm.data = {}
+    cm.data.touched = "true"
+    return {{operation = "patch", resource = cm}}
+`,
+			},
+		}, &v1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "argocd-secret", Namespace: testNamespace},
+		})
+		appServer.settingsMgr = settings.NewSettingsManager(context.Background(), kubeclientset, testNamespace)
+		kubectl := newRecordingKubectl()
+		appServer.kubectl = kubectl
+		return appServer, kubectl
+	}
+	runRequest := func(configMap string) *application.ResourceActionRunRequest {
+		return &application.ResourceActionRunRequest{
+			Name:         pointer.String("test-app"),
+			Namespace:    pointer.String(test.FakeDestNamespace),
+			ResourceName: pointer.String("my-deploy"),
+			Version:      pointer.String("v1"),
+			Group:        pointer.String("apps"),
+			Kind:         pointer.String("Deployment"),
+			Action:       pointer.String("touch"),
+			Params:       []*appsv1.ResourceActionParam{{Name: "configmap", Value: configMap}},
+		}
+	}
+
+	t.Run("Managed", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.RunResourceAction(context.Background(), runRequest("managed"))
+		require.NoError(t, err)
+		assert.Equal(t, `{"data":{"touched":"true"}}`, kubectl.patches["managed"])
+	})
+	t.Run("UpdateDenied", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.RunResourceAction(context.Background(), runRequest("locked"))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, kubectl.patches)
+	})
+	t.Run("NotManaged", func(t *testing.T) {
+		appServer, kubectl := newServer(t)
+		_, err := appServer.RunResourceAction(context.Background(), runRequest("orphan"))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, kubectl.patches)
+	})
+}
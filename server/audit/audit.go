@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	auditpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/audit"
+	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	"github.com/argoproj/argo-cd/v2/util/audit"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+// Server provides an Audit service
+type Server struct {
+	fileSink *audit.FileSink
+	enf      *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the Audit service. The file sink is nil if no audit log file is configured.
+func NewServer(fileSink *audit.FileSink, enf *rbac.Enforcer) *Server {
+	return &Server{fileSink: fileSink, enf: enf}
+}
+
+// GetAuditLog returns the most recent records of the audit log file matching the query
+func (s *Server) GetAuditLog(ctx context.Context, q *auditpkg.AuditLogQuery) (*auditpkg.AuditLogResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbacpolicy.ResourceAuditLogs, rbacpolicy.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	if s.fileSink == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "audit log file is not configured")
+	}
+	if q.Limit < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not be negative")
+	}
+	query := audit.Query{
+		User:     q.User,
+		Method:   q.Method,
+		Resource: q.Resource,
+		Limit:    int(q.Limit),
+	}
+	if q.Limit > audit.MaxQueryLimit {
+		query.Limit = audit.MaxQueryLimit
+	}
+	if q.Since > 0 {
+		query.Since = time.Unix(q.Since, 0)
+	}
+	records, err := s.fileSink.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	resp := &auditpkg.AuditLogResponse{Items: make([]*auditpkg.AuditRecord, 0, len(records))}
+	for _, record := range records {
+		resp.Items = append(resp.Items, &auditpkg.AuditRecord{
+			Time:      &metav1.Time{Time: record.Time},
+			User:      record.User,
+			Subject:   record.Subject,
+			Groups:    record.Groups,
+			Method:    record.Method,
+			Resource:  record.Resource,
+			Request:   string(record.Request),
+			Diff:      string(record.Diff),
+			Code:      record.Code,
+			Error:     record.Error,
+			ClientIP:  record.ClientIP,
+			UserAgent: record.UserAgent,
+		})
+	}
+	return resp, nil
+}
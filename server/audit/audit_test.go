@@ -0,0 +1,95 @@
+package audit
+
+import (
+	"context"
+	"math"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	auditpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/audit"
+	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/audit"
+	"github.com/argoproj/argo-cd/v2/util/rbac"
+)
+
+func newEnforcer(allow bool) *rbac.Enforcer {
+	enf := rbac.NewEnforcer(fake.NewSimpleClientset(test.NewFakeConfigMap()), test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		return allow
+	})
+	return enf
+}
+
+func newFileSink(t *testing.T, records ...*audit.Record) *audit.FileSink {
+	sink, err := audit.NewFileSink(filepath.Join(t.TempDir(), "audit.log"))
+	require.NoError(t, err)
+	for _, record := range records {
+		require.NoError(t, sink.Write(record))
+	}
+	return sink
+}
+
+func TestGetAuditLog(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	sink := newFileSink(t,
+		&audit.Record{Time: now.Add(-time.Hour), User: "alice", Method: "/application.ApplicationService/Sync", Resource: "application/guestbook", Code: "OK"},
+		&audit.Record{Time: now, User: "bob", Method: "/application.ApplicationService/Delete", Resource: "application/guestbook", Request: []byte(`{"name":"guestbook"}`), Diff: []byte(`{"spec":{"project":"team-a"}}`), Code: "PermissionDenied", Error: "permission denied"},
+		&audit.Record{Time: now, User: "alice", Method: "/project.ProjectService/Create", Resource: "project/default", Code: "OK"},
+	)
+
+	ctx := context.WithValue(context.Background(), "claims", &jwt.RegisteredClaims{Subject: "admin"})
+
+	t.Run("PermissionDenied", func(t *testing.T) {
+		_, err := NewServer(sink, newEnforcer(false)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, err := NewServer(nil, newEnforcer(true)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+	t.Run("All", func(t *testing.T) {
+		resp, err := NewServer(sink, newEnforcer(true)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 3)
+		assert.Equal(t, "/project.ProjectService/Create", resp.Items[0].Method)
+		assert.Equal(t, "/application.ApplicationService/Sync", resp.Items[2].Method)
+	})
+	t.Run("Filtered", func(t *testing.T) {
+		resp, err := NewServer(sink, newEnforcer(true)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{
+			Resource: "application/*",
+			Since:    now.Add(-time.Minute).Unix(),
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		item := resp.Items[0]
+		assert.Equal(t, "bob", item.User)
+		assert.Equal(t, "/application.ApplicationService/Delete", item.Method)
+		assert.Equal(t, `{"name":"guestbook"}`, item.Request)
+		assert.Equal(t, `{"spec":{"project":"team-a"}}`, item.Diff)
+		assert.Equal(t, "PermissionDenied", item.Code)
+		assert.Equal(t, "permission denied", item.Error)
+		assert.True(t, now.Equal(item.Time.Time))
+	})
+	t.Run("Limit", func(t *testing.T) {
+		resp, err := NewServer(sink, newEnforcer(true)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{User: "alice", Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, resp.Items, 1)
+		assert.Equal(t, "/project.ProjectService/Create", resp.Items[0].Method)
+
+		resp, err = NewServer(sink, newEnforcer(true)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{User: "alice", Limit: math.MaxInt64})
+		require.NoError(t, err)
+		assert.Len(t, resp.Items, 2)
+
+		_, err = NewServer(sink, newEnforcer(true)).GetAuditLog(ctx, &auditpkg.AuditLogQuery{Limit: -1})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -8,12 +9,14 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-cd/v2/util/profile"
 )
 
 type MetricsServer struct {
 	*http.Server
+	registry              *prometheus.Registry
 	redisRequestCounter   *prometheus.CounterVec
 	redisRequestHistogram *prometheus.HistogramVec
 }
@@ -34,6 +37,12 @@ var (
 		},
 		[]string{"initiator"},
 	)
+	activeSessionsDesc = prometheus.NewDesc(
+		"argocd_active_sessions",
+		"Number of active sessions issued by Argo CD.",
+		nil,
+		nil,
+	)
 )
 
 // NewMetricsServer returns a new prometheus server which collects api server metrics
@@ -54,11 +63,35 @@ func NewMetricsServer(host string, port int) *MetricsServer {
 			Addr:    fmt.Sprintf("%s:%d", host, port),
 			Handler: mux,
 		},
+		registry:              registry,
 		redisRequestCounter:   redisRequestCounter,
 		redisRequestHistogram: redisRequestHistogram,
 	}
 }
 
+// activeSessionsCollector counts the active sessions on each scrape
+type activeSessionsCollector struct {
+	countSessions func(ctx context.Context) (int, error)
+}
+
+func (c *activeSessionsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- activeSessionsDesc
+}
+
+func (c *activeSessionsCollector) Collect(ch chan<- prometheus.Metric) {
+	count, err := c.countSessions(context.Background())
+	if err != nil {
+		log.Warnf("Failed to count active sessions: %v", err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(activeSessionsDesc, prometheus.GaugeValue, float64(count))
+}
+
+// RegisterActiveSessionsCollector registers the gauge of active sessions, which are counted using the given function
+func (m *MetricsServer) RegisterActiveSessionsCollector(countSessions func(ctx context.Context) (int, error)) {
+	m.registry.MustRegister(&activeSessionsCollector{countSessions: countSessions})
+}
+
 func (m *MetricsServer) IncRedisRequest(failed bool) {
 	m.redisRequestCounter.WithLabelValues("argocd-server", strconv.FormatBool(failed)).Inc()
 }
@@ -398,6 +398,45 @@ func TestProjectServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("TestCreateTokenWithScopes", func(t *testing.T) {
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
+		clientset := apps.NewSimpleClientset(projectWithRole)
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB)
+		tokenResponse, err := projectServer.CreateToken(context.Background(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Scopes: []string{"applications,sync,test/*"}})
+		assert.NoError(t, err)
+		claims, _, err := sessionMgr.Parse(tokenResponse.Token)
+		assert.NoError(t, err)
+		scopes, restricted := rbac.GetTokenScopes(claims)
+		assert.True(t, restricted)
+		assert.Equal(t, []string{"applications, sync, test/*"}, scopes)
+
+		_, err = projectServer.CreateToken(context.Background(), &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Scopes: []string{"applications"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("TestCreateTokenWithScopedTokenDenied", func(t *testing.T) {
+		projectWithRole := existingProj.DeepCopy()
+		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName, Groups: []string{"my-group"}}}
+		clientset := apps.NewSimpleClientset(projectWithRole)
+
+		sessionMgr := session.NewSessionManager(settingsMgr, test.NewFakeProjListerFromInterface(clientset.ArgoprojV1alpha1().AppProjects("default")), "", nil, session.NewUserStateStorage(nil))
+		argoDB := db.NewDB("default", settingsMgr, kubeclientset)
+		projectServer := NewServer("default", fake.NewSimpleClientset(), clientset, enforcer, sync.NewKeyLock(), sessionMgr, policyEnf, projInformer, settingsMgr, argoDB)
+		// nolint:staticcheck
+		scopedCtx := context.WithValue(context.Background(), "claims", &jwt.MapClaims{"groups": []string{"my-group"}, "scopes": []string{"applications, sync, test/*"}})
+
+		_, err := projectServer.CreateToken(scopedCtx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = projectServer.CreateToken(scopedCtx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Scopes: []string{"applications, delete, test/*"}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = projectServer.CreateToken(scopedCtx, &project.ProjectTokenCreateRequest{Project: projectWithRole.Name, Role: tokenName, ExpiresIn: 100, Scopes: []string{"applications, sync, test/guestbook"}})
+		assert.NoError(t, err)
+	})
+
 	t.Run("TestCreateTokenWithSameIdDeny", func(t *testing.T) {
 		projectWithRole := existingProj.DeepCopy()
 		projectWithRole.Spec.Roles = []v1alpha1.ProjectRole{{Name: tokenName}}
@@ -1,6 +1,7 @@
 package rbacpolicy
 
 import (
+	"fmt"
 	"strings"
 
 	jwt "github.com/golang-jwt/jwt/v4"
@@ -23,6 +24,7 @@ const (
 	ResourceGPGKeys      = "gpgkeys"
 	ResourceLogs         = "logs"
 	ResourceExec         = "exec"
+	ResourceAuditLogs    = "auditlogs"
 
 	// please add new items to Actions
 	ActionGet      = "get"
@@ -44,6 +46,7 @@ var (
 		ResourceCertificates,
 		ResourceLogs,
 		ResourceExec,
+		ResourceAuditLogs,
 	}
 	Actions = []string{
 		ActionGet,
@@ -55,13 +58,25 @@ var (
 	}
 )
 
+// ResourceAction returns the fine-grained action enforced for the given action on a resource managed by an application,
+// in the form <action>/[<group>/]<kind>/<namespace>/<name>. The group is omitted for resources of the core API group
+// and the namespace is empty for cluster-scoped resources. Policies granting the plain update or delete action on
+// applications also grant the corresponding fine-grained actions.
+func ResourceAction(action, group, kind, namespace, name string) string {
+	if group == "" {
+		return strings.Join([]string{action, kind, namespace, name}, "/")
+	}
+	return strings.Join([]string{action, group, kind, namespace, name}, "/")
+}
+
 // RBACPolicyEnforcer provides an RBAC Claims Enforcer which additionally consults AppProject
 // roles, jwt tokens, and groups. It is backed by a AppProject informer/lister cache and does not
 // make any API calls during enforcement.
 type RBACPolicyEnforcer struct {
-	enf        *rbac.Enforcer
-	projLister applister.AppProjectNamespaceLister
-	scopes     []string
+	enf              *rbac.Enforcer
+	projLister       applister.AppProjectNamespaceLister
+	scopes           []string
+	scopeExpressions []jwtutil.ClaimsExpression
 }
 
 // NewRBACPolicyEnforcer returns a new RBAC Enforcer for the Argo CD API Server
@@ -85,6 +100,15 @@ func (p *RBACPolicyEnforcer) GetScopes() []string {
 	return scopes
 }
 
+// SetScopeExpressions sets the expressions which extract additional groups from the claims
+func (p *RBACPolicyEnforcer) SetScopeExpressions(expressions []jwtutil.ClaimsExpression) {
+	p.scopeExpressions = expressions
+}
+
+func (p *RBACPolicyEnforcer) GetScopeExpressions() []jwtutil.ClaimsExpression {
+	return p.scopeExpressions
+}
+
 func IsProjectSubject(subject string) bool {
 	_, _, ok := GetProjectRoleFromSubject(subject)
 	return ok
@@ -129,12 +153,8 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 		return true
 	}
 
-	scopes := p.scopes
-	if scopes == nil {
-		scopes = defaultScopes
-	}
 	// Finally check if any of the user's groups grant them permissions
-	groups := jwtutil.GetScopeValues(mapClaims, scopes)
+	groups := jwtutil.GetGroups(mapClaims, p.GetScopes(), p.scopeExpressions...)
 
 	// Get groups to reduce the amount to checking groups
 	groupingPolicies := enforcer.GetGroupingPolicy()
@@ -155,6 +175,61 @@ func (p *RBACPolicyEnforcer) EnforceClaims(claims jwt.Claims, rvals ...interface
 	return false
 }
 
+// ExplainClaims is the counterpart of EnforceClaims which explains how the decision was reached
+func (p *RBACPolicyEnforcer) ExplainClaims(claims jwt.Claims, rvals ...interface{}) *rbac.Explanation {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return &rbac.Explanation{Reason: fmt.Sprintf("invalid claims: %v", err)}
+	}
+
+	subject := jwtutil.StringField(mapClaims, "sub")
+	var runtimePolicy string
+	var projName string
+	proj := p.getProjectFromRequest(rvals...)
+	if proj != nil {
+		if IsProjectSubject(subject) {
+			if tokenProj, _, _ := GetProjectRoleFromSubject(subject); tokenProj != proj.Name {
+				return &rbac.Explanation{Subject: subject, Reason: fmt.Sprintf("project token is not valid for project '%s'", proj.Name)}
+			}
+			vals := append([]interface{}{subject}, rvals[1:]...)
+			return p.enf.ExplainRuntimePolicy(proj.Name, proj.ProjectPoliciesString(), vals...)
+		}
+		runtimePolicy = proj.ProjectPoliciesString()
+		projName = proj.Name
+	}
+
+	enforcer := p.enf.CreateEnforcerWithRuntimePolicy(projName, runtimePolicy)
+	vals := append([]interface{}{subject}, rvals[1:]...)
+	res := p.enf.ExplainWithCustomEnforcer(enforcer, vals...)
+	res.Groups = jwtutil.GetGroups(mapClaims, p.GetScopes(), p.scopeExpressions...)
+	if res.Allowed {
+		return res
+	}
+
+	groupingPolicies := enforcer.GetGroupingPolicy()
+	for _, group := range res.Groups {
+		for gpidx := range groupingPolicies {
+			if groupingPolicies[gpidx][0] == group {
+				vals := append([]interface{}{group}, rvals[1:]...)
+				groupRes := p.enf.ExplainWithCustomEnforcer(enforcer, vals...)
+				res.AddRoles(groupRes.Roles...)
+				if groupRes.Allowed {
+					res.Allowed = true
+					res.Policy = groupRes.Policy
+					res.Reason = fmt.Sprintf("allowed through group '%s'", group)
+					return res
+				}
+				if res.Policy == "" && groupRes.Policy != "" {
+					res.Policy = groupRes.Policy
+					res.Reason = fmt.Sprintf("denied by policy through group '%s'", group)
+				}
+				break
+			}
+		}
+	}
+	return res
+}
+
 // getProjectFromRequest parses the project name from the RBAC request and returns the associated
 // project (if it exists)
 func (p *RBACPolicyEnforcer) getProjectFromRequest(rvals ...interface{}) *v1alpha1.AppProject {
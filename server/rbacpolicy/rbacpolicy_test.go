@@ -6,12 +6,15 @@ import (
 
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	argoappv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/test"
+	"github.com/argoproj/argo-cd/v2/util/assets"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/rbac"
 )
 
@@ -89,6 +92,64 @@ func TestEnforceAllPolicies(t *testing.T) {
 	assert.True(t, enf.Enforce(claims, "applications", "create", "my-proj/my-app"))
 }
 
+func TestEnforceScopeExpressions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy(`g, argocd-admin, role:admin` + "\n" + `g, finance:production, role:readonly`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetClaimsExplainFunc(rbacEnf.ExplainClaims)
+	expressions, err := jwtutil.CompileClaimsExpressions([]string{"resource_access.argocd.roles", `department + ":" + environment`})
+	require.NoError(t, err)
+	rbacEnf.SetScopeExpressions(expressions)
+
+	admin := jwt.MapClaims{"sub": "alice", "resource_access": map[string]interface{}{"argocd": map[string]interface{}{"roles": []interface{}{"argocd-admin"}}}}
+	assert.True(t, enf.Enforce(admin, "clusters", "update", "*"))
+	assert.Equal(t, []string{"argocd-admin"}, enf.Explain(admin, "clusters", "update", "*").Groups)
+
+	viewer := jwt.MapClaims{"sub": "bob", "department": "finance", "environment": "production"}
+	assert.True(t, enf.Enforce(viewer, "clusters", "get", "*"))
+	assert.False(t, enf.Enforce(viewer, "clusters", "update", "*"))
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "cathy", "department": "finance"}, "clusters", "get", "*"))
+}
+
+func TestExplainClaims(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(`p, alice, applications, create, my-proj/*, allow`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+	enf.SetClaimsExplainFunc(rbacEnf.ExplainClaims)
+
+	res := enf.Explain(jwt.MapClaims{"sub": "alice"}, "applications", "create", "my-proj/my-app")
+	assert.True(t, res.Allowed)
+	assert.Equal(t, "alice", res.Subject)
+	assert.Equal(t, "p, alice, applications, create, my-proj/*, allow", res.Policy)
+
+	res = enf.Explain(jwt.MapClaims{"sub": "proj:my-proj:my-role", "iat": 1234}, "logs", "get", "my-proj/my-app")
+	assert.True(t, res.Allowed)
+	assert.Equal(t, "proj:my-proj:my-role", res.Subject)
+	assert.Equal(t, "p, proj:my-proj:my-role, logs, get, my-proj/*, allow", res.Policy)
+
+	res = enf.Explain(jwt.MapClaims{"sub": "proj:other-proj:my-role"}, "applications", "create", "my-proj/my-app")
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "project token is not valid for project 'my-proj'", res.Reason)
+
+	res = enf.Explain(jwt.MapClaims{"sub": "bob", "groups": []string{"my-org:my-team"}}, "exec", "create", "my-proj/my-app")
+	assert.True(t, res.Allowed)
+	assert.Equal(t, []string{"my-org:my-team"}, res.Groups)
+	assert.Equal(t, []string{"proj:my-proj:my-role"}, res.Roles)
+	assert.Equal(t, "allowed through group 'my-org:my-team'", res.Reason)
+
+	res = enf.Explain(jwt.MapClaims{"sub": "cathy"}, "applications", "delete", "my-proj/my-app")
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "no matching policy", res.Reason)
+	assert.Equal(t, enf.Enforce(jwt.MapClaims{"sub": "cathy"}, "applications", "delete", "my-proj/my-app"), res.Allowed)
+}
+
 func TestEnforceActionActions(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
@@ -122,6 +183,42 @@ p, cam, applications, %s/argoproj.io/Rollout/resume, my-proj/*, allow
 	assert.False(t, enf.Enforce(claims, "applications", ActionAction+"/argoproj.io/Rollout/resume", "my-proj/my-app"))
 }
 
+func TestResourceAction(t *testing.T) {
+	assert.Equal(t, "delete/Pod/default/my-pod", ResourceAction(ActionDelete, "", "Pod", "default", "my-pod"))
+	assert.Equal(t, "update/apps/Deployment/default/my-deploy", ResourceAction(ActionUpdate, "apps", "Deployment", "default", "my-deploy"))
+	assert.Equal(t, "delete/Namespace//my-ns", ResourceAction(ActionDelete, "", "Namespace", "", "my-ns"))
+}
+
+func TestEnforceResourceActions(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
+	projLister := test.NewFakeProjLister(newFakeProj())
+	enf := rbac.NewEnforcer(kubeclientset, test.FakeArgoCDNamespace, common.ArgoCDConfigMapName, nil)
+	enf.EnableLog(true)
+	_ = enf.SetBuiltinPolicy(`p, alice, applications, delete, my-proj/*, allow
+p, alice, applications, delete/Secret/*, my-proj/*, deny
+p, bob, applications, delete/Pod/*, my-proj/*, allow
+p, cam, applications, update/apps/Deployment/*, my-proj/*, allow
+`)
+	rbacEnf := NewRBACPolicyEnforcer(enf, projLister)
+	enf.SetClaimsEnforcerFunc(rbacEnf.EnforceClaims)
+
+	// Alice inherits resource level deletes from the application level policy, except for secrets
+	claims := jwt.MapClaims{"sub": "alice"}
+	assert.True(t, enf.Enforce(claims, "applications", ActionDelete, "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "", "Pod", "default", "my-pod"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "", "Secret", "default", "my-secret"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "", "Pod", "default", "my-pod"), "my-proj/my-app"))
+	// Bob may only delete pods
+	claims = jwt.MapClaims{"sub": "bob"}
+	assert.False(t, enf.Enforce(claims, "applications", ActionDelete, "my-proj/my-app"))
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "", "Pod", "default", "my-pod"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionDelete, "apps", "Deployment", "default", "my-deploy"), "my-proj/my-app"))
+	// Cam may only update deployments
+	claims = jwt.MapClaims{"sub": "cam"}
+	assert.True(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "apps", "Deployment", "default", "my-deploy"), "my-proj/my-app"))
+	assert.False(t, enf.Enforce(claims, "applications", ResourceAction(ActionUpdate, "apps", "StatefulSet", "default", "my-sts"), "my-proj/my-app"))
+}
+
 func TestInvalidatedCache(t *testing.T) {
 	kubeclientset := fake.NewSimpleClientset(test.NewFakeConfigMap())
 	projLister := test.NewFakeProjLister(newFakeProj())
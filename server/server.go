@@ -59,6 +59,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/account"
 	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	auditpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/audit"
 	certificatepkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/cluster"
 	gpgkeypkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/gpgkey"
@@ -76,6 +77,7 @@ import (
 	repocache "github.com/argoproj/argo-cd/v2/reposerver/cache"
 	"github.com/argoproj/argo-cd/v2/server/account"
 	"github.com/argoproj/argo-cd/v2/server/application"
+	"github.com/argoproj/argo-cd/v2/server/audit"
 	"github.com/argoproj/argo-cd/v2/server/badge"
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/server/certificate"
@@ -92,6 +94,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/server/version"
 	"github.com/argoproj/argo-cd/v2/ui"
 	"github.com/argoproj/argo-cd/v2/util/assets"
+	audit_util "github.com/argoproj/argo-cd/v2/util/audit"
 	cacheutil "github.com/argoproj/argo-cd/v2/util/cache"
 	"github.com/argoproj/argo-cd/v2/util/db"
 	"github.com/argoproj/argo-cd/v2/util/dex"
@@ -169,6 +172,10 @@ type ArgoCDServer struct {
 	appInformer    cache.SharedIndexInformer
 	appLister      applisters.ApplicationNamespaceLister
 	db             db.ArgoDB
+	auditRecorder  *audit_util.Recorder
+	auditFileSink  *audit_util.FileSink
+	// trustedProxies are the networks of the proxies whose X-Forwarded-For header is used for audit records and sessions
+	trustedProxies []*net.IPNet
 
 	// stopCh is the channel which when closed, will shutdown the Argo CD server
 	stopCh           chan struct{}
@@ -200,6 +207,17 @@ type ArgoCDServerOpts struct {
 	XFrameOptions         string
 	ContentSecurityPolicy string
 	ListenHost            string
+	// AuditLogFile is the path of the file audit records are appended to as JSON lines
+	AuditLogFile string
+	// AuditLogStdout enables writing audit records to stdout
+	AuditLogStdout bool
+	// AuditWebhookURL is the URL audit records are posted to
+	AuditWebhookURL string
+	// AuditWebhookHeaders are the headers of the requests to the webhook, in the form <name>: <value>
+	AuditWebhookHeaders []string
+	// AuditTrustedProxies are the IP addresses and networks of the proxies in front of the API server, whose
+	// X-Forwarded-For header is trusted to determine the client IP of audit records
+	AuditTrustedProxies []string
 }
 
 // initializeDefaultProject creates the default project if it does not already exist
@@ -248,6 +266,12 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 
 	policyEnf := rbacpolicy.NewRBACPolicyEnforcer(enf, projLister)
 	enf.SetClaimsEnforcerFunc(policyEnf.EnforceClaims)
+	enf.SetClaimsExplainFunc(policyEnf.ExplainClaims)
+
+	auditRecorder, auditFileSink, err := newAuditRecorder(opts)
+	errors.CheckError(err)
+	trustedProxies, err := grpc_util.ParseTrustedProxies(opts.AuditTrustedProxies)
+	errors.CheckError(err)
 
 	var staticFS fs.FS = io.NewSubDirFS("dist/app", ui.Embedded)
 	if opts.StaticAssetsDir != "" {
@@ -269,9 +293,38 @@ func NewServer(ctx context.Context, opts ArgoCDServerOpts) *ArgoCDServer {
 		userStateStorage: userStateStorage,
 		staticAssets:     http.FS(staticFS),
 		db:               db.NewDB(opts.Namespace, settingsMgr, opts.KubeClientset),
+		auditRecorder:    auditRecorder,
+		auditFileSink:    auditFileSink,
+		trustedProxies:   trustedProxies,
 	}
 }
 
+// newAuditRecorder returns the recorder of the audit trail, which writes to the configured sinks, and the file sink
+// if an audit log file is configured
+func newAuditRecorder(opts ArgoCDServerOpts) (*audit_util.Recorder, *audit_util.FileSink, error) {
+	var sinks []audit_util.Sink
+	var fileSink *audit_util.FileSink
+	if opts.AuditLogFile != "" {
+		var err error
+		fileSink, err = audit_util.NewFileSink(opts.AuditLogFile)
+		if err != nil {
+			return nil, nil, err
+		}
+		sinks = append(sinks, fileSink)
+	}
+	if opts.AuditLogStdout {
+		sinks = append(sinks, audit_util.NewWriterSink(os.Stdout))
+	}
+	if opts.AuditWebhookURL != "" {
+		webhookSink, err := audit_util.NewWebhookSink(opts.AuditWebhookURL, opts.AuditWebhookHeaders)
+		if err != nil {
+			return nil, nil, err
+		}
+		sinks = append(sinks, webhookSink)
+	}
+	return audit_util.NewRecorder(sinks...), fileSink, nil
+}
+
 const (
 	// catches corrupted informer state; see https://github.com/argoproj/argo-cd/issues/4960 for more information
 	notObjectErrMsg = "object does not implement the Object interfaces"
@@ -406,6 +459,7 @@ func (a *ArgoCDServer) Run(ctx context.Context, listeners *Listeners) {
 	metricsServ := metrics.NewMetricsServer(a.ListenHost, a.MetricsPort)
 	if a.RedisClient != nil {
 		cacheutil.CollectMetrics(a.RedisClient, metricsServ)
+		metricsServ.RegisterActiveSessionsCollector(a.sessionMgr.CountSessions)
 	}
 
 	// CMux is used to support servicing gRPC and HTTP1.1+JSON on the same port
@@ -518,6 +572,8 @@ func (a *ArgoCDServer) watchSettings() {
 	prevBitbucketUUID := a.settings.WebhookBitbucketUUID
 	prevBitbucketServerSecret := a.settings.WebhookBitbucketServerSecret
 	prevGogsSecret := a.settings.WebhookGogsSecret
+	prevHarborSecret := a.settings.WebhookHarborSecret
+	prevRegistrySecret := a.settings.WebhookRegistrySecret
 	var prevCert, prevCertKey string
 	if a.settings.Certificate != nil && !a.ArgoCDServerOpts.Insecure {
 		prevCert, prevCertKey = tlsutil.EncodeX509KeyPairString(*a.settings.Certificate)
@@ -560,6 +616,14 @@ func (a *ArgoCDServer) watchSettings() {
 			log.Infof("gogs secret modified. restarting")
 			break
 		}
+		if prevHarborSecret != a.settings.WebhookHarborSecret {
+			log.Infof("harbor secret modified. restarting")
+			break
+		}
+		if prevRegistrySecret != a.settings.WebhookRegistrySecret {
+			log.Infof("registry secret modified. restarting")
+			break
+		}
 		if !a.ArgoCDServerOpts.Insecure {
 			var newCert, newCertKey string
 			if a.settings.Certificate != nil {
@@ -588,7 +652,20 @@ func (a *ArgoCDServer) rbacPolicyLoader(ctx context.Context) {
 			}
 		}
 
+		var expressions []string
+		if expressionsStr, ok := cm.Data[rbac.ConfigMapScopeExpressionsKey]; len(expressionsStr) > 0 && ok {
+			err := yaml.Unmarshal([]byte(expressionsStr), &expressions)
+			if err != nil {
+				return err
+			}
+		}
+		scopeExpressions, err := jwtutil.CompileClaimsExpressions(expressions)
+		if err != nil {
+			return err
+		}
+
 		a.policyEnforcer.SetScopes(scopes)
+		a.policyEnforcer.SetScopeExpressions(scopeExpressions)
 		return nil
 	})
 	errors.CheckError(err)
@@ -624,6 +701,12 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		"/cluster.ClusterService/Update":                          true,
 		"/session.SessionService/Create":                          true,
 		"/account.AccountService/UpdatePassword":                  true,
+		"/account.AccountService/CreateAccount":                   true,
+		"/account.AccountService/ResetPassword":                   true,
+		"/account.AccountService/ConfirmTOTP":                     true,
+		"/account.AccountService/DisableTOTP":                     true,
+		"/session.SessionService/EnrollTOTP":                      true,
+		"/session.SessionService/ExchangeToken":                   true,
 		"/gpgkey.GPGKeyService/CreateGnuPGPublicKey":              true,
 		"/repository.RepositoryService/Create":                    true,
 		"/repository.RepositoryService/Update":                    true,
@@ -633,6 +716,14 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		"/repocreds.RepoCredsService/CreateRepositoryCredentials": true,
 		"/repocreds.RepoCredsService/UpdateRepositoryCredentials": true,
 		"/application.ApplicationService/PatchResource":           true,
+		"/application.ApplicationService/GetManifestsWithFiles":   true,
+		"/application.ApplicationService/ServerSideDiff":          true,
+	}
+	auditGroups := func(ctx context.Context) []string {
+		return util_session.Groups(ctx, a.policyEnforcer.GetScopes(), a.policyEnforcer.GetScopeExpressions()...)
+	}
+	auditOmitRequest := func(fullMethodName string) bool {
+		return sensitiveMethods[fullMethodName]
 	}
 	// NOTE: notice we do not configure the gRPC server here with TLS (e.g. grpc.Creds(creds))
 	// This is because TLS handshaking occurs in cmux handling
@@ -647,6 +738,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		}),
 		grpc_util.ErrorCodeK8sStreamServerInterceptor(),
 		grpc_util.ErrorCodeGitStreamServerInterceptor(),
+		audit_util.StreamServerInterceptor(a.auditRecorder, auditGroups, auditOmitRequest, a.trustedProxies),
 		grpc_util.PanicLoggerStreamServerInterceptor(a.log),
 	)))
 	sOpts = append(sOpts, grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
@@ -661,6 +753,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		}),
 		grpc_util.ErrorCodeK8sUnaryServerInterceptor(),
 		grpc_util.ErrorCodeGitUnaryServerInterceptor(),
+		audit_util.UnaryServerInterceptor(a.auditRecorder, auditGroups, auditOmitRequest, a.trustedProxies),
 		grpc_util.PanicLoggerUnaryServerInterceptor(a.log),
 	)))
 	grpcS := grpc.NewServer(sOpts...)
@@ -672,7 +765,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 	if maxConcurrentLoginRequestsCount > 0 {
 		loginRateLimiter = session.NewLoginRateLimiter(maxConcurrentLoginRequestsCount)
 	}
-	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter)
+	sessionService := session.NewServer(a.sessionMgr, a.settingsMgr, a, a.policyEnforcer, loginRateLimiter, a.trustedProxies)
 	projectLock := sync.NewKeyLock()
 	applicationService, appResourceTreeFn := application.NewServer(
 		a.Namespace,
@@ -690,9 +783,10 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 		a.projInformer)
 	projectService := project.NewServer(a.Namespace, a.KubeClientset, a.AppClientset, a.enf, projectLock, a.sessionMgr, a.policyEnforcer, a.projInformer, a.settingsMgr, a.db)
 	settingsService := settings.NewServer(a.settingsMgr, a, a.DisableAuth)
-	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf)
+	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.Namespace, a.KubeClientset)
 	certificateService := certificate.NewServer(a.RepoClientset, a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.RepoClientset, a.db, a.enf)
+	auditService := audit.NewServer(a.auditFileSink, a.enf)
 	versionpkg.RegisterVersionServiceServer(grpcS, version.NewServer(a, func() (bool, error) {
 		if a.DisableAuth {
 			return true, nil
@@ -713,6 +807,7 @@ func (a *ArgoCDServer) newGRPCServer() (*grpc.Server, application.AppResourceTre
 	accountpkg.RegisterAccountServiceServer(grpcS, accountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, certificateService)
 	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, gpgkeyService)
+	auditpkg.RegisterAuditServiceServer(grpcS, auditService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	grpc_prometheus.Register(grpcS)
@@ -858,6 +953,7 @@ func (a *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWebHandl
 	mustRegisterGWHandler(accountpkg.RegisterAccountServiceHandler, ctx, gwmux, conn)
 	mustRegisterGWHandler(certificatepkg.RegisterCertificateServiceHandler, ctx, gwmux, conn)
 	mustRegisterGWHandler(gpgkeypkg.RegisterGPGKeyServiceHandler, ctx, gwmux, conn)
+	mustRegisterGWHandler(auditpkg.RegisterAuditServiceHandler, ctx, gwmux, conn)
 
 	// Swagger UI
 	swagger.ServeSwaggerUI(mux, assets.SwaggerJSON, "/swagger-ui", a.RootPath)
@@ -1087,7 +1183,16 @@ func (a *ArgoCDServer) Authenticate(ctx context.Context) (context.Context, error
 		// Add claims to the context to inspect for RBAC
 		// nolint:staticcheck
 		ctx = context.WithValue(ctx, "claims", claims)
+		if claimsErr == nil {
+			if err := a.sessionMgr.TouchSession(ctx, claims); err != nil {
+				log.Debugf("Failed to update the last use of the session: %v", err)
+			}
+		}
 		if newToken != "" {
+			userAgent, clientIP := grpc_util.UserAgent(ctx), grpc_util.ClientIP(ctx, a.trustedProxies)
+			if err := a.sessionMgr.AddSession(ctx, newToken, userAgent, clientIP); err != nil {
+				log.Warnf("Failed to store session metadata: %v", err)
+			}
 			// Session tokens that are expiring soon should be regenerated if user stays active.
 			// The renewed token is stored in outgoing ServerMetadata. Metadata is available to grpc-gateway
 			// response forwarder that will translate it into Set-Cookie header.
@@ -3,17 +3,23 @@ package session
 import (
 	"context"
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/util/settings"
 
 	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
 	"github.com/argoproj/argo-cd/v2/server/rbacpolicy"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
 	util "github.com/argoproj/argo-cd/v2/util/io"
+	"github.com/argoproj/argo-cd/v2/util/password"
 	sessionmgr "github.com/argoproj/argo-cd/v2/util/session"
+	"github.com/argoproj/argo-cd/v2/util/totp"
 )
 
 // Server provides a Session service
@@ -23,6 +29,8 @@ type Server struct {
 	authenticator      Authenticator
 	policyEnf          *rbacpolicy.RBACPolicyEnforcer
 	limitLoginAttempts func() (util.Closer, error)
+	// trustedProxies are the networks of the proxies whose X-Forwarded-For header determines the client IP of sessions
+	trustedProxies []*net.IPNet
 }
 
 type Authenticator interface {
@@ -30,13 +38,13 @@ type Authenticator interface {
 }
 
 // NewServer returns a new instance of the Session service
-func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (util.Closer, error)) *Server {
-	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter}
+func NewServer(mgr *sessionmgr.SessionManager, settingsMgr *settings.SettingsManager, authenticator Authenticator, policyEnf *rbacpolicy.RBACPolicyEnforcer, rateLimiter func() (util.Closer, error), trustedProxies []*net.IPNet) *Server {
+	return &Server{mgr, settingsMgr, authenticator, policyEnf, rateLimiter, trustedProxies}
 }
 
 // Create generates a JWT token signed by Argo CD intended for web/CLI logins of the admin user
 // using username/password
-func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
+func (s *Server) Create(ctx context.Context, q *session.SessionCreateRequest) (*session.SessionResponse, error) {
 	if s.limitLoginAttempts != nil {
 		closer, err := s.limitLoginAttempts()
 		if err != nil {
@@ -55,6 +63,26 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	if err != nil {
 		return nil, err
 	}
+	err = s.mgr.VerifyOTP(q.Username, q.Otp)
+	if err != nil {
+		return nil, err
+	}
+	enrollmentRequired, err := s.verifyTOTPEnrollment(q.Username, q.Otp)
+	if err != nil {
+		return nil, err
+	}
+	err = s.changePasswordIfRequired(q.Username, q.Password, q.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+	var recoveryCodes []string
+	if enrollmentRequired {
+		recoveryCodes, err = s.mgr.ConfirmTOTP(q.Username, q.Otp)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("User %s enrolled a TOTP authenticator on login", q.Username)
+	}
 	uniqueId, err := uuid.NewRandom()
 	if err != nil {
 		return nil, err
@@ -71,6 +99,139 @@ func (s *Server) Create(_ context.Context, q *session.SessionCreateRequest) (*se
 	if err != nil {
 		return nil, err
 	}
+	s.addSession(ctx, jwtToken)
+	return &session.SessionResponse{Token: jwtToken, RecoveryCodes: recoveryCodes}, nil
+}
+
+// addSession stores the metadata of the created session, so that it can be listed and revoked. Failures are not fatal
+// for the login.
+func (s *Server) addSession(ctx context.Context, jwtToken string) {
+	userAgent, clientIP := grpc_util.UserAgent(ctx), grpc_util.ClientIP(ctx, s.trustedProxies)
+	if err := s.mgr.AddSession(ctx, jwtToken, userAgent, clientIP); err != nil {
+		log.Warnf("Failed to store session metadata: %v", err)
+	}
+}
+
+// verifyTOTPEnrollment returns whether multi-factor authentication is required but the account has no confirmed TOTP
+// enrollment yet. In that case the one-time password must be valid for the pending enrollment, which is confirmed once
+// the login succeeds.
+func (s *Server) verifyTOTPEnrollment(username string, otp string) (bool, error) {
+	account, err := s.settingsMgr.GetAccount(username)
+	if err != nil {
+		return false, err
+	}
+	if account.TOTPEnabled {
+		return false, nil
+	}
+	required, err := s.settingsMgr.GetMFARequired()
+	if err != nil {
+		return false, err
+	}
+	if !required {
+		return false, nil
+	}
+	if otp == "" || account.TOTPSecret == "" {
+		return false, sessionmgr.TOTPEnrollmentRequiredErr
+	}
+	if !totp.Validate(otp, account.TOTPSecret, time.Now()) {
+		return false, sessionmgr.InvalidOTPErr
+	}
+	return true, nil
+}
+
+// EnrollTOTP generates a TOTP secret for a local account which has to enroll a TOTP authenticator before logging in.
+// The enrollment is confirmed by logging in with a one-time password.
+func (s *Server) EnrollTOTP(_ context.Context, q *session.TOTPEnrollRequest) (*session.TOTPEnrollResponse, error) {
+	if s.limitLoginAttempts != nil {
+		closer, err := s.limitLoginAttempts()
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(closer)
+	}
+
+	if q.Username == "" || q.Password == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "no credentials supplied")
+	}
+	err := s.mgr.VerifyUsernamePassword(q.Username, q.Password)
+	if err != nil {
+		return nil, err
+	}
+	required, err := s.settingsMgr.GetMFARequired()
+	if err != nil {
+		return nil, err
+	}
+	if !required {
+		return nil, status.Errorf(codes.FailedPrecondition, "multi-factor authentication is not required, enroll using the account API after logging in")
+	}
+	secret, url, err := s.mgr.EnrollTOTP(q.Username)
+	if err != nil {
+		return nil, err
+	}
+	return &session.TOTPEnrollResponse{Secret: secret, Url: url}, nil
+}
+
+// changePasswordIfRequired changes the password of the given account to the new password if the password was reset by
+// an administrator or has expired. PasswordChangeRequiredErr is returned if a change is required but no new password
+// was supplied.
+func (s *Server) changePasswordIfRequired(username string, currentPassword string, newPassword string) error {
+	account, err := s.settingsMgr.GetAccount(username)
+	if err != nil {
+		return err
+	}
+	passwordPolicy, err := s.settingsMgr.GetPasswordPolicy()
+	if err != nil {
+		return err
+	}
+	if !account.PasswordChangeRequired && !passwordPolicy.IsExpired(account.PasswordMtime) {
+		return nil
+	}
+	if newPassword == "" {
+		return sessionmgr.PasswordChangeRequiredErr
+	}
+	if newPassword == currentPassword {
+		return status.Errorf(codes.InvalidArgument, "new password must differ from the current password")
+	}
+	if err := passwordPolicy.Validate(newPassword); err != nil {
+		return err
+	}
+	hashedPassword, err := password.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+	err = s.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+		now := time.Now().UTC()
+		acc.PasswordHash = hashedPassword
+		acc.PasswordMtime = &now
+		acc.PasswordChangeRequired = false
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	log.Infof("User %s changed the password on login", username)
+	return nil
+}
+
+// ExchangeToken exchanges a token of an external issuer configured for token exchange, e.g. the workload identity
+// token of a GitHub Actions or GitLab CI job, for a short-lived JWT signed by Argo CD
+func (s *Server) ExchangeToken(ctx context.Context, q *session.SessionExchangeTokenRequest) (*session.SessionResponse, error) {
+	if s.limitLoginAttempts != nil {
+		closer, err := s.limitLoginAttempts()
+		if err != nil {
+			return nil, err
+		}
+		defer util.Close(closer)
+	}
+
+	if q.Token == "" {
+		return nil, status.Errorf(codes.Unauthenticated, "no token supplied")
+	}
+	jwtToken, err := s.mgr.ExchangeToken(q.Token)
+	if err != nil {
+		return nil, err
+	}
+	s.addSession(ctx, jwtToken)
 	return &session.SessionResponse{Token: jwtToken}, nil
 }
 
@@ -94,6 +255,6 @@ func (s *Server) GetUserInfo(ctx context.Context, q *session.GetUserInfoRequest)
 		LoggedIn: sessionmgr.LoggedIn(ctx),
 		Username: sessionmgr.Username(ctx),
 		Iss:      sessionmgr.Iss(ctx),
-		Groups:   sessionmgr.Groups(ctx, s.policyEnf.GetScopes()),
+		Groups:   sessionmgr.Groups(ctx, s.policyEnf.GetScopes(), s.policyEnf.GetScopeExpressions()...),
 	}, nil
 }
@@ -14,7 +14,7 @@ func Path(root, path string) (string, error) {
 		return "", fmt.Errorf("%s: app path is absolute", path)
 	}
 	appPath := filepath.Join(root, path)
-	if !strings.HasPrefix(appPath, filepath.Clean(root)) {
+	if rel, err := filepath.Rel(root, appPath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
 		return "", fmt.Errorf("%s: app path outside root", path)
 	}
 	info, err := os.Stat(appPath)
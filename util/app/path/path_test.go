@@ -26,6 +26,11 @@ func TestPathDotDotSlash(t *testing.T) {
 	assert.EqualError(t, err, "../: app path outside root")
 }
 
+func TestPathSiblingWithCommonPrefix(t *testing.T) {
+	_, err := Path("./testdata", "../testdata-other")
+	assert.EqualError(t, err, "../testdata-other: app path outside root")
+}
+
 func TestPathDot(t *testing.T) {
 	_, err := Path("./testdata", ".")
 	assert.NoError(t, err)
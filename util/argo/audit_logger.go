@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
@@ -42,6 +43,9 @@ const (
 	EventReasonResourceActionRan  = "ResourceActionRan"
 	EventReasonOperationStarted   = "OperationStarted"
 	EventReasonOperationCompleted = "OperationCompleted"
+	EventReasonAccountCreated     = "AccountCreated"
+	EventReasonAccountUpdated     = "AccountUpdated"
+	EventReasonAccountDeleted     = "AccountDeleted"
 )
 
 func (l *AuditLogger) logEvent(objMeta ObjectRef, gvk schema.GroupVersionKind, info EventInfo, message string, logFields map[string]string) {
@@ -130,6 +134,18 @@ func (l *AuditLogger) LogAppProjEvent(proj *v1alpha1.AppProject, info EventInfo,
 	l.logEvent(objectMeta, v1alpha1.AppProjectSchemaGroupVersionKind, info, message, nil)
 }
 
+// LogAccountEvent logs an event about a local account. Since local accounts are declared in the argocd-cm ConfigMap,
+// the event is recorded for the ConfigMap.
+func (l *AuditLogger) LogAccountEvent(account string, info EventInfo, message string) {
+	objectMeta := ObjectRef{
+		Name:      common.ArgoCDConfigMapName,
+		Namespace: l.ns,
+	}
+	l.logEvent(objectMeta, v1.SchemeGroupVersion.WithKind("ConfigMap"), info, message, map[string]string{
+		"account": account,
+	})
+}
+
 func NewAuditLogger(ns string, kIf kubernetes.Interface, component string) *AuditLogger {
 	return &AuditLogger{
 		ns:        ns,
@@ -80,6 +80,18 @@ func (b *DiffConfigBuilder) WithGVKParser(parser *k8smanagedfields.GvkParser) *D
 	return b
 }
 
+// WithStrategy sets the diff strategy in the diff config.
+func (b *DiffConfigBuilder) WithStrategy(strategy DiffStrategy) *DiffConfigBuilder {
+	b.diffConfig.strategy = strategy
+	return b
+}
+
+// WithServerSideDryRunner sets the dry runner used by the server-side diff strategy in the diff config.
+func (b *DiffConfigBuilder) WithServerSideDryRunner(dryRunner ServerSideDryRunner) *DiffConfigBuilder {
+	b.diffConfig.serverSideDryRunner = dryRunner
+	return b
+}
+
 // Build will first validate the current state of the diff config and return the
 // DiffConfig implementation if no errors are found. Will return nil and the error
 // details otherwise.
@@ -118,6 +130,11 @@ type DiffConfig interface {
 	// GVKParser returns a parser able to build a TypedValue used in
 	// structured merge diffs.
 	GVKParser() *k8smanagedfields.GvkParser
+	// Strategy defines how the live and the desired states are compared.
+	Strategy() DiffStrategy
+	// ServerSideDryRunner is used by the server-side diff strategy to predict
+	// the live state.
+	ServerSideDryRunner() ServerSideDryRunner
 }
 
 // diffConfig defines the configurations used while applying diffs.
@@ -132,6 +149,8 @@ type diffConfig struct {
 	ignoreAggregatedRoles bool
 	logger                *logr.Logger
 	gvkParser             *k8smanagedfields.GvkParser
+	strategy              DiffStrategy
+	serverSideDryRunner   ServerSideDryRunner
 }
 
 func (c *diffConfig) Ignores() []v1alpha1.ResourceIgnoreDifferences {
@@ -164,6 +183,15 @@ func (c *diffConfig) Logger() *logr.Logger {
 func (c *diffConfig) GVKParser() *k8smanagedfields.GvkParser {
 	return c.gvkParser
 }
+func (c *diffConfig) Strategy() DiffStrategy {
+	if c.strategy == "" {
+		return DiffStrategyLegacy
+	}
+	return c.strategy
+}
+func (c *diffConfig) ServerSideDryRunner() ServerSideDryRunner {
+	return c.serverSideDryRunner
+}
 
 // Validate will check the current state of this diffConfig and return
 // error if it finds any required configuration missing.
@@ -175,6 +203,12 @@ func (c *diffConfig) Validate() error {
 	if c.overrides == nil {
 		return fmt.Errorf("%s: ResourceOverride can not be nil", msg)
 	}
+	if _, err := ParseDiffStrategy(string(c.strategy)); err != nil {
+		return fmt.Errorf("%s: %s", msg, err)
+	}
+	if c.Strategy() == DiffStrategyServerSide && c.serverSideDryRunner == nil {
+		return fmt.Errorf("%s: ServerSideDryRunner must be set when using the server-side diff strategy", msg)
+	}
 	if !c.noCache {
 		if c.appName == "" {
 			return fmt.Errorf("%s: AppName must be set when retrieving from cache", msg)
@@ -226,14 +260,39 @@ func StateDiffs(lives, configs []*unstructured.Unstructured, diffConfig DiffConf
 		diffOpts = append(diffOpts, diff.WithLogr(*diffConfig.Logger()))
 	}
 
+	diffFunc := newDiffFunc(diffConfig, diffOpts...)
 	useCache, cachedDiff := diffConfig.DiffFromCache(diffConfig.AppName())
 	if useCache && cachedDiff != nil {
-		return diffArrayCached(normResults.Targets, normResults.Lives, cachedDiff, diffOpts...)
+		return diffArrayCached(normResults.Targets, normResults.Lives, cachedDiff, diffConfig.Strategy(), diffFunc)
 	}
-	return diff.DiffArray(normResults.Targets, normResults.Lives, diffOpts...)
+	return diffArray(normResults.Targets, normResults.Lives, diffFunc)
 }
 
-func diffArrayCached(configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, cachedDiff []*appv1.ResourceDiff, opts ...diff.Option) (*diff.DiffResultList, error) {
+func diffArray(configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, diffFunc diffFunc) (*diff.DiffResultList, error) {
+	numItems := len(configArray)
+	if len(liveArray) != numItems {
+		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
+	}
+
+	diffResultList := diff.DiffResultList{
+		Diffs: make([]diff.DiffResult, numItems),
+	}
+	for i := 0; i < numItems; i++ {
+		dr, err := diffFunc(configArray[i], liveArray[i])
+		if err != nil {
+			return nil, err
+		}
+		diffResultList.Diffs[i] = *dr
+		if dr.Modified {
+			diffResultList.Modified = true
+		}
+	}
+	return &diffResultList, nil
+}
+
+// diffArrayCached reuses the cached diffs of resources whose resource version did not change since they were
+// calculated with the same diff strategy
+func diffArrayCached(configArray []*unstructured.Unstructured, liveArray []*unstructured.Unstructured, cachedDiff []*appv1.ResourceDiff, strategy DiffStrategy, diffFunc diffFunc) (*diff.DiffResultList, error) {
 	numItems := len(configArray)
 	if len(liveArray) != numItems {
 		return nil, fmt.Errorf("left and right arrays have mismatched lengths")
@@ -261,14 +320,14 @@ func diffArrayCached(configArray []*unstructured.Unstructured, liveArray []*unst
 			key = kube.GetResourceKey(config)
 		}
 		var dr *diff.DiffResult
-		if cachedDiff, ok := diffByKey[key]; ok && cachedDiff.ResourceVersion == resourceVersion {
+		if cachedDiff, ok := diffByKey[key]; ok && cachedDiff.ResourceVersion == resourceVersion && cachedDiffStrategy(cachedDiff) == strategy {
 			dr = &diff.DiffResult{
 				NormalizedLive: []byte(cachedDiff.NormalizedLiveState),
 				PredictedLive:  []byte(cachedDiff.PredictedLiveState),
 				Modified:       cachedDiff.Modified,
 			}
 		} else {
-			res, err := diff.Diff(configArray[i], liveArray[i], opts...)
+			res, err := diffFunc(configArray[i], liveArray[i])
 			if err != nil {
 				return nil, err
 			}
@@ -285,6 +344,15 @@ func diffArrayCached(configArray []*unstructured.Unstructured, liveArray []*unst
 	return &diffResultList, nil
 }
 
+// cachedDiffStrategy returns the strategy a cached diff was calculated with. Diffs cached without a strategy were
+// calculated with the legacy one.
+func cachedDiffStrategy(cachedDiff *appv1.ResourceDiff) DiffStrategy {
+	if cachedDiff.DiffStrategy == "" {
+		return DiffStrategyLegacy
+	}
+	return DiffStrategy(cachedDiff.DiffStrategy)
+}
+
 // DiffFromCache will verify if it should retrieve the cached ResourceDiff based on this
 // DiffConfig. Returns true and the cached ResourceDiff if configured to use the cache.
 // Returns false and nil otherwise.
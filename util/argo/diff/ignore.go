@@ -54,11 +54,7 @@ func (i *IgnoreDiffConfig) HasIgnoreDifference(group, kind, name, namespace stri
 	}
 
 	for _, ignore := range i.ignores {
-		if glob.Match(ignore.Group, group) &&
-			glob.Match(ignore.Kind, kind) &&
-			(ignore.Name == "" || ignore.Name == name) &&
-			(ignore.Namespace == "" || ignore.Namespace == namespace) {
-
+		if ignoreMatches(ignore, group, kind, name, namespace) {
 			mergeIgnoreDifferences(resourceToIgnoreDifference(ignore), result)
 			found = true
 		}
@@ -70,6 +66,14 @@ func (i *IgnoreDiffConfig) HasIgnoreDifference(group, kind, name, namespace stri
 	return found, result
 }
 
+// ignoreMatches returns whether an Application level ignore difference configuration applies to the given resource
+func ignoreMatches(ignore v1alpha1.ResourceIgnoreDifferences, group, kind, name, namespace string) bool {
+	return glob.Match(ignore.Group, group) &&
+		glob.Match(ignore.Kind, kind) &&
+		(ignore.Name == "" || ignore.Name == name) &&
+		(ignore.Namespace == "" || ignore.Namespace == namespace)
+}
+
 func overrideToIgnoreDifference(override v1alpha1.ResourceOverride) *IgnoreDifference {
 	return &IgnoreDifference{
 		JSONPointers:          override.IgnoreDifferences.JSONPointers,
@@ -0,0 +1,170 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// IgnoreRuleResult holds the differences of a resource which are ignored by a single ignore rule
+type IgnoreRuleResult struct {
+	// Index is the position of the rule in the simulated rules
+	Index int
+	Rule  v1alpha1.ResourceIgnoreDifferences
+	// IgnoredPaths are the JSON pointers of the differences which are no longer reported once the rule is applied
+	IgnoredPaths []string
+}
+
+// IgnoreDifferencesSimulation holds the outcome of simulating ignore rules on a resource
+type IgnoreDifferencesSimulation struct {
+	// Before is the diff of the resource without any of the simulated rules
+	Before diff.DiffResult
+	// After is the diff of the resource with all of the simulated rules
+	After diff.DiffResult
+	// Rules holds the results of the simulated rules which apply to the resource
+	Rules []IgnoreRuleResult
+}
+
+// simulationDiffConfig replaces the Application level ignore difference configurations of a DiffConfig, and never
+// retrieves the diff from the cache.
+type simulationDiffConfig struct {
+	DiffConfig
+	ignores []v1alpha1.ResourceIgnoreDifferences
+}
+
+func (c *simulationDiffConfig) Ignores() []v1alpha1.ResourceIgnoreDifferences {
+	return c.ignores
+}
+
+func (c *simulationDiffConfig) DiffFromCache(appName string) (bool, []*v1alpha1.ResourceDiff) {
+	return false, nil
+}
+
+func (c *simulationDiffConfig) NoCache() bool {
+	return true
+}
+
+// SimulateIgnoreDifferences calculates the diff between the live and the config/desired state of a resource without
+// and with the given ignore rules, using the same normalizations as StateDiff. The system level configurations of the
+// diff config are always applied, while its Application level ignore difference configurations are replaced by the
+// simulated rules.
+func SimulateIgnoreDifferences(live, config *unstructured.Unstructured, rules []v1alpha1.ResourceIgnoreDifferences, diffConfig DiffConfig) (*IgnoreDifferencesSimulation, error) {
+	obj := config
+	if obj == nil {
+		obj = live
+	}
+	if obj == nil {
+		return nil, fmt.Errorf("either the live or the config state of the resource is required")
+	}
+	stateDiff := func(ignores []v1alpha1.ResourceIgnoreDifferences) (diff.DiffResult, []string, error) {
+		res, err := StateDiff(live, config, &simulationDiffConfig{DiffConfig: diffConfig, ignores: ignores})
+		if err != nil {
+			return diff.DiffResult{}, nil, err
+		}
+		paths, err := diffPaths(res)
+		return res, paths, err
+	}
+
+	before, beforePaths, err := stateDiff([]v1alpha1.ResourceIgnoreDifferences{})
+	if err != nil {
+		return nil, fmt.Errorf("error calculating diff without ignore rules: %w", err)
+	}
+	after, _, err := stateDiff(rules)
+	if err != nil {
+		return nil, fmt.Errorf("error calculating diff with ignore rules: %w", err)
+	}
+	simulation := &IgnoreDifferencesSimulation{Before: before, After: after}
+
+	gvk := obj.GroupVersionKind()
+	for i, rule := range rules {
+		if !ignoreMatches(rule, gvk.Group, gvk.Kind, obj.GetName(), obj.GetNamespace()) {
+			continue
+		}
+		_, rulePaths, err := stateDiff([]v1alpha1.ResourceIgnoreDifferences{rule})
+		if err != nil {
+			return nil, fmt.Errorf("error calculating diff with ignore rule %d: %w", i, err)
+		}
+		remaining := map[string]bool{}
+		for _, path := range rulePaths {
+			remaining[path] = true
+		}
+		result := IgnoreRuleResult{Index: i, Rule: rule, IgnoredPaths: []string{}}
+		for _, path := range beforePaths {
+			if !remaining[path] {
+				result.IgnoredPaths = append(result.IgnoredPaths, path)
+			}
+		}
+		simulation.Rules = append(simulation.Rules, result)
+	}
+	return simulation, nil
+}
+
+// diffPaths returns the JSON pointers of the leaf fields which differ between the normalized live and the predicted
+// live state of a diff result
+func diffPaths(res diff.DiffResult) ([]string, error) {
+	if !res.Modified {
+		return []string{}, nil
+	}
+	var live, predicted interface{}
+	if err := json.Unmarshal(res.NormalizedLive, &live); err != nil {
+		return nil, fmt.Errorf("error unmarshaling normalized live state: %w", err)
+	}
+	if err := json.Unmarshal(res.PredictedLive, &predicted); err != nil {
+		return nil, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+	}
+	paths := []string{}
+	collectDiffPaths(live, predicted, "", &paths)
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func collectDiffPaths(a, b interface{}, prefix string, paths *[]string) {
+	switch aVal := a.(type) {
+	case map[string]interface{}:
+		if bVal, ok := b.(map[string]interface{}); ok {
+			keys := map[string]bool{}
+			for k := range aVal {
+				keys[k] = true
+			}
+			for k := range bVal {
+				keys[k] = true
+			}
+			for k := range keys {
+				collectDiffPaths(aVal[k], bVal[k], prefix+"/"+escapeJSONPointer(k), paths)
+			}
+			return
+		}
+	case []interface{}:
+		if bVal, ok := b.([]interface{}); ok {
+			for i := 0; i < len(aVal) || i < len(bVal); i++ {
+				var aItem, bItem interface{}
+				if i < len(aVal) {
+					aItem = aVal[i]
+				}
+				if i < len(bVal) {
+					bItem = bVal[i]
+				}
+				collectDiffPaths(aItem, bItem, prefix+"/"+strconv.Itoa(i), paths)
+			}
+			return
+		}
+	}
+	if !reflect.DeepEqual(a, b) {
+		if prefix == "" {
+			prefix = "/"
+		}
+		*paths = append(*paths, prefix)
+	}
+}
+
+func escapeJSONPointer(s string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(s, "~", "~0"), "/", "~1")
+}
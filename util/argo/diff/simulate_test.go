@@ -0,0 +1,50 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	testutil "github.com/argoproj/argo-cd/v2/test"
+	argo "github.com/argoproj/argo-cd/v2/util/argo/diff"
+	"github.com/argoproj/argo-cd/v2/util/argo/testdata"
+)
+
+func TestSimulateIgnoreDifferences(t *testing.T) {
+	diffConfig, err := argo.NewDiffConfigBuilder().
+		WithDiffSettings([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{}, true).
+		WithTracking("", "").
+		WithNoCache().
+		Build()
+	require.NoError(t, err)
+	rules := []v1alpha1.ResourceIgnoreDifferences{
+		{Group: "apps", Kind: "Deployment", ManagedFieldsManagers: []string{"kube-controller-manager"}},
+		{Kind: "Service", JSONPointers: []string{"/spec"}},
+		{Group: "*", Kind: "*", JSONPointers: []string{"/spec/revisionHistoryLimit"}},
+	}
+	live := testutil.YamlToUnstructured(testdata.LiveDeploymentWithManagedReplicaYaml)
+	desired := testutil.YamlToUnstructured(testdata.DesiredDeploymentYaml)
+
+	res, err := argo.SimulateIgnoreDifferences(live, desired, rules, diffConfig)
+	require.NoError(t, err)
+	assert.True(t, res.Before.Modified)
+	assert.False(t, res.After.Modified)
+	require.Len(t, res.Rules, 2)
+	assert.Equal(t, 0, res.Rules[0].Index)
+	assert.Equal(t, []string{"/spec/replicas"}, res.Rules[0].IgnoredPaths)
+	assert.Equal(t, 2, res.Rules[1].Index)
+	assert.Equal(t, []string{"/spec/revisionHistoryLimit"}, res.Rules[1].IgnoredPaths)
+
+	t.Run("NoMatchingRules", func(t *testing.T) {
+		res, err := argo.SimulateIgnoreDifferences(live, desired, rules[1:2], diffConfig)
+		require.NoError(t, err)
+		assert.True(t, res.After.Modified)
+		assert.Empty(t, res.Rules)
+	})
+	t.Run("MissingResource", func(t *testing.T) {
+		_, err := argo.SimulateIgnoreDifferences(nil, nil, rules, diffConfig)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,198 @@
+package diff
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/argoproj/gitops-engine/pkg/diff"
+	"github.com/argoproj/gitops-engine/pkg/utils/kube"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/argo/managedfields"
+)
+
+// DiffStrategy defines how the live state of a resource is compared with its desired state
+type DiffStrategy string
+
+const (
+	// DiffStrategyLegacy predicts the live state with a three-way merge of the desired state, the live state and the
+	// last applied configuration
+	DiffStrategyLegacy DiffStrategy = "legacy"
+	// DiffStrategyStructuredMerge predicts the live state by merging the desired state into the live state using the
+	// schema of the resource, like a server-side apply would do without webhooks and defaulting
+	DiffStrategyStructuredMerge DiffStrategy = "structured-merge"
+	// DiffStrategyServerSide predicts the live state by asking the API server of the destination cluster to dry-run a
+	// server-side apply of the desired state
+	DiffStrategyServerSide DiffStrategy = "server-side"
+)
+
+// diffStrategyCompareOption is the compare option of an Application selecting its diff strategy,
+// e.g. argocd.argoproj.io/compare-options: DiffStrategy=server-side
+const diffStrategyCompareOption = "DiffStrategy="
+
+// ServerSideDiffManager is the field manager used for server-side dry-run applies
+const ServerSideDiffManager = "argocd-controller"
+
+// dryRunTimeout is the maximum duration of the server-side dry-run of a single resource
+const dryRunTimeout = 30 * time.Second
+
+// ParseDiffStrategy parses the name of a diff strategy. An empty name denotes the legacy strategy.
+func ParseDiffStrategy(name string) (DiffStrategy, error) {
+	switch strategy := DiffStrategy(name); strategy {
+	case "":
+		return DiffStrategyLegacy, nil
+	case DiffStrategyLegacy, DiffStrategyStructuredMerge, DiffStrategyServerSide:
+		return strategy, nil
+	}
+	return "", fmt.Errorf("unknown diff strategy '%s', supported strategies are %s, %s and %s", name, DiffStrategyLegacy, DiffStrategyStructuredMerge, DiffStrategyServerSide)
+}
+
+// GetAppDiffStrategy returns the diff strategy selected in the compare options annotation of an Application, or the
+// given default strategy if the Application does not select one
+func GetAppDiffStrategy(app *v1alpha1.Application, defaultStrategy string) (DiffStrategy, error) {
+	for _, option := range strings.Split(app.GetAnnotations()[common.AnnotationCompareOptions], ",") {
+		option = strings.TrimSpace(option)
+		if strings.HasPrefix(option, diffStrategyCompareOption) {
+			return ParseDiffStrategy(strings.TrimPrefix(option, diffStrategyCompareOption))
+		}
+	}
+	return ParseDiffStrategy(defaultStrategy)
+}
+
+// ServerSideDryRunner applies resources in dry-run mode and returns the resulting state
+type ServerSideDryRunner interface {
+	// DryRunApply returns the state of the resource after a server-side apply of the given object
+	DryRunApply(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+}
+
+type serverSideDryRunner struct {
+	dynamicIf    dynamic.Interface
+	apiResources []kube.APIResourceInfo
+	manager      string
+}
+
+// NewServerSideDryRunner returns a ServerSideDryRunner which applies resources with the given dynamic client. The API
+// resources are used to resolve the group, version and resource of the applied objects.
+func NewServerSideDryRunner(dynamicIf dynamic.Interface, apiResources []kube.APIResourceInfo, manager string) ServerSideDryRunner {
+	return &serverSideDryRunner{dynamicIf: dynamicIf, apiResources: apiResources, manager: manager}
+}
+
+func (r *serverSideDryRunner) DryRunApply(ctx context.Context, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	gvk := obj.GroupVersionKind()
+	var apiResource *kube.APIResourceInfo
+	for i := range r.apiResources {
+		if r.apiResources[i].GroupKind == gvk.GroupKind() {
+			apiResource = &r.apiResources[i]
+			break
+		}
+	}
+	if apiResource == nil {
+		return nil, fmt.Errorf("unknown API resource for %s", gvk)
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	gvr := gvk.GroupVersion().WithResource(apiResource.GroupVersionResource.Resource)
+	resIf := kube.ToResourceInterface(r.dynamicIf, &apiResource.Meta, gvr, obj.GetNamespace())
+	return resIf.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:       []string{metav1.DryRunAll},
+		FieldManager: r.manager,
+		Force:        pointer.Bool(true),
+	})
+}
+
+// diffFunc calculates the diff between the desired and the live state of a single resource
+type diffFunc func(config, live *unstructured.Unstructured) (*diff.DiffResult, error)
+
+// newDiffFunc returns the function calculating diffs with the strategy of the diff config
+func newDiffFunc(diffConfig DiffConfig, opts ...diff.Option) diffFunc {
+	legacy := func(config, live *unstructured.Unstructured) (*diff.DiffResult, error) {
+		return diff.Diff(config, live, opts...)
+	}
+	switch diffConfig.Strategy() {
+	case DiffStrategyStructuredMerge:
+		return func(config, live *unstructured.Unstructured) (*diff.DiffResult, error) {
+			if config == nil || live == nil {
+				return legacy(config, live)
+			}
+			pt := managedfields.ResolveParseableType(config.GroupVersionKind(), diffConfig.GVKParser())
+			typedLive, err := pt.FromUnstructured(live.Object)
+			if err != nil {
+				return nil, fmt.Errorf("error creating typed live state of %s/%s: %w", live.GetKind(), live.GetName(), err)
+			}
+			typedConfig, err := pt.FromUnstructured(config.Object)
+			if err != nil {
+				return nil, fmt.Errorf("error creating typed config of %s/%s: %w", config.GetKind(), config.GetName(), err)
+			}
+			merged, err := typedLive.Merge(typedConfig)
+			if err != nil {
+				return nil, fmt.Errorf("error merging %s/%s: %w", config.GetKind(), config.GetName(), err)
+			}
+			predicted, ok := merged.AsValue().Unstructured().(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("error converting merged typedValue of %s/%s", config.GetKind(), config.GetName())
+			}
+			return predictedDiff(&unstructured.Unstructured{Object: predicted}, live, opts...)
+		}
+	case DiffStrategyServerSide:
+		dryRunner := diffConfig.ServerSideDryRunner()
+		return func(config, live *unstructured.Unstructured) (*diff.DiffResult, error) {
+			// missing and extraneous resources are out of sync regardless of the predicted state
+			if config == nil || live == nil {
+				return legacy(config, live)
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), dryRunTimeout)
+			defer cancel()
+			predicted, err := dryRunner.DryRunApply(ctx, config)
+			if err != nil {
+				if diffConfig.Logger() != nil {
+					diffConfig.Logger().Info(fmt.Sprintf("server-side dry-run of %s/%s failed, falling back to legacy diff: %v", config.GetKind(), config.GetName(), err))
+				}
+				return legacy(config, live)
+			}
+			// metadata maintained by the API server is not part of the desired state
+			for _, field := range []string{"managedFields", "resourceVersion", "generation"} {
+				if value, ok, _ := unstructured.NestedFieldCopy(live.Object, "metadata", field); ok {
+					_ = unstructured.SetNestedField(predicted.Object, value, "metadata", field)
+				} else {
+					unstructured.RemoveNestedField(predicted.Object, "metadata", field)
+				}
+			}
+			return predictedDiff(predicted, live, opts...)
+		}
+	default:
+		return legacy
+	}
+}
+
+// predictedDiff normalizes the predicted and the live state of a resource and compares them
+func predictedDiff(predicted, live *unstructured.Unstructured, opts ...diff.Option) (*diff.DiffResult, error) {
+	predicted = predicted.DeepCopy()
+	live = live.DeepCopy()
+	diff.Normalize(predicted, opts...)
+	diff.Normalize(live, opts...)
+	predictedData, err := json.Marshal(predicted)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling predicted live state: %w", err)
+	}
+	liveData, err := json.Marshal(live)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling live state: %w", err)
+	}
+	return &diff.DiffResult{
+		Modified:       !bytes.Equal(predictedData, liveData),
+		NormalizedLive: liveData,
+		PredictedLive:  predictedData,
+	}, nil
+}
@@ -0,0 +1,138 @@
+package diff_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	testutil "github.com/argoproj/argo-cd/v2/test"
+	argo "github.com/argoproj/argo-cd/v2/util/argo/diff"
+)
+
+const strategyConfigMapYaml = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+  namespace: default
+data:
+  foo: bar
+`
+
+const strategyLiveConfigMapYaml = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: my-config
+  namespace: default
+  resourceVersion: "123"
+  annotations:
+    added-by-webhook: "true"
+data:
+  foo: bar
+`
+
+type fakeDryRunner struct {
+	result *unstructured.Unstructured
+	err    error
+}
+
+func (r *fakeDryRunner) DryRunApply(_ context.Context, _ *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.result.DeepCopy(), nil
+}
+
+func TestParseDiffStrategy(t *testing.T) {
+	for name, expected := range map[string]argo.DiffStrategy{
+		"":                 argo.DiffStrategyLegacy,
+		"legacy":           argo.DiffStrategyLegacy,
+		"structured-merge": argo.DiffStrategyStructuredMerge,
+		"server-side":      argo.DiffStrategyServerSide,
+	} {
+		strategy, err := argo.ParseDiffStrategy(name)
+		require.NoError(t, err)
+		assert.Equal(t, expected, strategy)
+	}
+	_, err := argo.ParseDiffStrategy("magic")
+	assert.ErrorContains(t, err, "unknown diff strategy 'magic'")
+}
+
+func TestGetAppDiffStrategy(t *testing.T) {
+	app := &v1alpha1.Application{}
+	strategy, err := argo.GetAppDiffStrategy(app, "structured-merge")
+	require.NoError(t, err)
+	assert.Equal(t, argo.DiffStrategyStructuredMerge, strategy)
+
+	app.ObjectMeta = metav1.ObjectMeta{Annotations: map[string]string{common.AnnotationCompareOptions: "IgnoreExtraneous, DiffStrategy=server-side"}}
+	strategy, err = argo.GetAppDiffStrategy(app, "structured-merge")
+	require.NoError(t, err)
+	assert.Equal(t, argo.DiffStrategyServerSide, strategy)
+
+	app.Annotations[common.AnnotationCompareOptions] = "DiffStrategy=magic"
+	_, err = argo.GetAppDiffStrategy(app, "")
+	assert.Error(t, err)
+}
+
+func TestStateDiffStrategies(t *testing.T) {
+	config := testutil.YamlToUnstructured(strategyConfigMapYaml)
+	live := testutil.YamlToUnstructured(strategyLiveConfigMapYaml)
+	stateDiff := func(t *testing.T, strategy argo.DiffStrategy, dryRunner argo.ServerSideDryRunner, config *unstructured.Unstructured) bool {
+		t.Helper()
+		diffConfig, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, nil, false).
+			WithNoCache().
+			WithStrategy(strategy).
+			WithServerSideDryRunner(dryRunner).
+			Build()
+		require.NoError(t, err)
+		res, err := argo.StateDiff(live, config, diffConfig)
+		require.NoError(t, err)
+		return res.Modified
+	}
+
+	t.Run("StructuredMerge", func(t *testing.T) {
+		assert.False(t, stateDiff(t, argo.DiffStrategyStructuredMerge, nil, config))
+
+		modified := config.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(modified.Object, "baz", "data", "foo"))
+		assert.True(t, stateDiff(t, argo.DiffStrategyStructuredMerge, nil, modified))
+	})
+	t.Run("ServerSide", func(t *testing.T) {
+		predicted := live.DeepCopy()
+		predicted.SetResourceVersion("")
+		assert.False(t, stateDiff(t, argo.DiffStrategyServerSide, &fakeDryRunner{result: predicted}, config))
+
+		require.NoError(t, unstructured.SetNestedField(predicted.Object, "baz", "data", "foo"))
+		assert.True(t, stateDiff(t, argo.DiffStrategyServerSide, &fakeDryRunner{result: predicted}, config))
+	})
+	t.Run("ServerSideFallsBackToLegacy", func(t *testing.T) {
+		modified := config.DeepCopy()
+		require.NoError(t, unstructured.SetNestedField(modified.Object, "baz", "data", "foo"))
+		assert.True(t, stateDiff(t, argo.DiffStrategyServerSide, &fakeDryRunner{err: errors.New("webhook unavailable")}, modified))
+	})
+	t.Run("ServerSideRequiresDryRunner", func(t *testing.T) {
+		_, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, nil, false).
+			WithNoCache().
+			WithStrategy(argo.DiffStrategyServerSide).
+			Build()
+		assert.ErrorContains(t, err, "ServerSideDryRunner must be set")
+	})
+	t.Run("UnknownStrategy", func(t *testing.T) {
+		_, err := argo.NewDiffConfigBuilder().
+			WithDiffSettings(nil, nil, false).
+			WithNoCache().
+			WithStrategy("magic").
+			Build()
+		assert.ErrorContains(t, err, "unknown diff strategy")
+	})
+}
@@ -89,7 +89,8 @@ type ignoreNormalizer struct {
 
 // NewIgnoreNormalizer creates diff normalizer which removes ignored fields according to given application spec and resource overrides
 func NewIgnoreNormalizer(ignore []v1alpha1.ResourceIgnoreDifferences, overrides map[string]v1alpha1.ResourceOverride) (diff.Normalizer, error) {
-	for key, override := range overrides {
+	for _, key := range sortedOverrideKeys(overrides) {
+		override := overrides[key]
 		group, kind, err := getGroupKindForOverrideKey(key)
 		if err != nil {
 			log.Warn(err)
@@ -153,6 +153,28 @@ func TestNormalizeGlobMatch(t *testing.T) {
 	assert.False(t, has)
 }
 
+func TestNormalizeGlobOverrideKey(t *testing.T) {
+	normalizer, err := NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{}, map[string]v1alpha1.ResourceOverride{
+		"*/Deploy*": {
+			IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{"/spec/replicas"}},
+		},
+		"*.crossplane.io/*": {
+			IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{JSONPointers: []string{"/spec/template"}},
+		},
+	})
+	assert.Nil(t, err)
+
+	deployment := test.NewDeployment()
+	err = normalizer.Normalize(deployment)
+	assert.Nil(t, err)
+	_, has, err := unstructured.NestedFieldNoCopy(deployment.Object, "spec", "replicas")
+	assert.Nil(t, err)
+	assert.False(t, has)
+	_, has, err = unstructured.NestedFieldNoCopy(deployment.Object, "spec", "template")
+	assert.Nil(t, err)
+	assert.True(t, has)
+}
+
 func TestNormalizeJQPathExpression(t *testing.T) {
 	normalizer, err := NewIgnoreNormalizer([]v1alpha1.ResourceIgnoreDifferences{{
 		Group:             "apps",
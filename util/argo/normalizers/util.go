@@ -3,6 +3,8 @@ package normalizers
 import (
 	"fmt"
 	"strings"
+
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 )
 
 func getGroupKindForOverrideKey(key string) (string, string, error) {
@@ -19,3 +21,14 @@ func getGroupKindForOverrideKey(key string) (string, string, error) {
 	}
 	return group, kind, nil
 }
+
+// sortedOverrideKeys returns the keys of the overrides from the most to the least specific, so that normalizers are
+// configured deterministically. Keys may be glob patterns, e.g. '*.crossplane.io/*'.
+func sortedOverrideKeys(overrides map[string]v1alpha1.ResourceOverride) []string {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	v1alpha1.SortOverrideKeys(keys)
+	return keys
+}
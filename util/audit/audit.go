@@ -0,0 +1,94 @@
+package audit
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Record is an entry of the audit trail, which is recorded for every mutating API call
+type Record struct {
+	Time time.Time `json:"time"`
+	// User is the name of the user, which is the email address for SSO users
+	User string `json:"user,omitempty"`
+	// Subject is the RBAC subject of the user
+	Subject string   `json:"subject,omitempty"`
+	Groups  []string `json:"groups,omitempty"`
+	// Method is the full name of the called method, e.g. /application.ApplicationService/Sync
+	Method string `json:"method"`
+	// Resource identifies the resource the method was called for, e.g. application/guestbook
+	Resource string `json:"resource,omitempty"`
+	// Request is the redacted request, which holds the requested changes. It is omitted for methods whose requests
+	// contain credentials.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Diff is the JSON merge patch from the live to the updated state of the resource changed by the call, which is
+	// recorded by the handlers of update methods. It is omitted like the request.
+	Diff json.RawMessage `json:"diff,omitempty"`
+	// Code is the gRPC status code of the call, e.g. OK or PermissionDenied
+	Code      string `json:"code"`
+	Error     string `json:"error,omitempty"`
+	ClientIP  string `json:"clientIP,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+}
+
+// Sink stores or forwards audit records
+type Sink interface {
+	Write(record *Record) error
+}
+
+// Recorder writes audit records to all of its sinks
+type Recorder struct {
+	sinks []Sink
+}
+
+// NewRecorder returns a recorder which writes to the given sinks
+func NewRecorder(sinks ...Sink) *Recorder {
+	return &Recorder{sinks: sinks}
+}
+
+// Enabled returns whether the recorder has any sinks
+func (r *Recorder) Enabled() bool {
+	return r != nil && len(r.sinks) > 0
+}
+
+// Record writes the record to all sinks. Failures are logged, so that a failing sink neither blocks the others nor
+// fails the audited call.
+func (r *Recorder) Record(record *Record) {
+	for _, sink := range r.sinks {
+		if err := sink.Write(record); err != nil {
+			log.Warnf("Failed to write audit record of %s: %v", record.Method, err)
+		}
+	}
+}
+
+// readOnlyMethodPrefixes are the prefixes of the names of methods which do not change any state, and are therefore
+// not audited
+var readOnlyMethodPrefixes = []string{
+	"CanI",
+	"Explain",
+	"Get",
+	"List",
+	"ManagedResources",
+	"PodLogs",
+	"ResourceTree",
+	"RevisionMetadata",
+	"ServerSideDiff",
+	"Simulate",
+	"ValidateAccess",
+	"Version",
+	"Watch",
+}
+
+// IsMutatingMethod returns whether the method with the given full name, e.g. /application.ApplicationService/Sync,
+// may change state
+func IsMutatingMethod(fullMethod string) bool {
+	name := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, prefix := range readOnlyMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsMutatingMethod(t *testing.T) {
+	assert.True(t, IsMutatingMethod("/application.ApplicationService/Sync"))
+	assert.True(t, IsMutatingMethod("/application.ApplicationService/DeleteResource"))
+	assert.True(t, IsMutatingMethod("/account.AccountService/UpdatePassword"))
+	assert.True(t, IsMutatingMethod("/session.SessionService/Create"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/Get"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/ListResourceEvents"))
+	assert.False(t, IsMutatingMethod("/application.ApplicationService/ManagedResources"))
+	assert.False(t, IsMutatingMethod("/account.AccountService/CanI"))
+	assert.False(t, IsMutatingMethod("/version.VersionService/Version"))
+}
+
+type fakeSink struct {
+	records []*Record
+	err     error
+}
+
+func (s *fakeSink) Write(record *Record) error {
+	s.records = append(s.records, record)
+	return s.err
+}
+
+func TestRecorder(t *testing.T) {
+	assert.False(t, NewRecorder().Enabled())
+	var nilRecorder *Recorder
+	assert.False(t, nilRecorder.Enabled())
+
+	failing := &fakeSink{err: errors.New("disk full")}
+	sink := &fakeSink{}
+	recorder := NewRecorder(failing, sink)
+	assert.True(t, recorder.Enabled())
+	recorder.Record(&Record{Method: "/application.ApplicationService/Sync"})
+	assert.Len(t, failing.records, 1)
+	assert.Len(t, sink.records, 1)
+}
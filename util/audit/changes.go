@@ -0,0 +1,80 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	jsonpatch "github.com/evanphx/json-patch"
+)
+
+type changeKey string
+
+const (
+	contextKey changeKey = "change"
+)
+
+// change is the change of a resource by an audited call, which handlers register using RecordChange
+type change struct {
+	lock sync.Mutex
+	diff json.RawMessage
+}
+
+func contextWithChange(ctx context.Context, c *change) context.Context {
+	return context.WithValue(ctx, contextKey, c)
+}
+
+// ignoredMetadataFields are the metadata fields which are updated by the API server on every change, and are therefore
+// excluded from diffs
+var ignoredMetadataFields = []string{"resourceVersion", "generation", "managedFields"}
+
+// RecordChange records the change of a resource by the current call as a JSON merge patch from its live to its updated
+// state, which becomes the diff of the audit record. It does nothing if the call is not audited.
+func RecordChange(ctx context.Context, live interface{}, updated interface{}) {
+	c, ok := ctx.Value(contextKey).(*change)
+	if !ok {
+		return
+	}
+	diff, err := mergePatch(live, updated)
+	if err != nil {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.diff = diff
+}
+
+func (c *change) getDiff() json.RawMessage {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.diff
+}
+
+func mergePatch(live interface{}, updated interface{}) ([]byte, error) {
+	liveData, err := marshalWithoutIgnoredFields(live)
+	if err != nil {
+		return nil, err
+	}
+	updatedData, err := marshalWithoutIgnoredFields(updated)
+	if err != nil {
+		return nil, err
+	}
+	return jsonpatch.CreateMergePatch(liveData, updatedData)
+}
+
+func marshalWithoutIgnoredFields(obj interface{}) ([]byte, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	if metadata, ok := fields["metadata"].(map[string]interface{}); ok {
+		for _, field := range ignoredMetadataFields {
+			delete(metadata, field)
+		}
+	}
+	return json.Marshal(fields)
+}
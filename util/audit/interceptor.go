@@ -0,0 +1,191 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
+	"github.com/argoproj/argo-cd/v2/util/session"
+)
+
+// sensitiveFieldsRegex matches the JSON fields of requests which hold credentials, e.g. "password":"..." or
+// "bearerToken":"..."
+var sensitiveFieldsRegex = regexp.MustCompile(`"([A-Za-z]*(?:[Pp]assword|[Tt]oken|[Ss]ecret|[Pp]rivateKey|[Cc]ertKey|[Kk]eyData))":"(?:[^"\\]|\\.)*"`)
+
+// resourceIdentifiers are the request fields which identify the resource a method is called for, in order of
+// precedence
+var resourceIdentifiers = [][]string{
+	{"application", "metadata", "name"},
+	{"project", "metadata", "name"},
+	{"cluster", "server"},
+	{"repo", "repo"},
+	{"name"},
+	{"server"},
+	{"repo"},
+	{"url"},
+	{"project"},
+}
+
+// UnaryServerInterceptor returns an interceptor which records every call of a mutating method, see IsMutatingMethod.
+// The requests are redacted using a Sanitizer, which is added to the context so that handlers can register further
+// values to redact. Handlers record the changes of the resources they update using RecordChange. The requests and
+// changes of methods for which omitRequest returns true are not recorded. The client IP is read from the
+// X-Forwarded-For header only for requests forwarded by one of the trusted proxies, see grpc_util.ClientIP.
+func UnaryServerInterceptor(recorder *Recorder, groups func(ctx context.Context) []string, omitRequest func(fullMethod string) bool, trustedProxies []*net.IPNet) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !recorder.Enabled() || !IsMutatingMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		call := newAuditedCall(ctx)
+		resp, err := handler(call.ctx, req)
+		recorder.Record(call.record(info.FullMethod, req, err, groups, omitRequest, trustedProxies))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor which records every call of a mutating streaming method, like
+// UnaryServerInterceptor. The first message received from the client is recorded as the request.
+func StreamServerInterceptor(recorder *Recorder, groups func(ctx context.Context) []string, omitRequest func(fullMethod string) bool, trustedProxies []*net.IPNet) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if !recorder.Enabled() || !IsMutatingMethod(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		call := newAuditedCall(ss.Context())
+		stream := &recordingServerStream{ServerStream: ss, ctx: call.ctx}
+		err := handler(srv, stream)
+		recorder.Record(call.record(info.FullMethod, stream.request, err, groups, omitRequest, trustedProxies))
+		return err
+	}
+}
+
+// recordingServerStream keeps the first message received from the client, and passes the context of the audited
+// call to the handler
+type recordingServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	request interface{}
+}
+
+func (s *recordingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recordingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.request == nil {
+		s.request = m
+	}
+	return err
+}
+
+// auditedCall holds the sanitizer and the change registered by the handler of an audited call
+type auditedCall struct {
+	ctx       context.Context
+	sanitizer grpc_util.Sanitizer
+	change    *change
+}
+
+func newAuditedCall(ctx context.Context) *auditedCall {
+	sanitizer := grpc_util.NewSanitizer()
+	sanitizer.AddRegexReplacement(sensitiveFieldsRegex, `"$1":"******"`)
+	c := &change{}
+	return &auditedCall{
+		ctx:       contextWithChange(grpc_util.ContextWithSanitizer(ctx, sanitizer), c),
+		sanitizer: sanitizer,
+		change:    c,
+	}
+}
+
+func (c *auditedCall) record(fullMethod string, req interface{}, err error, groups func(ctx context.Context) []string, omitRequest func(fullMethod string) bool, trustedProxies []*net.IPNet) *Record {
+	// the interceptor runs inside of the interceptors which translate errors, so the same translation is applied to
+	// record the code returned to the client
+	err = grpc_util.ErrorToGRPC(err)
+	record := &Record{
+		Time:    time.Now().UTC(),
+		User:    session.Username(c.ctx),
+		Subject: session.Sub(c.ctx),
+		Groups:  groups(c.ctx),
+		Method:  fullMethod,
+		Code:    status.Code(err).String(),
+	}
+	if record.User == "" {
+		// calls like logins are not authenticated yet
+		if r, ok := req.(interface{ GetUsername() string }); ok {
+			record.User = r.GetUsername()
+		}
+	}
+	record.UserAgent = grpc_util.UserAgent(c.ctx)
+	record.ClientIP = grpc_util.ClientIP(c.ctx, trustedProxies)
+	if err != nil {
+		record.Error = c.sanitizer.Replace(status.Convert(err).Message())
+	}
+	if req != nil {
+		if data, err := marshalRequest(req); err == nil {
+			record.Resource = resourceOf(fullMethod, data)
+			if !omitRequest(fullMethod) {
+				record.Request = json.RawMessage(c.sanitizer.Replace(string(data)))
+			}
+		}
+	}
+	if diff := c.change.getDiff(); len(diff) > 0 && !omitRequest(fullMethod) {
+		record.Diff = json.RawMessage(c.sanitizer.Replace(string(diff)))
+	}
+	return record
+}
+
+func marshalRequest(req interface{}) ([]byte, error) {
+	if msg, ok := req.(proto.Message); ok {
+		var b bytes.Buffer
+		if err := (&jsonpb.Marshaler{}).Marshal(&b, msg); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	}
+	return json.Marshal(req)
+}
+
+// resourceOf returns the resource a method is called for in the form <kind>/<name>, where the kind is derived from
+// the service name, e.g. application for application.ApplicationService
+func resourceOf(fullMethod string, request []byte) string {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(request, &fields); err != nil {
+		return ""
+	}
+	var name string
+	for _, path := range resourceIdentifiers {
+		if value, ok := lookup(fields, path).(string); ok && value != "" {
+			name = value
+			break
+		}
+	}
+	if name == "" {
+		return ""
+	}
+	kind := strings.TrimPrefix(fullMethod, "/")
+	if i := strings.Index(kind, "."); i >= 0 {
+		kind = kind[:i]
+	}
+	return kind + "/" + name
+}
+
+func lookup(fields map[string]interface{}, path []string) interface{} {
+	var value interface{} = fields
+	for _, key := range path {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
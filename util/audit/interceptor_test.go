@@ -0,0 +1,204 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	applicationpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/application"
+	clusterpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/cluster"
+	projectpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/project"
+	sessionpkg "github.com/argoproj/argo-cd/v2/pkg/apiclient/session"
+	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	grpc_util "github.com/argoproj/argo-cd/v2/util/grpc"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &fakeSink{}
+	interceptor := UnaryServerInterceptor(NewRecorder(sink), func(ctx context.Context) []string {
+		return []string{"my-org:my-team"}
+	}, func(fullMethod string) bool {
+		return fullMethod == "/session.SessionService/Create"
+	}, nil)
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"iss": "https://dex.example.com", "sub": "CiQwOGE4Njg0", "email": "alice@example.com"})
+	// requests forwarded by the gRPC gateway reach the server from the loopback address
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 41234}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("user-agent", "argocd-client/v2.6.0", "x-forwarded-for", "10.0.0.1"))
+	call := func(ctx context.Context, method string, req interface{}, handler grpc.UnaryHandler) *Record {
+		t.Helper()
+		sink.records = nil
+		_, _ = interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		if len(sink.records) == 0 {
+			return nil
+		}
+		require.Len(t, sink.records, 1)
+		return sink.records[0]
+	}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	t.Run("Mutating", func(t *testing.T) {
+		name, revision := "guestbook", "HEAD"
+		record := call(ctx, "/application.ApplicationService/Sync", &applicationpkg.ApplicationSyncRequest{Name: &name, Revision: &revision}, ok)
+		require.NotNil(t, record)
+		assert.Equal(t, "alice@example.com", record.User)
+		assert.Equal(t, "CiQwOGE4Njg0", record.Subject)
+		assert.Equal(t, []string{"my-org:my-team"}, record.Groups)
+		assert.Equal(t, "application/guestbook", record.Resource)
+		assert.Equal(t, "OK", record.Code)
+		assert.Empty(t, record.Error)
+		assert.Equal(t, "argocd-client/v2.6.0", record.UserAgent)
+		assert.Equal(t, "10.0.0.1", record.ClientIP)
+		assert.JSONEq(t, `{"name":"guestbook","revision":"HEAD"}`, string(record.Request))
+		assert.False(t, record.Time.IsZero())
+	})
+	t.Run("ReadOnly", func(t *testing.T) {
+		name := "guestbook"
+		assert.Nil(t, call(ctx, "/application.ApplicationService/Get", &applicationpkg.ApplicationQuery{Name: &name}, ok))
+	})
+	t.Run("Redacted", func(t *testing.T) {
+		req := &clusterpkg.ClusterUpdateRequest{Cluster: &v1alpha1.Cluster{
+			Server: "https://kubernetes.example.com",
+			Config: v1alpha1.ClusterConfig{BearerToken: "secret-token", Password: `p"ssword`, TLSClientConfig: v1alpha1.TLSClientConfig{KeyData: []byte("key")}},
+		}}
+		record := call(ctx, "/cluster.ClusterService/Update", req, func(ctx context.Context, req interface{}) (interface{}, error) {
+			// handlers can redact additional values
+			sanitizer, ok := grpc_util.SanitizerFromContext(ctx)
+			require.True(t, ok)
+			sanitizer.AddReplacement("kubernetes.example.com", "******")
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied: clusters, update, https://kubernetes.example.com")
+		})
+		require.NotNil(t, record)
+		assert.Equal(t, "PermissionDenied", record.Code)
+		assert.Equal(t, "permission denied: clusters, update, https://******", record.Error)
+		assert.Equal(t, "cluster/https://kubernetes.example.com", record.Resource)
+		assert.NotContains(t, string(record.Request), "secret-token")
+		assert.NotContains(t, string(record.Request), `p\"ssword`)
+		var request map[string]interface{}
+		require.NoError(t, json.Unmarshal(record.Request, &request))
+		config := request["cluster"].(map[string]interface{})["config"].(map[string]interface{})
+		assert.Equal(t, "******", config["bearerToken"])
+		assert.Equal(t, "******", config["password"])
+		assert.Equal(t, "******", config["tlsClientConfig"].(map[string]interface{})["keyData"])
+	})
+	t.Run("Diff", func(t *testing.T) {
+		live := &v1alpha1.AppProject{
+			ObjectMeta: metav1.ObjectMeta{Name: "default", ResourceVersion: "1"},
+			Spec:       v1alpha1.AppProjectSpec{SourceRepos: []string{"https://github.com/argoproj/argo-cd.git"}, Description: "default project"},
+		}
+		updated := live.DeepCopy()
+		updated.ResourceVersion = "2"
+		updated.Spec.SourceRepos = []string{"*"}
+		record := call(ctx, "/project.ProjectService/Update", &projectpkg.ProjectUpdateRequest{Project: updated}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			RecordChange(ctx, live, updated)
+			return updated, nil
+		})
+		require.NotNil(t, record)
+		assert.JSONEq(t, `{"spec":{"sourceRepos":["*"]}}`, string(record.Diff))
+		assert.Equal(t, "project/default", record.Resource)
+	})
+	t.Run("TranslatedError", func(t *testing.T) {
+		name := "guestbook"
+		record := call(ctx, "/application.ApplicationService/Delete", &applicationpkg.ApplicationDeleteRequest{Name: &name}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, apierr.NewNotFound(schema.GroupResource{Group: "argoproj.io", Resource: "applications"}, name)
+		})
+		require.NotNil(t, record)
+		assert.Equal(t, "NotFound", record.Code)
+	})
+	t.Run("UntrustedForwardedFor", func(t *testing.T) {
+		untrustedCtx := peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("172.16.0.1"), Port: 41234}})
+		name := "guestbook"
+		record := call(untrustedCtx, "/application.ApplicationService/Sync", &applicationpkg.ApplicationSyncRequest{Name: &name}, ok)
+		require.NotNil(t, record)
+		assert.Equal(t, "172.16.0.1", record.ClientIP)
+	})
+	t.Run("OmittedRequest", func(t *testing.T) {
+		record := call(context.Background(), "/session.SessionService/Create", &sessionpkg.SessionCreateRequest{Username: "admin", Password: "password"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Errorf(codes.Unauthenticated, "Invalid username or password")
+		})
+		require.NotNil(t, record)
+		assert.Equal(t, "admin", record.User)
+		assert.Empty(t, record.Request)
+		assert.Equal(t, "Unauthenticated", record.Code)
+	})
+	t.Run("Disabled", func(t *testing.T) {
+		interceptor := UnaryServerInterceptor(NewRecorder(), nil, nil, nil)
+		resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/application.ApplicationService/Sync"}, ok)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []interface{}
+}
+
+func (s *fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeServerStream) RecvMsg(m interface{}) error {
+	if len(s.requests) == 0 {
+		return io.EOF
+	}
+	*m.(*applicationpkg.ApplicationSyncRequest) = *s.requests[0].(*applicationpkg.ApplicationSyncRequest)
+	s.requests = s.requests[1:]
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	sink := &fakeSink{}
+	interceptor := StreamServerInterceptor(NewRecorder(sink), func(ctx context.Context) []string {
+		return nil
+	}, func(fullMethod string) bool {
+		return false
+	}, nil)
+	// nolint:staticcheck
+	ctx := context.WithValue(context.Background(), "claims", jwt.MapClaims{"iss": "argocd", "sub": "admin"})
+	name, revision := "guestbook", "HEAD"
+	stream := &fakeServerStream{ctx: ctx, requests: []interface{}{&applicationpkg.ApplicationSyncRequest{Name: &name, Revision: &revision}}}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/Sync"}, func(srv interface{}, ss grpc.ServerStream) error {
+		_, ok := grpc_util.SanitizerFromContext(ss.Context())
+		assert.True(t, ok)
+		for {
+			var req applicationpkg.ApplicationSyncRequest
+			if err := ss.RecvMsg(&req); err != nil {
+				break
+			}
+		}
+		return status.Errorf(codes.FailedPrecondition, "sync failed")
+	})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	require.Len(t, sink.records, 1)
+	record := sink.records[0]
+	assert.Equal(t, "admin", record.User)
+	assert.Equal(t, "application/guestbook", record.Resource)
+	assert.JSONEq(t, `{"name":"guestbook","revision":"HEAD"}`, string(record.Request))
+	assert.Equal(t, "FailedPrecondition", record.Code)
+
+	sink.records = nil
+	err = interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/application.ApplicationService/PodLogs"}, func(srv interface{}, ss grpc.ServerStream) error {
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Empty(t, sink.records)
+}
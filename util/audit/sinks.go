@@ -0,0 +1,220 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+const (
+	// maxRecordSize is the maximum size of the records read from audit log files
+	maxRecordSize = 10 * 1024 * 1024
+	// DefaultQueryLimit is the number of records returned by queries without limit
+	DefaultQueryLimit = 100
+	// MaxQueryLimit is the maximum number of records returned by a query
+	MaxQueryLimit = 1000
+	// webhookQueueSize is the number of records buffered for delivery to a webhook
+	webhookQueueSize = 1000
+	webhookTimeout   = 10 * time.Second
+)
+
+// writerSink writes records as JSON lines to a writer, e.g. os.Stdout
+type writerSink struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// NewWriterSink returns a sink which writes records as JSON lines to the given writer
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+func (s *writerSink) Write(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err = s.w.Write(append(data, '\n'))
+	return err
+}
+
+// FileSink appends records as JSON lines to a file, which can be queried
+type FileSink struct {
+	writerSink
+	path string
+}
+
+// NewFileSink returns a sink which appends records to the file with the given path
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	return &FileSink{writerSink: writerSink{w: file}, path: path}, nil
+}
+
+// Query filters audit records
+type Query struct {
+	// User matches the user or the RBAC subject of the records
+	User string
+	// Method is a glob pattern matching the method of the records, e.g. /application.ApplicationService/*
+	Method string
+	// Resource is a glob pattern matching the resource of the records, e.g. application/guestbook*
+	Resource string
+	// Since excludes records which were recorded before the given time
+	Since time.Time
+	// Limit is the maximum number of records to return, defaults to DefaultQueryLimit and is capped at MaxQueryLimit
+	Limit int
+}
+
+func (q *Query) matches(record *Record) bool {
+	switch {
+	case q.User != "" && q.User != record.User && q.User != record.Subject:
+		return false
+	case q.Method != "" && !glob.Match(q.Method, record.Method):
+		return false
+	case q.Resource != "" && !glob.Match(q.Resource, record.Resource):
+		return false
+	case !q.Since.IsZero() && record.Time.Before(q.Since):
+		return false
+	}
+	return true
+}
+
+// Query returns the most recent records matching the query, the most recent first
+func (s *FileSink) Query(q Query) ([]Record, error) {
+	limit := q.Limit
+	switch {
+	case limit < 0:
+		return nil, fmt.Errorf("invalid audit log query limit %d: must not be negative", limit)
+	case limit == 0:
+		limit = DefaultQueryLimit
+	case limit > MaxQueryLimit:
+		limit = MaxQueryLimit
+	}
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit log file: %w", err)
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	// keep the last matching records in a ring buffer, which only grows up to the limit
+	var ring []Record
+	count := 0
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxRecordSize)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			log.Warnf("Skipping malformed audit record: %v", err)
+			continue
+		}
+		if q.matches(&record) {
+			if len(ring) < limit {
+				ring = append(ring, record)
+			} else {
+				ring[count%limit] = record
+			}
+			count++
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log file: %w", err)
+	}
+
+	var records []Record
+	for i := 1; i <= len(ring); i++ {
+		records = append(records, ring[(count-i)%limit])
+	}
+	return records, nil
+}
+
+// webhookSink posts each record as JSON to a webhook. Records are delivered asynchronously, so that a slow webhook
+// does not delay the audited calls.
+type webhookSink struct {
+	url     string
+	headers http.Header
+	client  *http.Client
+	queue   chan *Record
+}
+
+// NewWebhookSink returns a sink which posts records to the given URL. Headers are given in the form <name>: <value>,
+// e.g. to authenticate with the webhook.
+func NewWebhookSink(url string, headers []string) (Sink, error) {
+	header := http.Header{}
+	for _, h := range headers {
+		parts := strings.SplitN(h, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid audit log webhook header '%s', expected <name>: <value>", h)
+		}
+		header.Add(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]))
+	}
+	s := &webhookSink{
+		url:     url,
+		headers: header,
+		client:  &http.Client{Timeout: webhookTimeout},
+		queue:   make(chan *Record, webhookQueueSize),
+	}
+	go s.run()
+	return s, nil
+}
+
+func (s *webhookSink) Write(record *Record) error {
+	select {
+	case s.queue <- record:
+		return nil
+	default:
+		return fmt.Errorf("audit log webhook queue is full")
+	}
+}
+
+func (s *webhookSink) run() {
+	for record := range s.queue {
+		if err := s.post(record); err != nil {
+			log.Warnf("Failed to deliver audit record of %s to webhook: %v", record.Method, err)
+		}
+	}
+}
+
+func (s *webhookSink) post(record *Record) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header = s.headers.Clone()
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %s", resp.Status)
+	}
+	return nil
+}
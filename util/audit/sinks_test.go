@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+	require.NoError(t, sink.Write(&Record{Method: "/application.ApplicationService/Sync", Code: "OK"}))
+	require.NoError(t, sink.Write(&Record{Method: "/application.ApplicationService/Delete", Code: "NotFound"}))
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+	var record Record
+	require.NoError(t, json.Unmarshal(lines[1], &record))
+	assert.Equal(t, "/application.ApplicationService/Delete", record.Method)
+	assert.Equal(t, "NotFound", record.Code)
+}
+
+func TestFileSink_Query(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := NewFileSink(path)
+	require.NoError(t, err)
+
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	records := []Record{
+		{Time: start, User: "admin", Subject: "admin", Method: "/application.ApplicationService/Create", Resource: "application/guestbook"},
+		{Time: start.Add(time.Minute), User: "alice@example.com", Subject: "CiQwOGE4Njg0", Method: "/application.ApplicationService/Sync", Resource: "application/guestbook"},
+		{Time: start.Add(2 * time.Minute), User: "admin", Subject: "admin", Method: "/project.ProjectService/Update", Resource: "project/default"},
+		{Time: start.Add(3 * time.Minute), User: "admin", Subject: "admin", Method: "/application.ApplicationService/Sync", Resource: "application/other"},
+	}
+	for i := range records {
+		require.NoError(t, sink.Write(&records[i]))
+	}
+	// malformed lines are skipped
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0600)
+	require.NoError(t, err)
+	_, err = file.WriteString("{not json\n")
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	query := func(q Query) []string {
+		res, err := sink.Query(q)
+		require.NoError(t, err)
+		methods := make([]string, 0, len(res))
+		for _, r := range res {
+			methods = append(methods, r.Method+" "+r.Resource)
+		}
+		return methods
+	}
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/other",
+		"/project.ProjectService/Update project/default",
+		"/application.ApplicationService/Sync application/guestbook",
+		"/application.ApplicationService/Create application/guestbook",
+	}, query(Query{}))
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/other",
+		"/project.ProjectService/Update project/default",
+	}, query(Query{Limit: 2}))
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/guestbook",
+	}, query(Query{User: "alice@example.com"}))
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/guestbook",
+	}, query(Query{User: "CiQwOGE4Njg0"}))
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/other",
+		"/application.ApplicationService/Sync application/guestbook",
+	}, query(Query{Method: "*/Sync"}))
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/guestbook",
+		"/application.ApplicationService/Create application/guestbook",
+	}, query(Query{Resource: "application/guest*"}))
+	assert.Equal(t, []string{
+		"/application.ApplicationService/Sync application/other",
+		"/project.ProjectService/Update project/default",
+	}, query(Query{Since: start.Add(2 * time.Minute)}))
+	// the limit is capped instead of being allocated upfront
+	assert.Len(t, query(Query{Limit: math.MaxInt}), 4)
+
+	_, err = sink.Query(Query{Limit: -1})
+	assert.ErrorContains(t, err, "must not be negative")
+}
+
+func TestNewFileSink_InvalidPath(t *testing.T) {
+	_, err := NewFileSink(filepath.Join(t.TempDir(), "missing", "audit.log"))
+	assert.ErrorContains(t, err, "failed to open audit log file")
+}
+
+func TestWebhookSink(t *testing.T) {
+	received := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- r
+		bodies <- body
+	}))
+	defer server.Close()
+
+	_, err := NewWebhookSink(server.URL, []string{"invalid"})
+	assert.ErrorContains(t, err, "invalid audit log webhook header")
+
+	sink, err := NewWebhookSink(server.URL, []string{"Authorization: Bearer abc"})
+	require.NoError(t, err)
+	require.NoError(t, sink.Write(&Record{Method: "/application.ApplicationService/Sync", User: "admin"}))
+
+	select {
+	case req := <-received:
+		assert.Equal(t, http.MethodPost, req.Method)
+		assert.Equal(t, "Bearer abc", req.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		var record Record
+		require.NoError(t, json.Unmarshal(<-bodies, &record))
+		assert.Equal(t, "admin", record.User)
+	case <-time.After(5 * time.Second):
+		t.Fatal("record was not delivered to the webhook")
+	}
+}
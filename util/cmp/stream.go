@@ -42,19 +42,38 @@ func ReceiveRepoStream(ctx context.Context, receiver StreamReceiver, destDir str
 	}
 	metadata := header.GetMetadata()
 
-	tgzFile, err := receiveFile(ctx, receiver, metadata.GetChecksum(), destDir)
+	recvChunk := func() ([]byte, error) {
+		req, err := receiver.Recv()
+		if err != nil {
+			return nil, err
+		}
+		f := req.GetFile()
+		if f == nil {
+			return nil, fmt.Errorf("stream request file is nil")
+		}
+		return f.Chunk, nil
+	}
+	err = ReceiveCompressedFiles(ctx, recvChunk, metadata.GetChecksum(), destDir, 0)
 	if err != nil {
-		return nil, fmt.Errorf("error receiving tgz file: %w", err)
+		return nil, err
 	}
-	err = files.Untgz(destDir, tgzFile)
+	return metadata, nil
+}
+
+// ReceiveCompressedFiles receives a tgz file sent in chunks and decompresses it in destDir. recvChunk must
+// return io.EOF once all the chunks have been received. Returns an error if the checksum of the received file
+// doesn't match the given one, or if its size exceeds maxSize when maxSize is greater than zero.
+func ReceiveCompressedFiles(ctx context.Context, recvChunk func() ([]byte, error), checksum, destDir string, maxSize int64) error {
+	tgzFile, err := receiveFile(ctx, recvChunk, checksum, destDir, maxSize)
 	if err != nil {
-		return nil, fmt.Errorf("error decompressing tgz file: %w", err)
+		return fmt.Errorf("error receiving tgz file: %w", err)
 	}
-	err = os.Remove(tgzFile.Name())
+	defer closeAndDelete(tgzFile)
+	err = files.Untgz(destDir, tgzFile)
 	if err != nil {
-		log.Warnf("error removing the tgz file %q: %s", tgzFile.Name(), err)
+		return fmt.Errorf("error decompressing tgz file: %w", err)
 	}
-	return metadata, nil
+	return nil
 }
 
 // SenderOption defines the function type to by used by specific options
@@ -84,10 +103,33 @@ func WithTarDoneChan(ch chan<- bool) SenderOption {
 // SendRepoStream will compress the files under the given repoPath and send
 // them using the plugin stream sender.
 func SendRepoStream(ctx context.Context, appPath, repoPath string, sender StreamSender, env []string, excludedGlobs []string, opts ...SenderOption) error {
+	appRelPath, err := files.RelativePath(appPath, repoPath)
+	if err != nil {
+		return fmt.Errorf("error building app relative path: %s", err)
+	}
+	sendMetadata := func(checksum string, size int64) error {
+		// send metadata first
+		mr := appMetadataRequest(filepath.Base(appPath), appRelPath, env, checksum, size)
+		err := sender.Send(mr)
+		if err != nil {
+			return fmt.Errorf("error sending generate manifest metadata to cmp-server: %w", err)
+		}
+		return nil
+	}
+	sendChunk := func(chunk []byte) error {
+		return sender.Send(appFileRequest(chunk))
+	}
+	return SendCompressedFiles(ctx, repoPath, excludedGlobs, sendMetadata, sendChunk, opts...)
+}
+
+// SendCompressedFiles will compress the files under the given dir in a tgz
+// file and send it in chunks. sendMetadata is called with the checksum and
+// the size of the tgz file before any chunk is sent.
+func SendCompressedFiles(ctx context.Context, dir string, excludedGlobs []string, sendMetadata func(checksum string, size int64) error, sendChunk func(chunk []byte) error, opts ...SenderOption) error {
 	opt := newSenderOption(opts...)
 
-	// compress all files in appPath in tgz
-	tgz, checksum, err := compressFiles(repoPath, excludedGlobs)
+	// compress all files in dir in tgz
+	tgz, checksum, err := compressFiles(dir, excludedGlobs)
 	if err != nil {
 		return fmt.Errorf("error compressing repo files: %w", err)
 	}
@@ -101,28 +143,22 @@ func SendRepoStream(ctx context.Context, appPath, repoPath string, sender Stream
 	if err != nil {
 		return fmt.Errorf("error getting tgz stat: %w", err)
 	}
-	appRelPath, err := files.RelativePath(appPath, repoPath)
+	err = sendMetadata(checksum, fi.Size())
 	if err != nil {
-		return fmt.Errorf("error building app relative path: %s", err)
-	}
-	// send metadata first
-	mr := appMetadataRequest(filepath.Base(appPath), appRelPath, env, checksum, fi.Size())
-	err = sender.Send(mr)
-	if err != nil {
-		return fmt.Errorf("error sending generate manifest metadata to cmp-server: %w", err)
+		return err
 	}
 
 	// send the compressed file
-	err = sendFile(ctx, sender, tgz, opt)
+	err = sendFile(ctx, sendChunk, tgz, opt)
 	if err != nil {
-		return fmt.Errorf("error sending tgz file to cmp-server: %w", err)
+		return fmt.Errorf("error sending tgz file: %w", err)
 	}
 	return nil
 }
 
 // sendFile will send the file over the gRPC stream using a
 // buffer.
-func sendFile(ctx context.Context, sender StreamSender, file *os.File, opt *senderOption) error {
+func sendFile(ctx context.Context, sendChunk func(chunk []byte) error, file *os.File, opt *senderOption) error {
 	reader := bufio.NewReader(file)
 	chunk := make([]byte, opt.chunkSize)
 	for {
@@ -133,9 +169,8 @@ func sendFile(ctx context.Context, sender StreamSender, file *os.File, opt *send
 		}
 		n, err := reader.Read(chunk)
 		if n > 0 {
-			fr := appFileRequest(chunk[:n])
-			if e := sender.Send(fr); e != nil {
-				return fmt.Errorf("error sending stream: %w", err)
+			if e := sendChunk(chunk[:n]); e != nil {
+				return fmt.Errorf("error sending stream: %w", e)
 			}
 		}
 		if err != nil {
@@ -193,41 +228,49 @@ func compressFiles(appPath string, excluded []string) (*os.File, string, error)
 }
 
 // receiveFile will receive the file from the gRPC stream and save it in the dst folder.
-// Returns error if checksum doesn't match the one provided in the fileMetadata.
+// Returns error if checksum doesn't match the one provided in the fileMetadata, or if
+// the size of the file exceeds maxSize when maxSize is greater than zero.
 // It is responsibility of the caller to close the returned file.
-func receiveFile(ctx context.Context, receiver StreamReceiver, checksum, dst string) (*os.File, error) {
+func receiveFile(ctx context.Context, recvChunk func() ([]byte, error), checksum, dst string, maxSize int64) (*os.File, error) {
 	hasher := sha256.New()
 	file, err := os.CreateTemp(dst, "")
 	if err != nil {
 		return nil, fmt.Errorf("error creating file: %w", err)
 	}
+	var size int64
 	for {
 		if ctx != nil {
 			if err := ctx.Err(); err != nil {
+				closeAndDelete(file)
 				return nil, fmt.Errorf("stream context error: %w", err)
 			}
 		}
-		req, err := receiver.Recv()
+		chunk, err := recvChunk()
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
+			closeAndDelete(file)
 			return nil, fmt.Errorf("stream Recv error: %w", err)
 		}
-		f := req.GetFile()
-		if f == nil {
-			return nil, fmt.Errorf("stream request file is nil")
+		size += int64(len(chunk))
+		if maxSize > 0 && size > maxSize {
+			closeAndDelete(file)
+			return nil, fmt.Errorf("file exceeds the maximum size of %d bytes", maxSize)
 		}
-		_, err = file.Write(f.Chunk)
+		_, err = file.Write(chunk)
 		if err != nil {
+			closeAndDelete(file)
 			return nil, fmt.Errorf("error writing file: %w", err)
 		}
-		_, err = hasher.Write(f.Chunk)
+		_, err = hasher.Write(chunk)
 		if err != nil {
+			closeAndDelete(file)
 			return nil, fmt.Errorf("error writing hasher: %w", err)
 		}
 	}
 	if hex.EncodeToString(hasher.Sum(nil)) != checksum {
+		closeAndDelete(file)
 		return nil, fmt.Errorf("file checksum validation error")
 	}
 
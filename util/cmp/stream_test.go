@@ -82,6 +82,48 @@ func TestReceiveApplicationStream(t *testing.T) {
 	})
 }
 
+func TestSendAndReceiveCompressedFiles(t *testing.T) {
+	appDir := filepath.Join(getTestDataDir(t), "app")
+	var checksum string
+	var chunks [][]byte
+	sendMetadata := func(c string, _ int64) error {
+		checksum = c
+		return nil
+	}
+	sendChunk := func(chunk []byte) error {
+		chunks = append(chunks, append([]byte{}, chunk...))
+		return nil
+	}
+	require.NoError(t, cmp.SendCompressedFiles(context.Background(), appDir, []string{"DUMMY.md", "dum*"}, sendMetadata, sendChunk))
+	require.NotEmpty(t, chunks)
+	recvChunk := func() func() ([]byte, error) {
+		i := 0
+		return func() ([]byte, error) {
+			if i == len(chunks) {
+				return nil, io.EOF
+			}
+			i++
+			return chunks[i-1], nil
+		}
+	}
+
+	t.Run("will receive the files successfully", func(t *testing.T) {
+		workdir := t.TempDir()
+		err := cmp.ReceiveCompressedFiles(context.Background(), recvChunk(), checksum, workdir, 0)
+		require.NoError(t, err)
+		assert.FileExists(t, filepath.Join(workdir, "README.md"))
+		assert.NoFileExists(t, filepath.Join(workdir, "DUMMY.md"))
+	})
+	t.Run("will fail if the checksum does not match", func(t *testing.T) {
+		err := cmp.ReceiveCompressedFiles(context.Background(), recvChunk(), "invalid", t.TempDir(), 0)
+		assert.ErrorContains(t, err, "checksum validation error")
+	})
+	t.Run("will fail if the file exceeds the maximum size", func(t *testing.T) {
+		err := cmp.ReceiveCompressedFiles(context.Background(), recvChunk(), checksum, t.TempDir(), 1)
+		assert.ErrorContains(t, err, "exceeds the maximum size")
+	})
+}
+
 func (m *streamMock) sendFile(ctx context.Context, t *testing.T, basedir string, sender cmp.StreamSender, env []string, excludedGlobs []string) {
 	t.Helper()
 	defer func() {
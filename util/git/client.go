@@ -69,8 +69,16 @@ type Client interface {
 	CommitSHA() (string, error)
 	RevisionMetadata(revision string) (*RevisionMetadata, error)
 	VerifyCommitSignature(string) (string, error)
+	VerifySignature(revision string, objectType string) (string, error)
+	VerifyGitsignSignature(revision string, objectType string, identity string, issuer string) (string, error)
 }
 
+// Types of Git objects whose signature can be verified
+const (
+	SignedObjectCommit = "commit"
+	SignedObjectTag    = "tag"
+)
+
 type EventHandlers struct {
 	OnLsRemote func(repo string) func()
 	OnFetch    func(repo string) func()
@@ -606,7 +614,8 @@ func (m *nativeGitClient) RevisionMetadata(revision string) (*RevisionMetadata,
 	return &RevisionMetadata{author, time.Unix(authorDateUnixTimestamp, 0), tags, message}, nil
 }
 
-// VerifyCommitSignature Runs verify-commit on a given revision and returns the output
+// VerifyCommitSignature Runs verify-commit on a given revision and returns the output. If an annotated tag points to
+// the revision, verify-tag is run instead.
 func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error) {
 	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", revision)
 	if err != nil {
@@ -615,10 +624,46 @@ func (m *nativeGitClient) VerifyCommitSignature(revision string) (string, error)
 	return out, nil
 }
 
+// VerifySignature Runs verify-commit or verify-tag, depending on the object type, on a given revision and returns the
+// output. The output is empty if the object is not signed, or if a tag is to be verified but the revision is not an
+// annotated tag.
+func (m *nativeGitClient) VerifySignature(revision string, objectType string) (string, error) {
+	if objectType != SignedObjectCommit && objectType != SignedObjectTag {
+		return "", fmt.Errorf("cannot verify signature of object type '%s'", objectType)
+	}
+	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", revision, objectType)
+	if err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
+// VerifyGitsignSignature runs gitsign verify or verify-tag, depending on the object type, on a given revision and
+// returns the output. Unlike git verify-commit and verify-tag, gitsign validates that the signing certificate was
+// issued for the given identity by the given OIDC issuer.
+func (m *nativeGitClient) VerifyGitsignSignature(revision string, objectType string, identity string, issuer string) (string, error) {
+	if objectType != SignedObjectCommit && objectType != SignedObjectTag {
+		return "", fmt.Errorf("cannot verify signature of object type '%s'", objectType)
+	}
+	out, err := m.runGnuPGWrapper("git-verify-wrapper.sh", revision, objectType, identity, issuer)
+	if err != nil {
+		return "", err
+	}
+	return out, nil
+}
+
 // runWrapper runs a custom command with all the semantics of running the Git client
 func (m *nativeGitClient) runGnuPGWrapper(wrapper string, args ...string) (string, error) {
 	cmd := exec.Command(wrapper, args...)
 	cmd.Env = append(cmd.Env, fmt.Sprintf("GNUPGHOME=%s", common.GetGnuPGHomePath()), "LANG=C")
+	// SSH signatures are verified against the allowed signers file, gitsign signatures through the x509 program
+	cmd.Env = append(cmd.Env,
+		"GIT_CONFIG_COUNT=2",
+		"GIT_CONFIG_KEY_0=gpg.ssh.allowedSignersFile",
+		fmt.Sprintf("GIT_CONFIG_VALUE_0=%s", common.GetSSHAllowedSignersPath()),
+		"GIT_CONFIG_KEY_1=gpg.x509.program",
+		"GIT_CONFIG_VALUE_1=gitsign",
+	)
 	return m.runCmdOutput(cmd)
 }
 
@@ -236,3 +236,45 @@ func (_m *Client) VerifyCommitSignature(_a0 string) (string, error) {
 
 	return r0, r1
 }
+
+// VerifyGitsignSignature provides a mock function with given fields: revision, objectType, identity, issuer
+func (_m *Client) VerifyGitsignSignature(revision string, objectType string, identity string, issuer string) (string, error) {
+	ret := _m.Called(revision, objectType, identity, issuer)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, string, string) string); ok {
+		r0 = rf(revision, objectType, identity, issuer)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(revision, objectType, identity, issuer)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifySignature provides a mock function with given fields: revision, objectType
+func (_m *Client) VerifySignature(revision string, objectType string) (string, error) {
+	ret := _m.Called(revision, objectType)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(revision, objectType)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(revision, objectType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -0,0 +1,96 @@
+package gpg
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Regular expression to match a good gitsign signature, with the certificate identity and optionally its issuer
+var gitsignGoodSignatureMatch = regexp.MustCompile(`^gitsign: Good signature from \[([^\]]+)\](?:\(([^)]+)\))?$`)
+
+// Regular expression to match the certificate a gitsign signature was made with
+var gitsignCertificateMatch = regexp.MustCompile(`^gitsign: Signature made using certificate ID (0x[0-9a-fA-F]+)`)
+
+// Regular expression to match the results of validating a gitsign signature, its transparency log entry and the claims
+// of its certificate. Certificate claims are only validated by gitsign verify, not by git verify-commit.
+var gitsignValidatedMatch = regexp.MustCompile(`^Validated (Git signature|Rekor entry|Certificate claims): (true|false)$`)
+
+const (
+	gitsignCertificateClaims  = "Certificate claims"
+	gitsignClaimsNotValidated = "Certificate claims could not be validated, the signer does not match the identity and issuer of any gitsign key"
+)
+
+// gitsignVerifier handles keyless Sigstore signatures made with gitsign, as configured with gpg.format=x509
+type gitsignVerifier struct{}
+
+func (v *gitsignVerifier) Type() string {
+	return SignatureKeyTypeGitsign
+}
+
+func (v *gitsignVerifier) ParseVerification(output string) (PGPVerifyResult, bool) {
+	result := PGPVerifyResult{Result: VerifyResultUnknown, Cipher: "x509", Trust: TrustUnknown}
+	found := false
+	good := false
+	claimsValidated := false
+	linesParsed := 0
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() && linesParsed < MaxVerificationLinesToParse {
+		linesParsed += 1
+		line := strings.TrimSpace(scanner.Text())
+
+		if cert := gitsignCertificateMatch.FindStringSubmatch(line); len(cert) == 2 {
+			found = true
+			result.Message = fmt.Sprintf("Signature made using certificate ID %s", cert[1])
+		} else if sig := gitsignGoodSignatureMatch.FindStringSubmatch(line); len(sig) == 3 {
+			found = true
+			good = true
+			result.KeyID = sig[1]
+			result.Identity = sig[1]
+			result.Issuer = sig[2]
+		} else if validated := gitsignValidatedMatch.FindStringSubmatch(line); len(validated) == 3 {
+			found = true
+			if validated[2] != "true" {
+				result.Result = VerifyResultBad
+				result.Message = fmt.Sprintf("%s could not be validated", validated[1])
+				if validated[1] == gitsignCertificateClaims {
+					result.Message = gitsignClaimsNotValidated
+				}
+				return result, true
+			}
+			if validated[1] == gitsignCertificateClaims {
+				claimsValidated = true
+			}
+		} else if strings.HasPrefix(line, "gitsign: ") || strings.HasPrefix(line, "error: gitsign") {
+			found = true
+			result.Result = VerifyResultBad
+			result.Message = line
+			return result, true
+		}
+	}
+	if !found {
+		return PGPVerifyResult{}, false
+	}
+	if good && !claimsValidated {
+		// anyone can obtain a certificate for the identity from some issuer, so the signature alone proves nothing
+		result.Result = VerifyResultBad
+		result.Message = gitsignClaimsNotValidated
+	} else if good {
+		result.Result = VerifyResultGood
+		result.Trust = TrustFull
+		result.Message = fmt.Sprintf("Success verifying the commit signature, certificate issued by %s.", result.Issuer)
+	} else if result.Message == "" {
+		result.Message = "Could not parse output of gitsign verification."
+	}
+	return result, true
+}
+
+// NormalizeKeyID returns the certificate identity, usually an e-mail address, the signature must have been made with
+func (v *gitsignVerifier) NormalizeKeyID(keyID string) (string, error) {
+	keyID = strings.TrimSpace(keyID)
+	if keyID == "" || strings.ContainsAny(keyID, " \t[]()") {
+		return "", fmt.Errorf("'%s' is not a valid gitsign signer identity", keyID)
+	}
+	return keyID, nil
+}
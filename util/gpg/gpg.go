@@ -103,12 +103,16 @@ func IsShortKeyID(k string) bool {
 
 // Result of a git commit verification
 type PGPVerifyResult struct {
+	// Type of the key the signature was made with, see SignatureKeyTypeGPG and friends
+	Type string
 	// Date the signature was made
 	Date string
 	// KeyID the signature was made with
 	KeyID string
 	// Identity
 	Identity string
+	// Issuer of the certificate the signature was made with, only set for gitsign signatures
+	Issuer string
 	// Trust level of the key
 	Trust string
 	// Cipher of the key the signature was made with
@@ -255,6 +259,11 @@ func InitializeGnuPG() error {
 		return fmt.Errorf("could not create canary: %v", err)
 	}
 
+	err = initializeAllowedSigners()
+	if err != nil {
+		return fmt.Errorf("could not initialize SSH allowed signers: %v", err)
+	}
+
 	f, err := os.CreateTemp("", "gpg-key-recipe")
 	if err != nil {
 		return err
@@ -0,0 +1,86 @@
+package gpg
+
+import (
+	"bufio"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// Regular expression to match a good SSH signature as reported by git, with or without a principal from the allowed
+// signers file. The principal may contain whitespace, hence the greedy match up to the last " with ".
+var sshGoodSignatureMatch = regexp.MustCompile(`^Good "git" signature (?:for (.+) )?with ([A-Za-z0-9-]+) key (SHA256:[A-Za-z0-9+/=]+)$`)
+
+// Regular expression to match the SHA256 fingerprint of an SSH key
+var sshFingerprintMatch = regexp.MustCompile(`^SHA256:[A-Za-z0-9+/]{43}=?$`)
+
+// Prefixes of the messages emitted by git or ssh-keygen when an SSH signature could not be verified
+var sshBadSignaturePrefixes = []string{
+	"Signature verification failed",
+	"Could not verify signature",
+}
+
+// Prefixes of the messages emitted by git when SSH signature verification cannot be performed at all
+var sshUnknownSignaturePrefixes = []string{
+	"error: gpg.ssh.allowedSignersFile",
+	"error: ssh-keygen",
+}
+
+// sshVerifier handles signatures made with SSH keys, as configured with gpg.format=ssh
+type sshVerifier struct{}
+
+func (v *sshVerifier) Type() string {
+	return SignatureKeyTypeSSH
+}
+
+func (v *sshVerifier) ParseVerification(output string) (PGPVerifyResult, bool) {
+	linesParsed := 0
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() && linesParsed < MaxVerificationLinesToParse {
+		linesParsed += 1
+		line := strings.TrimSpace(scanner.Text())
+
+		if good := sshGoodSignatureMatch.FindStringSubmatch(line); len(good) == 4 {
+			result := PGPVerifyResult{
+				Result:   VerifyResultGood,
+				Identity: good[1],
+				Cipher:   good[2],
+				KeyID:    good[3],
+				Trust:    TrustUnknown,
+				Message:  "Success verifying the commit signature.",
+			}
+			// a principal is only reported for keys listed in the allowed signers file
+			if result.Identity != "" {
+				result.Trust = TrustFull
+			}
+			return result, true
+		}
+		for _, prefix := range sshBadSignaturePrefixes {
+			if strings.HasPrefix(line, prefix) {
+				return PGPVerifyResult{Result: VerifyResultBad, Trust: TrustUnknown, Message: line}, true
+			}
+		}
+		for _, prefix := range sshUnknownSignaturePrefixes {
+			if strings.HasPrefix(line, prefix) {
+				return PGPVerifyResult{Result: VerifyResultUnknown, Message: line}, true
+			}
+		}
+	}
+	return PGPVerifyResult{}, false
+}
+
+// NormalizeKeyID accepts either the SHA256 fingerprint of an SSH key or the public key in authorized_keys format, and
+// returns the fingerprint
+func (v *sshVerifier) NormalizeKeyID(keyID string) (string, error) {
+	keyID = strings.TrimSpace(keyID)
+	if sshFingerprintMatch.MatchString(keyID) {
+		return strings.TrimSuffix(keyID, "="), nil
+	}
+	pubKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(keyID))
+	if err != nil {
+		return "", fmt.Errorf("%s is neither a valid SSH public key nor a SHA256 key fingerprint", keyID)
+	}
+	return ssh.FingerprintSHA256(pubKey), nil
+}
@@ -33,6 +33,15 @@ var (
 	//go:embed garbage.asc
 	Garbage_asc string
 
+	//go:embed gitsign_bad_signature.txt
+	Gitsign_bad_signature_txt string
+
+	//go:embed gitsign_good_signature.txt
+	Gitsign_good_signature_txt string
+
+	//go:embed gitsign_unvalidated_claims.txt
+	Gitsign_unvalidated_claims_txt string
+
 	//go:embed github.asc
 	Github_asc string
 
@@ -51,6 +60,21 @@ var (
 	//go:embed multi2.asc
 	Multi2_asc string
 
+	//go:embed ssh_bad_signature.txt
+	Ssh_bad_signature_txt string
+
+	//go:embed ssh_good_signature.txt
+	Ssh_good_signature_txt string
+
+	//go:embed ssh_good_signature_principal.txt
+	Ssh_good_signature_principal_txt string
+
+	//go:embed ssh_no_allowed_signers.txt
+	Ssh_no_allowed_signers_txt string
+
+	//go:embed ssh_public_key.pub
+	Ssh_public_key_pub string
+
 	//go:embed unknown_signature1.txt
 	Unknown_signature1_txt string
 
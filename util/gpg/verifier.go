@@ -0,0 +1,226 @@
+package gpg
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+// Supported types of signature keys
+const (
+	SignatureKeyTypeGPG     = "gpg"
+	SignatureKeyTypeSSH     = "ssh"
+	SignatureKeyTypeGitsign = "gitsign"
+)
+
+// SignatureVerifier interprets the outcome of a Git signature verification for one type of signature keys
+type SignatureVerifier interface {
+	// Type returns the type of signature keys the verifier is responsible for
+	Type() string
+	// ParseVerification parses the output of git verify-commit or git verify-tag. The second return value is false if
+	// the output was not produced by a signature of the verifier's type.
+	ParseVerification(output string) (PGPVerifyResult, bool)
+	// NormalizeKeyID returns the canonical form of the given key ID, or an error if it is not valid for this verifier
+	NormalizeKeyID(keyID string) (string, error)
+}
+
+var (
+	verifiersLock sync.RWMutex
+	verifiers     = map[string]SignatureVerifier{}
+	// verifierTypes keeps the registration order, so that parsing is deterministic
+	verifierTypes []string
+)
+
+func init() {
+	RegisterSignatureVerifier(&gpgVerifier{})
+	RegisterSignatureVerifier(&sshVerifier{})
+	RegisterSignatureVerifier(&gitsignVerifier{})
+}
+
+// RegisterSignatureVerifier makes a verifier available for signature keys of its type. An already registered verifier
+// of the same type is replaced.
+func RegisterSignatureVerifier(v SignatureVerifier) {
+	verifiersLock.Lock()
+	defer verifiersLock.Unlock()
+	if _, ok := verifiers[v.Type()]; !ok {
+		verifierTypes = append(verifierTypes, v.Type())
+	}
+	verifiers[v.Type()] = v
+}
+
+// GetSignatureVerifier returns the verifier for the given type of signature keys. An empty type denotes GnuPG keys.
+func GetSignatureVerifier(keyType string) (SignatureVerifier, error) {
+	if keyType == "" {
+		keyType = SignatureKeyTypeGPG
+	}
+	verifiersLock.RLock()
+	defer verifiersLock.RUnlock()
+	v, ok := verifiers[keyType]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signature key type '%s'", keyType)
+	}
+	return v, nil
+}
+
+// ParseSignatureVerification parses the output of a Git signature verification with the verifier that produced it.
+// GnuPG is tried last, since its parser reports an unknown result for any output it does not understand.
+func ParseSignatureVerification(output string) PGPVerifyResult {
+	verifiersLock.RLock()
+	candidates := make([]SignatureVerifier, 0, len(verifierTypes))
+	for _, t := range verifierTypes {
+		if t != SignatureKeyTypeGPG {
+			candidates = append(candidates, verifiers[t])
+		}
+	}
+	gpgV := verifiers[SignatureKeyTypeGPG]
+	verifiersLock.RUnlock()
+
+	for _, v := range candidates {
+		if res, ok := v.ParseVerification(output); ok {
+			res.Type = v.Type()
+			return res
+		}
+	}
+	res, _ := gpgV.ParseVerification(output)
+	res.Type = SignatureKeyTypeGPG
+	return res
+}
+
+// SignatureInfo returns a short, human readable description of the signer from the output of a Git signature
+// verification
+func SignatureInfo(output string) string {
+	if output == "" {
+		return "Revision is not signed."
+	}
+	vr := ParseSignatureVerification(output)
+	if vr.Result == VerifyResultUnknown {
+		return fmt.Sprintf("UNKNOWN signature: %s", vr.Message)
+	}
+	if vr.Type == SignatureKeyTypeGPG {
+		return fmt.Sprintf("%s signature from %s key %s", vr.Result, vr.Cipher, KeyID(vr.KeyID))
+	}
+	result := vr.Result
+	if vr.Message == gitsignClaimsNotValidated {
+		// the certificate claims are only validated against the gitsign keys of a project when generating manifests
+		result = "Unvalidated"
+	}
+	info := fmt.Sprintf("%s %s signature from %s key %s", result, vr.Type, vr.Cipher, vr.KeyID)
+	if vr.Identity != "" && vr.Identity != vr.KeyID {
+		info += fmt.Sprintf(" (%s)", vr.Identity)
+	}
+	if vr.Issuer != "" {
+		info += fmt.Sprintf(" issued by %s", vr.Issuer)
+	}
+	return info
+}
+
+// NewSignatureKey validates the key ID for the given type of signature keys and returns the signature key with its
+// normalized key ID. If the type is empty, it is detected from the key ID among GnuPG and SSH keys. The issuer of the
+// signer's certificates is required for gitsign keys, and not supported for other types.
+func NewSignatureKey(keyType string, keyID string, issuer string) (appsv1.SignatureKey, error) {
+	if keyType == "" {
+		if issuer != "" {
+			keyType = SignatureKeyTypeGitsign
+		} else if IsShortKeyID(keyID) || IsLongKeyID(keyID) {
+			return appsv1.SignatureKey{KeyID: KeyID(keyID)}, nil
+		} else {
+			keyType = SignatureKeyTypeSSH
+		}
+	}
+	if err := validateIssuer(keyType, issuer); err != nil {
+		return appsv1.SignatureKey{}, err
+	}
+	v, err := GetSignatureVerifier(keyType)
+	if err != nil {
+		return appsv1.SignatureKey{}, err
+	}
+	normalized, err := v.NormalizeKeyID(keyID)
+	if err != nil {
+		return appsv1.SignatureKey{}, err
+	}
+	key := appsv1.SignatureKey{KeyID: normalized, Issuer: issuer}
+	if keyType != SignatureKeyTypeGPG {
+		key.Type = keyType
+	}
+	return key, nil
+}
+
+// ParseSignatureKey parses a signature key given as KEY-ID or TYPE:KEY-ID, e.g. ssh:SHA256:... gitsign keys cannot be
+// given this way, since they require an issuer.
+func ParseSignatureKey(s string) (appsv1.SignatureKey, error) {
+	if parts := strings.SplitN(s, ":", 2); len(parts) == 2 {
+		if _, err := GetSignatureVerifier(parts[0]); err == nil {
+			return NewSignatureKey(parts[0], parts[1], "")
+		}
+	}
+	return NewSignatureKey("", s, "")
+}
+
+// validateIssuer checks that an issuer is given for gitsign keys, and only for gitsign keys
+func validateIssuer(keyType string, issuer string) error {
+	if keyType != SignatureKeyTypeGitsign {
+		if issuer != "" {
+			return fmt.Errorf("an issuer is only supported for gitsign keys")
+		}
+		return nil
+	}
+	if issuer == "" {
+		return fmt.Errorf("gitsign keys require the OIDC issuer of the signer's certificates")
+	}
+	u, err := url.Parse(issuer)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("'%s' is not a valid OIDC issuer URL", issuer)
+	}
+	return nil
+}
+
+// SignatureKeyMatches returns true if the signature key identifies the signer of a verification result
+func SignatureKeyMatches(key appsv1.SignatureKey, result PGPVerifyResult) bool {
+	v, err := GetSignatureVerifier(key.Type)
+	if err != nil || v.Type() != result.Type {
+		return false
+	}
+	keyID, err := v.NormalizeKeyID(key.KeyID)
+	if err != nil {
+		return false
+	}
+	if keyID == "" || keyID != result.KeyID || key.Issuer != result.Issuer {
+		return false
+	}
+	// certificates for the identity of a gitsign key can be obtained from any issuer
+	return v.Type() != SignatureKeyTypeGitsign || key.Issuer != ""
+}
+
+// initializeAllowedSigners creates an empty allowed signers file if none exists yet. Git refuses to verify SSH
+// signatures without one, and signatures of keys not listed in it are still verified cryptographically.
+func initializeAllowedSigners() error {
+	allowedSigners := common.GetSSHAllowedSignersPath()
+	_, err := os.Stat(allowedSigners)
+	if err == nil || !os.IsNotExist(err) {
+		return err
+	}
+	return os.WriteFile(allowedSigners, []byte{}, 0644)
+}
+
+// gpgVerifier handles signatures made with GnuPG keys
+type gpgVerifier struct{}
+
+func (v *gpgVerifier) Type() string {
+	return SignatureKeyTypeGPG
+}
+
+func (v *gpgVerifier) ParseVerification(output string) (PGPVerifyResult, bool) {
+	return ParseGitCommitVerification(output), true
+}
+
+func (v *gpgVerifier) NormalizeKeyID(keyID string) (string, error) {
+	if !IsShortKeyID(keyID) && !IsLongKeyID(keyID) {
+		return "", fmt.Errorf("%s is not a valid GnuPG key ID", keyID)
+	}
+	return KeyID(keyID), nil
+}
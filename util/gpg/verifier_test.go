@@ -0,0 +1,145 @@
+package gpg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v2/util/gpg/testdata"
+)
+
+const sshTestKeyFingerprint = "SHA256:OW4ExkkOgRSi4AMq423pWSP4eWdAAjLp6Kf+HRMU/PE"
+
+func Test_ParseSignatureVerification(t *testing.T) {
+	t.Run("GnuPG", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Good_signature_txt)
+		assert.Equal(t, SignatureKeyTypeGPG, res.Type)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, "4AEE18F83AFDEB23", res.KeyID)
+	})
+	t.Run("SSH without principal", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Ssh_good_signature_txt)
+		assert.Equal(t, SignatureKeyTypeSSH, res.Type)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, "ED25519", res.Cipher)
+		assert.Equal(t, sshTestKeyFingerprint, res.KeyID)
+		assert.Equal(t, "", res.Identity)
+		assert.Equal(t, TrustUnknown, res.Trust)
+	})
+	t.Run("SSH with principal", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Ssh_good_signature_principal_txt)
+		assert.Equal(t, SignatureKeyTypeSSH, res.Type)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, sshTestKeyFingerprint, res.KeyID)
+		assert.Equal(t, "jane@example.com", res.Identity)
+		assert.Equal(t, TrustFull, res.Trust)
+	})
+	t.Run("SSH bad signature", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Ssh_bad_signature_txt)
+		assert.Equal(t, SignatureKeyTypeSSH, res.Type)
+		assert.Equal(t, VerifyResultBad, res.Result)
+	})
+	t.Run("SSH without allowed signers", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Ssh_no_allowed_signers_txt)
+		assert.Equal(t, SignatureKeyTypeSSH, res.Type)
+		assert.Equal(t, VerifyResultUnknown, res.Result)
+		assert.Contains(t, res.Message, "allowedSignersFile")
+	})
+	t.Run("gitsign", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Gitsign_good_signature_txt)
+		assert.Equal(t, SignatureKeyTypeGitsign, res.Type)
+		assert.Equal(t, VerifyResultGood, res.Result)
+		assert.Equal(t, "jane@example.com", res.KeyID)
+		assert.Equal(t, "https://accounts.google.com", res.Issuer)
+		assert.Contains(t, res.Message, "https://accounts.google.com")
+	})
+	t.Run("gitsign without validated certificate claims", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Gitsign_unvalidated_claims_txt)
+		assert.Equal(t, SignatureKeyTypeGitsign, res.Type)
+		assert.Equal(t, VerifyResultBad, res.Result)
+		assert.Contains(t, res.Message, "Certificate claims could not be validated")
+	})
+	t.Run("gitsign bad signature", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Gitsign_bad_signature_txt)
+		assert.Equal(t, SignatureKeyTypeGitsign, res.Type)
+		assert.Equal(t, VerifyResultBad, res.Result)
+	})
+	t.Run("Garbage", func(t *testing.T) {
+		res := ParseSignatureVerification(testdata.Bad_signature_nodata_txt)
+		assert.Equal(t, SignatureKeyTypeGPG, res.Type)
+		assert.Equal(t, VerifyResultUnknown, res.Result)
+	})
+}
+
+func Test_NewSignatureKey(t *testing.T) {
+	t.Run("GnuPG key ID", func(t *testing.T) {
+		key, err := NewSignatureKey("", "D56C4FCA57A46444", "")
+		require.NoError(t, err)
+		assert.Equal(t, appsv1.SignatureKey{KeyID: "D56C4FCA57A46444"}, key)
+	})
+	t.Run("GnuPG fingerprint", func(t *testing.T) {
+		key, err := NewSignatureKey(SignatureKeyTypeGPG, "FFD5ED81ED9D5E2C3BD3F44AD56C4FCA57A46444", "")
+		require.NoError(t, err)
+		assert.Equal(t, appsv1.SignatureKey{KeyID: "D56C4FCA57A46444"}, key)
+	})
+	t.Run("SSH public key", func(t *testing.T) {
+		key, err := NewSignatureKey("", testdata.Ssh_public_key_pub, "")
+		require.NoError(t, err)
+		assert.Equal(t, appsv1.SignatureKey{KeyID: sshTestKeyFingerprint, Type: SignatureKeyTypeSSH}, key)
+	})
+	t.Run("SSH fingerprint", func(t *testing.T) {
+		key, err := NewSignatureKey(SignatureKeyTypeSSH, sshTestKeyFingerprint, "")
+		require.NoError(t, err)
+		assert.Equal(t, appsv1.SignatureKey{KeyID: sshTestKeyFingerprint, Type: SignatureKeyTypeSSH}, key)
+	})
+	t.Run("gitsign identity", func(t *testing.T) {
+		key, err := NewSignatureKey(SignatureKeyTypeGitsign, "jane@example.com", "https://accounts.google.com")
+		require.NoError(t, err)
+		assert.Equal(t, appsv1.SignatureKey{KeyID: "jane@example.com", Type: SignatureKeyTypeGitsign, Issuer: "https://accounts.google.com"}, key)
+	})
+	t.Run("gitsign identity without issuer", func(t *testing.T) {
+		_, err := NewSignatureKey(SignatureKeyTypeGitsign, "jane@example.com", "")
+		assert.Error(t, err)
+		_, err = ParseSignatureKey("gitsign:jane@example.com")
+		assert.Error(t, err)
+		_, err = NewSignatureKey(SignatureKeyTypeGitsign, "jane@example.com", "accounts.google.com")
+		assert.Error(t, err)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := NewSignatureKey("", "not-a-key", "")
+		assert.Error(t, err)
+		_, err = NewSignatureKey("x509", "jane@example.com", "")
+		assert.Error(t, err)
+		_, err = NewSignatureKey(SignatureKeyTypeSSH, sshTestKeyFingerprint, "https://accounts.google.com")
+		assert.Error(t, err)
+	})
+}
+
+func Test_SignatureKeyMatches(t *testing.T) {
+	sshResult := ParseSignatureVerification(testdata.Ssh_good_signature_txt)
+	gpgResult := ParseSignatureVerification(testdata.Good_signature_txt)
+
+	assert.True(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: sshTestKeyFingerprint, Type: SignatureKeyTypeSSH}, sshResult))
+	// the public key may also be configured as is
+	assert.True(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: testdata.Ssh_public_key_pub, Type: SignatureKeyTypeSSH}, sshResult))
+	assert.False(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: sshTestKeyFingerprint}, sshResult))
+	assert.True(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: "4AEE18F83AFDEB23"}, gpgResult))
+	assert.False(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: "4AEE18F83AFDEB23", Type: SignatureKeyTypeSSH}, gpgResult))
+}
+
+func Test_SignatureKeyMatches_Gitsign(t *testing.T) {
+	result := ParseSignatureVerification(testdata.Gitsign_good_signature_txt)
+
+	assert.True(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: "jane@example.com", Type: SignatureKeyTypeGitsign, Issuer: "https://accounts.google.com"}, result))
+	// the same identity certified by another issuer
+	assert.False(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: "jane@example.com", Type: SignatureKeyTypeGitsign, Issuer: "https://token.actions.githubusercontent.com"}, result))
+	// keys without issuer never match
+	assert.False(t, SignatureKeyMatches(appsv1.SignatureKey{KeyID: "jane@example.com", Type: SignatureKeyTypeGitsign}, result))
+}
+
+func Test_SignatureInfo_Gitsign(t *testing.T) {
+	assert.Equal(t, "Good gitsign signature from x509 key jane@example.com issued by https://accounts.google.com", SignatureInfo(testdata.Gitsign_good_signature_txt))
+	assert.Equal(t, "Unvalidated gitsign signature from x509 key jane@example.com issued by https://accounts.google.com", SignatureInfo(testdata.Gitsign_unvalidated_claims_txt))
+}
@@ -112,6 +112,12 @@ func ErrorCodeGitStreamServerInterceptor() grpc.StreamServerInterceptor {
 	}
 }
 
+// ErrorToGRPC replaces Kubernetes and Git errors with relevant gRPC equivalents, like the ErrorCodeK8s and ErrorCodeGit
+// interceptors do
+func ErrorToGRPC(err error) error {
+	return kubeErrToGRPC(gitErrToGRPC(err))
+}
+
 // ErrorCodeK8sUnaryServerInterceptor replaces Kubernetes errors with relevant gRPC equivalents, if any.
 func ErrorCodeK8sUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
@@ -1,6 +1,8 @@
 package grpc
 
 import (
+	"fmt"
+	"net"
 	"strings"
 
 	"context"
@@ -8,6 +10,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 )
 
@@ -87,3 +90,89 @@ func userAgentEnforcer(ctx context.Context, clientName, constraintStr string, se
 func isLegacyClient(userAgents []string) bool {
 	return len(userAgents) == 1 && (userAgents[0] == "grpc-go/1.15.0" || userAgents[0] == "grpc-go/1.10.0")
 }
+
+// UserAgent returns the user agent of the client of the incoming request. The user agent forwarded by the gRPC gateway
+// takes precedence. The value is supplied by the client and must only be used for informational purposes.
+func UserAgent(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, key := range []string{"grpcgateway-user-agent", "user-agent"} {
+			if values := md[key]; len(values) > 0 && values[0] != "" {
+				return values[0]
+			}
+		}
+	}
+	return ""
+}
+
+// ClientIP returns the IP address of the client of the incoming request. The X-Forwarded-For header is
+// only followed while the request was forwarded by a trusted proxy, i.e. a peer with a loopback address, like the gRPC
+// gateway of the API server, or an address within one of the trusted proxy networks. The header is evaluated from right
+// to left, so that addresses prepended by the client are ignored.
+func ClientIP(ctx context.Context, trustedProxies []*net.IPNet) string {
+	var clientIP string
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		clientIP = p.Addr.String()
+		if host, _, err := net.SplitHostPort(clientIP); err == nil {
+			clientIP = host
+		}
+	}
+	var forwarded []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md["x-forwarded-for"] {
+			for _, addr := range strings.Split(value, ",") {
+				if addr = strings.TrimSpace(addr); addr != "" {
+					forwarded = append(forwarded, addr)
+				}
+			}
+		}
+	}
+	for i := len(forwarded) - 1; i >= 0 && isTrustedProxy(clientIP, trustedProxies); i-- {
+		clientIP = forwarded[i]
+	}
+	return clientIP
+}
+
+func isTrustedProxy(addr string, trustedProxies []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	for _, network := range trustedProxies {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseTrustedProxies parses a list of IP addresses and networks in CIDR notation, e.g. 10.0.0.0/8
+func ParseTrustedProxies(values []string) ([]*net.IPNet, error) {
+	var networks []*net.IPNet
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if !strings.Contains(value, "/") {
+			ip := net.ParseIP(value)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy '%s': must be an IP address or a network in CIDR notation", value)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy '%s': must be an IP address or a network in CIDR notation", value)
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
@@ -2,11 +2,14 @@ package grpc
 
 import (
 	"context"
+	"net"
 	"testing"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func Test_UserAgentEnforcer(t *testing.T) {
@@ -59,3 +62,40 @@ func Test_UserAgentEnforcer(t *testing.T) {
 		require.Contains(t, err.Error(), "could not parse version")
 	})
 }
+
+func Test_UserAgent(t *testing.T) {
+	assert.Equal(t, "argocd-client/v2.6.0 grpc-go/1.51.0", UserAgent(metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"user-agent": "argocd-client/v2.6.0 grpc-go/1.51.0"}))))
+	assert.Equal(t, "Mozilla/5.0", UserAgent(metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{
+		"user-agent":             "grpc-go/1.51.0",
+		"grpcgateway-user-agent": "Mozilla/5.0",
+	}))))
+	assert.Empty(t, UserAgent(context.Background()))
+}
+
+func Test_ClientIP(t *testing.T) {
+	withPeer := func(ip string, forwardedFor string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 41234}})
+		if forwardedFor != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-forwarded-for", forwardedFor))
+		}
+		return ctx
+	}
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.0/8", " 192.168.0.10 "})
+	require.NoError(t, err)
+
+	// the header of untrusted peers is ignored
+	assert.Equal(t, "172.16.0.1", ClientIP(withPeer("172.16.0.1", "1.2.3.4"), trustedProxies))
+	assert.Equal(t, "10.0.0.1", ClientIP(withPeer("10.0.0.1", ""), trustedProxies))
+	// the gRPC gateway of the API server forwards requests from the loopback address
+	assert.Equal(t, "172.16.0.1", ClientIP(withPeer("127.0.0.1", "172.16.0.1"), nil))
+	assert.Equal(t, "172.16.0.1", ClientIP(withPeer("127.0.0.1", "1.2.3.4, 172.16.0.1"), nil))
+	// addresses are followed while they belong to trusted proxies
+	assert.Equal(t, "172.16.0.1", ClientIP(withPeer("127.0.0.1", "1.2.3.4, 172.16.0.1, 192.168.0.10, 10.1.2.3"), trustedProxies))
+	assert.Equal(t, "1.2.3.4", ClientIP(withPeer("10.1.2.3", "1.2.3.4"), trustedProxies))
+	assert.Empty(t, ClientIP(context.Background(), trustedProxies))
+
+	_, err = ParseTrustedProxies([]string{"10.0.0.0/33"})
+	assert.Error(t, err)
+	_, err = ParseTrustedProxies([]string{"proxy.example.com"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,75 @@
+package jwt
+
+import (
+	"fmt"
+
+	"github.com/antonmedv/expr"
+	"github.com/antonmedv/expr/vm"
+	jwtgo "github.com/golang-jwt/jwt/v4"
+	log "github.com/sirupsen/logrus"
+)
+
+// ClaimsExpression is an expression which extracts groups from the claims of a token, e.g. realm_access.roles or
+// department + ":" + environment. The claims are available as variables, and the expression must return a string or
+// a list of strings.
+type ClaimsExpression struct {
+	Expression string
+	program    *vm.Program
+}
+
+// CompileClaimsExpressions compiles the given expressions, see ClaimsExpression
+func CompileClaimsExpressions(expressions []string) ([]ClaimsExpression, error) {
+	res := make([]ClaimsExpression, 0, len(expressions))
+	for _, expression := range expressions {
+		program, err := expr.Compile(expression, expr.AllowUndefinedVariables())
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile claims expression '%s': %w", expression, err)
+		}
+		res = append(res, ClaimsExpression{Expression: expression, program: program})
+	}
+	return res, nil
+}
+
+// Evaluate returns the groups the expression extracts from the given claims
+func (e *ClaimsExpression) Evaluate(claims jwtgo.MapClaims) ([]string, error) {
+	out, err := expr.Run(e.program, map[string]interface{}(claims))
+	if err != nil {
+		return nil, err
+	}
+	switch val := out.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		if val == "" {
+			return nil, nil
+		}
+		return []string{val}, nil
+	case []string:
+		return val, nil
+	case []interface{}:
+		groups := make([]string, 0, len(val))
+		for _, groupIf := range val {
+			if group, ok := groupIf.(string); ok {
+				groups = append(groups, group)
+			}
+		}
+		return groups, nil
+	default:
+		return nil, fmt.Errorf("expected a string or a list of strings, got %T", out)
+	}
+}
+
+// getExpressionValues returns the groups extracted from the claims by the given expressions. Expressions which fail
+// to evaluate, typically because the token lacks the claims they refer to, are skipped.
+func getExpressionValues(claims jwtgo.MapClaims, expressions []ClaimsExpression) []string {
+	groups := make([]string, 0)
+	for i := range expressions {
+		values, err := expressions[i].Evaluate(claims)
+		if err != nil {
+			log.Debugf("Failed to evaluate claims expression '%s': %v", expressions[i].Expression, err)
+			continue
+		}
+		groups = append(groups, values...)
+	}
+	return groups
+}
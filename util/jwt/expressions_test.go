@@ -0,0 +1,68 @@
+package jwt
+
+import (
+	"testing"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileClaimsExpressions(t *testing.T) {
+	_, err := CompileClaimsExpressions([]string{"realm_access.roles", "department +"})
+	assert.ErrorContains(t, err, "failed to compile claims expression 'department +'")
+}
+
+func TestClaimsExpression_Evaluate(t *testing.T) {
+	claims := jwt.MapClaims{
+		"realm_access":    map[string]interface{}{"roles": []interface{}{"admin", "viewer"}},
+		"resource_access": map[string]interface{}{"argocd": map[string]interface{}{"roles": []interface{}{"deployer", 1}}},
+		"department":      "finance",
+		"environment":     "production",
+		"email_verified":  true,
+	}
+	evaluate := func(expression string) ([]string, error) {
+		expressions, err := CompileClaimsExpressions([]string{expression})
+		require.NoError(t, err)
+		return expressions[0].Evaluate(claims)
+	}
+
+	groups, err := evaluate("realm_access.roles")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"admin", "viewer"}, groups)
+	groups, err = evaluate("resource_access.argocd.roles")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"deployer"}, groups)
+	groups, err = evaluate(`department + ":" + environment`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"finance:production"}, groups)
+	groups, err = evaluate(`environment == "production" ? "prod-" + department : ""`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"prod-finance"}, groups)
+	groups, err = evaluate(`map(realm_access.roles, {"realm:" + #})`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"realm:admin", "realm:viewer"}, groups)
+	groups, err = evaluate("team")
+	require.NoError(t, err)
+	assert.Empty(t, groups)
+
+	_, err = evaluate("email_verified")
+	assert.ErrorContains(t, err, "expected a string or a list of strings, got bool")
+	_, err = evaluate("team.roles")
+	assert.Error(t, err)
+}
+
+func TestGetGroups_Expressions(t *testing.T) {
+	expressions, err := CompileClaimsExpressions([]string{"realm_access.roles", "team.name", `department + ":" + environment`})
+	require.NoError(t, err)
+	claims := jwt.MapClaims{
+		"groups":       []interface{}{"admin", "my-org:my-team"},
+		"realm_access": map[string]interface{}{"roles": []interface{}{"admin", "viewer"}},
+		"department":   "finance",
+		"environment":  "production",
+	}
+	assert.Equal(t, []string{"admin", "my-org:my-team", "viewer", "finance:production"}, GetGroups(claims, []string{"groups"}, expressions...))
+	assert.Equal(t, []string{"admin", "my-org:my-team"}, GetGroups(claims, []string{"groups"}))
+	assert.True(t, IsMember(claims, []string{"finance:production"}, []string{"groups"}, expressions...))
+	assert.False(t, IsMember(claims, []string{"finance:production"}, []string{"groups"}))
+}
@@ -116,13 +116,13 @@ func Claims(in interface{}) jwtgo.Claims {
 }
 
 // IsMember returns whether or not the user's claims is a member of any of the groups
-func IsMember(claims jwtgo.Claims, groups []string, scopes []string) bool {
+func IsMember(claims jwtgo.Claims, groups []string, scopes []string, expressions ...ClaimsExpression) bool {
 	mapClaims, err := MapClaims(claims)
 	if err != nil {
 		return false
 	}
 	// O(n^2) loop
-	for _, userGroup := range GetGroups(mapClaims, scopes) {
+	for _, userGroup := range GetGroups(mapClaims, scopes, expressions...) {
 		for _, group := range groups {
 			if userGroup == group {
 				return true
@@ -132,8 +132,24 @@ func IsMember(claims jwtgo.Claims, groups []string, scopes []string) bool {
 	return false
 }
 
-func GetGroups(mapClaims jwtgo.MapClaims, scopes []string) []string {
-	return GetScopeValues(mapClaims, scopes)
+// GetGroups returns the values of the given scopes followed by the groups extracted using the given expressions,
+// without duplicates
+func GetGroups(mapClaims jwtgo.MapClaims, scopes []string, expressions ...ClaimsExpression) []string {
+	groups := GetScopeValues(mapClaims, scopes)
+	if len(expressions) == 0 {
+		return groups
+	}
+	seen := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		seen[group] = true
+	}
+	for _, group := range getExpressionValues(mapClaims, expressions) {
+		if !seen[group] {
+			seen[group] = true
+			groups = append(groups, group)
+		}
+	}
+	return groups
 }
 
 func IsValid(token string) bool {
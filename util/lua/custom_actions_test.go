@@ -84,8 +84,10 @@ func TestLuaResourceActionsScript(t *testing.T) {
 				assert.NoError(t, err)
 
 				assert.NoError(t, err)
-				result, err := vm.ExecuteResourceAction(obj, action.ActionLua)
+				impactedResources, err := vm.ExecuteResourceAction(obj, action.ActionLua, nil)
 				assert.NoError(t, err)
+				assert.Len(t, impactedResources, 1)
+				result := impactedResources[0].UnstructuredObj
 
 				expectedObj := getObj(filepath.Join(dir, test.ExpectedOutputPath))
 				// Ideally, we would use a assert.Equal to detect the difference, but the Lua VM returns a object with float64 instead of the original int32.  As a result, the assert.Equal is never true despite that the change has been applied.
@@ -0,0 +1,88 @@
+package lua
+
+import (
+	"fmt"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const defaultHealthConditionType = "Ready"
+
+// GetConditionsHealth assesses the health of a resource from the condition of its status described by the given rule
+func GetConditionsHealth(obj *unstructured.Unstructured, rule appv1.OverrideHealthConditions) (*health.HealthStatus, error) {
+	conditionType := rule.ConditionType
+	if conditionType == "" {
+		conditionType = defaultHealthConditionType
+	}
+	statusByCondition := map[string]health.HealthStatusCode{
+		"True":    defaultHealthStatusCode(rule.StatusTrue, health.HealthStatusHealthy),
+		"False":   defaultHealthStatusCode(rule.StatusFalse, health.HealthStatusDegraded),
+		"Unknown": defaultHealthStatusCode(rule.StatusUnknown, health.HealthStatusProgressing),
+	}
+	for _, code := range statusByCondition {
+		if !isValidHealthStatusCode(code) {
+			return nil, fmt.Errorf("invalid health status '%s' in health conditions of %s", code, obj.GroupVersionKind().GroupKind())
+		}
+	}
+
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return nil, fmt.Errorf("error reading status conditions: %w", err)
+	}
+	var condition map[string]interface{}
+	for _, c := range conditions {
+		if c, ok := c.(map[string]interface{}); ok && c["type"] == conditionType {
+			condition = c
+			break
+		}
+	}
+
+	if rule.CheckObservedGeneration {
+		observedGeneration, ok, _ := unstructured.NestedInt64(condition, "observedGeneration")
+		if !ok {
+			observedGeneration, ok, _ = unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+		}
+		if !ok || observedGeneration < obj.GetGeneration() {
+			return &health.HealthStatus{
+				Status:  health.HealthStatusProgressing,
+				Message: "Waiting for spec update to be observed",
+			}, nil
+		}
+	}
+
+	if condition == nil {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusProgressing,
+			Message: fmt.Sprintf("Waiting for %s condition", conditionType),
+		}, nil
+	}
+
+	status, _, _ := unstructured.NestedString(condition, "status")
+	reason, _, _ := unstructured.NestedString(condition, "reason")
+	message, _, _ := unstructured.NestedString(condition, "message")
+	if message == "" {
+		message = reason
+	}
+	if status != "True" {
+		for _, progressingReason := range rule.ProgressingReasons {
+			if reason == progressingReason {
+				return &health.HealthStatus{Status: health.HealthStatusProgressing, Message: message}, nil
+			}
+		}
+	}
+	code, ok := statusByCondition[status]
+	if !ok {
+		code = statusByCondition["Unknown"]
+	}
+	return &health.HealthStatus{Status: code, Message: message}, nil
+}
+
+func defaultHealthStatusCode(code health.HealthStatusCode, defaultCode health.HealthStatusCode) health.HealthStatusCode {
+	if code == "" {
+		return defaultCode
+	}
+	return code
+}
@@ -0,0 +1,111 @@
+package lua
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/argoproj/gitops-engine/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
+)
+
+const widgetYAML = `
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: my-widget
+  namespace: default
+  generation: 2
+status:
+  observedGeneration: 2
+  conditions:
+  - type: Ready
+    status: "%s"
+    reason: %s
+    message: widget is %s
+`
+
+func widgetWithReadyCondition(status string, reason string) string {
+	return fmt.Sprintf(widgetYAML, status, reason, reason)
+}
+
+func TestGetConditionsHealth(t *testing.T) {
+	t.Run("Defaults", func(t *testing.T) {
+		for status, expected := range map[string]health.HealthStatusCode{
+			"True":    health.HealthStatusHealthy,
+			"False":   health.HealthStatusDegraded,
+			"Unknown": health.HealthStatusProgressing,
+		} {
+			res, err := GetConditionsHealth(StrToUnstructured(widgetWithReadyCondition(status, "Reconciled")), appv1.OverrideHealthConditions{})
+			require.NoError(t, err)
+			assert.Equal(t, &health.HealthStatus{Status: expected, Message: "widget is Reconciled"}, res)
+		}
+	})
+	t.Run("CustomStatuses", func(t *testing.T) {
+		rule := appv1.OverrideHealthConditions{StatusFalse: health.HealthStatusSuspended}
+		res, err := GetConditionsHealth(StrToUnstructured(widgetWithReadyCondition("False", "Paused")), rule)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusSuspended, res.Status)
+	})
+	t.Run("InvalidStatus", func(t *testing.T) {
+		rule := appv1.OverrideHealthConditions{StatusTrue: "Fine"}
+		_, err := GetConditionsHealth(StrToUnstructured(widgetWithReadyCondition("True", "Reconciled")), rule)
+		assert.ErrorContains(t, err, "invalid health status 'Fine'")
+	})
+	t.Run("ProgressingReasons", func(t *testing.T) {
+		rule := appv1.OverrideHealthConditions{ProgressingReasons: []string{"Creating"}}
+		res, err := GetConditionsHealth(StrToUnstructured(widgetWithReadyCondition("False", "Creating")), rule)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "widget is Creating"}, res)
+
+		res, err = GetConditionsHealth(StrToUnstructured(widgetWithReadyCondition("False", "Failed")), rule)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, res.Status)
+	})
+	t.Run("MissingCondition", func(t *testing.T) {
+		rule := appv1.OverrideHealthConditions{ConditionType: "Available"}
+		res, err := GetConditionsHealth(StrToUnstructured(widgetWithReadyCondition("True", "Reconciled")), rule)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for Available condition"}, res)
+	})
+	t.Run("ObservedGeneration", func(t *testing.T) {
+		obj := StrToUnstructured(widgetWithReadyCondition("True", "Reconciled"))
+		obj.SetGeneration(3)
+		res, err := GetConditionsHealth(obj, appv1.OverrideHealthConditions{})
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, res.Status)
+
+		res, err = GetConditionsHealth(obj, appv1.OverrideHealthConditions{CheckObservedGeneration: true})
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for spec update to be observed"}, res)
+	})
+}
+
+func TestGetResourceHealthWithConditions(t *testing.T) {
+	obj := StrToUnstructured(widgetWithReadyCondition("False", "Failed"))
+
+	t.Run("Conditions", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"*.example.com/*": appv1.ResourceOverride{HealthLua: `return { status = "Suspended" }`},
+			"example.com/Widget": appv1.ResourceOverride{
+				HealthConditions: &appv1.OverrideHealthConditions{},
+			},
+		}
+		res, err := overrides.GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, res.Status)
+	})
+	t.Run("HealthScriptTakesPrecedence", func(t *testing.T) {
+		overrides := ResourceHealthOverrides{
+			"example.com/Widget": appv1.ResourceOverride{
+				HealthLua:        `return { status = "Suspended" }`,
+				HealthConditions: &appv1.OverrideHealthConditions{},
+			},
+		}
+		res, err := overrides.GetResourceHealth(obj)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusSuspended, res.Status)
+	})
+}
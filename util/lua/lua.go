@@ -6,9 +6,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/health"
+	log "github.com/sirupsen/logrus"
 	lua "github.com/yuin/gopher-lua"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -24,13 +28,54 @@ const (
 	healthScriptFile          = "health.lua"
 	actionScriptFile          = "action.lua"
 	actionDiscoveryScriptFile = "discovery.lua"
+	// MaxHealthChildren is the maximum number of children exposed to a health script
+	MaxHealthChildren = 100
 )
 
+// Types of the parameters of resource actions
+const (
+	ResourceActionParamTypeString  = "string"
+	ResourceActionParamTypeNumber  = "number"
+	ResourceActionParamTypeBoolean = "boolean"
+)
+
+// K8SOperation is an operation a resource action performs on a resource
+type K8SOperation string
+
+const (
+	CreateOperation K8SOperation = "create"
+	PatchOperation  K8SOperation = "patch"
+)
+
+// ImpactedResource is a resource returned by a resource action, along with the operation to perform on it
+type ImpactedResource struct {
+	UnstructuredObj *unstructured.Unstructured `json:"resource"`
+	K8SOperation    K8SOperation               `json:"operation"`
+}
+
 type ResourceHealthOverrides map[string]appv1.ResourceOverride
 
 func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	return ResourceHealthOverridesWithChildren{Overrides: overrides}.GetResourceHealth(obj)
+}
+
+// GetChildrenFunc returns at most limit children of a resource
+type GetChildrenFunc func(obj *unstructured.Unstructured, limit int) ([]appv1.ResourceNode, error)
+
+// ResourceHealthOverridesWithChildren assesses resource health like ResourceHealthOverrides. Additionally, the health
+// scripts of overrides which opted into it can access the children of the resource in the global 'children'.
+type ResourceHealthOverridesWithChildren struct {
+	Overrides   map[string]appv1.ResourceOverride
+	GetChildren GetChildrenFunc
+}
+
+func (o ResourceHealthOverridesWithChildren) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
 	luaVM := VM{
-		ResourceOverrides: overrides,
+		ResourceOverrides: o.Overrides,
+	}
+	// a health script takes precedence over health conditions configured for the same key
+	if override, ok := luaVM.getOverride(obj.GroupVersionKind(), hasHealthAssessment); ok && override.HealthLua == "" {
+		return GetConditionsHealth(obj, *override.HealthConditions)
 	}
 	script, useOpenLibs, err := luaVM.GetHealthScript(obj)
 	if err != nil {
@@ -41,6 +86,13 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	}
 	// enable/disable the usage of lua standard library
 	luaVM.UseOpenLibs = useOpenLibs
+	if o.GetChildren != nil && luaVM.healthScriptUsesChildren(obj) {
+		children, err := o.GetChildren(obj, MaxHealthChildren)
+		if err != nil {
+			return nil, fmt.Errorf("error getting children of %s/%s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		return luaVM.ExecuteHealthLuaWithChildren(obj, children, script)
+	}
 	result, err := luaVM.ExecuteHealthLua(obj, script)
 	if err != nil {
 		return nil, err
@@ -56,6 +108,12 @@ type VM struct {
 }
 
 func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState, error) {
+	return vm.runLuaWithGlobals(obj, script, nil)
+}
+
+// runLuaWithGlobals runs the script with the resource available as the global 'obj', along with the given additional
+// globals, e.g. the parameters of a resource action.
+func (vm VM) runLuaWithGlobals(obj *unstructured.Unstructured, script string, globals map[string]interface{}) (*lua.LState, error) {
 	l := lua.NewState(lua.Options{
 		SkipOpenLibs: !vm.UseOpenLibs,
 	})
@@ -87,6 +145,9 @@ func (vm VM) runLua(obj *unstructured.Unstructured, script string) (*lua.LState,
 	l.SetContext(ctx)
 	objectValue := decodeValue(l, obj.Object)
 	l.SetGlobal("obj", objectValue)
+	for name, value := range globals {
+		l.SetGlobal(name, decodeValue(l, value))
+	}
 	err := l.DoString(script)
 	return l, err
 }
@@ -97,6 +158,32 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 	if err != nil {
 		return nil, err
 	}
+	return getHealthStatus(l)
+}
+
+// ExecuteHealthLuaWithChildren runs a health script with the given children of the resource available in the global
+// 'children'. Each child is a table with the fields of a resource tree node, such as kind, name and health.
+func (vm VM) ExecuteHealthLuaWithChildren(obj *unstructured.Unstructured, children []appv1.ResourceNode, script string) (*health.HealthStatus, error) {
+	if len(children) > MaxHealthChildren {
+		children = children[:MaxHealthChildren]
+	}
+	childrenBytes, err := json.Marshal(children)
+	if err != nil {
+		return nil, err
+	}
+	childrenValue := make([]interface{}, 0, len(children))
+	if err := json.Unmarshal(childrenBytes, &childrenValue); err != nil {
+		return nil, err
+	}
+	l, err := vm.runLuaWithGlobals(obj, script, map[string]interface{}{"children": childrenValue})
+	if err != nil {
+		return nil, err
+	}
+	return getHealthStatus(l)
+}
+
+// getHealthStatus converts the value returned by a health script to a health status
+func getHealthStatus(l *lua.LState) (*health.HealthStatus, error) {
 	returnValue := l.Get(-1)
 	if returnValue.Type() == lua.LTTable {
 		jsonBytes, err := luajson.Encode(returnValue)
@@ -122,35 +209,191 @@ func (vm VM) ExecuteHealthLua(obj *unstructured.Unstructured, script string) (*h
 
 // GetHealthScript attempts to read lua script from config and then filesystem for that resource
 func (vm VM) GetHealthScript(obj *unstructured.Unstructured) (string, bool, error) {
-	key := GetConfigMapKey(obj.GroupVersionKind())
-	if script, ok := vm.ResourceOverrides[key]; ok && script.HealthLua != "" {
-		return script.HealthLua, script.UseOpenLibs, nil
+	if override, ok := vm.getOverride(obj.GroupVersionKind(), hasHealthLua); ok {
+		return override.HealthLua, override.UseOpenLibs, nil
+	}
+	for _, key := range getPredefinedKeys(obj.GroupVersionKind()) {
+		builtInScript, err := vm.getPredefinedLuaScripts(key, healthScriptFile)
+		if err != nil || builtInScript != "" {
+			// standard libraries will be enabled for all built-in scripts
+			return builtInScript, true, err
+		}
 	}
-	builtInScript, err := vm.getPredefinedLuaScripts(key, healthScriptFile)
-	// standard libraries will be enabled for all built-in scripts
-	return builtInScript, true, err
+	return "", true, nil
 }
 
-func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string) (*unstructured.Unstructured, error) {
-	l, err := vm.runLua(obj, script)
+// healthScriptUsesChildren returns whether the configured health script of the resource opted into accessing its
+// children. Built-in health scripts never do.
+func (vm VM) healthScriptUsesChildren(obj *unstructured.Unstructured) bool {
+	override, ok := vm.getOverride(obj.GroupVersionKind(), hasHealthLua)
+	return ok && override.UseChildren
+}
+
+func hasHealthLua(override appv1.ResourceOverride) bool {
+	return override.HealthLua != ""
+}
+
+// hasHealthAssessment returns whether the override assesses the health of resources, either with a health script or
+// with health conditions
+func hasHealthAssessment(override appv1.ResourceOverride) bool {
+	return override.HealthLua != "" || override.HealthConditions != nil
+}
+
+func hasActions(override appv1.ResourceOverride) bool {
+	return override.Actions != ""
+}
+
+// getOverride returns the most specific configured override matching the resource, among the ones for which
+// isApplicable returns true
+func (vm VM) getOverride(gvk schema.GroupVersionKind, isApplicable func(appv1.ResourceOverride) bool) (appv1.ResourceOverride, bool) {
+	for _, key := range GetMatchingOverrideKeys(vm.ResourceOverrides, gvk) {
+		if override := vm.ResourceOverrides[key]; isApplicable(override) {
+			return override, true
+		}
+	}
+	return appv1.ResourceOverride{}, false
+}
+
+// GetMatchingOverrideKeys returns the keys of the overrides which match the group and kind of a resource, either
+// exactly or as glob patterns like '*.crossplane.io/*', ordered from the most to the least specific key
+func GetMatchingOverrideKeys(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) []string {
+	var keys []string
+	for key := range overrides {
+		if appv1.OverrideKeyMatches(key, gvk.GroupKind()) {
+			keys = append(keys, key)
+		}
+	}
+	appv1.SortOverrideKeys(keys)
+	return keys
+}
+
+// ExecuteResourceAction runs the lua script of a resource action with the given parameters. The script either returns
+// the modified resource, which is patched, or a list of operations on the resource and on new resources.
+func (vm VM) ExecuteResourceAction(obj *unstructured.Unstructured, script string, params map[string]interface{}) ([]ImpactedResource, error) {
+	if params == nil {
+		params = map[string]interface{}{}
+	}
+	l, err := vm.runLuaWithGlobals(obj, script, map[string]interface{}{"actionParams": params})
 	if err != nil {
 		return nil, err
 	}
 	returnValue := l.Get(-1)
-	if returnValue.Type() == lua.LTTable {
-		jsonBytes, err := luajson.Encode(returnValue)
+	if returnValue.Type() != lua.LTTable {
+		return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+	}
+	jsonBytes, err := luajson.Encode(returnValue)
+	if err != nil {
+		return nil, err
+	}
+	var impactedResources []ImpactedResource
+	// A list of operations is encoded as JSON array, while a modified resource is encoded as JSON object
+	if len(jsonBytes) > 0 && jsonBytes[0] == '[' {
+		impactedResources, err = unmarshalToImpactedResources(jsonBytes)
 		if err != nil {
 			return nil, err
 		}
+	} else {
 		newObj, err := appv1.UnmarshalToUnstructured(string(jsonBytes))
 		if err != nil {
 			return nil, err
 		}
-		cleanedNewObj := cleanReturnedObj(newObj.Object, obj.Object)
-		newObj.Object = cleanedNewObj
-		return newObj, nil
+		impactedResources = []ImpactedResource{{UnstructuredObj: newObj, K8SOperation: PatchOperation}}
 	}
-	return nil, fmt.Errorf(incorrectReturnType, "table", returnValue.Type().String())
+	for _, impactedResource := range impactedResources {
+		// only a patch of the resource itself can unintentionally turn empty structs into empty arrays
+		if impactedResource.K8SOperation == PatchOperation {
+			impactedResource.UnstructuredObj.Object = cleanReturnedObj(impactedResource.UnstructuredObj.Object, obj.Object)
+		}
+	}
+	return impactedResources, nil
+}
+
+// unmarshalToImpactedResources parses the list of operations returned by a resource action
+func unmarshalToImpactedResources(jsonBytes []byte) ([]ImpactedResource, error) {
+	var operations []struct {
+		Operation K8SOperation           `json:"operation"`
+		Resource  map[string]interface{} `json:"resource"`
+	}
+	if err := json.Unmarshal(jsonBytes, &operations); err != nil {
+		return nil, fmt.Errorf("invalid list of operations returned by resource action: %w", err)
+	}
+	impactedResources := make([]ImpactedResource, 0, len(operations))
+	for i, op := range operations {
+		if op.Operation != PatchOperation && op.Operation != CreateOperation {
+			return nil, fmt.Errorf("unsupported operation '%s' returned by resource action", op.Operation)
+		}
+		if len(op.Resource) == 0 {
+			return nil, fmt.Errorf("operation %d returned by resource action has no resource", i)
+		}
+		obj := &unstructured.Unstructured{Object: op.Resource}
+		if obj.GetKind() == "" || obj.GetAPIVersion() == "" || (obj.GetName() == "" && obj.GetGenerateName() == "") {
+			return nil, fmt.Errorf("resource of operation %d returned by resource action must have an apiVersion, a kind and a name", i)
+		}
+		impactedResources = append(impactedResources, ImpactedResource{UnstructuredObj: obj, K8SOperation: op.Operation})
+	}
+	return impactedResources, nil
+}
+
+// GetResourceActionParams returns the parameters the action discovery script declares for the given action
+func (vm VM) GetResourceActionParams(obj *unstructured.Unstructured, actionName string) ([]appv1.ResourceActionParam, error) {
+	discoveryScript, err := vm.GetResourceActionDiscovery(obj)
+	if err != nil || discoveryScript == "" {
+		return nil, err
+	}
+	actions, err := vm.ExecuteResourceActionDiscovery(obj, discoveryScript)
+	if err != nil {
+		return nil, err
+	}
+	for _, action := range actions {
+		if action.Name == actionName {
+			return action.Params, nil
+		}
+	}
+	return nil, nil
+}
+
+// ParseResourceActionParams validates the parameters given to a resource action against the parameters declared by the
+// action, and converts them to their declared type. Declared parameters which are not given use their default value.
+func ParseResourceActionParams(declared []appv1.ResourceActionParam, given []appv1.ResourceActionParam) (map[string]interface{}, error) {
+	declaredByName := make(map[string]appv1.ResourceActionParam, len(declared))
+	for _, param := range declared {
+		declaredByName[param.Name] = param
+	}
+	values := make(map[string]string)
+	for _, param := range declared {
+		if param.Default != "" {
+			values[param.Name] = param.Default
+		}
+	}
+	for _, param := range given {
+		if _, ok := declaredByName[param.Name]; !ok {
+			return nil, fmt.Errorf("unknown parameter '%s'", param.Name)
+		}
+		values[param.Name] = param.Value
+	}
+	params := make(map[string]interface{}, len(values))
+	for name, value := range values {
+		paramType := declaredByName[name].Type
+		switch paramType {
+		case "", ResourceActionParamTypeString:
+			params[name] = value
+		case ResourceActionParamTypeNumber:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parameter '%s' must be a number, not '%s'", name, value)
+			}
+			params[name] = number
+		case ResourceActionParamTypeBoolean:
+			boolean, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("parameter '%s' must be a boolean, not '%s'", name, value)
+			}
+			params[name] = boolean
+		default:
+			return nil, fmt.Errorf("parameter '%s' has unsupported type '%s'", name, paramType)
+		}
+	}
+	return params, nil
 }
 
 // cleanReturnedObj Lua cannot distinguish an empty table as an array or map, and the library we are using choose to
@@ -281,15 +524,17 @@ func noAvailableActions(jsonBytes []byte) bool {
 }
 
 func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) (string, error) {
-	key := GetConfigMapKey(obj.GroupVersionKind())
-	override, ok := vm.ResourceOverrides[key]
-	if ok && override.Actions != "" {
+	if override, ok := vm.getOverride(obj.GroupVersionKind(), hasActions); ok {
 		actions, err := override.GetActions()
 		if err != nil {
 			return "", err
 		}
 		return actions.ActionDiscoveryLua, nil
 	}
+	key := getPredefinedActionsKey(obj.GroupVersionKind())
+	if key == "" {
+		return "", nil
+	}
 	discoveryKey := fmt.Sprintf("%s/actions/", key)
 	discoveryScript, err := vm.getPredefinedLuaScripts(discoveryKey, actionDiscoveryScriptFile)
 	if err != nil {
@@ -300,9 +545,7 @@ func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) (string,
 
 // GetResourceAction attempts to read lua script from config and then filesystem for that resource
 func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string) (appv1.ResourceActionDefinition, error) {
-	key := GetConfigMapKey(obj.GroupVersionKind())
-	override, ok := vm.ResourceOverrides[key]
-	if ok && override.Actions != "" {
+	if override, ok := vm.getOverride(obj.GroupVersionKind(), hasActions); ok {
 		actions, err := override.GetActions()
 		if err != nil {
 			return appv1.ResourceActionDefinition{}, err
@@ -314,6 +557,10 @@ func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string
 		}
 	}
 
+	key := getPredefinedActionsKey(obj.GroupVersionKind())
+	if key == "" {
+		key = GetConfigMapKey(obj.GroupVersionKind())
+	}
 	actionKey := fmt.Sprintf("%s/actions/%s", key, actionName)
 	actionScript, err := vm.getPredefinedLuaScripts(actionKey, actionScriptFile)
 	if err != nil {
@@ -333,6 +580,67 @@ func GetConfigMapKey(gvk schema.GroupVersionKind) string {
 	return fmt.Sprintf("%s/%s", gvk.Group, gvk.Kind)
 }
 
+// getPredefinedKeys returns the paths of the built-in resource customizations matching the resource, ordered from the
+// most to the least specific. Built-in customizations for groups and kinds matching a glob pattern are stored in
+// directories in which '_' stands for '*', e.g. '_.crossplane.io/_'.
+func getPredefinedKeys(gvk schema.GroupVersionKind) []string {
+	predefinedPatternsOnce.Do(loadPredefinedPatterns)
+	keys := []string{GetConfigMapKey(gvk)}
+	var patterns []string
+	for pattern := range predefinedPatterns {
+		if appv1.OverrideKeyMatches(pattern, gvk.GroupKind()) {
+			patterns = append(patterns, pattern)
+		}
+	}
+	appv1.SortOverrideKeys(patterns)
+	for _, pattern := range patterns {
+		keys = append(keys, predefinedPatterns[pattern])
+	}
+	return keys
+}
+
+var (
+	// predefinedPatterns maps the glob patterns of built-in resource customizations to their paths
+	predefinedPatterns     map[string]string
+	predefinedPatternsOnce sync.Once
+)
+
+func loadPredefinedPatterns() {
+	predefinedPatterns = make(map[string]string)
+	groups, err := resource_customizations.Embedded.ReadDir(".")
+	if err != nil {
+		log.Warnf("Failed to read built-in resource customizations: %v", err)
+		return
+	}
+	for _, group := range groups {
+		if !group.IsDir() {
+			continue
+		}
+		kinds, err := resource_customizations.Embedded.ReadDir(group.Name())
+		if err != nil {
+			log.Warnf("Failed to read built-in resource customizations: %v", err)
+			continue
+		}
+		for _, kind := range kinds {
+			key := fmt.Sprintf("%s/%s", group.Name(), kind.Name())
+			if kind.IsDir() && strings.Contains(key, "_") {
+				predefinedPatterns[strings.ReplaceAll(key, "_", "*")] = key
+			}
+		}
+	}
+}
+
+// getPredefinedActionsKey returns the path of the most specific built-in resource customization matching the resource
+// which provides actions, or an empty string if there is none
+func getPredefinedActionsKey(gvk schema.GroupVersionKind) string {
+	for _, key := range getPredefinedKeys(gvk) {
+		if _, err := resource_customizations.Embedded.ReadFile(filepath.Join(key, "actions", actionDiscoveryScriptFile)); err == nil {
+			return key
+		}
+	}
+	return ""
+}
+
 func (vm VM) getPredefinedLuaScripts(objKey string, scriptFile string) (string, error) {
 	data, err := resource_customizations.Embedded.ReadFile(filepath.Join(objKey, scriptFile))
 	if err != nil {
@@ -7,8 +7,10 @@ import (
 	"github.com/argoproj/gitops-engine/pkg/health"
 	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	appv1 "github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/util/grpc"
@@ -126,6 +128,60 @@ func TestGetHealthScriptWithOverride(t *testing.T) {
 	assert.Equal(t, newHealthStatusFunction, script)
 }
 
+func TestGetHealthScriptWithGlobOverride(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"*/*":           {HealthLua: "return {status = 'Unknown'}"},
+			"*.io/*":        {HealthLua: "return {status = 'Progressing'}"},
+			"argoproj.io/*": {HealthLua: newHealthStatusFunction},
+			// the exact override has no health script and must not hide the patterns
+			"argoproj.io/Rollout": {Actions: "discovery.lua: return {}"},
+		},
+	}
+	script, _, err := vm.GetHealthScript(testObj)
+	assert.NoError(t, err)
+	assert.Equal(t, newHealthStatusFunction, script)
+}
+
+func TestGetHealthScriptPredefinedGlob(t *testing.T) {
+	testObj := StrToUnstructured(`
+apiVersion: ec2.aws.crossplane.io/v1beta1
+kind: VPC
+metadata:
+  name: sample-vpc`)
+	vm := VM{}
+	script, _, err := vm.GetHealthScript(testObj)
+	assert.NoError(t, err)
+	builtInScript, err := vm.getPredefinedLuaScripts("_.crossplane.io/_", healthScriptFile)
+	assert.NoError(t, err)
+	assert.Equal(t, builtInScript, script)
+
+	// an exact built-in customization takes precedence over a pattern
+	testObj = StrToUnstructured(`
+apiVersion: pkg.crossplane.io/v1
+kind: Provider
+metadata:
+  name: provider-aws`)
+	script, _, err = vm.GetHealthScript(testObj)
+	assert.NoError(t, err)
+	assert.NotEqual(t, builtInScript, script)
+}
+
+func TestGetMatchingOverrideKeys(t *testing.T) {
+	overrides := map[string]appv1.ResourceOverride{
+		"*/*":                          {},
+		"*.crossplane.io/*":            {},
+		"ec2.aws.crossplane.io/*":      {},
+		"*.crossplane.io/VPC":          {},
+		"ec2.aws.crossplane.io/VPC":    {},
+		"ec2.aws.crossplane.io/Subnet": {},
+		"apps/Deployment":              {},
+	}
+	keys := GetMatchingOverrideKeys(overrides, schema.GroupVersionKind{Group: "ec2.aws.crossplane.io", Version: "v1beta1", Kind: "VPC"})
+	assert.Equal(t, []string{"ec2.aws.crossplane.io/VPC", "ec2.aws.crossplane.io/*", "*.crossplane.io/VPC", "*.crossplane.io/*", "*/*"}, keys)
+}
+
 func TestGetHealthScriptPredefined(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
@@ -217,6 +273,32 @@ func TestGetResourceActionDiscoveryWithOverride(t *testing.T) {
 	assert.Equal(t, validDiscoveryLua, discoveryLua)
 }
 
+func TestGetResourceActionDiscoveryWithGlobOverride(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"*.io/*": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					ActionDiscoveryLua: "return {}",
+				})),
+			},
+			"argoproj.io/*": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					ActionDiscoveryLua: validDiscoveryLua,
+					Definitions:        []appv1.ResourceActionDefinition{{Name: "resume", ActionLua: "return obj"}},
+				})),
+			},
+		},
+	}
+	discoveryLua, err := vm.GetResourceActionDiscovery(testObj)
+	assert.NoError(t, err)
+	assert.Equal(t, validDiscoveryLua, discoveryLua)
+
+	action, err := vm.GetResourceAction(testObj, "resume")
+	assert.NoError(t, err)
+	assert.Equal(t, "return obj", action.ActionLua)
+}
+
 const validDiscoveryLua = `
 scaleParams = { {name = "replicas", type = "number"} }
 scale = {name = 'scale', params = scaleParams}
@@ -300,15 +382,17 @@ func TestExecuteResourceAction(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	expectedObj := StrToUnstructured(expectedUpdatedObj)
 	vm := VM{}
-	newObj, err := vm.ExecuteResourceAction(testObj, validActionLua)
+	impactedResources, err := vm.ExecuteResourceAction(testObj, validActionLua, nil)
 	assert.Nil(t, err)
-	assert.Equal(t, expectedObj, newObj)
+	assert.Len(t, impactedResources, 1)
+	assert.Equal(t, PatchOperation, impactedResources[0].K8SOperation)
+	assert.Equal(t, expectedObj, impactedResources[0].UnstructuredObj)
 }
 
 func TestExecuteResourceActionNonTableReturn(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, returnInt)
+	_, err := vm.ExecuteResourceAction(testObj, returnInt, nil)
 	assert.Errorf(t, err, incorrectReturnType, "table", "number")
 }
 
@@ -320,7 +404,7 @@ return newObj
 func TestExecuteResourceActionInvalidUnstructured(t *testing.T) {
 	testObj := StrToUnstructured(objJSON)
 	vm := VM{}
-	_, err := vm.ExecuteResourceAction(testObj, invalidTableReturn)
+	_, err := vm.ExecuteResourceAction(testObj, invalidTableReturn, nil)
 	assert.Error(t, err)
 }
 
@@ -375,12 +459,84 @@ func TestCleanPatch(t *testing.T) {
 	testObj := StrToUnstructured(objWithEmptyStruct)
 	expectedObj := StrToUnstructured(expectedUpdatedObjWithEmptyStruct)
 	vm := VM{}
-	newObj, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua)
+	impactedResources, err := vm.ExecuteResourceAction(testObj, pausedToFalseLua, nil)
 	assert.Nil(t, err)
-	assert.Equal(t, expectedObj, newObj)
+	assert.Len(t, impactedResources, 1)
+	assert.Equal(t, expectedObj, impactedResources[0].UnstructuredObj)
 
 }
 
+const createJobActionLua = `
+job = {}
+job.apiVersion = "batch/v1"
+job.kind = "Job"
+job.metadata = {}
+job.metadata.name = obj.metadata.name .. "-" .. actionParams["suffix"]
+job.metadata.namespace = obj.metadata.namespace
+
+obj.metadata.labels["last-job"] = job.metadata.name
+
+return {{operation = "create", resource = job}, {operation = "patch", resource = obj}}
+`
+
+func TestExecuteResourceActionOperations(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	impactedResources, err := vm.ExecuteResourceAction(testObj, createJobActionLua, map[string]interface{}{"suffix": "manual"})
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 2)
+
+	assert.Equal(t, CreateOperation, impactedResources[0].K8SOperation)
+	job := impactedResources[0].UnstructuredObj
+	assert.Equal(t, "Job", job.GetKind())
+	assert.Equal(t, "helm-guestbook-manual", job.GetName())
+	assert.Equal(t, "default", job.GetNamespace())
+
+	assert.Equal(t, PatchOperation, impactedResources[1].K8SOperation)
+	assert.Equal(t, "helm-guestbook-manual", impactedResources[1].UnstructuredObj.GetLabels()["last-job"])
+}
+
+func TestExecuteResourceActionInvalidOperations(t *testing.T) {
+	testObj := StrToUnstructured(objJSON)
+	vm := VM{}
+	_, err := vm.ExecuteResourceAction(testObj, `return {{operation = "delete", resource = obj}}`, nil)
+	assert.EqualError(t, err, "unsupported operation 'delete' returned by resource action")
+
+	_, err = vm.ExecuteResourceAction(testObj, `return {{operation = "create", resource = {kind = "Job"}}}`, nil)
+	assert.Error(t, err)
+}
+
+func TestParseResourceActionParams(t *testing.T) {
+	declared := []appv1.ResourceActionParam{
+		{Name: "replicas", Type: ResourceActionParamTypeNumber, Default: "1"},
+		{Name: "force", Type: ResourceActionParamTypeBoolean},
+		{Name: "reason"},
+	}
+
+	t.Run("Defaults", func(t *testing.T) {
+		params, err := ParseResourceActionParams(declared, nil)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"replicas": float64(1)}, params)
+	})
+	t.Run("Typed", func(t *testing.T) {
+		params, err := ParseResourceActionParams(declared, []appv1.ResourceActionParam{
+			{Name: "replicas", Value: "3"},
+			{Name: "force", Value: "true"},
+			{Name: "reason", Value: "maintenance"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"replicas": float64(3), "force": true, "reason": "maintenance"}, params)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		_, err := ParseResourceActionParams(declared, []appv1.ResourceActionParam{{Name: "foo", Value: "bar"}})
+		assert.EqualError(t, err, "unknown parameter 'foo'")
+	})
+	t.Run("InvalidValue", func(t *testing.T) {
+		_, err := ParseResourceActionParams(declared, []appv1.ResourceActionParam{{Name: "replicas", Value: "many"}})
+		assert.EqualError(t, err, "parameter 'replicas' must be a number, not 'many'")
+	})
+}
+
 func TestGetResourceHealth(t *testing.T) {
 	const testSA = `
 apiVersion: v1
@@ -431,3 +587,47 @@ return hs`
 		assert.Nil(t, status)
 	})
 }
+
+func TestGetResourceHealthWithChildren(t *testing.T) {
+	const testSA = `
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: test
+  namespace: test`
+
+	const script = `
+hs = {status = "Healthy", message = "no children"}
+if children ~= nil then
+  hs.message = #children .. " children, first is " .. children[1].kind .. "/" .. children[1].name
+end
+return hs`
+
+	getChildren := func(obj *unstructured.Unstructured, limit int) ([]appv1.ResourceNode, error) {
+		var children []appv1.ResourceNode
+		for i := 0; i < limit+10; i++ {
+			children = append(children, appv1.ResourceNode{ResourceRef: appv1.ResourceRef{Version: "v1", Kind: "Secret", Name: fmt.Sprintf("token-%d", i)}})
+		}
+		return children, nil
+	}
+
+	t.Run("OptedIn", func(t *testing.T) {
+		overrides := ResourceHealthOverridesWithChildren{
+			Overrides:   map[string]appv1.ResourceOverride{"ServiceAccount": {HealthLua: script, UseChildren: true}},
+			GetChildren: getChildren,
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(testSA))
+		assert.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("%d children, first is Secret/token-0", MaxHealthChildren), status.Message)
+	})
+
+	t.Run("NotOptedIn", func(t *testing.T) {
+		overrides := ResourceHealthOverridesWithChildren{
+			Overrides:   map[string]appv1.ResourceOverride{"ServiceAccount": {HealthLua: script}},
+			GetChildren: getChildren,
+		}
+		status, err := overrides.GetResourceHealth(StrToUnstructured(testSA))
+		assert.NoError(t, err)
+		assert.Equal(t, "no children", status.Message)
+	})
+}
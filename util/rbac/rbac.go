@@ -5,6 +5,7 @@ import (
 	"encoding/csv"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +27,7 @@ import (
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	v1 "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -39,6 +41,11 @@ const (
 	GlobMatchMode             = "glob"
 	RegexMatchMode            = "regex"
 
+	// ConfigMapPolicyConfigMapSelectorKey is the label selector of additional ConfigMaps to load policy fragments from
+	ConfigMapPolicyConfigMapSelectorKey = "policy.configMapSelector"
+	// ConfigMapScopeExpressionsKey is the key of the expressions which extract additional groups from the claims
+	ConfigMapScopeExpressionsKey = "scopes.expressions"
+
 	defaultRBACSyncPeriod = 10 * time.Minute
 )
 
@@ -50,6 +57,8 @@ type CasbinEnforcer interface {
 	EnableEnforce(bool)
 	AddFunction(name string, function govaluate.ExpressionFunction)
 	GetGroupingPolicy() [][]string
+	EnforceEx(rvals ...interface{}) (bool, []string, error)
+	GetImplicitRolesForUser(name string, domain ...string) ([]string, error)
 }
 
 // Enforcer is a wrapper around an Casbin enforcer that:
@@ -68,9 +77,16 @@ type Enforcer struct {
 	namespace          string
 	configmap          string
 	claimsEnforcerFunc ClaimsEnforcerFunc
+	claimsExplainFunc  ClaimsExplainFunc
 	model              model.Model
 	defaultRole        string
 	matchMode          string
+	// policySelector selects additional ConfigMaps to load policy fragments from, nil if not configured
+	policySelector labels.Selector
+	// fragmentInformerSelector is the selector of the running fragment informer, which is stopped by
+	// stopFragmentInformer
+	fragmentInformerSelector string
+	stopFragmentInformer     context.CancelFunc
 }
 
 // cachedEnforcer holds the Casbin enforcer instances and optional custom project policy
@@ -141,6 +157,41 @@ func (e *Enforcer) tryGetCabinEnforcer(project string, policy string) (CasbinEnf
 // ClaimsEnforcerFunc is func template to enforce a JWT claims. The subject is replaced
 type ClaimsEnforcerFunc func(claims jwt.Claims, rvals ...interface{}) bool
 
+// ClaimsExplainFunc is func template to explain the enforcement of a JWT claims
+type ClaimsExplainFunc func(claims jwt.Claims, rvals ...interface{}) *Explanation
+
+// Explanation describes how an enforcement decision was reached
+type Explanation struct {
+	// Allowed indicates whether the request is permitted
+	Allowed bool
+	// Subject is the subject the decision was made for
+	Subject string
+	// Groups are the groups of the subject which were taken into account, e.g. OIDC groups
+	Groups []string
+	// Roles are the roles the subject and its groups resolve to through grouping policies
+	Roles []string
+	// Policy is the policy line which decided the request, if any
+	Policy string
+	// Reason is a human readable description of the decision
+	Reason string
+}
+
+// AddRoles adds the given roles to the explanation, skipping duplicates
+func (e *Explanation) AddRoles(roles ...string) {
+	for _, role := range roles {
+		found := false
+		for _, existing := range e.Roles {
+			if existing == role {
+				found = true
+				break
+			}
+		}
+		if !found {
+			e.Roles = append(e.Roles, role)
+		}
+	}
+}
+
 func newEnforcerSafe(matchFunction govaluate.ExpressionFunction, params ...interface{}) (e CasbinEnforcer, err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -233,12 +284,24 @@ func (e *Enforcer) SetClaimsEnforcerFunc(claimsEnforcer ClaimsEnforcerFunc) {
 	e.claimsEnforcerFunc = claimsEnforcer
 }
 
+// SetClaimsExplainFunc sets a claims explain function which mirrors the claims enforce function and
+// is used to explain the enforcement of JWT claims
+func (e *Enforcer) SetClaimsExplainFunc(claimsExplain ClaimsExplainFunc) {
+	e.claimsExplainFunc = claimsExplain
+}
+
 // Enforce is a wrapper around casbin.Enforce to additionally enforce a default role and a custom
 // claims function
 func (e *Enforcer) Enforce(rvals ...interface{}) bool {
 	return enforce(e.getCabinEnforcer("", ""), e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
+// Explain evaluates the request like Enforce and returns an explanation of the decision, including the
+// resolved roles and the policy line which decided the request
+func (e *Enforcer) Explain(rvals ...interface{}) *Explanation {
+	return explain(e.getCabinEnforcer("", ""), e.defaultRole, e.claimsExplainFunc, rvals...)
+}
+
 // EnforceErr is a convenience helper to wrap a failed enforcement with a detailed error about the request
 func (e *Enforcer) EnforceErr(rvals ...interface{}) error {
 	if !e.Enforce(rvals...) {
@@ -288,8 +351,26 @@ func (e *Enforcer) EnforceWithCustomEnforcer(enf CasbinEnforcer, rvals ...interf
 	return enforce(enf, e.defaultRole, e.claimsEnforcerFunc, rvals...)
 }
 
+// ExplainRuntimePolicy explains the enforcement of a policy defined at run-time, see EnforceRuntimePolicy.
+func (e *Enforcer) ExplainRuntimePolicy(project string, policy string, rvals ...interface{}) *Explanation {
+	enf := e.CreateEnforcerWithRuntimePolicy(project, policy)
+	return e.ExplainWithCustomEnforcer(enf, rvals...)
+}
+
+// ExplainWithCustomEnforcer wraps explain with an custom enforcer
+func (e *Enforcer) ExplainWithCustomEnforcer(enf CasbinEnforcer, rvals ...interface{}) *Explanation {
+	return explain(enf, e.defaultRole, e.claimsExplainFunc, rvals...)
+}
+
 // enforce is a helper to additionally check a default role and invoke a custom claims enforcement function
 func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEnforcerFunc, rvals ...interface{}) bool {
+	// requests of tokens restricted to scopes must be within one of the scopes, in addition to being permitted by
+	// the policy
+	if len(rvals) > 0 {
+		if claims, ok := rvals[0].(jwt.Claims); ok && !scopesPermit(claims, rvals[1:]...) {
+			return false
+		}
+	}
 	// check the default role
 	if defaultRole != "" && len(rvals) >= 2 {
 		if ok, err := enf.Enforce(append([]interface{}{defaultRole}, rvals[1:]...)...); ok && err == nil {
@@ -317,6 +398,79 @@ func enforce(enf CasbinEnforcer, defaultRole string, claimsEnforcerFunc ClaimsEn
 	return ok && err == nil
 }
 
+// explain is the counterpart of enforce which returns how the decision was reached
+func explain(enf CasbinEnforcer, defaultRole string, claimsExplainFunc ClaimsExplainFunc, rvals ...interface{}) *Explanation {
+	if len(rvals) == 0 {
+		return &Explanation{Reason: "no subject"}
+	}
+	if claims, ok := rvals[0].(jwt.Claims); ok && !scopesPermit(claims, rvals[1:]...) {
+		res := &Explanation{Reason: "not within the token scopes"}
+		if mapClaims, err := jwtutil.MapClaims(claims); err == nil {
+			res.Subject = jwtutil.StringField(mapClaims, "sub")
+		}
+		if scopes, _ := GetTokenScopes(claims); len(scopes) > 0 {
+			res.Reason = fmt.Sprintf("not within the token scopes '%s'", strings.Join(scopes, "', '"))
+		}
+		return res
+	}
+	var res *Explanation
+	switch s := rvals[0].(type) {
+	case string:
+		res = explainSubject(enf, s, rvals[1:]...)
+	case jwt.Claims:
+		if claimsExplainFunc != nil {
+			res = claimsExplainFunc(s, rvals...)
+		} else {
+			res = explainSubject(enf, "", rvals[1:]...)
+		}
+	default:
+		res = explainSubject(enf, "", rvals[1:]...)
+	}
+	if res.Allowed {
+		return res
+	}
+	// check the default role
+	if defaultRole != "" && len(rvals) >= 2 {
+		if defaultRes := explainSubject(enf, defaultRole, rvals[1:]...); defaultRes.Allowed {
+			res.Allowed = true
+			res.AddRoles(defaultRole)
+			res.Policy = defaultRes.Policy
+			res.Reason = fmt.Sprintf("allowed by default role '%s'", defaultRole)
+		}
+	}
+	return res
+}
+
+// explainSubject explains the enforcement of the request for a single subject
+func explainSubject(enf CasbinEnforcer, subject string, rvals ...interface{}) *Explanation {
+	res := &Explanation{Subject: subject}
+	if subject != "" {
+		if roles, err := enf.GetImplicitRolesForUser(subject); err == nil {
+			res.AddRoles(roles...)
+		}
+	}
+	ok, policy, err := enf.EnforceEx(append([]interface{}{subject}, rvals...)...)
+	if err != nil {
+		res.Reason = fmt.Sprintf("failed to evaluate policy: %v", err)
+		return res
+	}
+	res.Allowed = ok
+	if len(policy) > 0 {
+		res.Policy = "p, " + strings.Join(policy, ", ")
+	}
+	switch {
+	case ok && len(policy) == 0:
+		res.Reason = "RBAC enforcement is disabled"
+	case ok:
+		res.Reason = "allowed by policy"
+	case len(policy) > 0:
+		res.Reason = "denied by policy"
+	default:
+		res.Reason = "no matching policy"
+	}
+	return res
+}
+
 // SetBuiltinPolicy sets a built-in policy, which augments any user defined policies
 func (e *Enforcer) SetBuiltinPolicy(policy string) error {
 	e.invalidateCache(func() {
@@ -372,6 +526,7 @@ func (e *Enforcer) runInformer(ctx context.Context, onUpdated func(cm *apiv1.Con
 					} else {
 						log.Infof("RBAC ConfigMap '%s' added", e.configmap)
 					}
+					e.updateFragmentInformer(ctx, onUpdated)
 				}
 			},
 			UpdateFunc: func(old, new interface{}) {
@@ -386,37 +541,224 @@ func (e *Enforcer) runInformer(ctx context.Context, onUpdated func(cm *apiv1.Con
 				} else {
 					log.Infof("RBAC ConfigMap '%s' updated", e.configmap)
 				}
+				e.updateFragmentInformer(ctx, onUpdated)
 			},
 		},
 	)
+	e.updateFragmentInformer(ctx, onUpdated)
 	log.Info("Starting rbac config informer")
 	cmInformer.Run(ctx.Done())
 	log.Info("rbac configmap informer cancelled")
 }
 
-// syncUpdate updates the enforcer
+// updateFragmentInformer starts, restarts or stops the fragment informer according to the current
+// policy.configMapSelector, so that ConfigMaps are only watched while a selector is configured
+func (e *Enforcer) updateFragmentInformer(ctx context.Context, onUpdated func(cm *apiv1.ConfigMap) error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	var selectorStr string
+	if e.policySelector != nil {
+		selectorStr = e.policySelector.String()
+	}
+	if e.stopFragmentInformer != nil && e.fragmentInformerSelector == selectorStr {
+		return
+	}
+	if e.stopFragmentInformer != nil {
+		e.stopFragmentInformer()
+		e.stopFragmentInformer = nil
+	}
+	e.fragmentInformerSelector = selectorStr
+	if selectorStr == "" {
+		return
+	}
+	informerCtx, cancel := context.WithCancel(ctx)
+	e.stopFragmentInformer = cancel
+	log.Infof("Starting rbac policy ConfigMap informer with selector '%s'", selectorStr)
+	go e.newFragmentInformer(informerCtx, selectorStr, onUpdated).Run(informerCtx.Done())
+}
+
+// newFragmentInformer returns an informer which reloads the policy when a ConfigMap selected by the
+// policy.configMapSelector of the rbac configmap changes. Only the selected ConfigMaps are listed and cached.
+func (e *Enforcer) newFragmentInformer(ctx context.Context, selector string, onUpdated func(cm *apiv1.ConfigMap) error) cache.SharedIndexInformer {
+	tweakConfigMap := func(options *metav1.ListOptions) {
+		options.LabelSelector = selector
+	}
+	indexers := cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}
+	cmInformer := v1.NewFilteredConfigMapInformer(e.clientset, e.namespace, defaultRBACSyncPeriod, indexers, tweakConfigMap)
+	resync := func(objs ...interface{}) {
+		selected := false
+		for _, obj := range objs {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			if cm, ok := obj.(*apiv1.ConfigMap); ok && e.isPolicyFragmentConfigMap(cm) {
+				selected = true
+			}
+		}
+		if !selected {
+			return
+		}
+		cm, err := e.clientset.CoreV1().ConfigMaps(e.namespace).Get(ctx, e.configmap, metav1.GetOptions{})
+		if err != nil {
+			if !apierr.IsNotFound(err) {
+				log.Error(err)
+			}
+			return
+		}
+		if err := e.syncUpdate(cm, onUpdated); err != nil {
+			log.Error(err)
+		} else {
+			log.Infof("RBAC policy fragments of ConfigMap '%s' reloaded", e.configmap)
+		}
+	}
+	cmInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				resync(obj)
+			},
+			UpdateFunc: func(old, new interface{}) {
+				if old.(*apiv1.ConfigMap).ResourceVersion == new.(*apiv1.ConfigMap).ResourceVersion {
+					return
+				}
+				resync(old, new)
+			},
+			DeleteFunc: func(obj interface{}) {
+				resync(obj)
+			},
+		},
+	)
+	return cmInformer
+}
+
+// isPolicyFragmentConfigMap returns whether the given ConfigMap is selected by the configured policy ConfigMap selector
+func (e *Enforcer) isPolicyFragmentConfigMap(cm *apiv1.ConfigMap) bool {
+	e.lock.Lock()
+	selector := e.policySelector
+	e.lock.Unlock()
+	return selector != nil && cm.Name != e.configmap && selector.Matches(labels.Set(cm.Labels))
+}
+
+// setPolicySelector parses and sets the label selector of additional policy ConfigMaps
+func (e *Enforcer) setPolicySelector(selectorStr string) labels.Selector {
+	var selector labels.Selector
+	if selectorStr != "" {
+		var err error
+		if selector, err = labels.Parse(selectorStr); err != nil {
+			log.Errorf("Ignoring invalid RBAC policy ConfigMap selector '%s': %v", selectorStr, err)
+			selector = nil
+		}
+	}
+	e.lock.Lock()
+	e.policySelector = selector
+	e.lock.Unlock()
+	return selector
+}
+
+// syncUpdate updates the enforcer. If any policy fragment is invalid, the update is rejected and the previous policy
+// stays in force.
 func (e *Enforcer) syncUpdate(cm *apiv1.ConfigMap, onUpdated func(cm *apiv1.ConfigMap) error) error {
+	selector := e.setPolicySelector(cm.Data[ConfigMapPolicyConfigMapSelectorKey])
+	fragments := GetPolicyFragments(cm)
+	if selector != nil {
+		cms, err := e.clientset.CoreV1().ConfigMaps(e.namespace).List(context.Background(), metav1.ListOptions{LabelSelector: selector.String()})
+		if err != nil {
+			return fmt.Errorf("error listing RBAC policy ConfigMaps: %w", err)
+		}
+		sort.Slice(cms.Items, func(i, j int) bool {
+			return cms.Items[i].Name < cms.Items[j].Name
+		})
+		for i := range cms.Items {
+			if cms.Items[i].Name != cm.Name {
+				fragments = append(fragments, GetPolicyFragments(&cms.Items[i])...)
+			}
+		}
+	}
+	policyCSV, errs := AggregatePolicyFragments(fragments)
+	if len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for i := range errs {
+			msgs[i] = errs[i].Error()
+		}
+		return fmt.Errorf("rejecting the RBAC policy update, the previous policy stays in force: %s", strings.Join(msgs, "; "))
+	}
 	e.SetDefaultRole(cm.Data[ConfigMapPolicyDefaultKey])
 	e.SetMatchMode(cm.Data[ConfigMapMatchModeKey])
-	policyCSV, ok := cm.Data[ConfigMapPolicyCSVKey]
-	if !ok {
-		policyCSV = ""
-	}
 	if err := onUpdated(cm); err != nil {
 		return err
 	}
 	return e.SetUserPolicy(policyCSV)
 }
 
+// PolicyFragment is a part of the user-defined policy
+type PolicyFragment struct {
+	// Source is the location of the fragment in the form <configmap>/<key>
+	Source string
+	Policy string
+}
+
+// GetPolicyFragments returns the policy fragments of the given ConfigMap, which are the policy.csv key followed by
+// the policy.<name>.csv keys in lexical order
+func GetPolicyFragments(cm *apiv1.ConfigMap) []PolicyFragment {
+	var keys []string
+	for key := range cm.Data {
+		if key != ConfigMapPolicyCSVKey && strings.HasPrefix(key, "policy.") && strings.HasSuffix(key, ".csv") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	if _, ok := cm.Data[ConfigMapPolicyCSVKey]; ok {
+		keys = append([]string{ConfigMapPolicyCSVKey}, keys...)
+	}
+	fragments := make([]PolicyFragment, len(keys))
+	for i, key := range keys {
+		fragments[i] = PolicyFragment{Source: fmt.Sprintf("%s/%s", cm.Name, key), Policy: cm.Data[key]}
+	}
+	return fragments
+}
+
+// AggregatePolicyFragments validates the given policy fragments and joins them into a single policy. Every invalid
+// fragment is reported in the returned errors, in which case the policy must not be applied: skipping a fragment could
+// silently drop its deny lines.
+func AggregatePolicyFragments(fragments []PolicyFragment) (string, []error) {
+	var policies []string
+	var errs []error
+	for _, fragment := range fragments {
+		if err := ValidatePolicy(fragment.Policy); err != nil {
+			errs = append(errs, fmt.Errorf("invalid RBAC policy fragment '%s': %w", fragment.Source, err))
+			continue
+		}
+		policies = append(policies, fragment.Policy)
+	}
+	if len(errs) > 0 {
+		return "", errs
+	}
+	return strings.Join(policies, "\n"), nil
+}
+
 // ValidatePolicy verifies a policy string is acceptable to casbin
 func ValidatePolicy(policy string) error {
-	_, err := newEnforcerSafe(globMatchFunc, newBuiltInModel(), newAdapter("", "", policy))
+	enf, err := newEnforcerSafe(globMatchFunc, newBuiltInModel(), newAdapter("", "", policy))
+	if err == nil {
+		// casbin only detects policy lines with a wrong number of fields when evaluating them
+		err = evaluatePolicySafe(enf)
+	}
 	if err != nil {
 		return fmt.Errorf("policy syntax error: %s", policy)
 	}
 	return nil
 }
 
+// evaluatePolicySafe evaluates all policy lines of the given enforcer once
+func evaluatePolicySafe(enf CasbinEnforcer) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	_, err = enf.Enforce("", "", "", "")
+	return err
+}
+
 // newBuiltInModel is a helper to return a brand new casbin model from the built-in model string.
 // This is needed because it is not safe to re-use the same casbin Model when instantiating new
 // casbin enforcers.
@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 )
 
@@ -53,6 +54,112 @@ func TestPolicyInformer(t *testing.T) {
 	assert.False(t, enf.Enforce("admin", "applications", "delete", "foo/bar"))
 }
 
+// TestPolicyFragmentInformer verifies the policy is reloaded when a selected policy ConfigMap changes
+func TestPolicyFragmentInformer(t *testing.T) {
+
+	// !race:
+	// Same as TestPolicyInformer
+
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyConfigMapSelectorKey] = "argocd.argoproj.io/rbac-policy=true"
+	kubeclientset := fake.NewSimpleClientset(cm)
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go enf.runInformer(ctx, func(cm *apiv1.ConfigMap) error {
+		return nil
+	})
+
+	fragment := fakeConfigMap()
+	fragment.Name = "team-a"
+	fragment.Labels = map[string]string{"argocd.argoproj.io/rbac-policy": "true"}
+	fragment.Data[ConfigMapPolicyCSVKey] = "p, alice, applications, delete, */*, allow"
+	_, err := kubeclientset.CoreV1().ConfigMaps(fakeNamespace).Create(ctx, fragment, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	loaded := false
+	for i := 1; i <= 20; i++ {
+		if enf.Enforce("alice", "applications", "delete", "foo/bar") {
+			loaded = true
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.True(t, loaded, "Policy fragment failed to load")
+}
+
+// TestPolicyFragmentInformerSelector verifies policy ConfigMaps are only watched while a selector is configured
+func TestPolicyFragmentInformerSelector(t *testing.T) {
+
+	// !race:
+	// Same as TestPolicyInformer
+
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyCSVKey] = "p, admin, applications, delete, */*, allow"
+	kubeclientset := fake.NewSimpleClientset(cm)
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go enf.runInformer(ctx, func(cm *apiv1.ConfigMap) error {
+		return nil
+	})
+
+	informerRunning := func() bool {
+		enf.lock.Lock()
+		defer enf.lock.Unlock()
+		return enf.stopFragmentInformer != nil
+	}
+
+	for i := 1; i <= 20 && !enf.Enforce("admin", "applications", "delete", "foo/bar"); i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.True(t, enf.Enforce("admin", "applications", "delete", "foo/bar"), "Policy update failed to load")
+	assert.False(t, informerRunning(), "Policy ConfigMaps must not be watched without a selector")
+
+	fragment := fakeConfigMap()
+	fragment.Name = "team-a"
+	fragment.Labels = map[string]string{"argocd.argoproj.io/rbac-policy": "true"}
+	fragment.Data[ConfigMapPolicyCSVKey] = "p, alice, applications, delete, */*, allow"
+	_, err := kubeclientset.CoreV1().ConfigMaps(fakeNamespace).Create(ctx, fragment, metav1.CreateOptions{})
+	assert.NoError(t, err)
+
+	cm.Data[ConfigMapPolicyConfigMapSelectorKey] = "argocd.argoproj.io/rbac-policy=true"
+	cm.ResourceVersion = "2"
+	_, err = kubeclientset.CoreV1().ConfigMaps(fakeNamespace).Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	loaded := false
+	for i := 1; i <= 20; i++ {
+		if enf.Enforce("alice", "applications", "delete", "foo/bar") {
+			loaded = true
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.True(t, loaded, "Policy fragment failed to load")
+	assert.True(t, informerRunning())
+
+	delete(cm.Data, ConfigMapPolicyConfigMapSelectorKey)
+	cm.ResourceVersion = "3"
+	_, err = kubeclientset.CoreV1().ConfigMaps(fakeNamespace).Update(ctx, cm, metav1.UpdateOptions{})
+	assert.NoError(t, err)
+
+	stopped := false
+	for i := 1; i <= 20; i++ {
+		if !informerRunning() {
+			stopped = true
+			break
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	assert.True(t, stopped, "Policy ConfigMap informer was not stopped")
+	assert.False(t, enf.Enforce("alice", "applications", "delete", "foo/bar"))
+}
+
 // TestResourceActionWildcards verifies the ability to use wildcards in resources and actions
 func TestResourceActionWildcards(t *testing.T) {
 
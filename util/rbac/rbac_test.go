@@ -289,6 +289,7 @@ func TestValidatePolicy(t *testing.T) {
 	badPolicies := []string{
 		"this, is, not, a, good, policy",
 		"this\ttoo",
+		"p, role:admin, projects",
 	}
 	for _, bad := range badPolicies {
 		assert.Error(t, ValidatePolicy(bad))
@@ -439,3 +440,126 @@ func TestLoadPolicyLine(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestExplain(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy(`
+g, alice, role:dev
+g, role:dev, role:readonly
+p, role:dev, applications, delete, */*, allow
+p, role:dev, applications, delete, prod/*, deny
+`)
+
+	res := enf.Explain("alice", "applications", "delete", "dev/my-app")
+	assert.True(t, res.Allowed)
+	assert.Equal(t, "alice", res.Subject)
+	assert.ElementsMatch(t, []string{"role:dev", "role:readonly"}, res.Roles)
+	assert.Equal(t, "p, role:dev, applications, delete, */*, allow", res.Policy)
+	assert.Equal(t, "allowed by policy", res.Reason)
+
+	res = enf.Explain("alice", "applications", "delete", "prod/my-app")
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "p, role:dev, applications, delete, prod/*, deny", res.Policy)
+	assert.Equal(t, "denied by policy", res.Reason)
+
+	res = enf.Explain("bob", "applications", "get", "dev/my-app")
+	assert.False(t, res.Allowed)
+	assert.Empty(t, res.Roles)
+	assert.Equal(t, "no matching policy", res.Reason)
+
+	enf.SetDefaultRole("role:readonly")
+	res = enf.Explain("bob", "applications", "get", "dev/my-app")
+	assert.True(t, res.Allowed)
+	assert.Equal(t, []string{"role:readonly"}, res.Roles)
+	assert.Equal(t, "p, role:readonly, applications, get, */*, allow", res.Policy)
+	assert.Equal(t, "allowed by default role 'role:readonly'", res.Reason)
+
+	// the explanation matches the enforcement decision for claims without a claims explain function
+	claims := jwt.MapClaims{"sub": "alice"}
+	assert.Equal(t, enf.Enforce(claims, "applications", "delete", "dev/my-app"), enf.Explain(claims, "applications", "delete", "dev/my-app").Allowed)
+}
+
+func TestGetPolicyFragments(t *testing.T) {
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyDefaultKey] = "role:readonly"
+	cm.Data["policy.team-b.csv"] = "p, bob, applications, get, team-b/*, allow"
+	cm.Data["policy.team-a.csv"] = "p, alice, applications, get, team-a/*, allow"
+	cm.Data[ConfigMapPolicyCSVKey] = "p, admin, *, *, *, allow"
+
+	assert.Equal(t, []PolicyFragment{
+		{Source: fakeConfigMapName + "/policy.csv", Policy: "p, admin, *, *, *, allow"},
+		{Source: fakeConfigMapName + "/policy.team-a.csv", Policy: "p, alice, applications, get, team-a/*, allow"},
+		{Source: fakeConfigMapName + "/policy.team-b.csv", Policy: "p, bob, applications, get, team-b/*, allow"},
+	}, GetPolicyFragments(cm))
+	assert.Empty(t, GetPolicyFragments(fakeConfigMap()))
+}
+
+func TestAggregatePolicyFragments(t *testing.T) {
+	policy, errs := AggregatePolicyFragments([]PolicyFragment{
+		{Source: "cm/policy.csv", Policy: "p, alice, applications, get, */*, allow"},
+		{Source: "cm/policy.broken.csv", Policy: "x, bob, applications, get, */*, allow"},
+		{Source: "cm/policy.team.csv", Policy: "g, bob, role:readonly"},
+	})
+	assert.Empty(t, policy)
+	if assert.Len(t, errs, 1) {
+		assert.Contains(t, errs[0].Error(), "invalid RBAC policy fragment 'cm/policy.broken.csv'")
+	}
+
+	policy, errs = AggregatePolicyFragments([]PolicyFragment{
+		{Source: "cm/policy.csv", Policy: "p, alice, applications, get, */*, allow"},
+		{Source: "cm/policy.team.csv", Policy: "g, bob, role:readonly"},
+	})
+	assert.Empty(t, errs)
+	assert.Equal(t, "p, alice, applications, get, */*, allow\ng, bob, role:readonly", policy)
+}
+
+func TestPolicyFragmentConfigMaps(t *testing.T) {
+	cm := fakeConfigMap()
+	cm.Data[ConfigMapPolicyCSVKey] = "p, alice, applications, get, */*, allow"
+	fragmentCM := func(name string, selected bool, policy string) *apiv1.ConfigMap {
+		fragment := fakeConfigMap()
+		fragment.Name = name
+		if selected {
+			fragment.Labels = map[string]string{"argocd.argoproj.io/rbac-policy": "true"}
+		}
+		fragment.Data["policy.csv"] = policy
+		return fragment
+	}
+	kubeclientset := fake.NewSimpleClientset(
+		cm,
+		fragmentCM("team-a", true, "p, bob, applications, get, */*, allow"),
+		fragmentCM("team-b", true, "p, cathy, applications, get, */*, allow"),
+		fragmentCM("other", false, "p, dave, applications, get, */*, allow"),
+	)
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+
+	assert.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "foo/bar"))
+	assert.False(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
+
+	cm.Data[ConfigMapPolicyConfigMapSelectorKey] = "argocd.argoproj.io/rbac-policy=true"
+	assert.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "foo/bar"))
+	assert.True(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
+	assert.False(t, enf.Enforce("dave", "applications", "get", "foo/bar"))
+	assert.True(t, enf.isPolicyFragmentConfigMap(fragmentCM("team-c", true, "")))
+	assert.False(t, enf.isPolicyFragmentConfigMap(fragmentCM("other", false, "")))
+
+	cm.Data[ConfigMapPolicyConfigMapSelectorKey] = "invalid selector!"
+	assert.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "foo/bar"))
+	assert.False(t, enf.Enforce("bob", "applications", "get", "foo/bar"))
+	assert.False(t, enf.isPolicyFragmentConfigMap(fragmentCM("team-c", true, "")))
+
+	// an invalid fragment rejects the whole update, so that e.g. the deny lines of a fragment are never dropped
+	cm.Data[ConfigMapPolicyCSVKey] = "p, alice, applications, get, */*, allow\np, alice, applications, get, secret/*, deny"
+	assert.NoError(t, enf.syncUpdate(cm, noOpUpdate))
+	assert.False(t, enf.Enforce("alice", "applications", "get", "secret/bar"))
+	cm.Data["policy.broken.csv"] = "p, alice, applications"
+	cm.Data[ConfigMapPolicyCSVKey] = "p, alice, applications, get, */*, allow"
+	assert.ErrorContains(t, enf.syncUpdate(cm, noOpUpdate), "invalid RBAC policy fragment 'fake-cm/policy.broken.csv'")
+	assert.False(t, enf.Enforce("alice", "applications", "get", "secret/bar"))
+	assert.True(t, enf.Enforce("alice", "applications", "get", "foo/bar"))
+}
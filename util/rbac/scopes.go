@@ -0,0 +1,166 @@
+package rbac
+
+import (
+	"fmt"
+	"strings"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+)
+
+// TokenScopesClaim is the claim of tokens which restricts them to the requests matching one of the listed scopes
+const TokenScopesClaim = "scopes"
+
+// TokenScope restricts a token to requests matching its resource, action and object. It is written like the
+// corresponding fields of a policy line, e.g. `applications, sync, team-a/*`, and supports the same globs.
+type TokenScope struct {
+	Resource string
+	Action   string
+	Object   string
+}
+
+// ParseTokenScope parses a scope in the format `<resource>, <action>, <object>`
+func ParseTokenScope(scope string) (*TokenScope, error) {
+	parts := strings.Split(scope, ",")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid token scope '%s': must be in the format '<resource>, <action>, <object>'", scope)
+	}
+	for i := range parts {
+		parts[i] = strings.TrimSpace(parts[i])
+		if parts[i] == "" {
+			return nil, fmt.Errorf("invalid token scope '%s': resource, action and object must not be empty", scope)
+		}
+	}
+	return &TokenScope{Resource: parts[0], Action: parts[1], Object: parts[2]}, nil
+}
+
+// String returns the scope in the format `<resource>, <action>, <object>`
+func (s *TokenScope) String() string {
+	return strings.Join([]string{s.Resource, s.Action, s.Object}, ", ")
+}
+
+// Matches returns whether the request is within the scope. Like in policies, the update and delete actions also match
+// their fine-grained variants.
+func (s *TokenScope) Matches(resource string, action string, object string) bool {
+	actionMatches := glob.Match(s.Action, action) || ((s.Action == "update" || s.Action == "delete") && strings.HasPrefix(action, s.Action+"/"))
+	return glob.Match(s.Resource, resource) && actionMatches && glob.Match(s.Object, object)
+}
+
+// NormalizeTokenScopes validates the scopes and returns them in their canonical format
+func NormalizeTokenScopes(scopes []string) ([]string, error) {
+	var normalized []string
+	for _, scope := range scopes {
+		tokenScope, err := ParseTokenScope(scope)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, tokenScope.String())
+	}
+	return normalized, nil
+}
+
+// Covers returns whether every request within the other scope is also within this scope. Globs in the other scope are
+// only covered by an identical or a `*` pattern, since they cannot be compared in general.
+func (s *TokenScope) Covers(other *TokenScope) bool {
+	actionCovered := patternCovers(s.Action, other.Action) ||
+		((s.Action == "update" || s.Action == "delete") && strings.HasPrefix(other.Action, s.Action+"/"))
+	return patternCovers(s.Resource, other.Resource) && actionCovered && patternCovers(s.Object, other.Object)
+}
+
+func patternCovers(pattern string, value string) bool {
+	if pattern == "*" || pattern == value {
+		return true
+	}
+	return !strings.ContainsAny(value, "*?[]{}\\") && glob.Match(pattern, value)
+}
+
+// TokenScopesWithin returns whether each of the scopes is covered by one of the allowed scopes, i.e. whether a token
+// restricted to the scopes cannot make any request which a token restricted to the allowed scopes cannot make
+func TokenScopesWithin(scopes []string, allowed []string) bool {
+	var allowedScopes []*TokenScope
+	for _, scope := range allowed {
+		if tokenScope, err := ParseTokenScope(scope); err == nil {
+			allowedScopes = append(allowedScopes, tokenScope)
+		}
+	}
+	for _, scope := range scopes {
+		tokenScope, err := ParseTokenScope(scope)
+		if err != nil {
+			return false
+		}
+		covered := false
+		for _, allowedScope := range allowedScopes {
+			if allowedScope.Covers(tokenScope) {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// EnsureTokenScopesWithinClaims returns a permission denied error if the claims are restricted to scopes and a new
+// token with the given scopes would not be restricted to the same or narrower scopes. It prevents scoped tokens from
+// creating tokens with more permissions than themselves.
+func EnsureTokenScopesWithinClaims(claims interface{}, scopes []string) error {
+	jwtClaims, ok := claims.(jwt.Claims)
+	if !ok {
+		return nil
+	}
+	allowed, restricted := GetTokenScopes(jwtClaims)
+	if !restricted {
+		return nil
+	}
+	if len(scopes) == 0 || !TokenScopesWithin(scopes, allowed) {
+		return status.Errorf(codes.PermissionDenied, "the scopes of the new token must be within the scopes '%s' of the current token", strings.Join(allowed, "', '"))
+	}
+	return nil
+}
+
+// GetTokenScopes returns the scopes of the claims and whether the claims are restricted to scopes at all
+func GetTokenScopes(claims jwt.Claims) ([]string, bool) {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		// fail closed, if the claims cannot be inspected the token must be treated as restricted
+		return nil, true
+	}
+	if _, ok := mapClaims[TokenScopesClaim]; !ok {
+		return nil, false
+	}
+	return jwtutil.GetScopeValues(mapClaims, []string{TokenScopesClaim}), true
+}
+
+// scopesPermit returns whether the request is permitted by the scopes of the claims. Requests of claims without scopes
+// are always permitted, since they are only restricted by the policy.
+func scopesPermit(claims jwt.Claims, rvals ...interface{}) bool {
+	scopes, restricted := GetTokenScopes(claims)
+	if !restricted {
+		return true
+	}
+	if len(rvals) != 3 {
+		return false
+	}
+	resource, resOk := rvals[0].(string)
+	action, actOk := rvals[1].(string)
+	object, objOk := rvals[2].(string)
+	if !resOk || !actOk || !objOk {
+		return false
+	}
+	for _, scope := range scopes {
+		tokenScope, err := ParseTokenScope(scope)
+		if err != nil {
+			continue
+		}
+		if tokenScope.Matches(resource, action, object) {
+			return true
+		}
+	}
+	return false
+}
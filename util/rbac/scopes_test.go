@@ -0,0 +1,94 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v2/util/assets"
+)
+
+func TestParseTokenScope(t *testing.T) {
+	scope, err := ParseTokenScope(" applications,sync ,  team-a/* ")
+	require.NoError(t, err)
+	assert.Equal(t, &TokenScope{Resource: "applications", Action: "sync", Object: "team-a/*"}, scope)
+	assert.Equal(t, "applications, sync, team-a/*", scope.String())
+
+	for _, invalid := range []string{"", "applications, sync", "applications, sync, team-a/*, allow", "applications, , team-a/*"} {
+		_, err := ParseTokenScope(invalid)
+		assert.Error(t, err, invalid)
+	}
+
+	normalized, err := NormalizeTokenScopes([]string{"applications,get,*/*", "logs, get, team-a/*"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"applications, get, */*", "logs, get, team-a/*"}, normalized)
+	_, err = NormalizeTokenScopes([]string{"applications"})
+	assert.Error(t, err)
+}
+
+func TestTokenScopeMatches(t *testing.T) {
+	scope := TokenScope{Resource: "applications", Action: "sync", Object: "team-a/*"}
+	assert.True(t, scope.Matches("applications", "sync", "team-a/guestbook"))
+	assert.False(t, scope.Matches("applications", "sync", "team-b/guestbook"))
+	assert.False(t, scope.Matches("applications", "delete", "team-a/guestbook"))
+	assert.False(t, scope.Matches("projects", "sync", "team-a/guestbook"))
+
+	scope = TokenScope{Resource: "applications", Action: "update", Object: "*"}
+	assert.True(t, scope.Matches("applications", "update", "team-a/guestbook"))
+	assert.True(t, scope.Matches("applications", "update/apps/Deployment/default/guestbook", "team-a/guestbook"))
+	assert.False(t, scope.Matches("applications", "delete/apps/Deployment/default/guestbook", "team-a/guestbook"))
+}
+
+func TestTokenScopesWithin(t *testing.T) {
+	allowed := []string{"applications, sync, team-a/*", "applications, update, */*", "logs, get, *"}
+	assert.True(t, TokenScopesWithin([]string{"applications, sync, team-a/*"}, allowed))
+	assert.True(t, TokenScopesWithin([]string{"applications, sync, team-a/guestbook", "logs, get, team-b/*"}, allowed))
+	assert.True(t, TokenScopesWithin([]string{"applications, update/*, team-b/guestbook"}, allowed))
+	assert.True(t, TokenScopesWithin(nil, allowed))
+	assert.False(t, TokenScopesWithin([]string{"applications, sync, team-b/guestbook"}, allowed))
+	assert.False(t, TokenScopesWithin([]string{"applications, sync, team-*"}, allowed))
+	assert.False(t, TokenScopesWithin([]string{"applications, *, team-a/guestbook"}, allowed))
+	assert.False(t, TokenScopesWithin([]string{"applications, sync, team-a/guestbook", "projects, get, *"}, allowed))
+	assert.False(t, TokenScopesWithin([]string{"applications, sync, team-a/guestbook"}, nil))
+}
+
+func TestEnforceTokenScopes(t *testing.T) {
+	kubeclientset := fake.NewSimpleClientset(fakeConfigMap())
+	enf := NewEnforcer(kubeclientset, fakeNamespace, fakeConfigMapName, nil)
+	_ = enf.SetBuiltinPolicy(assets.BuiltinPolicyCSV)
+	_ = enf.SetUserPolicy("g, ci, role:admin")
+	enf.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...interface{}) bool {
+		mapClaims := claims.(jwt.MapClaims)
+		return enf.Enforce(append([]interface{}{mapClaims["sub"]}, rvals[1:]...)...)
+	})
+
+	unscoped := jwt.MapClaims{"sub": "ci"}
+	assert.True(t, enf.Enforce(unscoped, "applications", "delete", "team-a/guestbook"))
+
+	scoped := jwt.MapClaims{"sub": "ci", "scopes": []interface{}{"applications, sync, team-a/*", "applications, get, */*"}}
+	assert.True(t, enf.Enforce(scoped, "applications", "sync", "team-a/guestbook"))
+	assert.True(t, enf.Enforce(scoped, "applications", "get", "team-b/guestbook"))
+	assert.False(t, enf.Enforce(scoped, "applications", "sync", "team-b/guestbook"))
+	assert.False(t, enf.Enforce(scoped, "applications", "delete", "team-a/guestbook"))
+	assert.Error(t, enf.EnforceErr(scoped, "applications", "delete", "team-a/guestbook"))
+
+	// scopes do not grant permissions beyond the policy
+	readonly := jwt.MapClaims{"sub": "reader", "scopes": []interface{}{"applications, sync, */*"}}
+	assert.False(t, enf.Enforce(readonly, "applications", "sync", "team-a/guestbook"))
+
+	// scopes also restrict the default role
+	enf.SetDefaultRole("role:readonly")
+	assert.False(t, enf.Enforce(scoped, "clusters", "get", "https://kubernetes.default.svc"))
+	assert.True(t, enf.Enforce(jwt.MapClaims{"sub": "reader"}, "clusters", "get", "https://kubernetes.default.svc"))
+
+	// an empty list of scopes permits nothing
+	assert.False(t, enf.Enforce(jwt.MapClaims{"sub": "ci", "scopes": []interface{}{}}, "applications", "get", "team-a/guestbook"))
+
+	res := enf.Explain(scoped, "applications", "delete", "team-a/guestbook")
+	assert.False(t, res.Allowed)
+	assert.Equal(t, "ci", res.Subject)
+	assert.Equal(t, "not within the token scopes 'applications, sync, team-a/*', 'applications, get, */*'", res.Reason)
+}
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	oidc "github.com/coreos/go-oidc"
@@ -41,6 +42,10 @@ type SessionManager struct {
 	storage                       UserStateStorage
 	sleep                         func(d time.Duration)
 	verificationDelayNoiseEnabled bool
+	// exchangeClient is used to discover the signing keys of external issuers configured for token exchange
+	exchangeClient        *http.Client
+	exchangeProviders     map[string]oidcutil.Provider
+	exchangeProvidersLock sync.Mutex
 }
 
 // LoginAttempts is a timestamped counter for failed login attempts
@@ -62,6 +67,7 @@ const (
 	accountDisabled             = "Account %s is disabled"
 	usernameTooLongError        = "Username is too long (%d bytes max)"
 	userDoesNotHaveCapability   = "Account %s does not have %s capability"
+	passwordChangeRequiredError = "Password change required, please log in again with a new password"
 	autoRegenerateTokenDuration = time.Minute * 5
 )
 
@@ -93,6 +99,8 @@ const (
 
 var (
 	InvalidLoginErr = status.Errorf(codes.Unauthenticated, invalidLoginError)
+	// PasswordChangeRequiredErr is returned on login if the password of the account was reset or has expired
+	PasswordChangeRequiredErr = status.Errorf(codes.FailedPrecondition, passwordChangeRequiredError)
 )
 
 // Returns the maximum cache size as number of entries
@@ -137,6 +145,9 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 	s.client = &http.Client{
 		Transport: transport,
 	}
+	s.exchangeClient = &http.Client{
+		Transport: transport.Clone(),
+	}
 
 	if settings.DexConfig != "" {
 		transport.TLSClientConfig = dex.TLSConfig(dexTlsConfig)
@@ -156,6 +167,18 @@ func NewSessionManager(settingsMgr *settings.SettingsManager, projectsLister v1a
 // Passing a value of `0` for secondsBeforeExpiry creates a token that never expires.
 // The id parameter holds an optional unique JWT token identifier and stored as a standard claim "jti" in the JWT token.
 func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id string) (string, error) {
+	return mgr.CreateScoped(subject, secondsBeforeExpiry, id, nil)
+}
+
+// scopedClaims are the claims of tokens which are restricted to the requests within their scopes
+type scopedClaims struct {
+	jwt.RegisteredClaims
+	Scopes []string `json:"scopes"`
+}
+
+// CreateScoped creates a new token like Create, which is additionally restricted to the given scopes if any. See
+// rbac.TokenScope for the format of the scopes.
+func (mgr *SessionManager) CreateScoped(subject string, secondsBeforeExpiry int64, id string, scopes []string) (string, error) {
 	// Create a new token object, specifying signing method and the claims
 	// you would like it to contain.
 	now := time.Now().UTC()
@@ -171,6 +194,9 @@ func (mgr *SessionManager) Create(subject string, secondsBeforeExpiry int64, id
 		claims.ExpiresAt = jwt.NewNumericDate(expires)
 	}
 
+	if len(scopes) > 0 {
+		return mgr.signClaims(scopedClaims{RegisteredClaims: claims, Scopes: scopes})
+	}
 	return mgr.signClaims(claims)
 }
 
@@ -229,6 +255,13 @@ func (mgr *SessionManager) Parse(tokenString string) (jwt.Claims, string, error)
 	subject := jwtutil.StringField(claims, "sub")
 	id := jwtutil.StringField(claims, "jti")
 
+	if _, ok := claims[TokenExchangeIssuerClaim]; ok {
+		if err := mgr.parseExchanged(claims); err != nil {
+			return nil, "", err
+		}
+		return token.Claims, "", nil
+	}
+
 	if projName, role, ok := rbacpolicy.GetProjectRoleFromSubject(subject); ok {
 		proj, err := mgr.projectsLister.Get(projName)
 		if err != nil {
@@ -459,7 +492,11 @@ func (mgr *SessionManager) VerifyUsernamePassword(username string, password stri
 	if !account.HasCapability(settings.AccountCapabilityLogin) {
 		return status.Errorf(codes.Unauthenticated, userDoesNotHaveCapability, username, settings.AccountCapabilityLogin)
 	}
-	mgr.updateFailureCount(username, false)
+	// the failure count of accounts with TOTP enabled is reset once the one-time password was verified, so that the
+	// one-time password cannot be brute-forced by someone who knows the password
+	if !account.TOTPEnabled {
+		mgr.updateFailureCount(username, false)
+	}
 	return nil
 }
 
@@ -538,6 +575,78 @@ func (mgr *SessionManager) RevokeToken(ctx context.Context, id string, expiringA
 	return mgr.storage.RevokeToken(ctx, id, expiringAt)
 }
 
+// AddSession stores the metadata of the session with the given token, which was just issued to the client with the
+// given user agent and IP address. The session is listed until it expires or is revoked.
+func (mgr *SessionManager) AddSession(ctx context.Context, tokenString string, userAgent string, clientIP string) error {
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	claims := jwt.MapClaims{}
+	if _, _, err := parser.ParseUnverified(tokenString, &claims); err != nil {
+		return err
+	}
+	issuedAt, err := jwtutil.IssuedAtTime(claims)
+	if err != nil {
+		return err
+	}
+	subject := jwtutil.StringField(claims, "sub")
+	if _, ok := claims[TokenExchangeIssuerClaim]; !ok {
+		subject, _ = GetSubjectAccountAndCapability(subject)
+	}
+	session := &SessionInfo{
+		ID:        jwtutil.StringField(claims, "jti"),
+		Subject:   subject,
+		IssuedAt:  issuedAt,
+		LastUsed:  issuedAt,
+		UserAgent: userAgent,
+		ClientIP:  clientIP,
+	}
+	if session.ID == "" {
+		return fmt.Errorf("session token has no id")
+	}
+	if expiresAt, err := jwtutil.ExpirationTime(claims); err == nil {
+		session.ExpiresAt = &expiresAt
+	}
+	return mgr.storage.AddSession(ctx, session)
+}
+
+// TouchSession updates the time the session with the given claims was last used
+func (mgr *SessionManager) TouchSession(ctx context.Context, claims jwt.Claims) error {
+	mapClaims, err := jwtutil.MapClaims(claims)
+	if err != nil {
+		return err
+	}
+	id := jwtutil.StringField(mapClaims, "jti")
+	if id == "" || jwtutil.StringField(mapClaims, "iss") != SessionManagerClaimsIssuer {
+		return nil
+	}
+	return mgr.storage.TouchSession(ctx, id, time.Now().UTC())
+}
+
+// GetSessions returns the active sessions of the given account or subject
+func (mgr *SessionManager) GetSessions(ctx context.Context, subject string) ([]SessionInfo, error) {
+	return mgr.storage.GetSessions(ctx, subject)
+}
+
+// RevokeSession revokes the session with the given id of the given account or subject
+func (mgr *SessionManager) RevokeSession(ctx context.Context, subject string, id string) error {
+	session, err := mgr.storage.GetSession(ctx, id)
+	if err != nil {
+		return err
+	}
+	// sessions which have just expired are not found either
+	if session == nil || session.Subject != subject || session.ttl() < 0 {
+		return status.Errorf(codes.NotFound, "session %s of %s not found", id, subject)
+	}
+	if err := mgr.storage.RevokeToken(ctx, id, session.ttl()); err != nil {
+		return err
+	}
+	return mgr.storage.RemoveSession(ctx, session)
+}
+
+// CountSessions returns the number of active sessions
+func (mgr *SessionManager) CountSessions(ctx context.Context) (int, error) {
+	return mgr.storage.CountSessions(ctx)
+}
+
 func LoggedIn(ctx context.Context) bool {
 	return Sub(ctx) != "" && ctx.Value(AuthErrorCtxKey) == nil
 }
@@ -580,12 +689,23 @@ func Sub(ctx context.Context) string {
 	return jwtutil.StringField(mapClaims, "sub")
 }
 
-func Groups(ctx context.Context, scopes []string) []string {
+// Jti returns the unique identifier of the token of the current session
+func Jti(ctx context.Context) string {
+	mapClaims, ok := mapClaims(ctx)
+	if !ok {
+		return ""
+	}
+	return jwtutil.StringField(mapClaims, "jti")
+}
+
+// Groups returns the groups of the claims in the context, which are the values of the given scopes followed by the
+// groups extracted using the given expressions
+func Groups(ctx context.Context, scopes []string, expressions ...jwtutil.ClaimsExpression) []string {
 	mapClaims, ok := mapClaims(ctx)
 	if !ok {
 		return nil
 	}
-	return jwtutil.GetGroups(mapClaims, scopes)
+	return jwtutil.GetGroups(mapClaims, scopes, expressions...)
 }
 
 func mapClaims(ctx context.Context) (jwt.MapClaims, bool) {
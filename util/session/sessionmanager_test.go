@@ -27,6 +27,7 @@ import (
 	"github.com/argoproj/argo-cd/v2/pkg/client/listers/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v2/test"
 	"github.com/argoproj/argo-cd/v2/util/errors"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
 	"github.com/argoproj/argo-cd/v2/util/password"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 	utiltest "github.com/argoproj/argo-cd/v2/util/test"
@@ -102,6 +103,29 @@ func TestSessionManager_AdminToken(t *testing.T) {
 	}
 }
 
+func TestSessionManager_ScopedToken(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	token, err := mgr.CreateScoped("admin:login", 0, "123", []string{"applications, sync, */*"})
+	require.NoError(t, err)
+
+	claims, _, err := mgr.Parse(token)
+	require.NoError(t, err)
+	mapClaims := *(claims.(*jwt.MapClaims))
+	assert.Equal(t, "admin", mapClaims["sub"])
+	assert.Equal(t, []interface{}{"applications, sync, */*"}, mapClaims["scopes"])
+
+	token, err = mgr.Create("admin:login", 0, "456")
+	require.NoError(t, err)
+	claims, _, err = mgr.Parse(token)
+	require.NoError(t, err)
+	assert.NotContains(t, *(claims.(*jwt.MapClaims)), "scopes")
+}
+
 func TestSessionManager_AdminToken_ExpiringSoon(t *testing.T) {
 	redisClient, closer := test.NewInMemoryRedis()
 	defer closer()
@@ -248,6 +272,9 @@ func TestSub(t *testing.T) {
 func TestGroups(t *testing.T) {
 	assert.Empty(t, Groups(loggedOutContext, []string{"groups"}))
 	assert.Equal(t, []string{"baz"}, Groups(loggedInContext, []string{"groups"}))
+	expressions, err := jwtutil.CompileClaimsExpressions([]string{`"user:" + email`})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"baz", "user:bar"}, Groups(loggedInContext, []string{"groups"}, expressions...))
 }
 
 func TestVerifyUsernamePassword(t *testing.T) {
@@ -733,3 +760,38 @@ requestedScopes: ["oidc"]`, oidcTestServer.URL),
 		assert.NotContains(t, err.Error(), "certificate signed by unknown authority")
 	})
 }
+
+func TestSessionManager_Sessions(t *testing.T) {
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+	ctx := context.Background()
+
+	settingsMgr := settings.NewSettingsManager(ctx, getKubeClient("pass", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+
+	token, err := mgr.Create("admin:login", 3600, "123")
+	require.NoError(t, err)
+	require.NoError(t, mgr.AddSession(ctx, token, "Mozilla/5.0", "10.0.0.1"))
+
+	sessions, err := mgr.GetSessions(ctx, "admin")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, "123", sessions[0].ID)
+	assert.Equal(t, "Mozilla/5.0", sessions[0].UserAgent)
+	assert.Equal(t, "10.0.0.1", sessions[0].ClientIP)
+	require.NotNil(t, sessions[0].ExpiresAt)
+
+	// tokens which are not tracked as sessions are ignored
+	assert.NoError(t, mgr.TouchSession(ctx, jwt.MapClaims{"iss": "https://dex.example.com", "sub": "admin", "jti": "456"}))
+
+	err = mgr.RevokeSession(ctx, "alice", "123")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	require.NoError(t, mgr.RevokeSession(ctx, "admin", "123"))
+	_, _, err = mgr.Parse(token)
+	assert.ErrorContains(t, err, "token is revoked")
+	sessions, err = mgr.GetSessions(ctx, "admin")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+	err = mgr.RevokeSession(ctx, "admin", "123")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
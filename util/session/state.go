@@ -2,6 +2,11 @@ package session
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -15,14 +20,54 @@ import (
 const (
 	revokedTokenPrefix = "revoked-token|"
 	newRevokedTokenKey = "new-revoked-token"
+	sessionPrefix      = "session|"
+	userSessionsPrefix = "user-sessions|"
+	// sessionsKey is a sorted set of the ids of all sessions, scored by their expiry, which keeps track of the
+	// number of active sessions without scanning the keyspace
+	sessionsKey = "sessions"
+	// sessionTouchInterval is the minimum interval between updates of the time a session was last used
+	sessionTouchInterval = time.Minute
+	// missingKeyTTL is returned by the redis TTL command if the key does not exist
+	missingKeyTTL = time.Duration(-2)
 )
 
+// SessionInfo is the metadata of an active session, which is stored until the session expires or is revoked
+type SessionInfo struct {
+	// ID is the unique identifier of the session token
+	ID string `json:"id"`
+	// Subject is the account or RBAC subject of the session
+	Subject   string     `json:"subject"`
+	IssuedAt  time.Time  `json:"issuedAt"`
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	LastUsed  time.Time  `json:"lastUsed"`
+	UserAgent string     `json:"userAgent,omitempty"`
+	ClientIP  string     `json:"clientIP,omitempty"`
+}
+
+// ttl returns the duration until the session expires, or 0 if it never expires
+func (s *SessionInfo) ttl() time.Duration {
+	if s.ExpiresAt == nil {
+		return 0
+	}
+	return time.Until(*s.ExpiresAt)
+}
+
+// expiryScore returns the score of the session in the set of all sessions, which is the unix time it expires at
+func (s *SessionInfo) expiryScore() float64 {
+	if s.ExpiresAt == nil {
+		return math.Inf(1)
+	}
+	return float64(s.ExpiresAt.Unix())
+}
+
 type userStateStorage struct {
 	attempts       map[string]LoginAttempts
 	redis          *redis.Client
 	revokedTokens  map[string]bool
 	lock           sync.RWMutex
 	resyncDuration time.Duration
+	touchedLock    sync.Mutex
+	touched        map[string]time.Time
 }
 
 var _ UserStateStorage = &userStateStorage{}
@@ -33,6 +78,7 @@ func NewUserStateStorage(redis *redis.Client) *userStateStorage {
 		revokedTokens:  map[string]bool{},
 		resyncDuration: time.Hour,
 		redis:          redis,
+		touched:        map[string]time.Time{},
 	}
 }
 
@@ -125,6 +171,155 @@ func (storage *userStateStorage) IsTokenRevoked(id string) bool {
 	return storage.revokedTokens[id]
 }
 
+func (storage *userStateStorage) checkRedis() error {
+	if storage.redis == nil {
+		return fmt.Errorf("session storage is not available")
+	}
+	return nil
+}
+
+func (storage *userStateStorage) AddSession(ctx context.Context, session *SessionInfo) error {
+	if err := storage.checkRedis(); err != nil {
+		return err
+	}
+	ttl := session.ttl()
+	if ttl < 0 {
+		return nil
+	}
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	if err := storage.redis.Set(ctx, sessionPrefix+session.ID, data, ttl).Err(); err != nil {
+		return err
+	}
+	if err := storage.redis.ZAdd(ctx, sessionsKey, &redis.Z{Score: session.expiryScore(), Member: session.ID}).Err(); err != nil {
+		return err
+	}
+	// the index of the subject sessions expires together with the session which expires last
+	indexKey := userSessionsPrefix + session.Subject
+	indexTTL, err := storage.redis.TTL(ctx, indexKey).Result()
+	if err != nil {
+		return err
+	}
+	if err := storage.redis.SAdd(ctx, indexKey, session.ID).Err(); err != nil {
+		return err
+	}
+	switch {
+	case ttl == 0:
+		return storage.redis.Persist(ctx, indexKey).Err()
+	case indexTTL == missingKeyTTL || (indexTTL >= 0 && indexTTL < ttl):
+		return storage.redis.Expire(ctx, indexKey, ttl).Err()
+	}
+	return nil
+}
+
+func (storage *userStateStorage) GetSession(ctx context.Context, id string) (*SessionInfo, error) {
+	if err := storage.checkRedis(); err != nil {
+		return nil, err
+	}
+	data, err := storage.redis.Get(ctx, sessionPrefix+id).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var session SessionInfo
+	if err := json.Unmarshal(data, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (storage *userStateStorage) GetSessions(ctx context.Context, subject string) ([]SessionInfo, error) {
+	if err := storage.checkRedis(); err != nil {
+		return nil, err
+	}
+	indexKey := userSessionsPrefix + subject
+	ids, err := storage.redis.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	sessions := make([]SessionInfo, 0, len(ids))
+	for _, id := range ids {
+		session, err := storage.GetSession(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil {
+			// the session has expired
+			if err := storage.redis.SRem(ctx, indexKey, id).Err(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		sessions = append(sessions, *session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].IssuedAt.After(sessions[j].IssuedAt)
+	})
+	return sessions, nil
+}
+
+func (storage *userStateStorage) TouchSession(ctx context.Context, id string, lastUsed time.Time) error {
+	if err := storage.checkRedis(); err != nil {
+		return err
+	}
+	storage.touchedLock.Lock()
+	if lastUsed.Sub(storage.touched[id]) < sessionTouchInterval {
+		storage.touchedLock.Unlock()
+		return nil
+	}
+	for touchedID, touchedAt := range storage.touched {
+		if lastUsed.Sub(touchedAt) >= sessionTouchInterval {
+			delete(storage.touched, touchedID)
+		}
+	}
+	storage.touched[id] = lastUsed
+	storage.touchedLock.Unlock()
+
+	session, err := storage.GetSession(ctx, id)
+	if err != nil || session == nil {
+		return err
+	}
+	session.LastUsed = lastUsed
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	ttl := session.ttl()
+	if ttl < 0 {
+		return nil
+	}
+	return storage.redis.Set(ctx, sessionPrefix+id, data, ttl).Err()
+}
+
+func (storage *userStateStorage) RemoveSession(ctx context.Context, session *SessionInfo) error {
+	if err := storage.checkRedis(); err != nil {
+		return err
+	}
+	if err := storage.redis.Del(ctx, sessionPrefix+session.ID).Err(); err != nil {
+		return err
+	}
+	if err := storage.redis.ZRem(ctx, sessionsKey, session.ID).Err(); err != nil {
+		return err
+	}
+	return storage.redis.SRem(ctx, userSessionsPrefix+session.Subject, session.ID).Err()
+}
+
+func (storage *userStateStorage) CountSessions(ctx context.Context) (int, error) {
+	if err := storage.checkRedis(); err != nil {
+		return 0, err
+	}
+	// drop the sessions which have expired since they were added
+	now := strconv.FormatInt(time.Now().Unix(), 10)
+	if err := storage.redis.ZRemRangeByScore(ctx, sessionsKey, "-inf", "("+now).Err(); err != nil {
+		return 0, err
+	}
+	count, err := storage.redis.ZCard(ctx, sessionsKey).Result()
+	return int(count), err
+}
+
 type UserStateStorage interface {
 	Init(ctx context.Context)
 	// GetLoginAttempts return number of concurrent login attempts
@@ -135,4 +330,16 @@ type UserStateStorage interface {
 	RevokeToken(ctx context.Context, id string, expiringAt time.Duration) error
 	// IsTokenRevoked checks if given token is revoked
 	IsTokenRevoked(id string) bool
+	// AddSession stores the metadata of a session until it expires
+	AddSession(ctx context.Context, session *SessionInfo) error
+	// GetSession returns the metadata of the session with given id, or nil if the session does not exist
+	GetSession(ctx context.Context, id string) (*SessionInfo, error)
+	// GetSessions returns the active sessions of given subject, the most recently issued first
+	GetSessions(ctx context.Context, subject string) ([]SessionInfo, error)
+	// TouchSession updates the time the session with given id was last used, at most once per minute
+	TouchSession(ctx context.Context, id string, lastUsed time.Time) error
+	// RemoveSession removes the metadata of given session
+	RemoveSession(ctx context.Context, session *SessionInfo) error
+	// CountSessions returns the number of active sessions
+	CountSessions(ctx context.Context) (int, error)
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v2/test"
 
+	goredis "github.com/go-redis/redis/v8"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -27,3 +28,65 @@ func TestUserStateStorage_LoadRevokedTokens(t *testing.T) {
 
 	assert.True(t, storage.IsTokenRevoked("abc"))
 }
+
+func TestUserStateStorage_Sessions(t *testing.T) {
+	redis, closer := test.NewInMemoryRedis()
+	defer closer()
+	ctx := context.Background()
+	storage := NewUserStateStorage(redis)
+
+	now := time.Now().UTC().Truncate(time.Second)
+	expiresAt := now.Add(time.Hour)
+	older := &SessionInfo{ID: "older", Subject: "alice", IssuedAt: now.Add(-time.Minute), LastUsed: now.Add(-time.Minute), ExpiresAt: &expiresAt}
+	newer := &SessionInfo{ID: "newer", Subject: "alice", IssuedAt: now, LastUsed: now, ExpiresAt: &expiresAt, UserAgent: "argocd-client/v2.6.0", ClientIP: "10.0.0.1"}
+	other := &SessionInfo{ID: "other", Subject: "bob", IssuedAt: now, LastUsed: now, ExpiresAt: &expiresAt}
+	for _, session := range []*SessionInfo{older, newer, other} {
+		require.NoError(t, storage.AddSession(ctx, session))
+	}
+
+	sessions, err := storage.GetSessions(ctx, "alice")
+	require.NoError(t, err)
+	assert.Equal(t, []SessionInfo{*newer, *older}, sessions)
+	ttl, err := redis.TTL(ctx, userSessionsPrefix+"alice").Result()
+	require.NoError(t, err)
+	assert.Greater(t, ttl, 59*time.Minute)
+
+	// sessions which have expired since they were added are not counted
+	require.NoError(t, redis.ZAdd(ctx, sessionsKey, &goredis.Z{Score: float64(now.Add(-time.Minute).Unix()), Member: "expired"}).Err())
+	count, err := storage.CountSessions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+
+	lastUsed := now.Add(2 * time.Minute)
+	require.NoError(t, storage.TouchSession(ctx, "older", lastUsed))
+	// updates within the touch interval are skipped
+	require.NoError(t, storage.TouchSession(ctx, "older", lastUsed.Add(time.Second)))
+	session, err := storage.GetSession(ctx, "older")
+	require.NoError(t, err)
+	assert.Equal(t, lastUsed, session.LastUsed)
+	require.NoError(t, storage.TouchSession(ctx, "unknown", lastUsed))
+
+	require.NoError(t, storage.RemoveSession(ctx, newer))
+	session, err = storage.GetSession(ctx, "newer")
+	require.NoError(t, err)
+	assert.Nil(t, session)
+	count, err = storage.CountSessions(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// expired sessions are removed from the index
+	require.NoError(t, redis.Del(ctx, sessionPrefix+"older").Err())
+	sessions, err = storage.GetSessions(ctx, "alice")
+	require.NoError(t, err)
+	assert.Empty(t, sessions)
+	ids, err := redis.SMembers(ctx, userSessionsPrefix+"alice").Result()
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestUserStateStorage_SessionsWithoutRedis(t *testing.T) {
+	storage := NewUserStateStorage(nil)
+	assert.Error(t, storage.AddSession(context.Background(), &SessionInfo{ID: "abc", Subject: "alice"}))
+	_, err := storage.GetSessions(context.Background(), "alice")
+	assert.Error(t, err)
+}
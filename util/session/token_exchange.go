@@ -0,0 +1,144 @@
+package session
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	oidcutil "github.com/argoproj/argo-cd/v2/util/oidc"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+)
+
+const (
+	// TokenExchangeIssuerClaim holds the name of the external issuer whose token was exchanged for the session
+	TokenExchangeIssuerClaim = "exchange_iss"
+	// TokenExchangeSubjectClaim holds the subject of the exchanged token, e.g. repo:my-org/my-repo:ref:refs/heads/main
+	TokenExchangeSubjectClaim = "exchange_sub"
+)
+
+// exchangedClaims are the claims of sessions created in exchange for tokens of external issuers
+type exchangedClaims struct {
+	jwt.RegisteredClaims
+	Groups          []string `json:"groups,omitempty"`
+	ExchangeIssuer  string   `json:"exchange_iss"`
+	ExchangeSubject string   `json:"exchange_sub,omitempty"`
+}
+
+// ExchangeToken verifies a token of an external issuer which is configured for token exchange and creates a
+// short-lived session for the RBAC subject the token claims are mapped to. The session expires after the session
+// duration of the issuer, but never outlives the exchanged token.
+func (mgr *SessionManager) ExchangeToken(tokenString string) (string, error) {
+	parser := jwt.NewParser(jwt.WithoutClaimsValidation())
+	var unverified jwt.RegisteredClaims
+	if _, _, err := parser.ParseUnverified(tokenString, &unverified); err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+	issuer, err := mgr.getTokenExchangeIssuer(func(i *settings.TokenExchangeIssuer) bool {
+		return i.Issuer == unverified.Issuer
+	})
+	if err != nil {
+		return "", err
+	}
+	if issuer == nil {
+		return "", status.Errorf(codes.Unauthenticated, "issuer %q is not configured for token exchange", unverified.Issuer)
+	}
+
+	idToken, err := mgr.exchangeProvider(issuer.Issuer).Verify(issuer.Audience, tokenString)
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "failed to verify token: %v", err)
+	}
+	var tokenClaims map[string]interface{}
+	if err := idToken.Claims(&tokenClaims); err != nil {
+		return "", err
+	}
+	subject := issuer.MatchSubject(tokenClaims)
+	if subject == nil {
+		log.Warnf("Token of issuer %s with subject %s is not mapped to any subject", issuer.Name, idToken.Subject)
+		return "", status.Errorf(codes.PermissionDenied, "token of issuer %s is not mapped to any subject", issuer.Name)
+	}
+
+	uniqueId, err := uuid.NewRandom()
+	if err != nil {
+		return "", err
+	}
+	now := time.Now().UTC()
+	expiresAt := now.Add(issuer.GetSessionDuration())
+	if idToken.Expiry.Before(expiresAt) {
+		expiresAt = idToken.Expiry
+	}
+	token, err := mgr.signClaims(exchangedClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(now),
+			Issuer:    SessionManagerClaimsIssuer,
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			Subject:   subject.Subject,
+			ID:        uniqueId.String(),
+		},
+		Groups:          subject.Groups,
+		ExchangeIssuer:  issuer.Name,
+		ExchangeSubject: idToken.Subject,
+	})
+	if err != nil {
+		return "", err
+	}
+	log.Infof("Exchanged token of issuer %s with subject %s for a session of %s", issuer.Name, idToken.Subject, subject.Subject)
+	return token, nil
+}
+
+// parseExchanged verifies the claims of a session created by ExchangeToken. The session is rejected once its issuer
+// is no longer configured for token exchange.
+func (mgr *SessionManager) parseExchanged(claims jwt.MapClaims) error {
+	name := jwtutil.StringField(claims, TokenExchangeIssuerClaim)
+	issuer, err := mgr.getTokenExchangeIssuer(func(i *settings.TokenExchangeIssuer) bool {
+		return i.Name == name
+	})
+	if err != nil {
+		return err
+	}
+	if issuer == nil {
+		return fmt.Errorf("issuer %s is no longer configured for token exchange", name)
+	}
+	if _, err := jwtutil.ExpirationTime(claims); err != nil {
+		return fmt.Errorf("exchanged session has no expiration: %w", err)
+	}
+	id := jwtutil.StringField(claims, "jti")
+	if id == "" || mgr.storage.IsTokenRevoked(id) {
+		return fmt.Errorf("token is revoked, please re-login")
+	}
+	return nil
+}
+
+func (mgr *SessionManager) getTokenExchangeIssuer(match func(i *settings.TokenExchangeIssuer) bool) (*settings.TokenExchangeIssuer, error) {
+	issuers, err := mgr.settingsMgr.GetTokenExchangeIssuers()
+	if err != nil {
+		return nil, err
+	}
+	for i := range issuers {
+		if match(&issuers[i]) {
+			return &issuers[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// exchangeProvider returns the memoized OIDC provider of the given external issuer URL
+func (mgr *SessionManager) exchangeProvider(issuerURL string) oidcutil.Provider {
+	mgr.exchangeProvidersLock.Lock()
+	defer mgr.exchangeProvidersLock.Unlock()
+	if mgr.exchangeProviders == nil {
+		mgr.exchangeProviders = map[string]oidcutil.Provider{}
+	}
+	prov, ok := mgr.exchangeProviders[issuerURL]
+	if !ok {
+		prov = oidcutil.NewOIDCProvider(issuerURL, mgr.exchangeClient)
+		mgr.exchangeProviders[issuerURL] = prov
+	}
+	return prov
+}
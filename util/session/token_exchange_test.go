@@ -0,0 +1,159 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/test"
+	jwtutil "github.com/argoproj/argo-cd/v2/util/jwt"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+	utiltest "github.com/argoproj/argo-cd/v2/util/test"
+)
+
+type ciTokenClaims struct {
+	jwt.RegisteredClaims
+	Repository  string `json:"repository"`
+	Ref         string `json:"ref"`
+	Environment string `json:"environment,omitempty"`
+}
+
+func signCIToken(t *testing.T, claims ciTokenClaims) string {
+	t.Helper()
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(utiltest.PrivateKey)
+	require.NoError(t, err)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS512, claims).SignedString(key)
+	require.NoError(t, err)
+	return token
+}
+
+func TestSessionManager_ExchangeToken(t *testing.T) {
+	oidcTestServer := utiltest.GetOIDCTestServerWithKeys(t)
+	t.Cleanup(oidcTestServer.Close)
+	redisClient, closer := test.NewInMemoryRedis()
+	defer closer()
+
+	tokenExchangeConfig := fmt.Sprintf(`
+- name: github
+  issuer: %s
+  audience: https://argocd.example.com
+  sessionDuration: 10m
+  subjects:
+  - subject: production-deployer
+    groups: [ci]
+    claims:
+      repository: my-org/my-repo
+      ref: refs/heads/main
+      environment: production
+  - subject: ci
+    claims:
+      repository: my-org/*`, oidcTestServer.URL)
+	newExchangeSessionManager := func(config string) *SessionManager {
+		settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClientWithConfig(map[string]string{"oidc.tokenExchange": config}, nil), "argocd")
+		mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(redisClient))
+		// Use test server's client to avoid TLS issues.
+		mgr.exchangeClient = oidcTestServer.Client()
+		return mgr
+	}
+	mgr := newExchangeSessionManager(tokenExchangeConfig)
+	ciClaims := func(repository, ref, environment string) ciTokenClaims {
+		return ciTokenClaims{
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    oidcTestServer.URL,
+				Audience:  jwt.ClaimStrings{"https://argocd.example.com"},
+				Subject:   fmt.Sprintf("repo:%s:ref:%s", repository, ref),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			},
+			Repository:  repository,
+			Ref:         ref,
+			Environment: environment,
+		}
+	}
+	exchange := func(t *testing.T, claims ciTokenClaims) jwt.MapClaims {
+		t.Helper()
+		token, err := mgr.ExchangeToken(signCIToken(t, claims))
+		require.NoError(t, err)
+		parsed, newToken, err := mgr.VerifyToken(token)
+		require.NoError(t, err)
+		assert.Empty(t, newToken)
+		mapClaims, err := jwtutil.MapClaims(parsed)
+		require.NoError(t, err)
+		return mapClaims
+	}
+
+	t.Run("FirstMatchingSubject", func(t *testing.T) {
+		claims := exchange(t, ciClaims("my-org/my-repo", "refs/heads/main", "production"))
+		assert.Equal(t, SessionManagerClaimsIssuer, claims["iss"])
+		assert.Equal(t, "production-deployer", claims["sub"])
+		assert.Equal(t, []interface{}{"ci"}, claims["groups"])
+		assert.Equal(t, "github", claims[TokenExchangeIssuerClaim])
+		assert.Equal(t, "repo:my-org/my-repo:ref:refs/heads/main", claims[TokenExchangeSubjectClaim])
+		expiresAt, err := jwtutil.ExpirationTime(claims)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), expiresAt, 5*time.Second)
+	})
+	t.Run("GlobPattern", func(t *testing.T) {
+		claims := exchange(t, ciClaims("my-org/other-repo", "refs/heads/feature", ""))
+		assert.Equal(t, "ci", claims["sub"])
+		assert.NotContains(t, claims, "groups")
+	})
+	t.Run("SessionDoesNotOutliveToken", func(t *testing.T) {
+		tokenClaims := ciClaims("my-org/my-repo", "refs/heads/main", "")
+		tokenClaims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(2 * time.Minute))
+		expiresAt, err := jwtutil.ExpirationTime(exchange(t, tokenClaims))
+		require.NoError(t, err)
+		assert.WithinDuration(t, tokenClaims.ExpiresAt.Time, expiresAt, time.Second)
+	})
+	t.Run("NoMatchingSubject", func(t *testing.T) {
+		_, err := mgr.ExchangeToken(signCIToken(t, ciClaims("other-org/my-repo", "refs/heads/main", "production")))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("WrongAudience", func(t *testing.T) {
+		claims := ciClaims("my-org/my-repo", "refs/heads/main", "")
+		claims.Audience = jwt.ClaimStrings{"sts.amazonaws.com"}
+		_, err := mgr.ExchangeToken(signCIToken(t, claims))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("ExpiredToken", func(t *testing.T) {
+		claims := ciClaims("my-org/my-repo", "refs/heads/main", "")
+		claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Minute))
+		_, err := mgr.ExchangeToken(signCIToken(t, claims))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("UnknownIssuer", func(t *testing.T) {
+		claims := ciClaims("my-org/my-repo", "refs/heads/main", "")
+		claims.Issuer = "https://gitlab.example.com"
+		_, err := mgr.ExchangeToken(signCIToken(t, claims))
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("InvalidSignature", func(t *testing.T) {
+		token := signCIToken(t, ciClaims("my-org/my-repo", "refs/heads/main", ""))
+		_, err := mgr.ExchangeToken(token[:len(token)-4] + "AAAA")
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+	t.Run("SessionRevoked", func(t *testing.T) {
+		token, err := mgr.ExchangeToken(signCIToken(t, ciClaims("my-org/my-repo", "refs/heads/main", "")))
+		require.NoError(t, err)
+		claims, _, err := mgr.Parse(token)
+		require.NoError(t, err)
+		mapClaims, err := jwtutil.MapClaims(claims)
+		require.NoError(t, err)
+		require.NoError(t, mgr.RevokeToken(context.Background(), jwtutil.StringField(mapClaims, "jti"), time.Hour))
+		_, _, err = mgr.Parse(token)
+		assert.ErrorContains(t, err, "token is revoked")
+	})
+	t.Run("IssuerRemoved", func(t *testing.T) {
+		token, err := mgr.ExchangeToken(signCIToken(t, ciClaims("my-org/my-repo", "refs/heads/main", "")))
+		require.NoError(t, err)
+		_, _, err = newExchangeSessionManager("[]").Parse(token)
+		assert.ErrorContains(t, err, "no longer configured for token exchange")
+	})
+}
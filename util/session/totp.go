@@ -0,0 +1,174 @@
+package session
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	passwordutil "github.com/argoproj/argo-cd/v2/util/password"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/totp"
+)
+
+const (
+	// TOTPIssuer is the issuer shown by authenticator apps
+	TOTPIssuer = "Argo CD"
+	// recoveryCodeCount is the number of recovery codes generated on enrollment
+	recoveryCodeCount = 10
+
+	otpRequiredError            = "One-time password required"
+	totpEnrollmentRequiredError = "Multi-factor authentication is required, please enroll a TOTP authenticator"
+	invalidOTPError             = "Invalid one-time password"
+)
+
+var (
+	// OTPRequiredErr is returned on login if the account has TOTP enabled but no one-time password was supplied
+	OTPRequiredErr = status.Errorf(codes.Unauthenticated, otpRequiredError)
+	// TOTPEnrollmentRequiredErr is returned on login if multi-factor authentication is required but the account has no
+	// confirmed TOTP enrollment
+	TOTPEnrollmentRequiredErr = status.Errorf(codes.FailedPrecondition, totpEnrollmentRequiredError)
+	// InvalidOTPErr is returned on login if the one-time password is invalid
+	InvalidOTPErr = status.Errorf(codes.Unauthenticated, invalidOTPError)
+)
+
+// VerifyOTP verifies the one-time password or an unused recovery code of the given account. One-time passwords are
+// accepted only once and used recovery codes are removed. Accounts without TOTP enabled are not verified.
+func (mgr *SessionManager) VerifyOTP(username string, otp string) error {
+	account, err := mgr.settingsMgr.GetAccount(username)
+	if err != nil {
+		return err
+	}
+	if !account.TOTPEnabled {
+		return nil
+	}
+	if otp == "" {
+		return OTPRequiredErr
+	}
+
+	attempt := mgr.getFailureCount(username)
+	if mgr.exceededFailedLoginAttempts(attempt) {
+		log.Warnf("User %s had too many failed logins (%d)", username, attempt.FailCount)
+		return InvalidLoginErr
+	}
+
+	if counter, valid := totp.ValidateAfter(otp, account.TOTPSecret, time.Now(), account.TOTPLastCounter); valid {
+		// the counter is checked again on update, so that a one-time password is accepted only once
+		err = mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+			if counter <= acc.TOTPLastCounter {
+				return InvalidOTPErr
+			}
+			acc.TOTPLastCounter = counter
+			return nil
+		})
+		if err == InvalidOTPErr {
+			mgr.updateFailureCount(username, true)
+		}
+		if err != nil {
+			return err
+		}
+		mgr.updateFailureCount(username, false)
+		return nil
+	}
+	for _, hash := range account.TOTPRecoveryCodes {
+		if valid, _ := passwordutil.VerifyPassword(otp, hash); !valid {
+			continue
+		}
+		usedHash := hash
+		// the recovery code is checked again on update, so that it is accepted only once
+		err = mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+			var remaining []string
+			for _, h := range acc.TOTPRecoveryCodes {
+				if h != usedHash {
+					remaining = append(remaining, h)
+				}
+			}
+			if len(remaining) == len(acc.TOTPRecoveryCodes) {
+				return InvalidOTPErr
+			}
+			acc.TOTPRecoveryCodes = remaining
+			return nil
+		})
+		if err == InvalidOTPErr {
+			mgr.updateFailureCount(username, true)
+		}
+		if err != nil {
+			return err
+		}
+		log.Warnf("User %s logged in with a recovery code, %d recovery codes left", username, len(account.TOTPRecoveryCodes)-1)
+		mgr.updateFailureCount(username, false)
+		return nil
+	}
+	mgr.updateFailureCount(username, true)
+	return InvalidOTPErr
+}
+
+// EnrollTOTP generates a new TOTP secret for the given account, which has to be confirmed using ConfirmTOTP before it is
+// required on login. Returns the secret and its otpauth:// URI.
+func (mgr *SessionManager) EnrollTOTP(username string) (string, string, error) {
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return "", "", err
+	}
+	err = mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+		if acc.TOTPEnabled {
+			return status.Errorf(codes.FailedPrecondition, "TOTP is already enabled for account '%s', disable it before enrolling again", username)
+		}
+		acc.TOTPSecret = secret
+		acc.TOTPRecoveryCodes = nil
+		acc.TOTPLastCounter = 0
+		return nil
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return secret, totp.KeyURI(TOTPIssuer, username, secret), nil
+}
+
+// ConfirmTOTP verifies the one-time password of a pending TOTP enrollment of the given account and enables TOTP.
+// Returns the generated recovery codes.
+func (mgr *SessionManager) ConfirmTOTP(username string, otp string) ([]string, error) {
+	recoveryCodes, err := totp.GenerateRecoveryCodes(recoveryCodeCount)
+	if err != nil {
+		return nil, err
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i := range recoveryCodes {
+		if hashes[i], err = passwordutil.HashPassword(recoveryCodes[i]); err != nil {
+			return nil, err
+		}
+	}
+	err = mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+		if acc.TOTPEnabled {
+			return status.Errorf(codes.FailedPrecondition, "TOTP is already enabled for account '%s'", username)
+		}
+		if acc.TOTPSecret == "" {
+			return status.Errorf(codes.FailedPrecondition, "account '%s' has no pending TOTP enrollment", username)
+		}
+		counter, valid := totp.ValidateAfter(otp, acc.TOTPSecret, time.Now(), 0)
+		if !valid {
+			return status.Errorf(codes.InvalidArgument, invalidOTPError)
+		}
+		acc.TOTPEnabled = true
+		acc.TOTPRecoveryCodes = hashes
+		// the one-time password used for the confirmation cannot be used to log in
+		acc.TOTPLastCounter = counter
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return recoveryCodes, nil
+}
+
+// DisableTOTP disables TOTP for the given account and removes its secret and recovery codes
+func (mgr *SessionManager) DisableTOTP(username string) error {
+	return mgr.settingsMgr.UpdateAccount(username, func(acc *settings.Account) error {
+		acc.TOTPEnabled = false
+		acc.TOTPSecret = ""
+		acc.TOTPRecoveryCodes = nil
+		acc.TOTPLastCounter = 0
+		return nil
+	})
+}
@@ -0,0 +1,106 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/argoproj/argo-cd/v2/common"
+	"github.com/argoproj/argo-cd/v2/util/settings"
+	"github.com/argoproj/argo-cd/v2/util/totp"
+)
+
+func TestTOTPEnrollment(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("password", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+
+	// TOTP is not verified before it is enabled
+	assert.NoError(t, mgr.VerifyOTP(common.ArgoCDAdminUsername, ""))
+
+	_, err := mgr.ConfirmTOTP(common.ArgoCDAdminUsername, "123456")
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	secret, url, err := mgr.EnrollTOTP(common.ArgoCDAdminUsername)
+	require.NoError(t, err)
+	assert.Contains(t, url, secret)
+	assert.NoError(t, mgr.VerifyOTP(common.ArgoCDAdminUsername, ""))
+
+	_, err = mgr.ConfirmTOTP(common.ArgoCDAdminUsername, "abcdef")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	code, err := totp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+	recoveryCodes, err := mgr.ConfirmTOTP(common.ArgoCDAdminUsername, code)
+	require.NoError(t, err)
+	assert.Len(t, recoveryCodes, recoveryCodeCount)
+
+	account, err := settingsMgr.GetAccount(common.ArgoCDAdminUsername)
+	require.NoError(t, err)
+	assert.True(t, account.TOTPEnabled)
+	assert.Len(t, account.TOTPRecoveryCodes, recoveryCodeCount)
+	assert.NotContains(t, account.TOTPRecoveryCodes, recoveryCodes[0])
+
+	_, _, err = mgr.EnrollTOTP(common.ArgoCDAdminUsername)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	require.NoError(t, mgr.DisableTOTP(common.ArgoCDAdminUsername))
+	account, err = settingsMgr.GetAccount(common.ArgoCDAdminUsername)
+	require.NoError(t, err)
+	assert.False(t, account.TOTPEnabled)
+	assert.Empty(t, account.TOTPSecret)
+	assert.Empty(t, account.TOTPRecoveryCodes)
+}
+
+func TestVerifyOTP(t *testing.T) {
+	settingsMgr := settings.NewSettingsManager(context.Background(), getKubeClient("password", true), "argocd")
+	mgr := newSessionManager(settingsMgr, getProjLister(), NewUserStateStorage(nil))
+	secret, _, err := mgr.EnrollTOTP(common.ArgoCDAdminUsername)
+	require.NoError(t, err)
+	code, err := totp.GenerateCode(secret, time.Now())
+	require.NoError(t, err)
+	recoveryCodes, err := mgr.ConfirmTOTP(common.ArgoCDAdminUsername, code)
+	require.NoError(t, err)
+
+	t.Run("Missing", func(t *testing.T) {
+		assert.Equal(t, OTPRequiredErr, mgr.VerifyOTP(common.ArgoCDAdminUsername, ""))
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		assert.Equal(t, InvalidOTPErr, mgr.VerifyOTP(common.ArgoCDAdminUsername, "abcdef"))
+		assert.Equal(t, 1, mgr.getFailureCount(common.ArgoCDAdminUsername).FailCount)
+	})
+	t.Run("PasswordDoesNotResetFailures", func(t *testing.T) {
+		assert.NoError(t, mgr.VerifyUsernamePassword(common.ArgoCDAdminUsername, "password"))
+		assert.Equal(t, 1, mgr.getFailureCount(common.ArgoCDAdminUsername).FailCount)
+	})
+	t.Run("ConfirmationCode", func(t *testing.T) {
+		assert.Equal(t, InvalidOTPErr, mgr.VerifyOTP(common.ArgoCDAdminUsername, code))
+		assert.Equal(t, 2, mgr.getFailureCount(common.ArgoCDAdminUsername).FailCount)
+	})
+	t.Run("Valid", func(t *testing.T) {
+		// the one-time password of the next period is accepted to tolerate clock drift
+		code, err := totp.GenerateCode(secret, time.Now().Add(totp.Period))
+		require.NoError(t, err)
+		assert.NoError(t, mgr.VerifyOTP(common.ArgoCDAdminUsername, code))
+		assert.Equal(t, 0, mgr.getFailureCount(common.ArgoCDAdminUsername).FailCount)
+
+		// one-time passwords can only be used once
+		assert.Equal(t, InvalidOTPErr, mgr.VerifyOTP(common.ArgoCDAdminUsername, code))
+		account, err := settingsMgr.GetAccount(common.ArgoCDAdminUsername)
+		require.NoError(t, err)
+		assert.NotZero(t, account.TOTPLastCounter)
+	})
+	t.Run("RecoveryCode", func(t *testing.T) {
+		assert.NoError(t, mgr.VerifyOTP(common.ArgoCDAdminUsername, recoveryCodes[0]))
+		account, err := settingsMgr.GetAccount(common.ArgoCDAdminUsername)
+		require.NoError(t, err)
+		assert.Len(t, account.TOTPRecoveryCodes, recoveryCodeCount-1)
+
+		// recovery codes can only be used once
+		assert.Equal(t, InvalidOTPErr, mgr.VerifyOTP(common.ArgoCDAdminUsername, recoveryCodes[0]))
+		assert.NoError(t, mgr.VerifyOTP(common.ArgoCDAdminUsername, recoveryCodes[1]))
+	})
+}
@@ -3,9 +3,11 @@ package settings
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
@@ -21,6 +23,13 @@ const (
 	accountPasswordMtimeSuffix = "passwordMtime"
 	accountEnabledSuffix       = "enabled"
 	accountTokensSuffix        = "tokens"
+	// accountPasswordChangeRequiredSuffix is the suffix of the secret key which indicates that the password must be
+	// changed on the next login
+	accountPasswordChangeRequiredSuffix = "passwordChangeRequired"
+	accountTOTPSecretSuffix             = "totpSecret"
+	accountTOTPEnabledSuffix            = "totpEnabled"
+	accountTOTPRecoveryCodesSuffix      = "totpRecoveryCodes"
+	accountTOTPLastCounterSuffix        = "totpLastCounter"
 
 	// Admin superuser password storage
 	// settingAdminPasswordHashKey designates the key for a root password hash inside a Kubernetes secret.
@@ -29,6 +38,12 @@ const (
 	settingAdminPasswordMtimeKey = "admin.passwordMtime"
 	settingAdminEnabledKey       = "admin.enabled"
 	settingAdminTokensKey        = "admin.tokens"
+	// settingAdminPasswordChangeRequiredKey designates the key which indicates that the admin password must be changed
+	settingAdminPasswordChangeRequiredKey = "admin.passwordChangeRequired"
+	settingAdminTOTPSecretKey             = "admin.totpSecret"
+	settingAdminTOTPEnabledKey            = "admin.totpEnabled"
+	settingAdminTOTPRecoveryCodesKey      = "admin.totpRecoveryCodes"
+	settingAdminTOTPLastCounterKey        = "admin.totpLastCounter"
 )
 
 type AccountCapability string
@@ -45,6 +60,8 @@ type Token struct {
 	ID        string `json:"id"`
 	IssuedAt  int64  `json:"iat"`
 	ExpiresAt int64  `json:"exp,omitempty"`
+	// Scopes restrict the token to the requests within the listed scopes
+	Scopes []string `json:"scopes,omitempty"`
 }
 
 // Account holds local account information
@@ -54,6 +71,72 @@ type Account struct {
 	Enabled       bool
 	Capabilities  []AccountCapability
 	Tokens        []Token
+	// PasswordChangeRequired indicates that the password must be changed on the next login
+	PasswordChangeRequired bool
+	// TOTPSecret is the base32 encoded secret used to verify time-based one-time passwords
+	TOTPSecret string
+	// TOTPEnabled indicates that the enrollment of the TOTP secret was confirmed and one-time passwords are required
+	// on login
+	TOTPEnabled bool
+	// TOTPRecoveryCodes holds the hashes of the unused recovery codes, each of which can be used once instead of a
+	// one-time password
+	TOTPRecoveryCodes []string
+	// TOTPLastCounter is the time step counter of the last accepted one-time password, which prevents one-time
+	// passwords from being reused
+	TOTPLastCounter uint64
+}
+
+// PasswordPolicy holds the password policy of local accounts
+type PasswordPolicy struct {
+	// Pattern is a regular expression passwords must match
+	Pattern string
+	// MinLength is the minimum length of passwords
+	MinLength int
+	// RequiredCharacterClasses is the number of character classes (lowercase and uppercase letters, digits and
+	// symbols) passwords must contain
+	RequiredCharacterClasses int
+	// MaxAge is the duration after which passwords expire, zero if passwords never expire
+	MaxAge time.Duration
+}
+
+// Validate returns an error if the given password does not satisfy the policy
+func (p *PasswordPolicy) Validate(password string) error {
+	if len(password) < p.MinLength {
+		return status.Errorf(codes.InvalidArgument, "password must be at least %d characters long", p.MinLength)
+	}
+	if p.RequiredCharacterClasses > 0 {
+		var lower, upper, digit, symbol int
+		for _, r := range password {
+			switch {
+			case unicode.IsLower(r):
+				lower = 1
+			case unicode.IsUpper(r):
+				upper = 1
+			case unicode.IsDigit(r):
+				digit = 1
+			default:
+				symbol = 1
+			}
+		}
+		if lower+upper+digit+symbol < p.RequiredCharacterClasses {
+			return status.Errorf(codes.InvalidArgument, "password must contain characters of at least %d of the classes lowercase letters, uppercase letters, digits and symbols", p.RequiredCharacterClasses)
+		}
+	}
+	if p.Pattern != "" {
+		validPasswordRegexp, err := regexp.Compile(p.Pattern)
+		if err != nil {
+			return err
+		}
+		if !validPasswordRegexp.MatchString(password) {
+			return status.Errorf(codes.InvalidArgument, "New password does not match the following expression: %s.", p.Pattern)
+		}
+	}
+	return nil
+}
+
+// IsExpired returns true if a password which was last modified at the given time has expired
+func (p *PasswordPolicy) IsExpired(passwordMtime *time.Time) bool {
+	return p.MaxAge > 0 && passwordMtime != nil && time.Since(*passwordMtime) > p.MaxAge
 }
 
 // FormatPasswordMtime return the formatted password modify time or empty string of password modify time is nil.
@@ -113,6 +196,34 @@ func (mgr *SettingsManager) AddAccount(name string, account Account) error {
 	return mgr.saveAccount(name, account)
 }
 
+// DeleteAccount deletes the account with the given name. The admin account cannot be deleted.
+func (mgr *SettingsManager) DeleteAccount(name string) error {
+	if name == common.ArgoCDAdminUsername {
+		return status.Errorf(codes.InvalidArgument, "account '%s' cannot be deleted, disable it instead", name)
+	}
+	if _, err := mgr.GetAccount(name); err != nil {
+		return err
+	}
+	isAccountKey := func(key string) bool {
+		return key == fmt.Sprintf("%s.%s", accountsKeyPrefix, name) || strings.HasPrefix(key, fmt.Sprintf("%s.%s.", accountsKeyPrefix, name))
+	}
+	return mgr.updateSecret(func(secret *v1.Secret) error {
+		for key := range secret.Data {
+			if isAccountKey(key) {
+				delete(secret.Data, key)
+			}
+		}
+		return mgr.updateConfigMap(func(cm *v1.ConfigMap) error {
+			for key := range cm.Data {
+				if isAccountKey(key) {
+					delete(cm.Data, key)
+				}
+			}
+			return nil
+		})
+	})
+}
+
 // GetAccount return an account info by the specified name.
 func (mgr *SettingsManager) GetAccount(name string) (*Account, error) {
 	accounts, err := mgr.GetAccounts()
@@ -184,15 +295,33 @@ func saveAccount(secret *v1.Secret, cm *v1.ConfigMap, name string, account Accou
 	if err != nil {
 		return err
 	}
+	recoveryCodes := ""
+	if len(account.TOTPRecoveryCodes) > 0 {
+		recoveryCodesBytes, err := json.Marshal(account.TOTPRecoveryCodes)
+		if err != nil {
+			return err
+		}
+		recoveryCodes = string(recoveryCodesBytes)
+	}
 	if name == common.ArgoCDAdminUsername {
 		updateAccountSecret(secret, settingAdminPasswordHashKey, account.PasswordHash, "")
 		updateAccountSecret(secret, settingAdminPasswordMtimeKey, account.FormatPasswordMtime(), "")
 		updateAccountSecret(secret, settingAdminTokensKey, string(tokens), "[]")
+		updateAccountSecret(secret, settingAdminPasswordChangeRequiredKey, strconv.FormatBool(account.PasswordChangeRequired), "false")
+		updateAccountSecret(secret, settingAdminTOTPSecretKey, account.TOTPSecret, "")
+		updateAccountSecret(secret, settingAdminTOTPEnabledKey, strconv.FormatBool(account.TOTPEnabled), "false")
+		updateAccountSecret(secret, settingAdminTOTPRecoveryCodesKey, recoveryCodes, "")
+		updateAccountSecret(secret, settingAdminTOTPLastCounterKey, strconv.FormatUint(account.TOTPLastCounter, 10), "0")
 		updateAccountMap(cm, settingAdminEnabledKey, strconv.FormatBool(account.Enabled), "true")
 	} else {
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordSuffix), account.PasswordHash, "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordMtimeSuffix), account.FormatPasswordMtime(), "")
 		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix), string(tokens), "[]")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordChangeRequiredSuffix), strconv.FormatBool(account.PasswordChangeRequired), "false")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPSecretSuffix), account.TOTPSecret, "")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPEnabledSuffix), strconv.FormatBool(account.TOTPEnabled), "false")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPRecoveryCodesSuffix), recoveryCodes, "")
+		updateAccountSecret(secret, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPLastCounterSuffix), strconv.FormatUint(account.TOTPLastCounter, 10), "0")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountEnabledSuffix), strconv.FormatBool(account.Enabled), "true")
 		updateAccountMap(cm, fmt.Sprintf("%s.%s", accountsKeyPrefix, name), account.FormatCapabilities(), "")
 	}
@@ -210,6 +339,13 @@ func parseAdminAccount(secret *v1.Secret, cm *v1.ConfigMap) (*Account, error) {
 		}
 	}
 
+	if changeRequired, ok := secret.Data[settingAdminPasswordChangeRequiredKey]; ok {
+		adminAccount.PasswordChangeRequired, _ = strconv.ParseBool(string(changeRequired))
+	}
+	if err := parseTOTP(adminAccount, secret, settingAdminTOTPSecretKey, settingAdminTOTPEnabledKey, settingAdminTOTPRecoveryCodesKey, settingAdminTOTPLastCounterKey); err != nil {
+		return nil, err
+	}
+
 	adminAccount.Tokens = make([]Token, 0)
 	if tokensStr, ok := secret.Data[settingAdminTokensKey]; ok && string(tokensStr) != "" {
 		if err := json.Unmarshal(tokensStr, &adminAccount.Tokens); err != nil {
@@ -228,6 +364,26 @@ func parseAdminAccount(secret *v1.Secret, cm *v1.ConfigMap) (*Account, error) {
 	return adminAccount, nil
 }
 
+func parseTOTP(account *Account, secret *v1.Secret, secretKey string, enabledKey string, recoveryCodesKey string, lastCounterKey string) error {
+	account.TOTPSecret = string(secret.Data[secretKey])
+	if enabled, ok := secret.Data[enabledKey]; ok {
+		account.TOTPEnabled, _ = strconv.ParseBool(string(enabled))
+	}
+	if recoveryCodes, ok := secret.Data[recoveryCodesKey]; ok && len(recoveryCodes) > 0 {
+		if err := json.Unmarshal(recoveryCodes, &account.TOTPRecoveryCodes); err != nil {
+			return fmt.Errorf("failed to parse %s: %w", recoveryCodesKey, err)
+		}
+	}
+	if lastCounter, ok := secret.Data[lastCounterKey]; ok {
+		counter, err := strconv.ParseUint(string(lastCounter), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", lastCounterKey, err)
+		}
+		account.TOTPLastCounter = counter
+	}
+	return nil
+}
+
 func parseAccounts(secret *v1.Secret, cm *v1.ConfigMap) (map[string]Account, error) {
 	adminAccount, err := parseAdminAccount(secret, cm)
 	if err != nil {
@@ -303,6 +459,17 @@ func parseAccounts(secret *v1.Secret, cm *v1.ConfigMap) (map[string]Account, err
 				account.PasswordMtime = &mTime
 			}
 		}
+		if changeRequired, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountPasswordChangeRequiredSuffix)]; ok {
+			account.PasswordChangeRequired, _ = strconv.ParseBool(string(changeRequired))
+		}
+		err = parseTOTP(&account, secret,
+			fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPSecretSuffix),
+			fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPEnabledSuffix),
+			fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPRecoveryCodesSuffix),
+			fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTOTPLastCounterSuffix))
+		if err != nil {
+			return nil, err
+		}
 		if tokensStr, ok := secret.Data[fmt.Sprintf("%s.%s.%s", accountsKeyPrefix, name, accountTokensSuffix)]; ok {
 			account.Tokens = make([]Token, 0)
 			if string(tokensStr) != "" {
@@ -233,3 +233,128 @@ func TestUpdateAccount_AccountDoesNotExist(t *testing.T) {
 	})
 	assert.Error(t, err)
 }
+
+func TestAddAccount_PasswordChangeRequired(t *testing.T) {
+	clientset, settingsManager := fixtures(nil)
+	err := settingsManager.AddAccount("test", Account{
+		Capabilities:           []AccountCapability{AccountCapabilityLogin},
+		Enabled:                true,
+		PasswordChangeRequired: true,
+	})
+	assert.NoError(t, err)
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "true", string(secret.Data["accounts.test.passwordChangeRequired"]))
+
+	acc, err := settingsManager.GetAccount("test")
+	assert.NoError(t, err)
+	assert.True(t, acc.PasswordChangeRequired)
+
+	err = settingsManager.UpdateAccount("admin", func(account *Account) error {
+		account.PasswordChangeRequired = true
+		return nil
+	})
+	assert.NoError(t, err)
+	acc, err = settingsManager.GetAccount("admin")
+	assert.NoError(t, err)
+	assert.True(t, acc.PasswordChangeRequired)
+}
+
+func TestDeleteAccount(t *testing.T) {
+	clientset, settingsManager := fixtures(map[string]string{
+		"accounts.test":          "login",
+		"accounts.test.enabled":  "false",
+		"accounts.tester":        "apiKey",
+		"accounts.tester.enable": "true",
+	}, func(secret *v1.Secret) {
+		secret.Data["accounts.test.password"] = []byte("hash")
+		secret.Data["accounts.test.tokens"] = []byte(`[{"id":"123","iat":0}]`)
+	})
+
+	err := settingsManager.DeleteAccount("test")
+	assert.NoError(t, err)
+
+	cm, err := clientset.CoreV1().ConfigMaps("default").Get(context.Background(), common.ArgoCDConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, cm.Data, "accounts.test")
+	assert.NotContains(t, cm.Data, "accounts.test.enabled")
+	assert.Contains(t, cm.Data, "accounts.tester")
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, secret.Data, "accounts.test.password")
+	assert.NotContains(t, secret.Data, "accounts.test.tokens")
+
+	err = settingsManager.DeleteAccount("test")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	err = settingsManager.DeleteAccount("admin")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := PasswordPolicy{Pattern: common.PasswordPatten, MinLength: 10, RequiredCharacterClasses: 3}
+	assert.NoError(t, policy.Validate("Password123"))
+	assert.ErrorContains(t, policy.Validate("Pass123"), "at least 10 characters")
+	assert.ErrorContains(t, policy.Validate("passwordpassword"), "at least 3 of the classes")
+	assert.ErrorContains(t, policy.Validate("Password123Password123Password123"), "does not match the following expression")
+	assert.NoError(t, (&PasswordPolicy{}).Validate("a"))
+}
+
+func TestPasswordPolicy_IsExpired(t *testing.T) {
+	mTime := time.Now().Add(-48 * time.Hour)
+	assert.False(t, (&PasswordPolicy{}).IsExpired(&mTime))
+	assert.True(t, (&PasswordPolicy{MaxAge: 24 * time.Hour}).IsExpired(&mTime))
+	assert.False(t, (&PasswordPolicy{MaxAge: 72 * time.Hour}).IsExpired(&mTime))
+	assert.False(t, (&PasswordPolicy{MaxAge: 24 * time.Hour}).IsExpired(nil))
+}
+
+func TestUpdateAccount_TOTP(t *testing.T) {
+	clientset, settingsManager := fixtures(map[string]string{"accounts.test": "login"})
+
+	err := settingsManager.UpdateAccount("test", func(account *Account) error {
+		account.TOTPSecret = "SECRET"
+		account.TOTPEnabled = true
+		account.TOTPRecoveryCodes = []string{"hash1", "hash2"}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	secret, err := clientset.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", string(secret.Data["accounts.test.totpSecret"]))
+	assert.Equal(t, "true", string(secret.Data["accounts.test.totpEnabled"]))
+	assert.Equal(t, `["hash1","hash2"]`, string(secret.Data["accounts.test.totpRecoveryCodes"]))
+
+	acc, err := settingsManager.GetAccount("test")
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", acc.TOTPSecret)
+	assert.True(t, acc.TOTPEnabled)
+	assert.Equal(t, []string{"hash1", "hash2"}, acc.TOTPRecoveryCodes)
+
+	err = settingsManager.UpdateAccount("test", func(account *Account) error {
+		account.TOTPSecret = ""
+		account.TOTPEnabled = false
+		account.TOTPRecoveryCodes = nil
+		return nil
+	})
+	assert.NoError(t, err)
+	secret, err = clientset.CoreV1().Secrets("default").Get(context.Background(), common.ArgoCDSecretName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.NotContains(t, secret.Data, "accounts.test.totpSecret")
+	assert.NotContains(t, secret.Data, "accounts.test.totpEnabled")
+	assert.NotContains(t, secret.Data, "accounts.test.totpRecoveryCodes")
+}
+
+func TestGetAdminAccount_TOTP(t *testing.T) {
+	_, settingsManager := fixtures(nil, func(secret *v1.Secret) {
+		secret.Data["admin.totpSecret"] = []byte("SECRET")
+		secret.Data["admin.totpEnabled"] = []byte("true")
+		secret.Data["admin.totpRecoveryCodes"] = []byte(`["hash"]`)
+	})
+	acc, err := settingsManager.GetAccount("admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "SECRET", acc.TOTPSecret)
+	assert.True(t, acc.TOTPEnabled)
+	assert.Equal(t, []string{"hash"}, acc.TOTPRecoveryCodes)
+}
@@ -71,6 +71,10 @@ type ArgoCDSettings struct {
 	WebhookBitbucketServerSecret string `json:"webhookBitbucketServerSecret,omitempty"`
 	// WebhookGogsSecret holds the shared secret for authenticating Gogs webhook events
 	WebhookGogsSecret string `json:"webhookGogsSecret,omitempty"`
+	// WebhookHarborSecret holds the shared secret for authenticating Harbor webhook events
+	WebhookHarborSecret string `json:"webhookHarborSecret,omitempty"`
+	// WebhookRegistrySecret holds the shared secret for authenticating OCI registry notification events
+	WebhookRegistrySecret string `json:"webhookRegistrySecret,omitempty"`
 	// Secrets holds all secrets in argocd-secret as a map[string]string
 	Secrets map[string]string `json:"secrets,omitempty"`
 	// KustomizeBuildOptions is a string of kustomize build parameters
@@ -357,10 +361,16 @@ const (
 	settingsWebhookBitbucketServerSecretKey = "webhook.bitbucketserver.secret"
 	// settingsWebhookGogsSecret is the key for Gogs webhook secret
 	settingsWebhookGogsSecretKey = "webhook.gogs.secret"
+	// settingsWebhookHarborSecretKey is the key for Harbor webhook secret
+	settingsWebhookHarborSecretKey = "webhook.harbor.secret"
+	// settingsWebhookRegistrySecretKey is the key for OCI registry notification webhook secret
+	settingsWebhookRegistrySecretKey = "webhook.registry.secret"
 	// settingsApplicationInstanceLabelKey is the key to configure injected app instance label key
 	settingsApplicationInstanceLabelKey = "application.instanceLabelKey"
 	// settingsResourceTrackingMethodKey is the key to configure tracking method for application resources
 	settingsResourceTrackingMethodKey = "application.resourceTrackingMethod"
+	// settingsImpersonationEnabledKey is the key to configure whether syncs impersonate the project destination service accounts
+	settingsImpersonationEnabledKey = "application.sync.impersonation.enabled"
 	// resourcesCustomizationsKey is the key to the map of resource overrides
 	resourceCustomizationsKey = "resource.customizations"
 	// resourceExclusions is the key to the list of excluded resources
@@ -379,6 +389,8 @@ const (
 	anonymousUserEnabledKey = "users.anonymous.enabled"
 	// userSessionDurationKey is the key which specifies token expiration duration
 	userSessionDurationKey = "users.session.duration"
+	// mfaRequiredKey designates the key which requires local accounts with the login capability to use a second factor
+	mfaRequiredKey = "users.mfa.required"
 	// diffOptions is the key where diff options are configured
 	resourceCompareOptionsKey = "resource.compareoptions"
 	// settingUiCssURLKey designates the key for user-defined CSS URL for UI customization
@@ -407,6 +419,13 @@ const (
 	partOfArgoCDSelector = "app.kubernetes.io/part-of=argocd"
 	// settingsPasswordPatternKey is the key to configure user password regular expression
 	settingsPasswordPatternKey = "passwordPattern"
+	// settingsPasswordMinLengthKey is the key to configure the minimum length of local account passwords
+	settingsPasswordMinLengthKey = "passwordPolicy.minLength"
+	// settingsPasswordRequiredCharacterClassesKey is the key to configure the number of character classes (lowercase
+	// and uppercase letters, digits and symbols) local account passwords must contain
+	settingsPasswordRequiredCharacterClassesKey = "passwordPolicy.requiredCharacterClasses"
+	// settingsPasswordMaxAgeKey is the key to configure the duration after which local account passwords expire
+	settingsPasswordMaxAgeKey = "passwordPolicy.maxAge"
 	// inClusterEnabledKey is the key to configure whether to allow in-cluster server address
 	inClusterEnabledKey = "cluster.inClusterEnabled"
 	// settingsServerRBACLogEnforceEnable is a temp param, it exists in order to mitigate the breaking change introduced by RBAC enforcing on app pod logs
@@ -467,6 +486,10 @@ type ArgoCDDiffOptions struct {
 
 	// If set to true then differences caused by status are ignored.
 	IgnoreResourceStatusField IgnoreStatus `json:"ignoreResourceStatusField,omitempty"`
+
+	// DiffStrategy is the default strategy used to compare live and desired states, one of legacy, structured-merge
+	// and server-side. Applications can select another one with the compare options annotation.
+	DiffStrategy string `json:"diffStrategy,omitempty"`
 }
 
 func (e *incompleteSettingsError) Error() string {
@@ -657,6 +680,18 @@ func (mgr *SettingsManager) GetTrackingMethod() (string, error) {
 	return argoCDCM.Data[settingsResourceTrackingMethodKey], nil
 }
 
+// IsImpersonationEnabled returns whether application syncs impersonate the destination service accounts of the project
+func (mgr *SettingsManager) IsImpersonationEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[settingsImpersonationEnabledKey] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[settingsImpersonationEnabledKey])
+}
+
 func (mgr *SettingsManager) GetPasswordPattern() (string, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -669,6 +704,46 @@ func (mgr *SettingsManager) GetPasswordPattern() (string, error) {
 	return label, nil
 }
 
+// GetPasswordPolicy returns the password policy of local accounts
+func (mgr *SettingsManager) GetPasswordPolicy() (*PasswordPolicy, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	policy := &PasswordPolicy{Pattern: argoCDCM.Data[settingsPasswordPatternKey]}
+	if policy.Pattern == "" {
+		policy.Pattern = common.PasswordPatten
+	}
+	if minLength := argoCDCM.Data[settingsPasswordMinLengthKey]; minLength != "" {
+		if policy.MinLength, err = strconv.Atoi(minLength); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", settingsPasswordMinLengthKey, err)
+		}
+	}
+	if classes := argoCDCM.Data[settingsPasswordRequiredCharacterClassesKey]; classes != "" {
+		if policy.RequiredCharacterClasses, err = strconv.Atoi(classes); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", settingsPasswordRequiredCharacterClassesKey, err)
+		}
+	}
+	if maxAge := argoCDCM.Data[settingsPasswordMaxAgeKey]; maxAge != "" {
+		if policy.MaxAge, err = time.ParseDuration(maxAge); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", settingsPasswordMaxAgeKey, err)
+		}
+	}
+	return policy, nil
+}
+
+// GetMFARequired returns whether local accounts with the login capability must enroll a second factor
+func (mgr *SettingsManager) GetMFARequired() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, err
+	}
+	if argoCDCM.Data[mfaRequiredKey] == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(argoCDCM.Data[mfaRequiredKey])
+}
+
 func (mgr *SettingsManager) GetServerRBACLogEnforceEnable() (bool, error) {
 	argoCDCM, err := mgr.getConfigMap()
 	if err != nil {
@@ -825,6 +900,19 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.UseOpenLibs = useOpenLibs
+		case "useChildren":
+			useChildren, err := strconv.ParseBool(v)
+			if err != nil {
+				return err
+			}
+			overrideVal.UseChildren = useChildren
+		case "healthConditions":
+			healthConditions := &v1alpha1.OverrideHealthConditions{}
+			err := yaml.Unmarshal([]byte(v), healthConditions)
+			if err != nil {
+				return err
+			}
+			overrideVal.HealthConditions = healthConditions
 		case "actions":
 			overrideVal.Actions = v
 		case "ignoreDifferences":
@@ -1334,6 +1422,12 @@ func (mgr *SettingsManager) updateSettingsFromSecret(settings *ArgoCDSettings, a
 	if gogsWebhookSecret := argoCDSecret.Data[settingsWebhookGogsSecretKey]; len(gogsWebhookSecret) > 0 {
 		settings.WebhookGogsSecret = string(gogsWebhookSecret)
 	}
+	if harborWebhookSecret := argoCDSecret.Data[settingsWebhookHarborSecretKey]; len(harborWebhookSecret) > 0 {
+		settings.WebhookHarborSecret = string(harborWebhookSecret)
+	}
+	if registryWebhookSecret := argoCDSecret.Data[settingsWebhookRegistrySecretKey]; len(registryWebhookSecret) > 0 {
+		settings.WebhookRegistrySecret = string(registryWebhookSecret)
+	}
 
 	// The TLS certificate may be externally managed. We try to load it from an
 	// external secret first. If the external secret doesn't exist, we either
@@ -1453,6 +1547,12 @@ func (mgr *SettingsManager) SaveSettings(settings *ArgoCDSettings) error {
 		if settings.WebhookGogsSecret != "" {
 			argoCDSecret.Data[settingsWebhookGogsSecretKey] = []byte(settings.WebhookGogsSecret)
 		}
+		if settings.WebhookHarborSecret != "" {
+			argoCDSecret.Data[settingsWebhookHarborSecretKey] = []byte(settings.WebhookHarborSecret)
+		}
+		if settings.WebhookRegistrySecret != "" {
+			argoCDSecret.Data[settingsWebhookRegistrySecretKey] = []byte(settings.WebhookRegistrySecret)
+		}
 		// we only write the certificate to the secret if it's not externally
 		// managed.
 		if settings.Certificate != nil && !settings.CertificateIsExternal {
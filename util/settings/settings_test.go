@@ -8,6 +8,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/argoproj/argo-cd/v2/common"
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
@@ -196,6 +197,26 @@ func TestGetAppInstanceLabelKey(t *testing.T) {
 	assert.Equal(t, "testLabel", label)
 }
 
+func TestIsImpersonationEnabled(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	enabled, err := settingsManager.IsImpersonationEnabled()
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.sync.impersonation.enabled": "true",
+	})
+	enabled, err = settingsManager.IsImpersonationEnabled()
+	assert.NoError(t, err)
+	assert.True(t, enabled)
+
+	_, settingsManager = fixtures(map[string]string{
+		"application.sync.impersonation.enabled": "yes please",
+	})
+	_, err = settingsManager.IsImpersonationEnabled()
+	assert.Error(t, err)
+}
+
 func TestGetServerRBACLogEnforceEnableKeyDefaultFalse(t *testing.T) {
 	_, settingsManager := fixtures(nil)
 	serverRBACLogEnforceEnable, err := settingsManager.GetServerRBACLogEnforceEnable()
@@ -365,6 +386,10 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.health.cert-manager.io_Certificate":         "bar",
 			"resource.customizations.useOpenLibs.certmanager.k8s.io_Certificate": "false",
 			"resource.customizations.useOpenLibs.cert-manager.io_Certificate":    "true",
+			"resource.customizations.useChildren.cert-manager.io_Certificate":    "true",
+			"resource.customizations.healthConditions.cert-manager.io_Certificate": `conditionType: Ready
+progressingReasons:
+- Issuing`,
 			"resource.customizations.actions.apps_Deployment":                    "bar",
 			"resource.customizations.actions.Deployment":                         "bar",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
@@ -396,6 +421,10 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, "bar", overrides["cert-manager.io/Certificate"].HealthLua)
 		assert.Equal(t, false, overrides["certmanager.k8s.io/Certificate"].UseOpenLibs)
 		assert.Equal(t, true, overrides["cert-manager.io/Certificate"].UseOpenLibs)
+		assert.Equal(t, false, overrides["certmanager.k8s.io/Certificate"].UseChildren)
+		assert.Equal(t, true, overrides["cert-manager.io/Certificate"].UseChildren)
+		assert.Nil(t, overrides["certmanager.k8s.io/Certificate"].HealthConditions)
+		assert.Equal(t, &v1alpha1.OverrideHealthConditions{ConditionType: "Ready", ProgressingReasons: []string{"Issuing"}}, overrides["cert-manager.io/Certificate"].HealthConditions)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
@@ -488,6 +517,16 @@ func TestGetResourceCompareOptions(t *testing.T) {
 		assert.False(t, compareOptions.IgnoreAggregatedRoles)
 	}
 
+	// diffStrategy is set
+	{
+		_, settingsManager := fixtures(map[string]string{
+			"resource.compareoptions": "diffStrategy: server-side",
+		})
+		compareOptions, err := settingsManager.GetResourceCompareOptions()
+		assert.NoError(t, err)
+		assert.Equal(t, "server-side", compareOptions.DiffStrategy)
+	}
+
 	// The empty resource.compareoptions should result in default being returned
 	{
 		_, settingsManager := fixtures(map[string]string{
@@ -1245,3 +1284,40 @@ rootCA: "invalid"`},
 		})
 	}
 }
+
+func TestGetPasswordPolicy(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		policy, err := settingsManager.GetPasswordPolicy()
+		assert.NoError(t, err)
+		assert.Equal(t, &PasswordPolicy{Pattern: common.PasswordPatten}, policy)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{
+			"passwordPattern":                         "^.{12,64}$",
+			"passwordPolicy.minLength":                "12",
+			"passwordPolicy.requiredCharacterClasses": "3",
+			"passwordPolicy.maxAge":                   "2160h",
+		})
+		policy, err := settingsManager.GetPasswordPolicy()
+		assert.NoError(t, err)
+		assert.Equal(t, &PasswordPolicy{Pattern: "^.{12,64}$", MinLength: 12, RequiredCharacterClasses: 3, MaxAge: 2160 * time.Hour}, policy)
+	})
+	t.Run("Invalid", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"passwordPolicy.maxAge": "forever"})
+		_, err := settingsManager.GetPasswordPolicy()
+		assert.ErrorContains(t, err, "failed to parse passwordPolicy.maxAge")
+	})
+}
+
+func TestGetMFARequired(t *testing.T) {
+	_, settingsManager := fixtures(nil)
+	required, err := settingsManager.GetMFARequired()
+	assert.NoError(t, err)
+	assert.False(t, required)
+
+	_, settingsManager = fixtures(map[string]string{"users.mfa.required": "true"})
+	required, err = settingsManager.GetMFARequired()
+	assert.NoError(t, err)
+	assert.True(t, required)
+}
@@ -0,0 +1,154 @@
+package settings
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/argoproj/argo-cd/v2/util/glob"
+)
+
+const (
+	// tokenExchangeKey designates the key for the external issuers whose tokens can be exchanged for Argo CD sessions
+	tokenExchangeKey = "oidc.tokenExchange"
+	// defaultTokenExchangeSessionDuration is the duration of exchanged sessions if none is configured for the issuer
+	defaultTokenExchangeSessionDuration = 15 * time.Minute
+)
+
+// TokenExchangeIssuer is an external OIDC issuer, e.g. GitHub Actions or GitLab CI, whose workload identity tokens can
+// be exchanged for short-lived Argo CD sessions
+type TokenExchangeIssuer struct {
+	// Name identifies the issuer in the exchanged sessions
+	Name string `json:"name"`
+	// Issuer is the issuer URL, which is used to discover the signing keys of the tokens
+	Issuer string `json:"issuer"`
+	// Audience is the audience the tokens must be issued for
+	Audience string `json:"audience"`
+	// SessionDuration is the maximum duration of exchanged sessions, defaults to 15m. Sessions never outlive the
+	// exchanged token.
+	SessionDuration string `json:"sessionDuration,omitempty"`
+	// Subjects map the claims of the tokens to RBAC subjects, the first matching subject is used
+	Subjects []TokenExchangeSubject `json:"subjects"`
+}
+
+// TokenExchangeSubject maps tokens with matching claims to an RBAC subject and groups
+type TokenExchangeSubject struct {
+	// Claims are glob patterns which the claims of the same name must all match, e.g. repository: my-org/*
+	Claims map[string]string `json:"claims"`
+	// Subject is the RBAC subject of the exchanged session
+	Subject string `json:"subject"`
+	// Groups are the RBAC groups of the exchanged session
+	Groups []string `json:"groups,omitempty"`
+}
+
+// GetSessionDuration returns the maximum duration of sessions exchanged for tokens of the issuer
+func (i *TokenExchangeIssuer) GetSessionDuration() time.Duration {
+	if duration, err := time.ParseDuration(i.SessionDuration); err == nil {
+		return duration
+	}
+	return defaultTokenExchangeSessionDuration
+}
+
+// MatchSubject returns the first subject whose claim patterns match the given token claims, or nil if none matches
+func (i *TokenExchangeIssuer) MatchSubject(claims map[string]interface{}) *TokenExchangeSubject {
+	for idx := range i.Subjects {
+		if i.Subjects[idx].Match(claims) {
+			return &i.Subjects[idx]
+		}
+	}
+	return nil
+}
+
+// Match returns whether all claim patterns of the subject match the given token claims. Missing claims never match,
+// list claims match if any of their values matches.
+func (s *TokenExchangeSubject) Match(claims map[string]interface{}) bool {
+	if len(s.Claims) == 0 {
+		return false
+	}
+	for name, pattern := range s.Claims {
+		if !matchClaim(pattern, claims[name]) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchClaim(pattern string, value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return false
+	case []interface{}:
+		for _, item := range v {
+			if matchClaim(pattern, item) {
+				return true
+			}
+		}
+		return false
+	case map[string]interface{}:
+		return false
+	default:
+		return glob.Match(pattern, fmt.Sprint(v))
+	}
+}
+
+func (i *TokenExchangeIssuer) validate() error {
+	if i.Name == "" {
+		return fmt.Errorf("name is required")
+	}
+	if i.Issuer == "" {
+		return fmt.Errorf("issuer %s: issuer URL is required", i.Name)
+	}
+	if i.Audience == "" {
+		return fmt.Errorf("issuer %s: audience is required", i.Name)
+	}
+	if i.SessionDuration != "" {
+		duration, err := time.ParseDuration(i.SessionDuration)
+		if err != nil {
+			return fmt.Errorf("issuer %s: failed to parse sessionDuration: %w", i.Name, err)
+		}
+		if duration <= 0 {
+			return fmt.Errorf("issuer %s: sessionDuration must be positive", i.Name)
+		}
+	}
+	if len(i.Subjects) == 0 {
+		return fmt.Errorf("issuer %s: at least one subject is required", i.Name)
+	}
+	for _, subject := range i.Subjects {
+		if subject.Subject == "" {
+			return fmt.Errorf("issuer %s: subject is required", i.Name)
+		}
+		// a subject without claims would accept the tokens of any workload the issuer issues tokens for
+		if len(subject.Claims) == 0 {
+			return fmt.Errorf("issuer %s: subject %s must match at least one claim", i.Name, subject.Subject)
+		}
+	}
+	return nil
+}
+
+// GetTokenExchangeIssuers returns the external issuers whose tokens can be exchanged for Argo CD sessions
+func (mgr *SettingsManager) GetTokenExchangeIssuers() ([]TokenExchangeIssuer, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	value, ok := argoCDCM.Data[tokenExchangeKey]
+	if !ok {
+		return nil, nil
+	}
+	issuers := make([]TokenExchangeIssuer, 0)
+	if err := yaml.Unmarshal([]byte(value), &issuers); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", tokenExchangeKey, err)
+	}
+	names := map[string]bool{}
+	for idx := range issuers {
+		if err := issuers[idx].validate(); err != nil {
+			return nil, fmt.Errorf("invalid %s: %w", tokenExchangeKey, err)
+		}
+		if names[issuers[idx].Name] {
+			return nil, fmt.Errorf("invalid %s: duplicate issuer name %s", tokenExchangeKey, issuers[idx].Name)
+		}
+		names[issuers[idx].Name] = true
+	}
+	return issuers, nil
+}
@@ -0,0 +1,77 @@
+package settings
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTokenExchangeIssuers(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		_, settingsManager := fixtures(nil)
+		issuers, err := settingsManager.GetTokenExchangeIssuers()
+		require.NoError(t, err)
+		assert.Empty(t, issuers)
+	})
+	t.Run("Configured", func(t *testing.T) {
+		_, settingsManager := fixtures(map[string]string{"oidc.tokenExchange": `
+- name: gitlab
+  issuer: https://gitlab.example.com
+  audience: https://argocd.example.com
+  sessionDuration: 5m
+  subjects:
+  - subject: deployer
+    groups: [ci]
+    claims:
+      project_path: my-group/*
+      ref_protected: "true"`})
+		issuers, err := settingsManager.GetTokenExchangeIssuers()
+		require.NoError(t, err)
+		require.Len(t, issuers, 1)
+		assert.Equal(t, "https://gitlab.example.com", issuers[0].Issuer)
+		assert.Equal(t, 5*time.Minute, issuers[0].GetSessionDuration())
+		assert.Equal(t, []TokenExchangeSubject{{
+			Subject: "deployer",
+			Groups:  []string{"ci"},
+			Claims:  map[string]string{"project_path": "my-group/*", "ref_protected": "true"},
+		}}, issuers[0].Subjects)
+	})
+	for name, config := range map[string]string{
+		"MissingAudience":  `[{name: github, issuer: https://token.actions.githubusercontent.com, subjects: [{subject: ci, claims: {repository: my-org/*}}]}]`,
+		"MissingClaims":    `[{name: github, issuer: https://token.actions.githubusercontent.com, audience: argocd, subjects: [{subject: ci}]}]`,
+		"MissingSubjects":  `[{name: github, issuer: https://token.actions.githubusercontent.com, audience: argocd}]`,
+		"InvalidDuration":  `[{name: github, issuer: https://token.actions.githubusercontent.com, audience: argocd, sessionDuration: soon, subjects: [{subject: ci, claims: {repository: my-org/*}}]}]`,
+		"DuplicateIssuers": `[{name: ci, issuer: https://a.example.com, audience: argocd, subjects: [{subject: ci, claims: {sub: a}}]}, {name: ci, issuer: https://b.example.com, audience: argocd, subjects: [{subject: ci, claims: {sub: b}}]}]`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, settingsManager := fixtures(map[string]string{"oidc.tokenExchange": config})
+			_, err := settingsManager.GetTokenExchangeIssuers()
+			assert.ErrorContains(t, err, "invalid oidc.tokenExchange")
+		})
+	}
+}
+
+func TestTokenExchangeIssuer_MatchSubject(t *testing.T) {
+	issuer := TokenExchangeIssuer{
+		Subjects: []TokenExchangeSubject{
+			{Subject: "production", Claims: map[string]string{"repository": "my-org/my-repo", "environment": "production"}},
+			{Subject: "ci", Claims: map[string]string{"repository": "my-org/*", "ref": "refs/heads/*"}},
+			{Subject: "admins", Claims: map[string]string{"teams": "admins"}},
+		},
+	}
+	match := func(claims map[string]interface{}) string {
+		if subject := issuer.MatchSubject(claims); subject != nil {
+			return subject.Subject
+		}
+		return ""
+	}
+	assert.Equal(t, "production", match(map[string]interface{}{"repository": "my-org/my-repo", "ref": "refs/heads/main", "environment": "production"}))
+	assert.Equal(t, "ci", match(map[string]interface{}{"repository": "my-org/my-repo", "ref": "refs/heads/main"}))
+	assert.Equal(t, "", match(map[string]interface{}{"repository": "my-org/my-repo"}))
+	assert.Equal(t, "", match(map[string]interface{}{"repository": "other-org/my-repo", "ref": "refs/heads/main"}))
+	assert.Equal(t, "admins", match(map[string]interface{}{"teams": []interface{}{"developers", "admins"}}))
+	assert.Equal(t, "", match(map[string]interface{}{"teams": map[string]interface{}{"name": "admins"}}))
+	assert.Equal(t, defaultTokenExchangeSessionDuration, issuer.GetSessionDuration())
+}
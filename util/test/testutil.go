@@ -1,12 +1,15 @@
 package test
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 
+	"github.com/golang-jwt/jwt/v4"
 	"github.com/stretchr/testify/require"
 )
 
@@ -137,4 +140,28 @@ func GetOIDCTestServer(t *testing.T) *httptest.Server {
 		oidcMockHandler(t, ts.URL)(w, r)
 	})
 	return ts
-}
\ No newline at end of file
+}
+
+// GetOIDCTestServerWithKeys returns an OIDC test server like GetOIDCTestServer, which additionally serves the public key
+// of PrivateKey as its JSON Web Key Set, so that tokens signed with PrivateKey are verified successfully.
+func GetOIDCTestServerWithKeys(t *testing.T) *httptest.Server {
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(PrivateKey)
+	require.NoError(t, err)
+	jwks := fmt.Sprintf(`{"keys": [{"kty": "RSA", "use": "sig", "alg": "RS512", "n": "%s", "e": "%s"}]}`,
+		base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()))
+
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Start with a placeholder. We need the server URL before setting up the real handler.
+	}))
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.RequestURI == "/keys" {
+			w.Header().Set("Content-Type", "application/json")
+			_, err := io.WriteString(w, jwks)
+			require.NoError(t, err)
+			return
+		}
+		oidcMockHandler(t, ts.URL)(w, r)
+	})
+	return ts
+}
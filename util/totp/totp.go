@@ -0,0 +1,136 @@
+// Package totp implements time-based one-time passwords as specified in RFC 6238, using the defaults supported by
+// common authenticator apps (HMAC-SHA1, 6 digits and a period of 30 seconds).
+package totp
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	argorand "github.com/argoproj/argo-cd/v2/util/rand"
+)
+
+const (
+	// Digits is the number of digits of one-time passwords
+	Digits = 6
+	// Period is the duration for which a one-time password is valid
+	Period = 30 * time.Second
+	// secretSize is the size of generated secrets in bytes, as recommended by RFC 4226
+	secretSize = 20
+	// skew is the number of periods before and after the current one for which one-time passwords are accepted, to
+	// tolerate clock drift between the server and the authenticator
+	skew = 1
+	// recoveryCodeCharset is the charset of generated recovery codes
+	recoveryCodeCharset = "abcdefghijkmnpqrstuvwxyz23456789"
+	// recoveryCodeLength is the number of characters of each half of a recovery code
+	recoveryCodeLength = 5
+)
+
+var encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateSecret generates a random base32 encoded secret
+func GenerateSecret() (string, error) {
+	secret := make([]byte, secretSize)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate TOTP secret: %w", err)
+	}
+	return encoding.EncodeToString(secret), nil
+}
+
+// GenerateCode returns the one-time password of the given base32 encoded secret at the given time
+func GenerateCode(secret string, t time.Time) (string, error) {
+	key, err := decodeSecret(secret)
+	if err != nil {
+		return "", err
+	}
+	return hotp(key, counter(t)), nil
+}
+
+// Validate returns whether the code is a valid one-time password of the given base32 encoded secret at the given time
+func Validate(code string, secret string, t time.Time) bool {
+	_, valid := ValidateAfter(code, secret, t, 0)
+	return valid
+}
+
+// ValidateAfter returns whether the code is a valid one-time password of the given base32 encoded secret at the given
+// time, which belongs to a later period than the given counter, along with the counter of its period. Storing the
+// counter of an accepted password and passing it on the next validation prevents the password from being reused.
+func ValidateAfter(code string, secret string, t time.Time, lastCounter uint64) (uint64, bool) {
+	key, err := decodeSecret(secret)
+	if err != nil || len(code) != Digits {
+		return 0, false
+	}
+	var matched uint64
+	valid := false
+	current := counter(t)
+	for i := -skew; i <= skew; i++ {
+		c := uint64(int64(current) + int64(i))
+		if subtle.ConstantTimeCompare([]byte(hotp(key, c)), []byte(code)) == 1 && c > lastCounter {
+			matched = c
+			valid = true
+		}
+	}
+	return matched, valid
+}
+
+// KeyURI returns the otpauth:// URI of the given secret, which can be rendered as QR code and scanned by authenticator
+// apps
+func KeyURI(issuer string, accountName string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", Digits))
+	query.Set("period", fmt.Sprintf("%d", int(Period.Seconds())))
+	uri := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + accountName,
+		RawQuery: query.Encode(),
+	}
+	return uri.String()
+}
+
+// GenerateRecoveryCodes generates the given number of random recovery codes
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		code, err := argorand.StringFromCharset(2*recoveryCodeLength, recoveryCodeCharset)
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code[:recoveryCodeLength] + "-" + code[recoveryCodeLength:]
+	}
+	return codes, nil
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	key, err := encoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	return key, nil
+}
+
+func counter(t time.Time) uint64 {
+	return uint64(t.Unix() / int64(Period.Seconds()))
+}
+
+// hotp returns the HMAC-based one-time password of the given key and counter as specified in RFC 4226
+func hotp(key []byte, counter uint64) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, key)
+	_, _ = mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0xf
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", Digits, value%1000000)
+}
@@ -0,0 +1,89 @@
+package totp
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfcSecret is the SHA1 secret of the RFC 6238 test vectors
+var rfcSecret = base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateCode(t *testing.T) {
+	// the RFC 6238 test vectors use 8 digits, the expected codes are the last 6 of them
+	for unix, expected := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		code, err := GenerateCode(rfcSecret, time.Unix(unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, expected, code, unix)
+	}
+
+	_, err := GenerateCode("not base32!", time.Now())
+	assert.Error(t, err)
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	assert.True(t, Validate(code, secret, now))
+	assert.True(t, Validate(code, secret, now.Add(Period)))
+	assert.False(t, Validate(code, secret, now.Add(3*Period)))
+	assert.False(t, Validate("000000"+code, secret, now))
+	assert.False(t, Validate(code, "not base32!", now))
+	otherSecret, err := GenerateSecret()
+	require.NoError(t, err)
+	assert.False(t, Validate(code, otherSecret, now))
+}
+
+func TestValidateAfter(t *testing.T) {
+	secret, err := GenerateSecret()
+	require.NoError(t, err)
+	now := time.Now()
+	code, err := GenerateCode(secret, now)
+	require.NoError(t, err)
+
+	counter, valid := ValidateAfter(code, secret, now, 0)
+	require.True(t, valid)
+	assert.Equal(t, uint64(now.Unix()/int64(Period.Seconds())), counter)
+
+	// the code cannot be reused once its counter was stored, even within the tolerated clock skew
+	_, valid = ValidateAfter(code, secret, now, counter)
+	assert.False(t, valid)
+	_, valid = ValidateAfter(code, secret, now.Add(Period), counter)
+	assert.False(t, valid)
+	_, valid = ValidateAfter(code, secret, now, counter-1)
+	assert.True(t, valid)
+}
+
+func TestKeyURI(t *testing.T) {
+	uri, err := url.Parse(KeyURI("Argo CD", "alice", "SECRET"))
+	require.NoError(t, err)
+	assert.Equal(t, "otpauth", uri.Scheme)
+	assert.Equal(t, "totp", uri.Host)
+	assert.Equal(t, "/Argo CD:alice", uri.Path)
+	assert.Equal(t, "SECRET", uri.Query().Get("secret"))
+	assert.Equal(t, "Argo CD", uri.Query().Get("issuer"))
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(10)
+	require.NoError(t, err)
+	assert.Len(t, codes, 10)
+	for _, code := range codes {
+		assert.Regexp(t, "^[a-z2-9]{5}-[a-z2-9]{5}$", code)
+	}
+}
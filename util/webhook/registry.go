@@ -0,0 +1,216 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// registryEventsMediaType is the content type of notifications sent by registries implementing the distribution
+// notification protocol, e.g. the CNCF Distribution registry, GitLab and Quay
+const registryEventsMediaType = "application/vnd.docker.distribution.events.v1+json"
+
+// Harbor event types which announce a new version of a chart
+const (
+	harborEventPushArtifact = "PUSH_ARTIFACT"
+	harborEventUploadChart  = "UPLOAD_CHART"
+)
+
+var (
+	errInvalidHTTPMethod   = errors.New("invalid HTTP Method")
+	errAuthorizationFailed = errors.New("authorization header does not match the configured secret")
+	errParsingPayload      = errors.New("error parsing payload")
+)
+
+// harborPayload is the payload of Harbor webhook events in the default (non CloudEvents) format.
+// See: https://goharbor.io/docs/main/working-with-projects/project-configuration/configure-webhooks/
+type harborPayload struct {
+	Type      string `json:"type"`
+	OccurAt   int64  `json:"occur_at"`
+	Operator  string `json:"operator"`
+	EventData *struct {
+		Resources []struct {
+			Digest      string `json:"digest"`
+			Tag         string `json:"tag"`
+			ResourceURL string `json:"resource_url"`
+		} `json:"resources"`
+		Repository struct {
+			Name         string `json:"name"`
+			Namespace    string `json:"namespace"`
+			RepoFullName string `json:"repo_full_name"`
+			RepoType     string `json:"repo_type"`
+		} `json:"repository"`
+	} `json:"event_data"`
+}
+
+// registryPayload is the payload of a registry notification.
+// See: https://distribution.github.io/distribution/about/notifications/
+type registryPayload struct {
+	Events []struct {
+		ID     string `json:"id"`
+		Action string `json:"action"`
+		Target struct {
+			MediaType  string `json:"mediaType"`
+			Digest     string `json:"digest"`
+			Repository string `json:"repository"`
+			URL        string `json:"url"`
+			Tag        string `json:"tag"`
+		} `json:"target"`
+		Request struct {
+			Host string `json:"host"`
+		} `json:"request"`
+	} `json:"events"`
+}
+
+// chartChange describes a new version of a Helm chart pushed to a chart repository or an OCI registry
+type chartChange struct {
+	// repoURLs are the possible repository URLs of the chart, without scheme
+	repoURLs []string
+	chart    string
+	version  string
+}
+
+// registryWebhook parses Harbor and OCI registry notification events. Both only support a static Authorization header
+// for authentication, which is compared against the configured secret.
+type registryWebhook struct {
+	secret string
+}
+
+func (hook *registryWebhook) readBody(r *http.Request) ([]byte, error) {
+	defer func() {
+		_, _ = io.Copy(io.Discard, r.Body)
+		_ = r.Body.Close()
+	}()
+	if r.Method != http.MethodPost {
+		return nil, errInvalidHTTPMethod
+	}
+	if hook.secret != "" && subtle.ConstantTimeCompare([]byte(r.Header.Get("Authorization")), []byte(hook.secret)) != 1 {
+		return nil, errAuthorizationFailed
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil || len(body) == 0 {
+		return nil, errParsingPayload
+	}
+	return body, nil
+}
+
+// ParseHarbor verifies and parses a Harbor event
+func (hook *registryWebhook) ParseHarbor(r *http.Request) (harborPayload, error) {
+	var payload harborPayload
+	body, err := hook.readBody(r)
+	if err != nil {
+		return payload, err
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || payload.EventData == nil {
+		return payload, errParsingPayload
+	}
+	return payload, nil
+}
+
+// ParseRegistry verifies and parses a registry notification
+func (hook *registryWebhook) ParseRegistry(r *http.Request) (registryPayload, error) {
+	var payload registryPayload
+	body, err := hook.readBody(r)
+	if err != nil {
+		return payload, err
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return payload, errParsingPayload
+	}
+	return payload, nil
+}
+
+// isRegistryNotification returns whether the request carries a registry notification
+func isRegistryNotification(r *http.Request) bool {
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	return err == nil && mediaType == registryEventsMediaType
+}
+
+// isHarborEvent returns whether the request is likely to carry a Harbor event. Harbor does not send any specific
+// header along with its events, hence the payload is inspected without consuming the request body.
+func isHarborEvent(r *http.Request) bool {
+	if r.Body == nil {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || mediaType != "application/json" {
+		return false
+	}
+	body, err := io.ReadAll(r.Body)
+	_ = r.Body.Close()
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+	var payload harborPayload
+	return json.Unmarshal(body, &payload) == nil && payload.Type != "" && payload.EventData != nil
+}
+
+// registryHost returns the host of a reference such as registry.example.com/library/mychart:1.0.0 or of a registry URL
+func registryHost(ref string) string {
+	ref = strings.TrimPrefix(strings.TrimPrefix(ref, "https://"), "http://")
+	parts := strings.SplitN(ref, "/", 2)
+	if len(parts) != 2 {
+		return ""
+	}
+	return parts[0]
+}
+
+// affectedChartInfo examines a payload from a chart registry webhook event, and extracts the charts which have
+// changed. It returns nil for any payload that is not from a chart registry.
+func affectedChartInfo(payloadIf interface{}) []chartChange {
+	var changes []chartChange
+	switch payload := payloadIf.(type) {
+	case harborPayload:
+		if payload.Type != harborEventPushArtifact && payload.Type != harborEventUploadChart {
+			return []chartChange{}
+		}
+		repo := payload.EventData.Repository
+		for _, res := range payload.EventData.Resources {
+			host := registryHost(res.ResourceURL)
+			if host == "" {
+				continue
+			}
+			changes = append(changes, chartChange{
+				// Harbor serves charts both from its OCI registry and from its (deprecated) ChartMuseum repositories
+				repoURLs: []string{
+					fmt.Sprintf("%s/%s", host, repo.Namespace),
+					fmt.Sprintf("%s/chartrepo/%s", host, repo.Namespace),
+				},
+				chart:   repo.Name,
+				version: res.Tag,
+			})
+		}
+	case registryPayload:
+		for _, event := range payload.Events {
+			if event.Action != "push" || event.Target.Repository == "" {
+				continue
+			}
+			host := event.Request.Host
+			if host == "" {
+				host = registryHost(event.Target.URL)
+			}
+			if host == "" {
+				continue
+			}
+			changes = append(changes, chartChange{
+				repoURLs: []string{path.Join(host, path.Dir(event.Target.Repository))},
+				chart:    path.Base(event.Target.Repository),
+				version:  event.Target.Tag,
+			})
+		}
+	default:
+		return nil
+	}
+	if changes == nil {
+		changes = []chartChange{}
+	}
+	return changes
+}
@@ -25,6 +25,7 @@ import (
 	servercache "github.com/argoproj/argo-cd/v2/server/cache"
 	"github.com/argoproj/argo-cd/v2/util/argo"
 	"github.com/argoproj/argo-cd/v2/util/db"
+	"github.com/argoproj/argo-cd/v2/util/helm"
 	"github.com/argoproj/argo-cd/v2/util/security"
 	"github.com/argoproj/argo-cd/v2/util/settings"
 )
@@ -47,6 +48,8 @@ type ArgoCDWebhookHandler struct {
 	bitbucket       *bitbucket.Webhook
 	bitbucketserver *bitbucketserver.Webhook
 	gogs            *gogs.Webhook
+	harbor          *registryWebhook
+	registry        *registryWebhook
 	settingsSrc     settingsSource
 }
 
@@ -80,6 +83,8 @@ func NewHandler(namespace string, appClientset appclientset.Interface, set *sett
 		bitbucket:       bitbucketWebhook,
 		bitbucketserver: bitbucketserverWebhook,
 		gogs:            gogsWebhook,
+		harbor:          &registryWebhook{secret: set.WebhookHarborSecret},
+		registry:        &registryWebhook{secret: set.WebhookRegistrySecret},
 		settingsSrc:     settingsSrc,
 		repoCache:       repoCache,
 		serverCache:     serverCache,
@@ -201,6 +206,10 @@ type changeInfo struct {
 
 // HandleEvent handles webhook events for repo push events
 func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
+	if charts := affectedChartInfo(payload); charts != nil {
+		a.handleChartEvent(charts)
+		return
+	}
 	webURLs, revision, change, touchedHead, changedFiles := affectedRevisionInfo(payload)
 	// NOTE: the webURL does not include the .git extension
 	if len(webURLs) == 0 {
@@ -252,6 +261,79 @@ func (a *ArgoCDWebhookHandler) HandleEvent(payload interface{}) {
 	}
 }
 
+// handleChartEvent invalidates the cached index of the Helm repositories of the changed charts, and refreshes the
+// applications using them
+func (a *ArgoCDWebhookHandler) handleChartEvent(charts []chartChange) {
+	if len(charts) == 0 {
+		log.Info("Ignoring webhook event")
+		return
+	}
+	for _, chart := range charts {
+		log.Infof("Received push event chart: %s, version: %s, repos: %s", chart.chart, chart.version, strings.Join(chart.repoURLs, ", "))
+	}
+	appIf := a.appClientset.ArgoprojV1alpha1().Applications(a.ns)
+	apps, err := appIf.List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		log.Warnf("Failed to list applications: %v", err)
+		return
+	}
+
+	invalidatedRepos := map[string]bool{}
+	for _, app := range apps.Items {
+		for _, chart := range charts {
+			if !appUsesChart(&app, chart) || !appChartVersionHasChanged(&app, chart.version) {
+				continue
+			}
+			repoURL := app.Spec.Source.RepoURL
+			if !invalidatedRepos[repoURL] {
+				if err := a.repoCache.SetHelmIndex(repoURL, nil); err != nil {
+					log.Warnf("Failed to invalidate the index cache of repo '%s': %v", repoURL, err)
+				}
+				invalidatedRepos[repoURL] = true
+			}
+			_, err = argo.RefreshApp(appIf, app.ObjectMeta.Name, v1alpha1.RefreshTypeNormal)
+			if err != nil {
+				log.Warnf("Failed to refresh app '%s' for controller reprocessing: %v", app.ObjectMeta.Name, err)
+			}
+			break
+		}
+	}
+}
+
+// normalizeChartRepoURL strips the scheme and the trailing slash from a Helm repository URL, so that the URLs of
+// chart repositories and OCI registries can be compared
+func normalizeChartRepoURL(repoURL string) string {
+	repoURL = strings.ToLower(repoURL)
+	for _, scheme := range []string{"oci://", "https://", "http://"} {
+		repoURL = strings.TrimPrefix(repoURL, scheme)
+	}
+	return strings.TrimSuffix(repoURL, "/")
+}
+
+func appUsesChart(app *v1alpha1.Application, chart chartChange) bool {
+	if app.Spec.Source.Chart == "" || app.Spec.Source.Chart != chart.chart {
+		return false
+	}
+	repoURL := normalizeChartRepoURL(app.Spec.Source.RepoURL)
+	for _, chartRepoURL := range chart.repoURLs {
+		if repoURL == normalizeChartRepoURL(chartRepoURL) {
+			log.Debugf("%s uses chart %s of repoURL %s", app.Name, chart.chart, chartRepoURL)
+			return true
+		}
+	}
+	return false
+}
+
+// appChartVersionHasChanged returns whether the pushed version may affect the chart version the app resolves to. Apps
+// pinned to another exact version are not affected, while version constraints may resolve to the pushed version.
+func appChartVersionHasChanged(app *v1alpha1.Application, version string) bool {
+	targetRev := app.Spec.Source.TargetRevision
+	if version == "" || targetRev == "" || !helm.IsVersion(targetRev) {
+		return true
+	}
+	return strings.TrimPrefix(targetRev, "v") == strings.TrimPrefix(version, "v")
+}
+
 // getWebUrlRegex compiles a regex that will match any targetRevision referring to the same repo as the given webURL.
 // webURL is expected to be a URL from an SCM webhook payload pointing to the web page for the repo.
 func getWebUrlRegex(webURL string) (*regexp.Regexp, error) {
@@ -403,6 +485,10 @@ func (a *ArgoCDWebhookHandler) Handler(w http.ResponseWriter, r *http.Request) {
 		payload, err = a.bitbucket.Parse(r, bitbucket.RepoPushEvent)
 	case r.Header.Get("X-Event-Key") != "":
 		payload, err = a.bitbucketserver.Parse(r, bitbucketserver.RepositoryReferenceChangedEvent, bitbucketserver.DiagnosticsPingEvent)
+	case isRegistryNotification(r):
+		payload, err = a.registry.ParseRegistry(r)
+	case isHarborEvent(r):
+		payload, err = a.harbor.ParseHarbor(r)
 	default:
 		log.Debug("Ignoring unknown webhook event")
 		http.Error(w, "Unknown webhook event", http.StatusBadRequest)
@@ -2,6 +2,7 @@ package webhook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -26,6 +27,7 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/argoproj/argo-cd/v2/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v2/pkg/client/clientset/versioned/fake"
@@ -45,10 +47,14 @@ func (f fakeSettingsSrc) GetTrackingMethod() (string, error) {
 	return "", nil
 }
 
-func NewMockHandler() *ArgoCDWebhookHandler {
-	appClientset := appclientset.NewSimpleClientset()
+func NewMockHandler(objects ...runtime.Object) *ArgoCDWebhookHandler {
+	return newMockHandlerWithSettings(&settings.ArgoCDSettings{}, objects...)
+}
+
+func newMockHandlerWithSettings(set *settings.ArgoCDSettings, objects ...runtime.Object) *ArgoCDWebhookHandler {
+	appClientset := appclientset.NewSimpleClientset(objects...)
 	cacheClient := cacheutil.NewCache(cacheutil.NewInMemoryCache(1 * time.Hour))
-	return NewHandler("", appClientset, &settings.ArgoCDSettings{}, &fakeSettingsSrc{}, cache.NewCache(
+	return NewHandler("", appClientset, set, &fakeSettingsSrc{}, cache.NewCache(
 		cacheClient,
 		1*time.Minute,
 		1*time.Minute,
@@ -167,6 +173,94 @@ func TestGitLabPushEvent(t *testing.T) {
 	hook.Reset()
 }
 
+func TestHarborPushEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("Content-Type", "application/json")
+	eventJSON, err := os.ReadFile("testdata/harbor-push-event.json")
+	assert.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	expectedLogResult := "Received push event chart: guestbook, version: 1.2.0, repos: harbor.example.com/library, harbor.example.com/chartrepo/library"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestRegistryPushEvent(t *testing.T) {
+	hook := test.NewGlobal()
+	h := NewMockHandler()
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("Content-Type", "application/vnd.docker.distribution.events.v1+json")
+	eventJSON, err := os.ReadFile("testdata/registry-push-event.json")
+	assert.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusOK)
+	expectedLogResult := "Received push event chart: guestbook, version: 1.2.0, repos: registry.example.com/charts"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestRegistryEventInvalidSecret(t *testing.T) {
+	hook := test.NewGlobal()
+	h := newMockHandlerWithSettings(&settings.ArgoCDSettings{WebhookRegistrySecret: "shhhh"})
+	req := httptest.NewRequest("POST", "/api/webhook", nil)
+	req.Header.Set("Content-Type", "application/vnd.docker.distribution.events.v1+json")
+	req.Header.Set("Authorization", "wrong")
+	eventJSON, err := os.ReadFile("testdata/registry-push-event.json")
+	assert.NoError(t, err)
+	req.Body = io.NopCloser(bytes.NewReader(eventJSON))
+	w := httptest.NewRecorder()
+	h.Handler(w, req)
+	assert.Equal(t, w.Code, http.StatusBadRequest)
+	expectedLogResult := "Webhook processing failed: authorization header does not match the configured secret"
+	assert.Equal(t, expectedLogResult, hook.LastEntry().Message)
+	hook.Reset()
+}
+
+func TestChartEventRefreshesApps(t *testing.T) {
+	newChartApp := func(name, repoURL, chart, targetRevision string) *v1alpha1.Application {
+		return &v1alpha1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: v1alpha1.ApplicationSpec{
+				Source: v1alpha1.ApplicationSource{RepoURL: repoURL, Chart: chart, TargetRevision: targetRevision},
+			},
+		}
+	}
+	h := NewMockHandler(
+		newChartApp("oci", "harbor.example.com/library", "guestbook", "1.2.0"),
+		newChartApp("chartrepo", "https://harbor.example.com/chartrepo/library/", "guestbook", "1.x"),
+		newChartApp("pinned", "harbor.example.com/library", "guestbook", "1.1.0"),
+		newChartApp("other-chart", "harbor.example.com/library", "helm-guestbook", "1.2.0"),
+		newChartApp("git", "https://harbor.example.com/library", "", "HEAD"),
+	)
+	assert.NoError(t, h.repoCache.SetHelmIndex("https://harbor.example.com/chartrepo/library/", []byte("index")))
+
+	payload, err := (&registryWebhook{}).ParseHarbor(func() *http.Request {
+		eventJSON, err := os.ReadFile("testdata/harbor-push-event.json")
+		assert.NoError(t, err)
+		return httptest.NewRequest("POST", "/api/webhook", bytes.NewReader(eventJSON))
+	}())
+	assert.NoError(t, err)
+	h.HandleEvent(payload)
+
+	refreshed := map[string]bool{}
+	apps, err := h.appClientset.ArgoprojV1alpha1().Applications("").List(context.Background(), metav1.ListOptions{})
+	assert.NoError(t, err)
+	for _, app := range apps.Items {
+		_, refreshed[app.Name] = app.Annotations[v1alpha1.AnnotationKeyRefresh]
+	}
+	assert.Equal(t, map[string]bool{"oci": true, "chartrepo": true, "pinned": false, "other-chart": false, "git": false}, refreshed)
+
+	var index []byte
+	err = h.repoCache.GetHelmIndex("https://harbor.example.com/chartrepo/library/", &index)
+	assert.Equal(t, cacheutil.ErrCacheMiss, err)
+}
+
 func TestInvalidMethod(t *testing.T) {
 	hook := test.NewGlobal()
 	h := NewMockHandler()
@@ -424,6 +518,24 @@ func Test_affectedRevisionInfo_appRevisionHasChanged(t *testing.T) {
 	}
 }
 
+func Test_appChartVersionHasChanged(t *testing.T) {
+	appWithRevision := func(targetRevision string) *v1alpha1.Application {
+		return &v1alpha1.Application{Spec: v1alpha1.ApplicationSpec{
+			Source: v1alpha1.ApplicationSource{
+				TargetRevision: targetRevision,
+				Chart:          "guestbook",
+			},
+		}}
+	}
+	assert.True(t, appChartVersionHasChanged(appWithRevision("1.2.0"), "1.2.0"))
+	assert.True(t, appChartVersionHasChanged(appWithRevision("v1.2.0"), "1.2.0"))
+	assert.True(t, appChartVersionHasChanged(appWithRevision("1.*"), "1.2.0"))
+	assert.True(t, appChartVersionHasChanged(appWithRevision(">=1.0.0 <2.0.0"), "1.2.0"))
+	assert.True(t, appChartVersionHasChanged(appWithRevision(""), "1.2.0"))
+	assert.True(t, appChartVersionHasChanged(appWithRevision("1.1.0"), ""))
+	assert.False(t, appChartVersionHasChanged(appWithRevision("1.1.0"), "1.2.0"))
+}
+
 func Test_getWebUrlRegex(t *testing.T) {
 	tests := []struct {
 		shouldMatch bool